@@ -222,6 +222,37 @@ CREATE TABLE `t_group_9`
     KEY           `idx_username` (`username`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE `t_group_expiry_policy`
+(
+    `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`             varchar(32)   DEFAULT NULL COMMENT '分组标识',
+    `expired_url`     varchar(1024) DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)  DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `create_time`     datetime      DEFAULT NULL COMMENT '创建时间',
+    `update_time`     datetime      DEFAULT NULL COMMENT '修改时间',
+    `del_flag`        tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_unique_gid` (`gid`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `t_group_transfer`
+(
+    `id`            bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`           varchar(32)  DEFAULT NULL COMMENT '分组标识',
+    `group_name`    varchar(64)  DEFAULT NULL COMMENT '发起转让时的分组名称',
+    `from_username` varchar(256) DEFAULT NULL COMMENT '转出用户名',
+    `to_username`   varchar(256) DEFAULT NULL COMMENT '接收用户名',
+    `status`        tinyint(1) DEFAULT '0' COMMENT '转让状态 0：待接受 1：已接受 2：已拒绝 3：已取消',
+    `create_time`   datetime     DEFAULT NULL COMMENT '创建时间',
+    `update_time`   datetime     DEFAULT NULL COMMENT '修改时间',
+    `del_flag`      tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    KEY             `idx_gid_status` (`gid`, `status`) USING BTREE,
+    KEY             `idx_to_username_status` (`to_username`, `status`) USING BTREE,
+    KEY             `idx_from_username_status` (`from_username`, `status`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE `t_group_unique`
 (
     `id`  bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -234,416 +265,928 @@ CREATE TABLE `t_link_0`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_1`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_10`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_11`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_12`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_13`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_14`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_15`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_2`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_3`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_4`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_5`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_6`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_7`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_8`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_9`
 (
     `id`              bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
     `domain`          varchar(128)                                   DEFAULT NULL COMMENT '域名',
-    `short_uri`       varchar(8) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
+    `short_uri`       varchar(32) CHARACTER SET utf8 COLLATE utf8_bin DEFAULT NULL COMMENT '短链接',
     `full_short_url`  varchar(128)                                   DEFAULT NULL COMMENT '完整短链接',
     `origin_url`      varchar(1024)                                  DEFAULT NULL COMMENT '原始链接',
     `click_num`       int(11) DEFAULT '0' COMMENT '点击量',
+    `max_clicks`      int(11) DEFAULT '0' COMMENT '最大访问次数 0：不限制',
     `gid`             varchar(32)                                    DEFAULT 'default' COMMENT '分组标识',
     `favicon`         varchar(256)                                   DEFAULT NULL COMMENT '网站图标',
     `enable_status`   tinyint(1) DEFAULT NULL COMMENT '启用标识 0：启用 1：未启用',
     `created_type`    tinyint(1) DEFAULT NULL COMMENT '创建类型 0：接口创建 1：控制台创建',
     `valid_date_type` tinyint(1) DEFAULT NULL COMMENT '有效期类型 0：永久有效 1：自定义',
+    `valid_from`      datetime                                       DEFAULT NULL COMMENT '生效时间，为空表示立即生效',
     `valid_date`      datetime                                       DEFAULT NULL COMMENT '有效期',
+    `expired_url`     varchar(1024)                                  DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message` varchar(256)                                   DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`      int(11) DEFAULT '0' COMMENT '过期宽限天数，宽限期内继续跳转',
+    `redirect_type`   tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `ios_deep_link`   varchar(1024)                                  DEFAULT NULL COMMENT 'iOS深度链接，自定义Scheme或通用链接',
+    `android_package` varchar(256)                                   DEFAULT NULL COMMENT 'Android应用包名',
+    `android_deep_link` varchar(1024)                                DEFAULT NULL COMMENT 'Android深度链接，自定义Scheme或App Links链接',
+    `deep_link_fallback_url` varchar(1024)                           DEFAULT NULL COMMENT '未安装应用时的兜底链接，如应用商店或网页',
+    `og_title`        varchar(256)                                   DEFAULT NULL COMMENT '社交分享预览标题',
+    `og_description`  varchar(512)                                   DEFAULT NULL COMMENT '社交分享预览描述',
+    `og_image`        varchar(1024)                                  DEFAULT NULL COMMENT '社交分享预览图片',
+    `safety_status`   tinyint(1) DEFAULT '0' COMMENT '安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）',
+    `safety_reason`   varchar(256)                                   DEFAULT NULL COMMENT '安全检测命中原因',
+    `health_status`   tinyint(1) DEFAULT '0' COMMENT '目标链接健康状态 0：未检测 1：正常 2：失效',
+    `health_code`     int(3) DEFAULT '0' COMMENT '最近一次检测的HTTP状态码，请求失败时为0',
+    `health_latency`  int(11) DEFAULT '0' COMMENT '最近一次检测的响应耗时（毫秒）',
+    `health_redirects` varchar(2048)                                 DEFAULT NULL COMMENT '最近一次检测的重定向链路（JSON数组）',
+    `health_error`    varchar(256)                                   DEFAULT NULL COMMENT '最近一次检测的失败原因',
+    `health_fail_count` int(11) DEFAULT '0' COMMENT '连续检测失败次数',
+    `health_check_time` datetime                                     DEFAULT NULL COMMENT '最近一次检测时间',
     `describe`        varchar(1024)                                  DEFAULT NULL COMMENT '描述',
+    `password`        varchar(128)                                   DEFAULT NULL COMMENT '访问密码（bcrypt哈希）',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`      varchar(100)                                   DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`    varchar(100)                                   DEFAULT NULL COMMENT 'utm_campaign模板',
     `total_pv`        int(11) DEFAULT NULL COMMENT '历史PV',
     `total_uv`        int(11) DEFAULT NULL COMMENT '历史UV',
     `total_uip`       int(11) DEFAULT NULL COMMENT '历史UIP',
     `create_time`     datetime                                       DEFAULT NULL COMMENT '创建时间',
     `update_time`     datetime                                       DEFAULT NULL COMMENT '修改时间',
+    `recycle_time`    datetime                                       DEFAULT NULL COMMENT '移入回收站时间，不在回收站中时为空',
     `del_time`        bigint(20) DEFAULT '0' COMMENT '删除时间戳',
     `del_flag`        tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE
+    UNIQUE KEY `idx_unique_full-short-url` (`full_short_url`,`del_time`) USING BTREE,
+    KEY `idx_gid_health_status` (`gid`,`health_status`) USING BTREE,
+    KEY `idx_gid_create_time` (`gid`,`create_time`) USING BTREE,
+    KEY `idx_gid_total_pv` (`gid`,`total_pv`) USING BTREE,
+    KEY `idx_gid_total_uv` (`gid`,`total_uv`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 CREATE TABLE `t_link_access_logs`
@@ -657,6 +1200,8 @@ CREATE TABLE `t_link_access_logs`
     `network`        varchar(64)  DEFAULT NULL COMMENT '访问网络',
     `device`         varchar(64)  DEFAULT NULL COMMENT '访问设备',
     `locale`         varchar(256) DEFAULT NULL COMMENT '地区',
+    `variant`        varchar(32)  DEFAULT NULL COMMENT 'A/B分流版本',
+    `channel`        varchar(32)  DEFAULT NULL COMMENT '访问渠道',
     `create_time`    datetime     DEFAULT NULL COMMENT '创建时间',
     `update_time`    datetime     DEFAULT NULL COMMENT '修改时间',
     `del_flag`       tinyint(1) DEFAULT NULL COMMENT '删除标识 0：未删除 1：已删除',
@@ -854,6 +1399,20 @@ CREATE TABLE `t_link_goto_9`
     UNIQUE KEY `idx_full_short_url` (`full_short_url`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE `t_link_history`
+(
+    `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`            varchar(32)  DEFAULT NULL COMMENT '分组标识',
+    `full_short_url` varchar(128) DEFAULT NULL COMMENT '完整短链接',
+    `operator`       varchar(256) DEFAULT NULL COMMENT '操作人',
+    `action`         varchar(16)  DEFAULT NULL COMMENT '操作类型 create/update/enable/disable/recycle/recover/rollback',
+    `changes`        text COMMENT '字段变更，JSON数组',
+    `snapshot`       text COMMENT '变更后的短链接属性，JSON对象，用于回滚',
+    `create_time`    datetime     DEFAULT NULL COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY              `idx_full_short_url` (`full_short_url`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE `t_link_locale_stats`
 (
     `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -871,6 +1430,24 @@ CREATE TABLE `t_link_locale_stats`
     UNIQUE KEY `idx_unique_locale_stats` (`full_short_url`,`date`,`adcode`,`province`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE `t_link_moderation`
+(
+    `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`            varchar(32)   DEFAULT NULL COMMENT '分组标识',
+    `full_short_url` varchar(128)  DEFAULT NULL COMMENT '完整短链接',
+    `origin_url`     varchar(1024) DEFAULT NULL COMMENT '被标记的目标链接',
+    `provider`       varchar(32)   DEFAULT NULL COMMENT '检测来源 blocklist/redis/http/manual',
+    `reason`         varchar(256)  DEFAULT NULL COMMENT '标记原因',
+    `status`         tinyint(1) DEFAULT '0' COMMENT '审核状态 0：待审核 1：已通过 2：已拒绝',
+    `reviewer`       varchar(256)  DEFAULT NULL COMMENT '审核人',
+    `create_time`    datetime      DEFAULT NULL COMMENT '创建时间',
+    `update_time`    datetime      DEFAULT NULL COMMENT '修改时间',
+    `del_flag`       tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    KEY              `idx_status` (`status`) USING BTREE,
+    KEY              `idx_full_short_url` (`full_short_url`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE `t_link_network_stats`
 (
     `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -899,6 +1476,22 @@ CREATE TABLE `t_link_os_stats`
     UNIQUE KEY `idx_unique_os_stats` (`full_short_url`,`date`,`os`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE `t_link_redirect_rule`
+(
+    `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`            varchar(32)   DEFAULT NULL COMMENT '分组标识',
+    `full_short_url` varchar(128)  DEFAULT NULL COMMENT '完整短链接',
+    `rule_type`      varchar(16)   DEFAULT NULL COMMENT '规则类型 os：操作系统 province：省份 language：语言',
+    `rule_value`     varchar(64)   DEFAULT NULL COMMENT '匹配值',
+    `target_url`     varchar(1024) DEFAULT NULL COMMENT '目标链接',
+    `sort_order`     int(11) DEFAULT '0' COMMENT '排序，越小越优先',
+    `create_time`    datetime      DEFAULT NULL COMMENT '创建时间',
+    `update_time`    datetime      DEFAULT NULL COMMENT '修改时间',
+    `del_flag`       tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    KEY              `idx_full_short_url` (`full_short_url`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE `t_link_stats_today`
 (
     `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -914,6 +1507,57 @@ CREATE TABLE `t_link_stats_today`
     UNIQUE KEY `idx_unique_today_stats` (`full_short_url`,`date`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+CREATE TABLE `t_link_tag`
+(
+    `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`            varchar(32)  DEFAULT NULL COMMENT '分组标识',
+    `full_short_url` varchar(128) DEFAULT NULL COMMENT '完整短链接',
+    `tag`            varchar(32)  DEFAULT NULL COMMENT '标签',
+    `create_time`    datetime     DEFAULT NULL COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_unique_full_short_url_tag` (`full_short_url`, `tag`) USING BTREE,
+    KEY              `idx_gid_tag` (`gid`, `tag`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `t_link_template`
+(
+    `id`                 bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `username`           varchar(256)  DEFAULT NULL COMMENT '用户名',
+    `name`               varchar(64)   DEFAULT NULL COMMENT '模板名称',
+    `gid`                varchar(32)   DEFAULT NULL COMMENT '默认分组标识',
+    `domain`             varchar(128)  DEFAULT NULL COMMENT '默认域名',
+    `valid_days`         int(11) DEFAULT '0' COMMENT '有效天数，从创建时开始计算，0表示永久有效',
+    `describe`           varchar(1024) DEFAULT NULL COMMENT '描述模板，支持{date}和{week}占位符',
+    `query_param_policy` tinyint(1) DEFAULT '0' COMMENT '查询参数策略 0：忽略 1：透传 2：合并',
+    `utm_source`         varchar(128)  DEFAULT NULL COMMENT 'utm_source模板',
+    `utm_medium`         varchar(128)  DEFAULT NULL COMMENT 'utm_medium模板',
+    `utm_campaign`       varchar(128)  DEFAULT NULL COMMENT 'utm_campaign模板',
+    `redirect_type`      tinyint(1) DEFAULT '0' COMMENT '跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转',
+    `expired_url`        varchar(1024) DEFAULT NULL COMMENT '过期后跳转链接',
+    `expired_message`    varchar(256)  DEFAULT NULL COMMENT '过期后提示信息',
+    `grace_days`         int(11) DEFAULT '0' COMMENT '过期宽限天数',
+    `tags`               varchar(1024) DEFAULT NULL COMMENT '标签，JSON数组',
+    `create_time`        datetime      DEFAULT NULL COMMENT '创建时间',
+    `update_time`        datetime      DEFAULT NULL COMMENT '修改时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_unique_username_name` (`username`, `name`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `t_link_variant`
+(
+    `id`             bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `gid`            varchar(32)   DEFAULT NULL COMMENT '分组标识',
+    `full_short_url` varchar(128)  DEFAULT NULL COMMENT '完整短链接',
+    `name`           varchar(32)   DEFAULT NULL COMMENT '版本名称',
+    `target_url`     varchar(1024) DEFAULT NULL COMMENT '目标链接',
+    `weight`         int(11) DEFAULT '0' COMMENT '流量权重（百分比）',
+    `create_time`    datetime      DEFAULT NULL COMMENT '创建时间',
+    `update_time`    datetime      DEFAULT NULL COMMENT '修改时间',
+    `del_flag`       tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    KEY              `idx_full_short_url` (`full_short_url`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE `t_user_0`
 (
     `id`            bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
@@ -1169,3 +1813,21 @@ CREATE TABLE `t_user_9`
     PRIMARY KEY (`id`),
     UNIQUE KEY `idx_unique_username` (`username`) USING BTREE
 ) ENGINE=InnoDB AUTO_INCREMENT=1726852231086505986 DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `t_user_domain`
+(
+    `id`            bigint(20) NOT NULL AUTO_INCREMENT COMMENT 'ID',
+    `username`      varchar(256) DEFAULT NULL COMMENT '用户名',
+    `domain`        varchar(128) DEFAULT NULL COMMENT '自定义域名',
+    `verify_status` tinyint(1) DEFAULT '0' COMMENT '验证状态 0：未验证 1：已验证',
+    `verify_token`  varchar(64)  DEFAULT NULL COMMENT '域名验证令牌，配置在DNS TXT记录中',
+    `apple_app_ids` varchar(1024) DEFAULT NULL COMMENT 'iOS应用标识列表（TeamID.BundleID），逗号分隔',
+    `android_package` varchar(256) DEFAULT NULL COMMENT 'Android应用包名',
+    `android_cert_fingerprints` varchar(2048) DEFAULT NULL COMMENT 'Android应用签名证书SHA256指纹列表，逗号分隔',
+    `create_time`   datetime     DEFAULT NULL COMMENT '创建时间',
+    `update_time`   datetime     DEFAULT NULL COMMENT '修改时间',
+    `del_flag`      tinyint(1) DEFAULT '0' COMMENT '删除标识 0：未删除 1：已删除',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_unique_domain` (`domain`) USING BTREE,
+    KEY             `idx_username` (`username`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
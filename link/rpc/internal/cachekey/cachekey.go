@@ -0,0 +1,45 @@
+// Package cachekey 定义logic和后台任务共用的短链接Redis缓存键模板
+package cachekey
+
+import "fmt"
+
+// 短链接缓存键模板，%s为不带协议的完整短链接
+const (
+	// 短链接跳转前缀Key
+	ShortLinkGotoKey = "short-link:goto:%s"
+	// 短链接跳转缓存键，保存目标链接
+	GotoShortLinkKey = "link:goto:%s"
+	// 短链接空值跳转前缀Key
+	ShortLinkIsNullGotoKey = "short-link:is-null:goto_%s"
+	// 短链接空值缓存键
+	GotoIsNullShortLinkKey = "link:is-null:goto_%s"
+	// 短链接社交分享预览前缀Key
+	ShortLinkPreviewKey = "short-link:preview:%s"
+	// 短链接剩余访问次数前缀Key
+	ShortLinkClicksRemainingKey = "short-link:clicks:remaining:%s"
+	// 短链接UV统计前缀Key
+	ShortLinkStatsUvKey = "short-link:stats:uv:%s"
+	// 短链接UIP统计前缀Key
+	ShortLinkStatsUipKey = "short-link:stats:uip:%s"
+)
+
+// GotoKeys 返回短链接的跳转缓存键，短链接修改、移入回收站或清除时删除
+// 二维码和预览页缓存以参数哈希为键，依赖过期时间清除
+func GotoKeys(fullShortUrl string) []string {
+	return []string{
+		fmt.Sprintf(ShortLinkGotoKey, fullShortUrl),
+		fmt.Sprintf(GotoShortLinkKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkIsNullGotoKey, fullShortUrl),
+		fmt.Sprintf(GotoIsNullShortLinkKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkPreviewKey, fullShortUrl),
+	}
+}
+
+// StatsKeys 返回短链接的剩余访问次数和访问统计缓存键，短链接清除时删除
+func StatsKeys(fullShortUrl string) []string {
+	return []string{
+		fmt.Sprintf(ShortLinkClicksRemainingKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkStatsUvKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkStatsUipKey, fullShortUrl),
+	}
+}
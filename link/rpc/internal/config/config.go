@@ -47,4 +47,95 @@ type Config struct {
 		Details []string `json:",optional"`
 		Names   string   `json:",optional"`
 	}
+
+	// 自定义短链接后缀配置
+	CustomUri struct {
+		MinLength     int      `json:",default=3"`
+		MaxLength     int      `json:",default=32"`
+		ReservedWords []string `json:",optional"` // 额外保留字，与默认保留字合并
+	}
+
+	// 短链接后缀生成策略配置
+	ShortCode struct {
+		Strategy     string  `json:",default=hash,options=hash|random|snowflake|counter"` // 生成策略
+		MinLength    int     `json:",default=6"`                                          // 最小长度（hash、random策略）
+		MaxLength    int     `json:",default=12"`                                         // 最大长度（hash、random策略）
+		MaxFillRatio float64 `json:",default=0.1"`                                        // 键空间占用率阈值，超过后自动增加长度
+		CounterStart int64   `json:",optional"`                                           // counter策略的起始偏移
+	}
+
+	// 短链接访问密码配置
+	LinkPassword struct {
+		MaxAttempts     int    `json:",default=5"`   // 统计窗口内单个IP允许的最大错误次数
+		MaxLinkAttempts int    `json:",default=50"`  // 统计窗口内单个短链接允许的最大错误次数（所有IP合计）
+		WindowSeconds   int    `json:",default=900"` // 错误次数统计窗口（秒）
+		UnlockSecret    string // 解锁令牌签名密钥，通过环境变量配置
+		UnlockMaxAge    int    `json:",default=86400"` // 解锁令牌有效期（秒）
+	}
+
+	// 目标链接安全检测配置，未配置任何检测来源时不检测
+	UrlSafety struct {
+		BlocklistFile          string   `json:",optional"`      // 本地黑名单文件路径
+		BlocklistReloadSeconds int      `json:",default=30"`    // 黑名单文件变更检查间隔（秒）
+		RedisDenyKey           string   `json:",optional"`      // Redis黑名单集合Key
+		HttpEndpoint           string   `json:",optional"`      // 外部检测服务地址
+		HttpToken              string   `json:",optional"`      // 外部检测服务鉴权令牌
+		HttpTimeoutMs          int      `json:",default=3000"`  // 外部检测服务超时时间（毫秒）
+		FailClosed             bool     `json:",default=false"` // 检测服务异常时按可疑链接处理
+		Moderators             []string `json:",optional"`      // 安全审核人员用户名
+	}
+
+	// 短链接二维码配置
+	QrCode struct {
+		Scheme       string `json:",default=http,options=http|https"` // 二维码中短链接使用的协议
+		DefaultSize  int    `json:",default=256"`                     // 默认图片边长（像素）
+		MaxSize      int    `json:",default=2048"`                    // 最大图片边长（像素）
+		CacheSeconds int    `json:",default=86400"`                   // 生成结果缓存时间（秒）
+		LogoMaxBytes int64  `json:",default=1048576"`                 // 中心图标最大字节数
+	}
+
+	// 目标链接健康检测配置，定期检测短链接的目标链接是否可访问
+	LinkHealth struct {
+		Enable          bool   `json:",default=false"` // 是否启用后台检测
+		IntervalSeconds int    `json:",default=3600"`  // 每轮检测间隔（秒）
+		RecheckSeconds  int    `json:",default=86400"` // 同一短链接的最短复检间隔（秒）
+		BatchSize       int    `json:",default=100"`   // 每批从分片中读取的短链接数量
+		Concurrency     int    `json:",default=8"`     // 同时检测的短链接数量
+		HostIntervalMs  int    `json:",default=1000"`  // 同一主机两次请求的最小间隔（毫秒）
+		TimeoutMs       int    `json:",default=5000"`  // 单次请求超时时间（毫秒）
+		MaxRedirects    int    `json:",default=10"`    // 最大重定向次数
+		FailThreshold   int    `json:",default=2"`     // 连续失败达到该次数后标记为失效
+		UserAgent       string `json:",optional"`      // 请求标识，为空时使用默认值
+	}
+
+	// 短链接批量导入配置
+	Import struct {
+		MaxBytes         int `json:",default=2097152"` // 导入文件最大字节数
+		MaxRows          int `json:",default=10000"`   // 单次导入最大行数
+		SyncRows         int `json:",default=20"`      // 不超过该行数时同步处理并直接返回结果，超过时后台处理
+		JobExpireSeconds int `json:",default=86400"`   // 导入任务结果保留时间（秒）
+	}
+
+	// 短链接导出配置
+	Export struct {
+		MaxGids          int `json:",default=20"`      // 单次导出最大分组数
+		SyncLinks        int `json:",default=200"`     // 不超过该短链接数时同步生成文件，超过时后台处理
+		BatchSize        int `json:",default=500"`     // 每批查询的短链接数
+		DefaultStatsDays int `json:",default=30"`      // 未指定日期范围时导出最近多少天的每日访问数据
+		MaxStatsDays     int `json:",default=366"`     // 每日访问数据最大日期跨度（天）
+		ChunkBytes       int `json:",default=1048576"` // 导出文件按该大小分块保存到Redis，下载时每次读取一块
+		JobExpireSeconds int `json:",default=86400"`   // 导出任务和文件保留时间（秒）
+	}
+
+	// 短链接数据保留配置，定期将过期较久的短链接移入回收站，并清除回收站和已永久删除短链接的数据
+	Retention struct {
+		Enable          bool `json:",default=false"` // 是否启用后台清理
+		DryRun          bool `json:",default=false"` // 预演模式，只生成清理报告，不修改数据
+		IntervalSeconds int  `json:",default=86400"` // 每轮清理间隔（秒）
+		BatchSize       int  `json:",default=200"`   // 每批从分片中读取的短链接数量
+		ExpiredDays     int  `json:",default=90"`    // 过期超过该天数的短链接移入回收站，0表示不处理
+		RecycleBinDays  int  `json:",default=30"`    // 移入回收站超过该天数的短链接永久清除，0表示不处理
+		RemovedDays     int  `json:",default=7"`     // 永久删除超过该天数的短链接清除数据，0表示不处理
+		ReportSamples   int  `json:",default=100"`   // 清理报告中每条规则最多列出的短链接数量
+	}
 }
@@ -40,6 +40,8 @@ type StatsRecord struct {
 	Device       string    `json:"device"`
 	Network      string    `json:"network"`
 	Locale       string    `json:"locale"`
+	Variant      string    `json:"variant"`
+	Channel      string    `json:"channel"`
 	CurrentDate  time.Time `json:"current_date"`
 }
 
@@ -127,6 +129,8 @@ func (c *ShortLinkStatsConsumer) Submit(record *StatsRecord) {
 		"device":         record.Device,
 		"network":        record.Network,
 		"locale":         record.Locale,
+		"variant":        record.Variant,
+		"channel":        record.Channel,
 		"current_date":   record.CurrentDate.Format(time.RFC3339),
 	}
 
@@ -309,6 +313,12 @@ func (c *ShortLinkStatsConsumer) parseStreamMessage(msg StreamMessage) (*StatsRe
 	if locale, ok := msg.Fields["locale"]; ok {
 		record.Locale = locale
 	}
+	if variant, ok := msg.Fields["variant"]; ok {
+		record.Variant = variant
+	}
+	if channel, ok := msg.Fields["channel"]; ok {
+		record.Channel = channel
+	}
 	if currentDate, ok := msg.Fields["current_date"]; ok {
 		record.CurrentDate, err = time.Parse(time.RFC3339, currentDate)
 		if err != nil {
@@ -393,10 +403,45 @@ func (c *ShortLinkStatsConsumer) processMessage(record *StatsRecord) error {
 
 // updateBaseStats 更新基础统计
 func (c *ShortLinkStatsConsumer) updateBaseStats(ctx context.Context, db *gorm.DB, record *StatsRecord, gid string) error {
-	// 更新总访问量
+	// 更新总访问量及点击量
+	incrPv := func(gid string) (int64, error) {
+		result := db.WithContext(ctx).Model(&model.Link{}).
+			Where("gid = ? AND full_short_url = ?", gid, record.FullShortUrl).
+			UpdateColumns(map[string]interface{}{
+				"total_pv":  gorm.Expr("total_pv + ?", 1),
+				"click_num": gorm.Expr("click_num + ?", 1),
+			})
+		return result.RowsAffected, result.Error
+	}
+	affected, err := incrPv(gid)
+	if err != nil {
+		return err
+	}
+
+	// 统计消息产生后短链接被移动到其他分组时，按跳转表中的最新分组重新定位
+	if affected == 0 {
+		var linkGoto model.LinkGoto
+		err := c.serviceCtx.GetDBs().GetGotoLinkDB().WithContext(ctx).
+			Where("full_short_url = ?", record.FullShortUrl).
+			First(&linkGoto).Error
+		if err != nil {
+			return fmt.Errorf("查询短链接最新分组失败: %v", err)
+		}
+		if linkGoto.Gid != gid {
+			logx.Infof("[统计] 短链接分组已变更: %s, %s -> %s", record.FullShortUrl, gid, linkGoto.Gid)
+			gid = linkGoto.Gid
+			record.Gid = gid
+			if _, err := incrPv(gid); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 访问次数达到上限时禁用短链接
 	if err := db.WithContext(ctx).Model(&model.Link{}).
-		Where("gid = ? AND full_short_url = ?", gid, record.FullShortUrl).
-		UpdateColumn("total_pv", gorm.Expr("total_pv + ?", 1)).Error; err != nil {
+		Where("gid = ? AND full_short_url = ? AND enable_status = 0 AND max_clicks > 0 AND click_num >= max_clicks",
+			gid, record.FullShortUrl).
+		UpdateColumn("enable_status", 1).Error; err != nil {
 		return err
 	}
 
@@ -551,8 +596,8 @@ func (c *ShortLinkStatsConsumer) updateNetworkStats(ctx context.Context, tx *gor
 func (c *ShortLinkStatsConsumer) insertAccessLog(ctx context.Context, tx *gorm.DB, record *StatsRecord) error {
 	// 插入访问日志记录
 	sql := `INSERT INTO t_link_access_logs 
-            (full_short_url, user, ip, browser, os, network, device, locale, create_time, update_time, del_flag) 
-            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0)`
+            (full_short_url, user, ip, browser, os, network, device, locale, variant, channel, create_time, update_time, del_flag) 
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0)`
 
 	err := tx.Exec(sql,
 		record.FullShortUrl,
@@ -563,6 +608,8 @@ func (c *ShortLinkStatsConsumer) insertAccessLog(ctx context.Context, tx *gorm.D
 		record.Network,
 		record.Device,
 		record.Locale,
+		record.Variant,
+		record.Channel,
 		time.Now(),
 		time.Now()).Error
 
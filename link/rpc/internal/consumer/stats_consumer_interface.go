@@ -15,6 +15,7 @@ type ServiceContext interface {
 type DBInterface interface {
 	GetCommon() *gorm.DB
 	GetLinkDB() *gorm.DB
+	GetGotoLinkDB() *gorm.DB
 }
 
 // StatsConsumer 统计消费者接口
@@ -0,0 +1,182 @@
+// Package linkhistory 生成短链接变更记录，供logic和后台任务共用
+package linkhistory
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pkg/util"
+)
+
+// 短链接变更操作类型
+const (
+	ActionCreate   = "create"
+	ActionUpdate   = "update"
+	ActionEnable   = "enable"
+	ActionDisable  = "disable"
+	ActionRecycle  = "recycle"
+	ActionRecover  = "recover"
+	ActionRollback = "rollback"
+)
+
+// 变更记录中访问密码的展示值
+const passwordMask = "******"
+
+// Snapshot 变更记录中保存的短链接属性，永久有效的短链接不记录有效期
+type Snapshot struct {
+	OriginUrl           string `json:"originUrl"`
+	Describe            string `json:"describe"`
+	ValidDateType       int    `json:"validDateType"`
+	ValidDate           string `json:"validDate"`
+	ValidFrom           string `json:"validFrom"`
+	EnableStatus        int    `json:"enableStatus"`
+	Password            string `json:"password"`
+	MaxClicks           int    `json:"maxClicks"`
+	QueryParamPolicy    int    `json:"queryParamPolicy"`
+	UtmSource           string `json:"utmSource"`
+	UtmMedium           string `json:"utmMedium"`
+	UtmCampaign         string `json:"utmCampaign"`
+	ExpiredUrl          string `json:"expiredUrl"`
+	ExpiredMessage      string `json:"expiredMessage"`
+	GraceDays           int    `json:"graceDays"`
+	RedirectType        int    `json:"redirectType"`
+	IosDeepLink         string `json:"iosDeepLink"`
+	AndroidPackage      string `json:"androidPackage"`
+	AndroidDeepLink     string `json:"androidDeepLink"`
+	DeepLinkFallbackUrl string `json:"deepLinkFallbackUrl"`
+	OgTitle             string `json:"ogTitle"`
+	OgDescription       string `json:"ogDescription"`
+	OgImage             string `json:"ogImage"`
+}
+
+// snapshotFields 参与比较的字段，按展示顺序排列
+var snapshotFields = []struct {
+	name  string
+	value func(s *Snapshot) string
+}{
+	{"originUrl", func(s *Snapshot) string { return s.OriginUrl }},
+	{"describe", func(s *Snapshot) string { return s.Describe }},
+	{"validDateType", func(s *Snapshot) string { return strconv.Itoa(s.ValidDateType) }},
+	{"validDate", func(s *Snapshot) string { return s.ValidDate }},
+	{"validFrom", func(s *Snapshot) string { return s.ValidFrom }},
+	{"enableStatus", func(s *Snapshot) string { return strconv.Itoa(s.EnableStatus) }},
+	{"password", func(s *Snapshot) string { return s.Password }},
+	{"maxClicks", func(s *Snapshot) string { return strconv.Itoa(s.MaxClicks) }},
+	{"queryParamPolicy", func(s *Snapshot) string { return strconv.Itoa(s.QueryParamPolicy) }},
+	{"utmSource", func(s *Snapshot) string { return s.UtmSource }},
+	{"utmMedium", func(s *Snapshot) string { return s.UtmMedium }},
+	{"utmCampaign", func(s *Snapshot) string { return s.UtmCampaign }},
+	{"expiredUrl", func(s *Snapshot) string { return s.ExpiredUrl }},
+	{"expiredMessage", func(s *Snapshot) string { return s.ExpiredMessage }},
+	{"graceDays", func(s *Snapshot) string { return strconv.Itoa(s.GraceDays) }},
+	{"redirectType", func(s *Snapshot) string { return strconv.Itoa(s.RedirectType) }},
+	{"iosDeepLink", func(s *Snapshot) string { return s.IosDeepLink }},
+	{"androidPackage", func(s *Snapshot) string { return s.AndroidPackage }},
+	{"androidDeepLink", func(s *Snapshot) string { return s.AndroidDeepLink }},
+	{"deepLinkFallbackUrl", func(s *Snapshot) string { return s.DeepLinkFallbackUrl }},
+	{"ogTitle", func(s *Snapshot) string { return s.OgTitle }},
+	{"ogDescription", func(s *Snapshot) string { return s.OgDescription }},
+	{"ogImage", func(s *Snapshot) string { return s.OgImage }},
+}
+
+// Change 保存在变更记录中的字段变更
+type Change struct {
+	Field    string `json:"field"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// NewSnapshot 提取短链接当前的属性
+func NewSnapshot(link *model.Link) *Snapshot {
+	snapshot := &Snapshot{
+		OriginUrl:           link.OriginUrl,
+		Describe:            link.Describe,
+		ValidDateType:       link.ValidDateType,
+		EnableStatus:        link.EnableStatus,
+		Password:            link.Password,
+		MaxClicks:           link.MaxClicks,
+		QueryParamPolicy:    link.QueryParamPolicy,
+		UtmSource:           link.UtmSource,
+		UtmMedium:           link.UtmMedium,
+		UtmCampaign:         link.UtmCampaign,
+		ExpiredUrl:          link.ExpiredUrl,
+		ExpiredMessage:      link.ExpiredMessage,
+		GraceDays:           link.GraceDays,
+		RedirectType:        link.RedirectType,
+		IosDeepLink:         link.IosDeepLink,
+		AndroidPackage:      link.AndroidPackage,
+		AndroidDeepLink:     link.AndroidDeepLink,
+		DeepLinkFallbackUrl: link.DeepLinkFallbackUrl,
+		OgTitle:             link.OgTitle,
+		OgDescription:       link.OgDescription,
+		OgImage:             link.OgImage,
+	}
+	// 永久有效时有效期每次修改都会顺延，不作为变更记录
+	if link.ValidDateType == util.ValidDateTypeCustom {
+		snapshot.ValidDate = link.ValidDate.Format(time.RFC3339)
+	}
+	if link.ValidFrom != nil {
+		snapshot.ValidFrom = link.ValidFrom.Format(time.RFC3339)
+	}
+	return snapshot
+}
+
+// Diff 比较变更前后的属性，访问密码只记录是否设置
+func Diff(before, after *Snapshot) []Change {
+	var changes []Change
+	for _, field := range snapshotFields {
+		oldValue, newValue := field.value(before), field.value(after)
+		if oldValue == newValue {
+			continue
+		}
+		if field.name == "password" {
+			oldValue, newValue = maskPassword(oldValue), maskPassword(newValue)
+		}
+		changes = append(changes, Change{Field: field.name, OldValue: oldValue, NewValue: newValue})
+	}
+	return changes
+}
+
+// maskPassword 隐藏访问密码
+func maskPassword(password string) string {
+	if password == "" {
+		return ""
+	}
+	return passwordMask
+}
+
+// New 根据变更前的属性和短链接当前的属性生成变更记录，属性没有变化时返回nil
+// 创建短链接时before传nil，记录创建时的属性，使最初的版本也可以回滚
+func New(action, operator string, before *Snapshot, link *model.Link) (*model.LinkHistory, error) {
+	if before == nil {
+		before = &Snapshot{}
+	}
+	after := NewSnapshot(link)
+	changes := Diff(before, after)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return newHistory(action, operator, link, changes, after)
+}
+
+func newHistory(action, operator string, link *model.Link, changes []Change, snapshot *Snapshot) (*model.LinkHistory, error) {
+	changesJson, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	snapshotJson, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LinkHistory{
+		Gid:          link.Gid,
+		FullShortUrl: link.FullShortUrl,
+		Operator:     operator,
+		Action:       action,
+		Changes:      string(changesJson),
+		Snapshot:     string(snapshotJson),
+		CreateTime:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type DomainAppLinksGetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDomainAppLinksGetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DomainAppLinksGetLogic {
+	return &DomainAppLinksGetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询域名关联的应用信息，无需登录，仅返回已验证的域名
+func (l *DomainAppLinksGetLogic) DomainAppLinksGet(in *pb.GetDomainAppLinksRequest) (*pb.GetDomainAppLinksResponse, error) {
+	domain := util.NormalizeHost(in.Domain)
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "域名不能为空")
+	}
+
+	userDomain, err := l.svcCtx.RepoManager.UserDomain.FindVerifiedByDomain(l.ctx, domain)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "域名不存在或未验证")
+		}
+		l.Logger.Errorf("查询域名失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询域名失败")
+	}
+
+	return &pb.GetDomainAppLinksResponse{
+		AppLinks: &pb.DomainAppLinks{
+			Domain:                  userDomain.Domain,
+			AppleAppIds:             util.SplitList(userDomain.AppleAppIds),
+			AndroidPackage:          userDomain.AndroidPackage,
+			AndroidCertFingerprints: util.SplitList(userDomain.AndroidCertFingerprints),
+		},
+	}, nil
+}
@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 域名应用关联配置限制
+const (
+	// 单个域名最多关联的iOS应用数
+	AppleAppIdsMax = 10
+	// 单个应用最多配置的签名证书指纹数
+	CertFingerprintsMax = 10
+)
+
+type DomainAppLinksSaveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDomainAppLinksSaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DomainAppLinksSaveLogic {
+	return &DomainAppLinksSaveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 保存域名关联的应用信息，只有已验证域名的所有者可以修改
+func (l *DomainAppLinksSaveLogic) DomainAppLinksSave(in *pb.SaveDomainAppLinksRequest) (*pb.SaveDomainAppLinksResponse, error) {
+	domain := util.NormalizeHost(in.Domain)
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "域名不能为空")
+	}
+
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	// 校验iOS应用标识
+	if len(in.AppleAppIds) > AppleAppIdsMax {
+		return nil, status.Errorf(codes.InvalidArgument, "最多关联%d个iOS应用", AppleAppIdsMax)
+	}
+	appleAppIds := make([]string, 0, len(in.AppleAppIds))
+	for _, appId := range in.AppleAppIds {
+		appId = strings.TrimSpace(appId)
+		if !util.IsValidAppleAppId(appId) {
+			return nil, status.Errorf(codes.InvalidArgument, "iOS应用标识 %s 格式错误，应为TeamID.BundleID", appId)
+		}
+		appleAppIds = append(appleAppIds, appId)
+	}
+
+	// 校验Android应用包名和签名证书指纹，两者需同时配置
+	androidPackage := strings.TrimSpace(in.AndroidPackage)
+	if androidPackage != "" && !util.IsValidAndroidPackage(androidPackage) {
+		return nil, status.Error(codes.InvalidArgument, "Android应用包名格式错误")
+	}
+	if len(in.AndroidCertFingerprints) > CertFingerprintsMax {
+		return nil, status.Errorf(codes.InvalidArgument, "最多配置%d个签名证书指纹", CertFingerprintsMax)
+	}
+	fingerprints := make([]string, 0, len(in.AndroidCertFingerprints))
+	for _, fingerprint := range in.AndroidCertFingerprints {
+		normalized := util.NormalizeCertFingerprint(fingerprint)
+		if normalized == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "签名证书指纹 %s 格式错误", fingerprint)
+		}
+		fingerprints = append(fingerprints, normalized)
+	}
+	if (androidPackage == "") != (len(fingerprints) == 0) {
+		return nil, status.Error(codes.InvalidArgument, "Android应用包名和签名证书指纹需同时配置")
+	}
+
+	userDomain, err := l.svcCtx.RepoManager.UserDomain.FindVerifiedByUsernameAndDomain(l.ctx, username, domain)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "域名不存在或未验证")
+		}
+		l.Logger.Errorf("查询用户域名失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询用户域名失败")
+	}
+
+	err = l.svcCtx.RepoManager.UserDomain.UpdateAppLinks(l.ctx, userDomain.ID,
+		strings.Join(appleAppIds, ","), androidPackage, strings.Join(fingerprints, ","))
+	if err != nil {
+		l.Logger.Errorf("保存域名应用关联失败: %v", err)
+		return nil, status.Error(codes.Internal, "保存域名应用关联失败")
+	}
+
+	return &pb.SaveDomainAppLinksResponse{
+		Success: true,
+	}, nil
+}
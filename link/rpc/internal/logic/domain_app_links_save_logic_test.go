@@ -0,0 +1,85 @@
+package logic_test
+
+import (
+	"fmt"
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// TestDomainAppLinksSave_Normal 测试保存并查询域名应用关联
+func TestDomainAppLinksSave_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-applink-user"
+	domain := fmt.Sprintf("applink-%d.example.com", time.Now().UnixNano())
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+	fingerprint := strings.TrimSuffix(strings.Repeat("AB:", 32), ":")
+
+	// 准备当前用户已验证的域名
+	userDomain := &model.UserDomain{
+		Username:     username,
+		Domain:       domain,
+		VerifyStatus: repo.DomainVerifyStatusVerified,
+		CreateTime:   time.Now(),
+		UpdateTime:   time.Now(),
+	}
+	if err := svcCtx.RepoManager.UserDomain.Create(ctx, userDomain); err != nil {
+		t.Errorf("创建域名失败: %v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if err := svcCtx.RepoManager.GetCommonDB().Delete(userDomain).Error; err != nil {
+			t.Logf("清理域名失败: %v", err)
+		}
+	})
+
+	// 签名证书指纹格式错误时保存失败
+	saveLogic := logic.NewDomainAppLinksSaveLogic(userCtx, svcCtx)
+	if _, err := saveLogic.DomainAppLinksSave(&pb.SaveDomainAppLinksRequest{
+		Domain:                  domain,
+		AndroidPackage:          "com.example.app",
+		AndroidCertFingerprints: []string{"invalid"},
+	}); err == nil {
+		t.Error("期望签名证书指纹格式错误时保存失败，但实际成功")
+		return
+	}
+
+	// 其他用户不能修改该域名
+	otherLogic := logic.NewDomainAppLinksSaveLogic(metadata.NewIncomingContext(ctx, metadata.Pairs("username", "other-user")), svcCtx)
+	if _, err := otherLogic.DomainAppLinksSave(&pb.SaveDomainAppLinksRequest{Domain: domain}); err == nil {
+		t.Error("期望非域名所有者保存失败，但实际成功")
+		return
+	}
+
+	_, err := saveLogic.DomainAppLinksSave(&pb.SaveDomainAppLinksRequest{
+		Domain:                  domain,
+		AppleAppIds:             []string{"ABCDE12345.com.example.app"},
+		AndroidPackage:          "com.example.app",
+		AndroidCertFingerprints: []string{strings.ToLower(fingerprint)},
+	})
+	if err != nil {
+		t.Errorf("保存域名应用关联失败: %v", err)
+		return
+	}
+
+	// 查询无需登录，指纹统一为大写
+	resp, err := logic.NewDomainAppLinksGetLogic(ctx, svcCtx).DomainAppLinksGet(&pb.GetDomainAppLinksRequest{Domain: domain + ":443"})
+	if err != nil {
+		t.Errorf("查询域名应用关联失败: %v", err)
+		return
+	}
+	if len(resp.AppLinks.AppleAppIds) != 1 || resp.AppLinks.AndroidPackage != "com.example.app" ||
+		len(resp.AppLinks.AndroidCertFingerprints) != 1 || resp.AppLinks.AndroidCertFingerprints[0] != fingerprint {
+		t.Errorf("域名应用关联不符合预期: %+v", resp.AppLinks)
+		return
+	}
+
+	t.Logf("域名应用关联保存成功: %+v", resp.AppLinks)
+}
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GroupExpiryPolicyGetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupExpiryPolicyGetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupExpiryPolicyGetLogic {
+	return &GroupExpiryPolicyGetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询分组过期策略
+func (l *GroupExpiryPolicyGetLogic) GroupExpiryPolicyGet(in *pb.GetGroupExpiryPolicyRequest) (*pb.GetGroupExpiryPolicyResponse, error) {
+	if err := checkGroupOwner(l.ctx, l.svcCtx, in.Gid); err != nil {
+		return nil, err
+	}
+
+	result := &pb.GroupExpiryPolicy{Gid: in.Gid}
+	if policy := findGroupExpiryPolicy(l.ctx, l.svcCtx, in.Gid); policy != nil {
+		result.ExpiredUrl = policy.ExpiredUrl
+		result.ExpiredMessage = policy.ExpiredMessage
+		result.GraceDays = int32(policy.GraceDays)
+	}
+
+	return &pb.GetGroupExpiryPolicyResponse{
+		Policy: result,
+	}, nil
+}
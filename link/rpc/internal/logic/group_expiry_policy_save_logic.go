@@ -0,0 +1,170 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 过期处理配置限制
+const (
+	// 过期提示信息最大长度
+	ExpiredMessageMaxLength = 256
+	// 过期宽限最大天数
+	GraceDaysMax = 365
+)
+
+// linkExpiryPolicy 短链接生效的过期处理配置，短链接未配置的字段使用分组配置
+type linkExpiryPolicy struct {
+	ExpiredUrl     string
+	ExpiredMessage string
+	GraceDays      int
+}
+
+type GroupExpiryPolicySaveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupExpiryPolicySaveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupExpiryPolicySaveLogic {
+	return &GroupExpiryPolicySaveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 保存分组过期策略
+func (l *GroupExpiryPolicySaveLogic) GroupExpiryPolicySave(in *pb.SaveGroupExpiryPolicyRequest) (*pb.SaveGroupExpiryPolicyResponse, error) {
+	if err := checkGroupOwner(l.ctx, l.svcCtx, in.Gid); err != nil {
+		return nil, err
+	}
+	if err := validateExpirySettings(l.svcCtx, in.ExpiredUrl, in.ExpiredMessage, int(in.GraceDays)); err != nil {
+		return nil, err
+	}
+	// 分组策略没有审核流程，过期后跳转链接未通过安全检测时直接拒绝
+	if in.ExpiredUrl != "" {
+		if safety := checkUrlSafety(l.ctx, l.svcCtx, []string{in.ExpiredUrl}); safety.Flagged {
+			return nil, status.Errorf(codes.FailedPrecondition, "过期后跳转链接未通过安全检测: %s", safety.Reason)
+		}
+	}
+
+	policy := &model.GroupExpiryPolicy{
+		Gid:            in.Gid,
+		ExpiredUrl:     in.ExpiredUrl,
+		ExpiredMessage: strings.TrimSpace(in.ExpiredMessage),
+		GraceDays:      int(in.GraceDays),
+	}
+	if err := l.svcCtx.RepoManager.GroupExpiry.Save(l.ctx, policy); err != nil {
+		l.Logger.Errorf("保存分组过期策略失败: %v", err)
+		return nil, status.Error(codes.Internal, "保存分组过期策略失败")
+	}
+
+	// 清除分组内短链接的跳转缓存，使新策略立即生效
+	l.clearGroupGotoCache(in.Gid)
+
+	return &pb.SaveGroupExpiryPolicyResponse{
+		Success: true,
+	}, nil
+}
+
+// clearGroupGotoCache 分页遍历分组内的短链接并删除跳转缓存
+func (l *GroupExpiryPolicySaveLogic) clearGroupGotoCache(gid string) {
+	const pageSize = 100
+	for page := 1; ; page++ {
+		links, _, err := l.svcCtx.RepoManager.Link.FindByGid(l.ctx, gid, page, pageSize)
+		if err != nil {
+			l.Logger.Errorf("查询分组短链接失败: %v", err)
+			return
+		}
+		for _, link := range links {
+			deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+		}
+		if len(links) < pageSize {
+			return
+		}
+	}
+}
+
+// checkGroupOwner 校验分组是否属于当前登录用户
+func checkGroupOwner(ctx context.Context, svcCtx *svc.ServiceContext, gid string) error {
+	if gid == "" {
+		return status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+
+	username, err := svcCtx.RepoManager.GetCurrentUsername(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	ok, err := svcCtx.RepoManager.Group.CheckGroupBelongToUser(ctx, gid, username)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询分组失败: %v", err)
+		return status.Error(codes.Internal, "查询分组失败")
+	}
+	if !ok {
+		return status.Error(codes.NotFound, "分组不存在")
+	}
+	return nil
+}
+
+// validateExpirySettings 校验过期后跳转链接、提示信息和宽限天数，过期后跳转链接与原始链接一样需要在白名单中
+func validateExpirySettings(svcCtx *svc.ServiceContext, expiredUrl, expiredMessage string, graceDays int) error {
+	if expiredUrl != "" {
+		if !util.IsHttpUrl(expiredUrl) {
+			return status.Error(codes.InvalidArgument, "过期后跳转链接格式错误")
+		}
+		if err := verifyTargetWhitelist(svcCtx, expiredUrl); err != nil {
+			return err
+		}
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(expiredMessage)) > ExpiredMessageMaxLength {
+		return status.Errorf(codes.InvalidArgument, "过期提示信息不能超过%d个字符", ExpiredMessageMaxLength)
+	}
+	if graceDays < 0 || graceDays > GraceDaysMax {
+		return status.Errorf(codes.InvalidArgument, "过期宽限天数需在0到%d之间", GraceDaysMax)
+	}
+	return nil
+}
+
+// mergeExpiryPolicy 合并短链接和分组的过期处理配置，短链接的配置优先
+func mergeExpiryPolicy(link *model.Link, group *model.GroupExpiryPolicy) linkExpiryPolicy {
+	policy := linkExpiryPolicy{
+		ExpiredUrl:     link.ExpiredUrl,
+		ExpiredMessage: link.ExpiredMessage,
+		GraceDays:      link.GraceDays,
+	}
+	if group == nil {
+		return policy
+	}
+
+	if policy.ExpiredUrl == "" {
+		policy.ExpiredUrl = group.ExpiredUrl
+	}
+	if policy.ExpiredMessage == "" {
+		policy.ExpiredMessage = group.ExpiredMessage
+	}
+	if policy.GraceDays <= 0 {
+		policy.GraceDays = group.GraceDays
+	}
+	return policy
+}
+
+// findGroupExpiryPolicy 查询分组过期策略，未配置或查询失败时返回空
+func findGroupExpiryPolicy(ctx context.Context, svcCtx *svc.ServiceContext, gid string) *model.GroupExpiryPolicy {
+	policy, err := svcCtx.RepoManager.GroupExpiry.FindByGid(ctx, gid)
+	if err != nil {
+		return nil
+	}
+	return policy
+}
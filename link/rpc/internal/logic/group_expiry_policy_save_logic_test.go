@@ -0,0 +1,88 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestGroupExpiryPolicySave_Normal 测试保存并查询分组过期策略
+func TestGroupExpiryPolicySave_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-expiry-user"
+	gid := "test-expiry"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	// 准备当前用户的分组
+	if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+		Gid:        gid,
+		Name:       "过期策略测试分组",
+		Username:   username,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}); err != nil {
+		t.Errorf("创建分组失败: %v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, gid, username); err != nil {
+			t.Logf("清理分组失败: %v", err)
+		}
+	})
+
+	// 宽限天数超出范围时保存失败
+	saveLogic := logic.NewGroupExpiryPolicySaveLogic(userCtx, svcCtx)
+	if _, err := saveLogic.GroupExpiryPolicySave(&pb.SaveGroupExpiryPolicyRequest{
+		Gid:       gid,
+		GraceDays: 1000,
+	}); err == nil {
+		t.Error("期望宽限天数超出范围时保存失败，但实际成功")
+		return
+	}
+
+	// 过期后跳转链接不在白名单中时保存失败
+	if _, err := saveLogic.GroupExpiryPolicySave(&pb.SaveGroupExpiryPolicyRequest{
+		Gid:        gid,
+		ExpiredUrl: "https://example.com/expired",
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("期望过期后跳转链接不在白名单中时返回 PermissionDenied，实际: %v", err)
+		return
+	}
+
+	// 其他用户不能保存该分组的策略
+	otherLogic := logic.NewGroupExpiryPolicySaveLogic(metadata.NewIncomingContext(ctx, metadata.Pairs("username", "other-user")), svcCtx)
+	if _, err := otherLogic.GroupExpiryPolicySave(&pb.SaveGroupExpiryPolicyRequest{Gid: gid}); err == nil {
+		t.Error("期望非分组所有者保存失败，但实际成功")
+		return
+	}
+
+	_, err := saveLogic.GroupExpiryPolicySave(&pb.SaveGroupExpiryPolicyRequest{
+		Gid:            gid,
+		ExpiredUrl:     "https://github.com/expired",
+		ExpiredMessage: "活动已结束",
+		GraceDays:      3,
+	})
+	if err != nil {
+		t.Errorf("保存分组过期策略失败: %v", err)
+		return
+	}
+
+	resp, err := logic.NewGroupExpiryPolicyGetLogic(userCtx, svcCtx).GroupExpiryPolicyGet(&pb.GetGroupExpiryPolicyRequest{Gid: gid})
+	if err != nil {
+		t.Errorf("查询分组过期策略失败: %v", err)
+		return
+	}
+	if resp.Policy.ExpiredUrl != "https://github.com/expired" || resp.Policy.GraceDays != 3 {
+		t.Errorf("分组过期策略不符合预期: %+v", resp.Policy)
+		return
+	}
+
+	t.Logf("分组过期策略保存成功: %+v", resp.Policy)
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GroupTransferCancelLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupTransferCancelLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupTransferCancelLogic {
+	return &GroupTransferCancelLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 转出用户取消待接受的分组转让
+func (l *GroupTransferCancelLogic) GroupTransferCancel(in *pb.CancelGroupTransferRequest) (*pb.CancelGroupTransferResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	transfer, err := l.svcCtx.RepoManager.GroupTransfer.FindByID(l.ctx, in.Id)
+	if err != nil || transfer.FromUsername != username {
+		return nil, status.Error(codes.NotFound, "分组转让不存在")
+	}
+	if transfer.Status != repo.GroupTransferStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "分组转让已处理")
+	}
+
+	ok, err := l.svcCtx.RepoManager.GroupTransfer.UpdateStatus(l.ctx, transfer.ID, repo.GroupTransferStatusCancelled)
+	if err != nil {
+		l.Logger.Errorf("取消分组转让失败: %v", err)
+		return nil, status.Error(codes.Internal, "取消分组转让失败")
+	}
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "分组转让已处理")
+	}
+
+	return &pb.CancelGroupTransferResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GroupTransferCreateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupTransferCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupTransferCreateLogic {
+	return &GroupTransferCreateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 发起分组转让，接收用户接受前分组归属不变
+func (l *GroupTransferCreateLogic) GroupTransferCreate(in *pb.CreateGroupTransferRequest) (*pb.CreateGroupTransferResponse, error) {
+	if err := checkGroupOwner(l.ctx, l.svcCtx, in.Gid); err != nil {
+		return nil, err
+	}
+	username, _ := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+
+	toUsername := strings.TrimSpace(in.ToUsername)
+	if toUsername == "" {
+		return nil, status.Error(codes.InvalidArgument, "接收用户名不能为空")
+	}
+	if toUsername == username {
+		return nil, status.Error(codes.InvalidArgument, "不能将分组转让给自己")
+	}
+	if _, err := l.svcCtx.RepoManager.User.FindByUsername(l.ctx, toUsername); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "接收用户不存在")
+		}
+		l.Logger.Errorf("查询接收用户失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询接收用户失败")
+	}
+
+	lock, err := lockGroup(l.ctx, l.svcCtx, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+
+	// 同一分组只允许存在一条待接受的转让
+	if _, err := l.svcCtx.RepoManager.GroupTransfer.FindPendingByGid(l.ctx, in.Gid); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "分组已有待接受的转让，请先取消")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Logger.Errorf("查询分组转让记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询分组转让记录失败")
+	}
+
+	group, err := l.svcCtx.RepoManager.Group.FindByGidAndUsername(l.ctx, in.Gid, username)
+	if err != nil {
+		l.Logger.Errorf("查询分组失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询分组失败")
+	}
+
+	transfer := &model.GroupTransfer{
+		Gid:          group.Gid,
+		GroupName:    group.Name,
+		FromUsername: username,
+		ToUsername:   toUsername,
+	}
+	if err := l.svcCtx.RepoManager.GroupTransfer.Create(l.ctx, transfer); err != nil {
+		l.Logger.Errorf("创建分组转让记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建分组转让记录失败")
+	}
+	l.Logger.Infof("发起分组转让: %s, %s -> %s", group.Gid, username, toUsername)
+
+	return &pb.CreateGroupTransferResponse{
+		Id: transfer.ID,
+	}, nil
+}
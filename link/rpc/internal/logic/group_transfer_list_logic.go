@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GroupTransferListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupTransferListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupTransferListLogic {
+	return &GroupTransferListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询当前用户收到和发起的待接受分组转让
+func (l *GroupTransferListLogic) GroupTransferList(in *pb.ListGroupTransferRequest) (*pb.ListGroupTransferResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	incoming, err := l.svcCtx.RepoManager.GroupTransfer.FindPendingByToUsername(l.ctx, username)
+	if err != nil {
+		l.Logger.Errorf("查询收到的分组转让失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询分组转让失败")
+	}
+	outgoing, err := l.svcCtx.RepoManager.GroupTransfer.FindPendingByFromUsername(l.ctx, username)
+	if err != nil {
+		l.Logger.Errorf("查询发起的分组转让失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询分组转让失败")
+	}
+
+	return &pb.ListGroupTransferResponse{
+		Incoming: toGroupTransferRecords(incoming),
+		Outgoing: toGroupTransferRecords(outgoing),
+	}, nil
+}
+
+// toGroupTransferRecords 转换分组转让记录
+func toGroupTransferRecords(transfers []*model.GroupTransfer) []*pb.GroupTransferRecord {
+	records := make([]*pb.GroupTransferRecord, 0, len(transfers))
+	for _, transfer := range transfers {
+		records = append(records, &pb.GroupTransferRecord{
+			Id:           transfer.ID,
+			Gid:          transfer.Gid,
+			GroupName:    transfer.GroupName,
+			FromUsername: transfer.FromUsername,
+			ToUsername:   transfer.ToUsername,
+			Status:       int32(transfer.Status),
+			CreateTime:   transfer.CreateTime.Format(time.RFC3339),
+			UpdateTime:   transfer.UpdateTime.Format(time.RFC3339),
+		})
+	}
+	return records
+}
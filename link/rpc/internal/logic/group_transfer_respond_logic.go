@@ -0,0 +1,139 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 每个用户的最大分组数，与用户服务创建分组时的限制一致
+	GroupMaxCount = 20
+	// 用户创建分组锁，与用户服务共用，保证接受转让与创建分组不会同时突破分组数上限
+	groupCreateLockKey = "lock:group:create:%s"
+)
+
+type GroupTransferRespondLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGroupTransferRespondLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GroupTransferRespondLogic {
+	return &GroupTransferRespondLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 接收用户接受或拒绝分组转让
+// 接受后分组记录从转出用户分片移动到接收用户分片，分组ID不变，分组内短链接、跳转记录和统计数据无需迁移
+func (l *GroupTransferRespondLogic) GroupTransferRespond(in *pb.RespondGroupTransferRequest) (*pb.RespondGroupTransferResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	transfer, err := l.svcCtx.RepoManager.GroupTransfer.FindByID(l.ctx, in.Id)
+	if err != nil || transfer.ToUsername != username {
+		return nil, status.Error(codes.NotFound, "分组转让不存在")
+	}
+	if transfer.Status != repo.GroupTransferStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "分组转让已处理")
+	}
+
+	if !in.Accept {
+		ok, err := l.svcCtx.RepoManager.GroupTransfer.UpdateStatus(l.ctx, transfer.ID, repo.GroupTransferStatusRejected)
+		if err != nil {
+			l.Logger.Errorf("拒绝分组转让失败: %v", err)
+			return nil, status.Error(codes.Internal, "拒绝分组转让失败")
+		}
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "分组转让已处理")
+		}
+		return &pb.RespondGroupTransferResponse{
+			Success: true,
+		}, nil
+	}
+
+	// 锁定分组，避免转让过程中移动分组内的短链接
+	lock, err := lockGroup(l.ctx, l.svcCtx, transfer.Gid)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+
+	// 锁定接收用户的分组创建，保证分组数量上限
+	createLock := redis.NewRedisLock(l.svcCtx.BizRedis, fmt.Sprintf(groupCreateLockKey, username))
+	createLock.SetExpire(groupLockExpireSeconds)
+	acquired, err := createLock.AcquireCtx(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("获取分组创建锁失败: %v", err)
+		return nil, status.Error(codes.Internal, "获取分组锁失败")
+	}
+	if !acquired {
+		return nil, status.Error(codes.Aborted, "分组正在处理其他操作，请稍后再试")
+	}
+	defer createLock.Release()
+
+	group, err := l.svcCtx.RepoManager.Group.FindByGidAndUsername(l.ctx, transfer.Gid, transfer.FromUsername)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "分组已不属于转出用户")
+	}
+	_, groupCount, err := l.svcCtx.RepoManager.Group.FindByUsername(l.ctx, username, 1, 1)
+	if err != nil {
+		l.Logger.Errorf("查询接收用户分组数量失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询分组失败")
+	}
+	if groupCount >= GroupMaxCount {
+		return nil, status.Errorf(codes.ResourceExhausted, "分组数量已达上限%d个", GroupMaxCount)
+	}
+
+	// 分组表和转让表位于不同的数据库对象，分组分片表复用公共表的事务连接，转让状态和分组归属在同一事务中提交
+	commonTx := l.svcCtx.DBs.Common.WithContext(l.ctx).Begin()
+	if commonTx.Error != nil {
+		l.Logger.Errorf("开启事务失败: %v", commonTx.Error)
+		return nil, status.Error(codes.Internal, "接受分组转让失败")
+	}
+	groupTx := svc.JoinTx(l.svcCtx.DBs.GroupDB, commonTx)
+	defer func() {
+		if r := recover(); r != nil {
+			commonTx.Rollback()
+			panic(r)
+		}
+	}()
+
+	ok, err := repo.NewGroupTransferRepo(commonTx).UpdateStatus(l.ctx, transfer.ID, repo.GroupTransferStatusAccepted)
+	if err != nil || !ok {
+		commonTx.Rollback()
+		if err != nil {
+			l.Logger.Errorf("更新分组转让状态失败: %v", err)
+			return nil, status.Error(codes.Internal, "接受分组转让失败")
+		}
+		return nil, status.Error(codes.FailedPrecondition, "分组转让已处理")
+	}
+	if err := repo.NewGroupRepo(groupTx).Transfer(l.ctx, group, username); err != nil {
+		commonTx.Rollback()
+		l.Logger.Errorf("转让分组失败: %v", err)
+		return nil, status.Error(codes.Internal, "接受分组转让失败")
+	}
+
+	if err := commonTx.Commit().Error; err != nil {
+		l.Logger.Errorf("提交分组转让事务失败: %v", err)
+		return nil, status.Error(codes.Internal, "接受分组转让失败")
+	}
+	l.Logger.Infof("分组转让完成: %s, %s -> %s", transfer.Gid, transfer.FromUsername, username)
+
+	return &pb.RespondGroupTransferResponse{
+		Success: true,
+	}, nil
+}
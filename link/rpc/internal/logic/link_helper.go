@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// verifyTargetWhitelist 校验跳转目标只使用http(s)协议且域名在白名单中（支持子域名），未启用白名单时只校验协议
+func verifyTargetWhitelist(svcCtx *svc.ServiceContext, targetUrl string) error {
+	if !util.IsHttpUrl(targetUrl) {
+		return status.Error(codes.InvalidArgument, "跳转链接仅支持http或https协议")
+	}
+	if !svcCtx.Config.GotoDomainWhiteList.Enable {
+		return nil
+	}
+
+	domain := util.ExtractDomain(targetUrl)
+	if domain == "" {
+		return status.Error(codes.InvalidArgument, "跳转链接填写错误")
+	}
+
+	details := svcCtx.Config.GotoDomainWhiteList.Details
+	if len(details) == 0 {
+		return nil
+	}
+	for _, whiteDomain := range details {
+		if domain == whiteDomain || strings.HasSuffix(domain, "."+whiteDomain) {
+			return nil
+		}
+	}
+
+	errMsg := fmt.Sprintf("演示环境为避免恶意攻击，请生成以下网站跳转链接：%s", svcCtx.Config.GotoDomainWhiteList.Names)
+	return status.Error(codes.PermissionDenied, errMsg)
+}
+
+// flagUnsafeTargets 检测短链接新增的跳转目标，命中时将短链接标记为可疑并加入审核队列
+// 已禁用的短链接仅更新原因，返回是否命中
+func flagUnsafeTargets(ctx context.Context, svcCtx *svc.ServiceContext, link *model.Link, targetUrls []string) bool {
+	safety := checkUrlSafety(ctx, svcCtx, targetUrls)
+	if !safety.Flagged {
+		return false
+	}
+
+	if link.SafetyStatus == urlsafety.SafetyStatusNormal {
+		link.SafetyStatus = urlsafety.SafetyStatusWarning
+	}
+	link.SafetyReason = safety.Reason
+	if err := svcCtx.RepoManager.Link.Update(ctx, link); err != nil {
+		logx.WithContext(ctx).Errorf("更新短链接安全状态失败: %s, %v", link.FullShortUrl, err)
+	}
+	submitModeration(ctx, svcCtx, link, safety)
+	return true
+}
@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// 短链接标签限制
+const (
+	// 每个短链接最多的标签数量
+	LinkTagMaxCount = 10
+	// 单个标签最大长度
+	LinkTagMaxLength = 32
+)
+
+// normalizeTags 去除空白和重复标签，并校验数量和长度
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > LinkTagMaxLength {
+			return nil, fmt.Errorf("标签不能超过%d个字符: %s", LinkTagMaxLength, tag)
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	if len(result) > LinkTagMaxCount {
+		return nil, fmt.Errorf("标签不能超过%d个", LinkTagMaxCount)
+	}
+	return result, nil
+}
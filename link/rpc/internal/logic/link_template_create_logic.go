@@ -0,0 +1,256 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// 每个用户最多保存的模板数量
+	LinkTemplateMaxCount = 50
+	// 模板名称最大长度
+	LinkTemplateNameMaxLength = 64
+	// 模板有效天数上限
+	LinkTemplateMaxValidDays = 3650
+)
+
+type LinkTemplateCreateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkTemplateCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkTemplateCreateLogic {
+	return &LinkTemplateCreateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建当前用户的短链接模板，模板名称在用户内唯一
+func (l *LinkTemplateCreateLogic) LinkTemplateCreate(in *pb.CreateLinkTemplateRequest) (*pb.CreateLinkTemplateResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	template, err := buildLinkTemplate(l.ctx, l.svcCtx, in)
+	if err != nil {
+		return nil, err
+	}
+	template.Username = username
+
+	count, err := l.svcCtx.RepoManager.Template.CountByUsername(l.ctx, username)
+	if err != nil {
+		l.Logger.Errorf("统计短链接模板数量失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建短链接模板失败")
+	}
+	if count >= LinkTemplateMaxCount {
+		return nil, status.Errorf(codes.ResourceExhausted, "最多保存%d个短链接模板", LinkTemplateMaxCount)
+	}
+	if err := checkLinkTemplateName(l.ctx, l.svcCtx, username, template.Name, 0); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template.CreateTime = now
+	template.UpdateTime = now
+	if err := l.svcCtx.RepoManager.Template.Create(l.ctx, template); err != nil {
+		l.Logger.Errorf("创建短链接模板失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建短链接模板失败")
+	}
+
+	return &pb.CreateLinkTemplateResponse{
+		Id: template.ID,
+	}, nil
+}
+
+// buildLinkTemplate 校验模板内容，分组和域名必须属于当前用户
+func buildLinkTemplate(ctx context.Context, svcCtx *svc.ServiceContext, in *pb.CreateLinkTemplateRequest) (*model.LinkTemplate, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "模板名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > LinkTemplateNameMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "模板名称不能超过%d个字符", LinkTemplateNameMaxLength)
+	}
+
+	gid := strings.TrimSpace(in.Gid)
+	if gid != "" {
+		if err := checkGroupOwner(ctx, svcCtx, gid); err != nil {
+			return nil, err
+		}
+	}
+	domain := util.NormalizeHost(in.Domain)
+	if domain != "" {
+		if err := verifyUserDomain(ctx, svcCtx, domain); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.ValidDays < 0 || in.ValidDays > LinkTemplateMaxValidDays {
+		return nil, status.Errorf(codes.InvalidArgument, "有效天数必须在0到%d之间", LinkTemplateMaxValidDays)
+	}
+	describe := strings.TrimSpace(in.Describe)
+	if utf8.RuneCountInString(describe) > LinkDescribeMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "描述不能超过%d个字符", LinkDescribeMaxLength)
+	}
+
+	utm := util.UtmTemplate{Source: in.UtmSource, Medium: in.UtmMedium, Campaign: in.UtmCampaign}
+	if err := validateQueryParamSettings(int(in.QueryParamPolicy), utm); err != nil {
+		return nil, err
+	}
+	if !util.IsValidRedirectType(int(in.RedirectType)) {
+		return nil, status.Error(codes.InvalidArgument, "不支持的跳转类型")
+	}
+	expiredMessage := strings.TrimSpace(in.ExpiredMessage)
+	if err := validateExpirySettings(svcCtx, in.ExpiredUrl, expiredMessage, int(in.GraceDays)); err != nil {
+		return nil, err
+	}
+
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tagsJson, err := json.Marshal(tags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "序列化标签失败")
+	}
+
+	return &model.LinkTemplate{
+		Name:             name,
+		Gid:              gid,
+		Domain:           domain,
+		ValidDays:        int(in.ValidDays),
+		Describe:         describe,
+		QueryParamPolicy: int(in.QueryParamPolicy),
+		UtmSource:        utm.Source,
+		UtmMedium:        utm.Medium,
+		UtmCampaign:      utm.Campaign,
+		RedirectType:     int(in.RedirectType),
+		ExpiredUrl:       in.ExpiredUrl,
+		ExpiredMessage:   expiredMessage,
+		GraceDays:        int(in.GraceDays),
+		Tags:             string(tagsJson),
+	}, nil
+}
+
+// checkLinkTemplateName 校验模板名称未被用户的其他模板使用
+func checkLinkTemplateName(ctx context.Context, svcCtx *svc.ServiceContext, username, name string, id int64) error {
+	existing, err := svcCtx.RepoManager.Template.FindByUsernameAndName(ctx, username, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询短链接模板失败: %v", err)
+		return status.Error(codes.Internal, "查询短链接模板失败")
+	}
+	if existing.ID != id {
+		return status.Errorf(codes.AlreadyExists, "模板名称已存在: %s", name)
+	}
+	return nil
+}
+
+// findLinkTemplate 查询当前用户的模板
+func findLinkTemplate(ctx context.Context, svcCtx *svc.ServiceContext, id int64) (*model.LinkTemplate, error) {
+	username, err := svcCtx.RepoManager.GetCurrentUsername(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	template, err := svcCtx.RepoManager.Template.FindByIDAndUsername(ctx, id, username)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "短链接模板不存在")
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询短链接模板失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接模板失败")
+	}
+	return template, nil
+}
+
+// linkTemplateTags 解析模板保存的标签，内容无效时忽略
+func linkTemplateTags(template *model.LinkTemplate) []string {
+	var tags []string
+	if template.Tags != "" {
+		_ = json.Unmarshal([]byte(template.Tags), &tags)
+	}
+	return tags
+}
+
+// renderTemplateDescribe 替换描述模板中的占位符，{date}为创建日期，{week}为创建时的ISO周，如2024-W05
+func renderTemplateDescribe(describe string, now time.Time) string {
+	year, week := now.ISOWeek()
+	return strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{week}", fmt.Sprintf("%d-W%02d", year, week),
+	).Replace(describe)
+}
+
+// templateValidDate 按模板的有效天数计算有效期，永久有效时返回空
+func templateValidDate(template *model.LinkTemplate, now time.Time) string {
+	if template.ValidDays <= 0 {
+		return ""
+	}
+	return now.AddDate(0, 0, template.ValidDays).Format(time.RFC3339)
+}
+
+// applyLinkTemplate 使用模板填充创建请求中未填写的字段，已填写的字段保持不变
+func applyLinkTemplate(template *model.LinkTemplate, in *pb.CreateShortLinkRequest, now time.Time) {
+	if in.Gid == "" {
+		in.Gid = template.Gid
+	}
+	if in.Domain == "" {
+		in.Domain = template.Domain
+	}
+	if in.ValidDateType == util.ValidDateTypePermanent && in.ValidDate == "" {
+		if validDate := templateValidDate(template, now); validDate != "" {
+			in.ValidDateType, in.ValidDate = util.ValidDateTypeCustom, validDate
+		}
+	}
+	if in.Describe == "" {
+		in.Describe = renderTemplateDescribe(template.Describe, now)
+	}
+	if in.QueryParamPolicy == 0 {
+		in.QueryParamPolicy = int32(template.QueryParamPolicy)
+	}
+	if in.UtmSource == "" {
+		in.UtmSource = template.UtmSource
+	}
+	if in.UtmMedium == "" {
+		in.UtmMedium = template.UtmMedium
+	}
+	if in.UtmCampaign == "" {
+		in.UtmCampaign = template.UtmCampaign
+	}
+	if in.RedirectType == 0 {
+		in.RedirectType = int32(template.RedirectType)
+	}
+	if in.ExpiredUrl == "" {
+		in.ExpiredUrl = template.ExpiredUrl
+	}
+	if in.ExpiredMessage == "" {
+		in.ExpiredMessage = template.ExpiredMessage
+	}
+	if in.GraceDays == 0 {
+		in.GraceDays = int32(template.GraceDays)
+	}
+	if len(in.Tags) == 0 {
+		in.Tags = linkTemplateTags(template)
+	}
+}
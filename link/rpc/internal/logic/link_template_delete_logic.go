@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LinkTemplateDeleteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkTemplateDeleteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkTemplateDeleteLogic {
+	return &LinkTemplateDeleteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除当前用户的短链接模板，已创建的短链接不受影响
+func (l *LinkTemplateDeleteLogic) LinkTemplateDelete(in *pb.DeleteLinkTemplateRequest) (*pb.DeleteLinkTemplateResponse, error) {
+	template, err := findLinkTemplate(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.RepoManager.Template.Delete(l.ctx, template.ID, template.Username); err != nil {
+		l.Logger.Errorf("删除短链接模板失败: %v", err)
+		return nil, status.Error(codes.Internal, "删除短链接模板失败")
+	}
+
+	return &pb.DeleteLinkTemplateResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LinkTemplateListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkTemplateListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkTemplateListLogic {
+	return &LinkTemplateListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询当前用户的全部短链接模板
+func (l *LinkTemplateListLogic) LinkTemplateList(in *pb.ListLinkTemplateRequest) (*pb.ListLinkTemplateResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	templates, err := l.svcCtx.RepoManager.Template.FindByUsername(l.ctx, username)
+	if err != nil {
+		l.Logger.Errorf("查询短链接模板失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接模板失败")
+	}
+
+	resp := &pb.ListLinkTemplateResponse{
+		Templates: make([]*pb.LinkTemplate, 0, len(templates)),
+	}
+	for _, template := range templates {
+		resp.Templates = append(resp.Templates, toPbLinkTemplate(template))
+	}
+	return resp, nil
+}
+
+// toPbLinkTemplate 转换短链接模板
+func toPbLinkTemplate(template *model.LinkTemplate) *pb.LinkTemplate {
+	return &pb.LinkTemplate{
+		Id:               template.ID,
+		Name:             template.Name,
+		Gid:              template.Gid,
+		Domain:           template.Domain,
+		ValidDays:        int32(template.ValidDays),
+		Describe:         template.Describe,
+		QueryParamPolicy: int32(template.QueryParamPolicy),
+		UtmSource:        template.UtmSource,
+		UtmMedium:        template.UtmMedium,
+		UtmCampaign:      template.UtmCampaign,
+		RedirectType:     int32(template.RedirectType),
+		ExpiredUrl:       template.ExpiredUrl,
+		ExpiredMessage:   template.ExpiredMessage,
+		GraceDays:        int32(template.GraceDays),
+		Tags:             linkTemplateTags(template),
+		CreateTime:       template.CreateTime.Format(time.RFC3339),
+		UpdateTime:       template.UpdateTime.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,190 @@
+package logic_test
+
+import (
+	"fmt"
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestLinkTemplate_InvalidParams 测试短链接模板的参数校验
+func TestLinkTemplate_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-template-user"))
+
+	_, err := logic.NewLinkTemplateCreateLogic(userCtx, svcCtx).LinkTemplateCreate(&pb.CreateLinkTemplateRequest{Name: "  "})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("模板名称为空时期望 InvalidArgument，实际: %v", err)
+	}
+
+	_, err = logic.NewLinkTemplateCreateLogic(userCtx, svcCtx).LinkTemplateCreate(&pb.CreateLinkTemplateRequest{
+		Name:      "有效天数超出范围",
+		ValidDays: logic.LinkTemplateMaxValidDays + 1,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("有效天数超出范围时期望 InvalidArgument，实际: %v", err)
+	}
+
+	_, err = logic.NewShortLinkCreateLogic(userCtx, svcCtx).ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:  "https://github.com/zeromicro/go-zero",
+		TemplateId: 999999999,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("模板不存在时期望 NotFound，实际: %v", err)
+	}
+}
+
+// TestLinkTemplate_CreateAndClone 测试使用模板创建短链接，并复制到另一个分组
+func TestLinkTemplate_CreateAndClone(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-template-user"
+	gid, targetGid := "test-template", "test-template-target"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	for _, g := range []string{gid, targetGid} {
+		g := g
+		if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+			Gid:        g,
+			Name:       "模板测试分组-" + g,
+			Username:   username,
+			CreateTime: time.Now(),
+			UpdateTime: time.Now(),
+		}); err != nil {
+			t.Fatalf("创建分组失败: %v", err)
+		}
+		t.Cleanup(func() {
+			if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, g, username); err != nil {
+				t.Logf("清理分组失败: %v", err)
+			}
+		})
+	}
+
+	templateResp, err := logic.NewLinkTemplateCreateLogic(userCtx, svcCtx).LinkTemplateCreate(&pb.CreateLinkTemplateRequest{
+		Name:        "周报活动",
+		Gid:         gid,
+		ValidDays:   7,
+		Describe:    "周报 {week}",
+		UtmSource:   "newsletter",
+		UtmCampaign: "weekly",
+		Tags:        []string{"周报"},
+	})
+	if err != nil {
+		t.Fatalf("创建短链接模板失败: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := svcCtx.RepoManager.Template.Delete(ctx, templateResp.Id, username); err != nil {
+			t.Logf("清理短链接模板失败: %v", err)
+		}
+	})
+
+	_, err = logic.NewLinkTemplateCreateLogic(userCtx, svcCtx).LinkTemplateCreate(&pb.CreateLinkTemplateRequest{Name: "周报活动"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("模板名称重复时期望 AlreadyExists，实际: %v", err)
+	}
+
+	// 未填写分组和描述，使用模板的默认值
+	createResp, err := logic.NewShortLinkCreateLogic(userCtx, svcCtx).ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:  "https://github.com/zeromicro/go-zero",
+		TemplateId: templateResp.Id,
+	})
+	if err != nil {
+		t.Fatalf("使用模板创建短链接失败: %v", err)
+	}
+	fullShortUrl := strings.TrimPrefix(createResp.FullShortUrl, "http://")
+	t.Cleanup(func() {
+		cleanSpecificTestData(t, svcCtx, ctx, fullShortUrl, gid)
+	})
+	if createResp.Gid != gid {
+		t.Errorf("短链接分组不符合预期: %s", createResp.Gid)
+	}
+
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+	if err != nil {
+		t.Fatalf("查询短链接失败: %v", err)
+	}
+	year, week := time.Now().ISOWeek()
+	if link.Describe != fmt.Sprintf("周报 %d-W%02d", year, week) {
+		t.Errorf("描述占位符未替换: %s", link.Describe)
+	}
+	if link.UtmSource != "newsletter" || link.UtmCampaign != "weekly" {
+		t.Errorf("UTM参数未使用模板默认值: %+v", link)
+	}
+	if link.ValidDateType != 1 || link.ValidDate.Before(time.Now().AddDate(0, 0, 6)) {
+		t.Errorf("有效期未使用模板默认值: %d, %v", link.ValidDateType, link.ValidDate)
+	}
+
+	// 模拟访问统计，复制后的短链接不应带有统计数据
+	link.ClickNum, link.TotalPv = 10, 10
+	if err := svcCtx.RepoManager.Link.Update(ctx, link); err != nil {
+		t.Fatalf("更新短链接失败: %v", err)
+	}
+
+	// 源短链接10天前创建、有效期7天，复制后的短链接有效期应从现在起顺延7天
+	if err := svcCtx.RepoManager.Link.BatchUpdateFields(ctx, gid, []int64{link.ID}, map[string]interface{}{
+		"create_time": time.Now().AddDate(0, 0, -10),
+		"valid_date":  time.Now().AddDate(0, 0, -3),
+	}); err != nil {
+		t.Fatalf("更新短链接有效期失败: %v", err)
+	}
+	if _, err := logic.NewRedirectRuleCreateLogic(userCtx, svcCtx).RedirectRuleCreate(&pb.CreateRedirectRuleRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		RuleType:     "os",
+		RuleValue:    "iOS",
+		TargetUrl:    "https://github.com/zeromicro",
+	}); err != nil {
+		t.Fatalf("创建跳转规则失败: %v", err)
+	}
+
+	cloneResp, err := logic.NewShortLinkCloneLogic(userCtx, svcCtx).ShortLinkClone(&pb.CloneShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		TargetGid:    targetGid,
+	})
+	if err != nil {
+		t.Fatalf("复制短链接失败: %v", err)
+	}
+	cloneUrl := strings.TrimPrefix(cloneResp.FullShortUrl, "http://")
+	t.Cleanup(func() {
+		cleanSpecificTestData(t, svcCtx, ctx, cloneUrl, targetGid)
+	})
+	if cloneUrl == fullShortUrl || cloneResp.Gid != targetGid {
+		t.Fatalf("复制结果不符合预期: %+v", cloneResp)
+	}
+
+	clone, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, cloneUrl, targetGid)
+	if err != nil {
+		t.Fatalf("查询复制的短链接失败: %v", err)
+	}
+	if clone.OriginUrl != link.OriginUrl || clone.Describe != link.Describe || clone.UtmSource != link.UtmSource {
+		t.Errorf("复制的短链接配置不符合预期: %+v", clone)
+	}
+	if clone.ClickNum != 0 || clone.TotalPv != 0 {
+		t.Errorf("复制的短链接不应包含访问统计: %d, %d", clone.ClickNum, clone.TotalPv)
+	}
+	if clone.ValidDate.Before(time.Now().AddDate(0, 0, 6)) {
+		t.Errorf("复制的短链接有效期未顺延: %v", clone.ValidDate)
+	}
+	rules, err := svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(ctx, cloneUrl)
+	if err != nil {
+		t.Fatalf("查询跳转规则失败: %v", err)
+	}
+	if len(rules) != 1 || rules[0].Gid != targetGid || rules[0].TargetUrl != "https://github.com/zeromicro" {
+		t.Errorf("复制的跳转规则不符合预期: %+v", rules)
+	}
+	tags, err := svcCtx.RepoManager.Tag.FindByFullShortUrls(ctx, []string{cloneUrl})
+	if err != nil {
+		t.Fatalf("查询标签失败: %v", err)
+	}
+	if len(tags[cloneUrl]) != 1 || tags[cloneUrl][0] != "周报" {
+		t.Errorf("复制的短链接标签不符合预期: %v", tags[cloneUrl])
+	}
+}
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LinkTemplateUpdateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkTemplateUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkTemplateUpdateLogic {
+	return &LinkTemplateUpdateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改当前用户的短链接模板，覆盖模板的全部字段，已创建的短链接不受影响
+func (l *LinkTemplateUpdateLogic) LinkTemplateUpdate(in *pb.UpdateLinkTemplateRequest) (*pb.UpdateLinkTemplateResponse, error) {
+	existing, err := findLinkTemplate(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := buildLinkTemplate(l.ctx, l.svcCtx, &pb.CreateLinkTemplateRequest{
+		Name:             in.Name,
+		Gid:              in.Gid,
+		Domain:           in.Domain,
+		ValidDays:        in.ValidDays,
+		Describe:         in.Describe,
+		QueryParamPolicy: in.QueryParamPolicy,
+		UtmSource:        in.UtmSource,
+		UtmMedium:        in.UtmMedium,
+		UtmCampaign:      in.UtmCampaign,
+		RedirectType:     in.RedirectType,
+		ExpiredUrl:       in.ExpiredUrl,
+		ExpiredMessage:   in.ExpiredMessage,
+		GraceDays:        in.GraceDays,
+		Tags:             in.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	template.ID = existing.ID
+	template.Username = existing.Username
+	if err := checkLinkTemplateName(l.ctx, l.svcCtx, existing.Username, template.Name, existing.ID); err != nil {
+		return nil, err
+	}
+
+	template.UpdateTime = time.Now()
+	if err := l.svcCtx.RepoManager.Template.Update(l.ctx, template); err != nil {
+		l.Logger.Errorf("修改短链接模板失败: %v", err)
+		return nil, status.Error(codes.Internal, "修改短链接模板失败")
+	}
+
+	return &pb.UpdateLinkTemplateResponse{}, nil
+}
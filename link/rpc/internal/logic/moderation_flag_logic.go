@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 安全标记原因最大长度
+const SafetyReasonMaxLength = 256
+
+type ModerationFlagLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewModerationFlagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationFlagLogic {
+	return &ModerationFlagLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 手动标记已创建的可疑短链接，标记后跳转前展示风险提示，并加入审核队列等待处理
+func (l *ModerationFlagLogic) ModerationFlag(in *pb.FlagModerationRequest) (*pb.FlagModerationResponse, error) {
+	if _, err := checkModerator(l.ctx, l.svcCtx); err != nil {
+		return nil, err
+	}
+
+	if in.FullShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "标记原因不能为空")
+	}
+	if utf8.RuneCountInString(reason) > SafetyReasonMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "标记原因不能超过%d个字符", SafetyReasonMaxLength)
+	}
+
+	link, err := findModerationLink(l.ctx, l.svcCtx, in.FullShortUrl)
+	if err != nil {
+		return nil, err
+	}
+	if link.SafetyStatus == urlsafety.SafetyStatusPending || link.SafetyStatus == urlsafety.SafetyStatusBlocked {
+		return nil, status.Error(codes.FailedPrecondition, "短链接已禁用，无需重复标记")
+	}
+
+	link.SafetyStatus = urlsafety.SafetyStatusWarning
+	link.SafetyReason = reason
+	link.UpdateTime = time.Now()
+	if err := l.svcCtx.RepoManager.Link.Update(l.ctx, link); err != nil {
+		l.Logger.Errorf("更新短链接安全状态失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新短链接安全状态失败")
+	}
+
+	submitModeration(l.ctx, l.svcCtx, link, &urlsafety.Result{
+		Flagged:  true,
+		Provider: urlsafety.ProviderManual,
+		Reason:   reason,
+	})
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+
+	return &pb.FlagModerationResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ModerationPageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewModerationPageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationPageLogic {
+	return &ModerationPageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 分页查询安全审核队列
+func (l *ModerationPageLogic) ModerationPage(in *pb.PageModerationRequest) (*pb.PageModerationResponse, error) {
+	if _, err := checkModerator(l.ctx, l.svcCtx); err != nil {
+		return nil, err
+	}
+
+	switch in.Status {
+	case repo.ModerationStatusPending, repo.ModerationStatusApproved, repo.ModerationStatusRejected:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "审核状态错误")
+	}
+
+	page := int(in.Current)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(in.Size)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	moderations, total, err := l.svcCtx.RepoManager.Moderation.FindPage(l.ctx, int(in.Status), page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询安全审核队列失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询安全审核队列失败")
+	}
+
+	records := make([]*pb.ModerationRecord, 0, len(moderations))
+	for _, moderation := range moderations {
+		records = append(records, &pb.ModerationRecord{
+			Id:           moderation.ID,
+			Gid:          moderation.Gid,
+			FullShortUrl: moderation.FullShortUrl,
+			OriginUrl:    moderation.OriginUrl,
+			Provider:     moderation.Provider,
+			Reason:       moderation.Reason,
+			Status:       int32(moderation.Status),
+			Reviewer:     moderation.Reviewer,
+			CreateTime:   moderation.CreateTime.Format(time.RFC3339),
+			UpdateTime:   moderation.UpdateTime.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.PageModerationResponse{
+		Records: records,
+		Total:   int32(total),
+		Size:    int32(pageSize),
+		Current: int32(page),
+	}, nil
+}
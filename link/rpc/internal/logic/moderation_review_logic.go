@@ -0,0 +1,139 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ModerationReviewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewModerationReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationReviewLogic {
+	return &ModerationReviewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 审核可疑短链接，通过后恢复正常跳转，拒绝后禁用短链接
+func (l *ModerationReviewLogic) ModerationReview(in *pb.ReviewModerationRequest) (*pb.ReviewModerationResponse, error) {
+	reviewer, err := checkModerator(l.ctx, l.svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	moderation, err := l.svcCtx.RepoManager.Moderation.FindByID(l.ctx, in.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "审核记录不存在")
+	}
+	if moderation.Status != repo.ModerationStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "审核记录已处理")
+	}
+
+	link, err := findModerationLink(l.ctx, l.svcCtx, moderation.FullShortUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先更新审核记录，避免重复审核
+	reviewStatus := repo.ModerationStatusRejected
+	if in.Approve {
+		reviewStatus = repo.ModerationStatusApproved
+	}
+	ok, err := l.svcCtx.RepoManager.Moderation.Review(l.ctx, moderation.ID, reviewStatus, reviewer)
+	if err != nil {
+		l.Logger.Errorf("更新审核记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新审核记录失败")
+	}
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "审核记录已处理")
+	}
+
+	// 审核导致的启用和停用记录到变更记录
+	before := linkhistory.NewSnapshot(link)
+	historyAction := LinkHistoryDisable
+	if in.Approve {
+		historyAction = LinkHistoryEnable
+		// 创建时被禁用的短链接审核通过后启用
+		if link.SafetyStatus == urlsafety.SafetyStatusPending {
+			link.EnableStatus = 0
+			link.RecycleTime = nil
+		}
+		link.SafetyStatus = urlsafety.SafetyStatusNormal
+		link.SafetyReason = ""
+	} else {
+		link.EnableStatus = 1
+		link.SafetyStatus = urlsafety.SafetyStatusBlocked
+	}
+	link.UpdateTime = time.Now()
+	if err := l.svcCtx.RepoManager.Link.Update(l.ctx, link); err != nil {
+		l.Logger.Errorf("更新短链接安全状态失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新短链接安全状态失败")
+	}
+
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+	recordLinkHistory(l.ctx, l.svcCtx, historyAction, before, link)
+	l.Logger.Infof("短链接安全审核完成: %s, 审核人: %s, 通过: %v", link.FullShortUrl, reviewer, in.Approve)
+
+	return &pb.ReviewModerationResponse{
+		Success: true,
+	}, nil
+}
+
+// checkModerator 校验当前登录用户是否为安全审核人员，返回用户名
+func checkModerator(ctx context.Context, svcCtx *svc.ServiceContext) (string, error) {
+	username, err := svcCtx.RepoManager.GetCurrentUsername(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	for _, moderator := range svcCtx.Config.UrlSafety.Moderators {
+		if moderator == username {
+			return username, nil
+		}
+	}
+	return "", status.Error(codes.PermissionDenied, "当前用户没有安全审核权限")
+}
+
+// findModerationLink 查询待审核的短链接，分组可能已变更，通过跳转表定位
+func findModerationLink(ctx context.Context, svcCtx *svc.ServiceContext, fullShortUrl string) (*model.Link, error) {
+	fullShortUrl = strings.TrimPrefix(strings.TrimPrefix(fullShortUrl, "http://"), "https://")
+	linkGoto, err := svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(ctx, fullShortUrl)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, linkGoto.Gid)
+	if err != nil || link.DelFlag > 0 {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+	return link, nil
+}
+
+// submitModeration 将命中安全检测的短链接加入审核队列，同一短链接只保留一条待审核记录
+func submitModeration(ctx context.Context, svcCtx *svc.ServiceContext, link *model.Link, result *urlsafety.Result) {
+	err := svcCtx.RepoManager.Moderation.SavePending(ctx, &model.LinkModeration{
+		Gid:          link.Gid,
+		FullShortUrl: link.FullShortUrl,
+		OriginUrl:    link.OriginUrl,
+		Provider:     result.Provider,
+		Reason:       result.Reason,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("加入安全审核队列失败: %s, %v", link.FullShortUrl, err)
+	}
+}
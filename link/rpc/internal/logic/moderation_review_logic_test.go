@@ -0,0 +1,48 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestModeration_Permission 测试非审核人员不能查看、审核和标记
+func TestModeration_Permission(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "not-a-moderator"))
+
+	if _, err := logic.NewModerationPageLogic(userCtx, svcCtx).ModerationPage(&pb.PageModerationRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("查看审核队列期望无权限，实际: %v", err)
+	}
+	if _, err := logic.NewModerationReviewLogic(userCtx, svcCtx).ModerationReview(&pb.ReviewModerationRequest{Id: 1, Approve: true}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("审核期望无权限，实际: %v", err)
+	}
+	if _, err := logic.NewModerationFlagLogic(userCtx, svcCtx).ModerationFlag(&pb.FlagModerationRequest{FullShortUrl: "s.xleft.cn/abc", Reason: "钓鱼网站"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("标记期望无权限，实际: %v", err)
+	}
+}
+
+// TestModerationFlag_InvalidParams 测试手动标记参数校验
+func TestModerationFlag_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	if len(svcCtx.Config.UrlSafety.Moderators) == 0 {
+		t.Skip("未配置安全审核人员")
+	}
+	moderatorCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", svcCtx.Config.UrlSafety.Moderators[0]))
+
+	l := logic.NewModerationFlagLogic(moderatorCtx, svcCtx)
+	cases := map[string]*pb.FlagModerationRequest{
+		"短链接为空": {Reason: "钓鱼网站"},
+		"原因为空":  {FullShortUrl: "s.xleft.cn/abc", Reason: "  "},
+	}
+	for name, req := range cases {
+		_, err := l.ModerationFlag(req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: 期望参数错误，实际: %v", name, err)
+		}
+	}
+}
@@ -72,21 +72,43 @@ func (l *RecycleBinPageLogic) RecycleBinPage(in *pb.PageRecycleBinShortLinkReque
 	var links []*pb.ShortLinkRecord
 	for _, link := range linksList {
 		record := &pb.ShortLinkRecord{
-			FullShortUrl: link.FullShortUrl,
-			OriginUrl:    link.OriginUrl,
-			Domain:       "http://" + link.Domain,
-			Gid:          link.Gid,
-			CreateTime:   link.CreateTime.Format(time.RFC3339),
-			Describe:     link.Describe,
-			TotalPv:      int32(link.TotalPv),
-			TotalUv:      int32(link.TotalUv),
-			TotalUip:     int32(link.TotalUip),
+			FullShortUrl:        link.FullShortUrl,
+			OriginUrl:           link.OriginUrl,
+			Domain:              "http://" + link.Domain,
+			Gid:                 link.Gid,
+			CreateTime:          link.CreateTime.Format(time.RFC3339),
+			Describe:            link.Describe,
+			TotalPv:             int32(link.TotalPv),
+			TotalUv:             int32(link.TotalUv),
+			TotalUip:            int32(link.TotalUip),
+			EnableStatus:        int32(link.EnableStatus),
+			MaxClicks:           int32(link.MaxClicks),
+			ClickNum:            int32(link.ClickNum),
+			QueryParamPolicy:    int32(link.QueryParamPolicy),
+			UtmSource:           link.UtmSource,
+			UtmMedium:           link.UtmMedium,
+			UtmCampaign:         link.UtmCampaign,
+			ExpiredUrl:          link.ExpiredUrl,
+			ExpiredMessage:      link.ExpiredMessage,
+			GraceDays:           int32(link.GraceDays),
+			RedirectType:        int32(link.RedirectType),
+			IosDeepLink:         link.IosDeepLink,
+			AndroidPackage:      link.AndroidPackage,
+			AndroidDeepLink:     link.AndroidDeepLink,
+			DeepLinkFallbackUrl: link.DeepLinkFallbackUrl,
+			OgTitle:             link.OgTitle,
+			OgDescription:       link.OgDescription,
+			OgImage:             link.OgImage,
+			SafetyStatus:        int32(link.SafetyStatus),
 		}
 
 		// 设置有效期
 		if link.ValidDate.Unix() > 0 {
 			record.ValidDate = link.ValidDate.Format(time.RFC3339)
 		}
+		if link.ValidFrom != nil {
+			record.ValidFrom = link.ValidFrom.Format(time.RFC3339)
+		}
 
 		links = append(links, record)
 	}
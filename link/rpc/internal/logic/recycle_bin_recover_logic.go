@@ -2,10 +2,12 @@ package logic
 
 import (
 	"context"
-	"fmt"
 
+	"shorterurl/link/rpc/internal/cachekey"
+	"shorterurl/link/rpc/internal/linkhistory"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -13,7 +15,7 @@ import (
 )
 
 // 回收站空值键
-const GotoIsNullShortLinkKey = "link:is-null:goto_%s"
+const GotoIsNullShortLinkKey = cachekey.GotoIsNullShortLinkKey
 
 type RecycleBinRecoverLogic struct {
 	ctx    context.Context
@@ -62,20 +64,27 @@ func (l *RecycleBinRecoverLogic) RecycleBinRecover(in *pb.RecoverFromRecycleBinR
 		return nil, status.Error(codes.FailedPrecondition, "短链接已被永久删除，无法恢复")
 	}
 
+	// 安全检测禁用的短链接需审核通过后才能恢复
+	switch link.SafetyStatus {
+	case urlsafety.SafetyStatusPending:
+		return nil, status.Error(codes.FailedPrecondition, "短链接待安全审核，暂不能恢复")
+	case urlsafety.SafetyStatusBlocked:
+		return nil, status.Error(codes.FailedPrecondition, "短链接未通过安全审核，无法恢复")
+	}
+
+	before := linkhistory.NewSnapshot(link)
 	// 将短链接恢复为正常状态 (设置EnableStatus = 0表示启用状态，非回收站)
 	link.EnableStatus = 0
+	link.RecycleTime = nil
 	if err := l.svcCtx.RepoManager.Link.Update(l.ctx, link); err != nil {
 		l.Logger.Errorf("更新短链接状态失败: %v", err)
 		return nil, status.Error(codes.Internal, "从回收站恢复失败")
 	}
 
-	// 删除空值缓存，以便能够重新使用
-	cacheKey := fmt.Sprintf(GotoIsNullShortLinkKey, in.FullShortUrl)
-	_, err = l.svcCtx.BizRedis.Del(cacheKey)
-	if err != nil {
-		l.Logger.Errorf("删除短链接空值缓存失败: %v, 键: %s", err, cacheKey)
-		// 继续执行，不影响主流程
-	}
+	// 删除跳转、空值和预览缓存，以便能够重新使用
+	deleteGotoCache(l.ctx, l.svcCtx, in.FullShortUrl)
+
+	recordLinkHistory(l.ctx, l.svcCtx, LinkHistoryRecover, before, link)
 
 	return &pb.RecoverFromRecycleBinResponse{
 		Success: true,
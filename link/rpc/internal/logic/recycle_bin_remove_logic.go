@@ -67,6 +67,9 @@ func (l *RecycleBinRemoveLogic) RecycleBinRemove(in *pb.RemoveFromRecycleBinRequ
 		return nil, status.Error(codes.Internal, "从回收站永久删除失败")
 	}
 
+	// 删除跳转、空值和预览缓存
+	deleteGotoCache(l.ctx, l.svcCtx, in.FullShortUrl)
+
 	return &pb.RemoveFromRecycleBinResponse{
 		Success: true,
 	}, nil
@@ -2,8 +2,10 @@ package logic
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"shorterurl/link/rpc/internal/cachekey"
+	"shorterurl/link/rpc/internal/linkhistory"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
 
@@ -13,7 +15,7 @@ import (
 )
 
 // 短链接跳转缓存键
-const GotoShortLinkKey = "link:goto:%s"
+const GotoShortLinkKey = cachekey.GotoShortLinkKey
 
 type RecycleBinSaveLogic struct {
 	ctx    context.Context
@@ -62,20 +64,21 @@ func (l *RecycleBinSaveLogic) RecycleBinSave(in *pb.SaveToRecycleBinRequest) (*p
 		return nil, status.Error(codes.FailedPrecondition, "短链接已被永久删除")
 	}
 
-	// 将短链接移至回收站（设置enable_status=1表示将链接放入回收站）
+	before := linkhistory.NewSnapshot(link)
+	// 将短链接移至回收站（设置enable_status=1表示将链接放入回收站），记录移入回收站的时间用于到期清除
+	now := time.Now()
 	link.EnableStatus = 1
+	link.RecycleTime = &now
+	link.UpdateTime = now
 	if err := l.svcCtx.RepoManager.Link.Update(l.ctx, link); err != nil {
 		l.Logger.Errorf("更新短链接状态失败: %v", err)
 		return nil, status.Error(codes.Internal, "保存到回收站失败")
 	}
 
-	// 删除跳转缓存
-	cacheKey := fmt.Sprintf(GotoShortLinkKey, in.FullShortUrl)
-	_, err = l.svcCtx.BizRedis.Del(cacheKey)
-	if err != nil {
-		l.Logger.Errorf("删除短链接跳转缓存失败: %v, 键: %s", err, cacheKey)
-		// 继续执行，不影响主流程
-	}
+	// 删除跳转、空值和预览缓存，移入回收站后立即停止跳转
+	deleteGotoCache(l.ctx, l.svcCtx, in.FullShortUrl)
+
+	recordLinkHistory(l.ctx, l.svcCtx, LinkHistoryRecycle, before, link)
 
 	return &pb.SaveToRecycleBinResponse{
 		Success: true,
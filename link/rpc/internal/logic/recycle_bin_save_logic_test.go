@@ -104,6 +104,10 @@ func TestRecycleBinSave_Normal(t *testing.T) {
 		t.Errorf("链接未进入回收站，期望EnableStatus=1，实际为%d", updatedLink.EnableStatus)
 		return
 	}
+	if updatedLink.RecycleTime == nil {
+		t.Errorf("链接进入回收站后未记录移入回收站时间")
+		return
+	}
 
 	t.Logf("正常保存到回收站测试成功")
 }
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/cachekey"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RedirectRuleCreateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedirectRuleCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedirectRuleCreateLogic {
+	return &RedirectRuleCreateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建跳转规则
+func (l *RedirectRuleCreateLogic) RedirectRuleCreate(in *pb.CreateRedirectRuleRequest) (*pb.CreateRedirectRuleResponse, error) {
+	// 参数校验
+	if err := validateRedirectRule(in.RuleType, in.RuleValue, in.TargetUrl); err != nil {
+		return nil, err
+	}
+
+	if err := verifyTargetWhitelist(l.svcCtx, in.TargetUrl); err != nil {
+		return nil, err
+	}
+
+	link, err := findRedirectRuleLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &model.LinkRedirectRule{
+		Gid:          link.Gid,
+		FullShortUrl: link.FullShortUrl,
+		RuleType:     in.RuleType,
+		RuleValue:    strings.TrimSpace(in.RuleValue),
+		TargetUrl:    in.TargetUrl,
+		SortOrder:    int(in.SortOrder),
+		CreateTime:   time.Now(),
+		UpdateTime:   time.Now(),
+		DelFlag:      0,
+	}
+	if err := l.svcCtx.RepoManager.RedirectRule.Create(l.ctx, rule); err != nil {
+		l.Logger.Errorf("创建跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建跳转规则失败")
+	}
+
+	// 目标链接安全检测，命中时跳转前展示风险提示并加入审核队列
+	flagUnsafeTargets(l.ctx, l.svcCtx, link, []string{rule.TargetUrl})
+
+	// 删除跳转缓存，使规则立即生效
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+
+	return &pb.CreateRedirectRuleResponse{
+		Id: rule.ID,
+	}, nil
+}
+
+// validateRedirectRule 校验跳转规则参数
+func validateRedirectRule(ruleType, ruleValue, targetUrl string) error {
+	if !util.IsValidRedirectRuleType(ruleType) {
+		return status.Errorf(codes.InvalidArgument, "不支持的规则类型: %s", ruleType)
+	}
+	if strings.TrimSpace(ruleValue) == "" {
+		return status.Error(codes.InvalidArgument, "匹配值不能为空")
+	}
+	if !util.IsHttpUrl(targetUrl) {
+		return status.Error(codes.InvalidArgument, "目标链接格式错误")
+	}
+	return nil
+}
+
+// findRedirectRuleLink 查询跳转规则所属的短链接，短链接不存在或已删除时返回错误
+func findRedirectRuleLink(ctx context.Context, svcCtx *svc.ServiceContext, fullShortUrl, gid string) (*model.Link, error) {
+	if fullShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+	if gid == "" {
+		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+
+	// 去掉协议前缀
+	fullShortUrl = strings.TrimPrefix(strings.TrimPrefix(fullShortUrl, "http://"), "https://")
+
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+	if err != nil || link.DelFlag > 0 {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+	return link, nil
+}
+
+// deleteGotoCache 删除短链接跳转缓存、空值缓存及社交分享预览缓存
+// 同时删除创建和修改短链接时写入的link:goto缓存
+func deleteGotoCache(ctx context.Context, svcCtx *svc.ServiceContext, fullShortUrl string) {
+	if _, err := svcCtx.BizRedis.DelCtx(ctx, cachekey.GotoKeys(fullShortUrl)...); err != nil {
+		logx.WithContext(ctx).Errorf("删除跳转缓存失败: %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// createRuleTestLink 创建用于测试跳转规则的短链接，返回完整短链接和短链接后缀
+func createRuleTestLink(t *testing.T) (string, string) {
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-rule",
+		Describe:  "测试跳转规则的短链接",
+	})
+	if err != nil {
+		t.Fatalf("创建短链接失败: %v", err)
+	}
+	return createResp.FullShortUrl, extractShortUri(createResp.FullShortUrl)
+}
+
+// TestRedirectRuleCreate_Normal 测试创建跳转规则后按规则跳转
+func TestRedirectRuleCreate_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	fullShortUrl, shortUri := createRuleTestLink(t)
+
+	// 1. 创建iOS和英语两条规则
+	createLogic := logic.NewRedirectRuleCreateLogic(ctx, svcCtx)
+	rules := []*pb.CreateRedirectRuleRequest{
+		{RuleType: "os", RuleValue: "iOS", TargetUrl: "https://apps.apple.com/app/id1", SortOrder: 1},
+		{RuleType: "language", RuleValue: "en", TargetUrl: "https://example.com/en", SortOrder: 2},
+	}
+	for _, rule := range rules {
+		rule.FullShortUrl = fullShortUrl
+		rule.Gid = "test-rule"
+		if _, err := createLogic.RedirectRuleCreate(rule); err != nil {
+			t.Errorf("创建跳转规则失败: %v", err)
+			return
+		}
+	}
+
+	// 2. 按规则匹配跳转
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	cases := []struct {
+		in   *pb.RestoreUrlRequest
+		want string
+	}{
+		{&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "iOS", AcceptLanguage: "en-US"}, "https://apps.apple.com/app/id1"},
+		{&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Android", AcceptLanguage: "en-US,en;q=0.9"}, "https://example.com/en"},
+		{&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Windows", AcceptLanguage: "zh-CN"}, "https://github.com/zeromicro/go-zero"},
+		// 英语不是最优先的语言时不命中
+		{&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Windows", AcceptLanguage: "zh-CN,zh;q=0.9,en;q=0.8"}, "https://github.com/zeromicro/go-zero"},
+		{&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Windows", AcceptLanguage: "zh-CN;q=0.5,en-GB"}, "https://example.com/en"},
+	}
+	for _, c := range cases {
+		resp, err := restoreLogic.RestoreUrl(c.in)
+		if err != nil {
+			t.Errorf("短链接跳转失败: %v", err)
+			return
+		}
+		if resp.OriginUrl != c.want {
+			t.Errorf("系统=%s 语言=%s 期望跳转到 %s, 实际为 %s", c.in.Os, c.in.AcceptLanguage, c.want, resp.OriginUrl)
+		}
+	}
+
+	// 3. 未携带操作系统时从User-Agent解析，iPhone的UA包含"like Mac OS X"也应识别为iOS
+	iphoneCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148"))
+	resp, err := logic.NewRestoreUrlLogic(iphoneCtx, svcCtx).RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri})
+	if err != nil {
+		t.Fatalf("短链接跳转失败: %v", err)
+	}
+	if resp.OriginUrl != "https://apps.apple.com/app/id1" {
+		t.Errorf("iPhone访问期望命中iOS规则，实际跳转到 %s", resp.OriginUrl)
+	}
+}
+
+// TestRedirectRuleCreate_InvalidType 测试创建不支持类型的跳转规则
+func TestRedirectRuleCreate_InvalidType(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	l := logic.NewRedirectRuleCreateLogic(ctx, svcCtx)
+	_, err := l.RedirectRuleCreate(&pb.CreateRedirectRuleRequest{
+		FullShortUrl: "nurl.ink/notexist",
+		Gid:          "test-rule",
+		RuleType:     "browser",
+		RuleValue:    "Chrome",
+		TargetUrl:    "https://example.com",
+	})
+	if err == nil {
+		t.Error("期望创建失败，但实际成功")
+		return
+	}
+	t.Logf("正确拒绝不支持的规则类型: %v", err)
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RedirectRuleDeleteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedirectRuleDeleteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedirectRuleDeleteLogic {
+	return &RedirectRuleDeleteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除跳转规则
+func (l *RedirectRuleDeleteLogic) RedirectRuleDelete(in *pb.DeleteRedirectRuleRequest) (*pb.DeleteRedirectRuleResponse, error) {
+	// 参数校验
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "规则ID不能为空")
+	}
+
+	link, err := findRedirectRuleLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 规则必须属于该短链接
+	rule, err := l.svcCtx.RepoManager.RedirectRule.FindByID(l.ctx, in.Id)
+	if err != nil || rule.FullShortUrl != link.FullShortUrl {
+		return nil, status.Error(codes.NotFound, "跳转规则不存在")
+	}
+
+	if err := l.svcCtx.RepoManager.RedirectRule.Delete(l.ctx, rule.ID); err != nil {
+		l.Logger.Errorf("删除跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "删除跳转规则失败")
+	}
+
+	// 删除跳转缓存，使规则立即生效
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+
+	return &pb.DeleteRedirectRuleResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+)
+
+// TestRedirectRuleDelete_Normal 测试删除跳转规则后回退到原始链接
+func TestRedirectRuleDelete_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	fullShortUrl, shortUri := createRuleTestLink(t)
+
+	createResp, err := logic.NewRedirectRuleCreateLogic(ctx, svcCtx).RedirectRuleCreate(&pb.CreateRedirectRuleRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          "test-rule",
+		RuleType:     "os",
+		RuleValue:    "iOS",
+		TargetUrl:    "https://apps.apple.com/app/id1",
+	})
+	if err != nil {
+		t.Errorf("创建跳转规则失败: %v", err)
+		return
+	}
+
+	_, err = logic.NewRedirectRuleDeleteLogic(ctx, svcCtx).RedirectRuleDelete(&pb.DeleteRedirectRuleRequest{
+		Id:           createResp.Id,
+		FullShortUrl: fullShortUrl,
+		Gid:          "test-rule",
+	})
+	if err != nil {
+		t.Errorf("删除跳转规则失败: %v", err)
+		return
+	}
+
+	resp, err := logic.NewRestoreUrlLogic(ctx, svcCtx).RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "iOS"})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if resp.OriginUrl != "https://github.com/zeromicro/go-zero" {
+		t.Errorf("期望跳转到原始链接，实际为 %s", resp.OriginUrl)
+	}
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RedirectRuleListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedirectRuleListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedirectRuleListLogic {
+	return &RedirectRuleListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询跳转规则
+func (l *RedirectRuleListLogic) RedirectRuleList(in *pb.ListRedirectRuleRequest) (*pb.ListRedirectRuleResponse, error) {
+	link, err := findRedirectRuleLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := l.svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(l.ctx, link.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询跳转规则失败")
+	}
+
+	records := make([]*pb.RedirectRule, 0, len(rules))
+	for _, rule := range rules {
+		records = append(records, &pb.RedirectRule{
+			Id:           rule.ID,
+			FullShortUrl: rule.FullShortUrl,
+			Gid:          rule.Gid,
+			RuleType:     rule.RuleType,
+			RuleValue:    rule.RuleValue,
+			TargetUrl:    rule.TargetUrl,
+			SortOrder:    int32(rule.SortOrder),
+			CreateTime:   rule.CreateTime.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.ListRedirectRuleResponse{
+		Rules: records,
+	}, nil
+}
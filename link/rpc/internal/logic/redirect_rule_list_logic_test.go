@@ -0,0 +1,41 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+)
+
+// TestRedirectRuleList_Normal 测试按优先级查询跳转规则
+func TestRedirectRuleList_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	fullShortUrl, _ := createRuleTestLink(t)
+
+	createLogic := logic.NewRedirectRuleCreateLogic(ctx, svcCtx)
+	for _, sortOrder := range []int32{2, 1} {
+		if _, err := createLogic.RedirectRuleCreate(&pb.CreateRedirectRuleRequest{
+			FullShortUrl: fullShortUrl,
+			Gid:          "test-rule",
+			RuleType:     "province",
+			RuleValue:    "广东",
+			TargetUrl:    "https://example.com/gd",
+			SortOrder:    sortOrder,
+		}); err != nil {
+			t.Errorf("创建跳转规则失败: %v", err)
+			return
+		}
+	}
+
+	l := logic.NewRedirectRuleListLogic(ctx, svcCtx)
+	resp, err := l.RedirectRuleList(&pb.ListRedirectRuleRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          "test-rule",
+	})
+	if err != nil {
+		t.Errorf("查询跳转规则失败: %v", err)
+		return
+	}
+	if len(resp.Rules) != 2 || resp.Rules[0].SortOrder != 1 {
+		t.Errorf("期望按排序返回2条规则，实际: %+v", resp.Rules)
+	}
+}
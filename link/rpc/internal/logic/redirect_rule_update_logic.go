@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RedirectRuleUpdateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedirectRuleUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedirectRuleUpdateLogic {
+	return &RedirectRuleUpdateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改跳转规则
+func (l *RedirectRuleUpdateLogic) RedirectRuleUpdate(in *pb.UpdateRedirectRuleRequest) (*pb.UpdateRedirectRuleResponse, error) {
+	// 参数校验
+	if in.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "规则ID不能为空")
+	}
+	if err := validateRedirectRule(in.RuleType, in.RuleValue, in.TargetUrl); err != nil {
+		return nil, err
+	}
+	if err := verifyTargetWhitelist(l.svcCtx, in.TargetUrl); err != nil {
+		return nil, err
+	}
+
+	link, err := findRedirectRuleLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 规则必须属于该短链接
+	rule, err := l.svcCtx.RepoManager.RedirectRule.FindByID(l.ctx, in.Id)
+	if err != nil || rule.FullShortUrl != link.FullShortUrl {
+		return nil, status.Error(codes.NotFound, "跳转规则不存在")
+	}
+
+	targetChanged := rule.TargetUrl != in.TargetUrl
+	rule.RuleType = in.RuleType
+	rule.RuleValue = strings.TrimSpace(in.RuleValue)
+	rule.TargetUrl = in.TargetUrl
+	rule.SortOrder = int(in.SortOrder)
+	if err := l.svcCtx.RepoManager.RedirectRule.Update(l.ctx, rule); err != nil {
+		l.Logger.Errorf("修改跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "修改跳转规则失败")
+	}
+
+	// 目标链接变更时重新进行安全检测
+	if targetChanged {
+		flagUnsafeTargets(l.ctx, l.svcCtx, link, []string{rule.TargetUrl})
+	}
+
+	// 删除跳转缓存，使规则立即生效
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+
+	return &pb.UpdateRedirectRuleResponse{}, nil
+}
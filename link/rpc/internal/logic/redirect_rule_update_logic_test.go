@@ -0,0 +1,54 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+)
+
+// TestRedirectRuleUpdate_Normal 测试修改跳转规则后立即生效
+func TestRedirectRuleUpdate_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	fullShortUrl, shortUri := createRuleTestLink(t)
+
+	createResp, err := logic.NewRedirectRuleCreateLogic(ctx, svcCtx).RedirectRuleCreate(&pb.CreateRedirectRuleRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          "test-rule",
+		RuleType:     "os",
+		RuleValue:    "Android",
+		TargetUrl:    "https://example.com/android",
+	})
+	if err != nil {
+		t.Errorf("创建跳转规则失败: %v", err)
+		return
+	}
+
+	// 先访问一次，写入跳转缓存
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	if _, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Android"}); err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+
+	_, err = logic.NewRedirectRuleUpdateLogic(ctx, svcCtx).RedirectRuleUpdate(&pb.UpdateRedirectRuleRequest{
+		Id:           createResp.Id,
+		FullShortUrl: fullShortUrl,
+		Gid:          "test-rule",
+		RuleType:     "os",
+		RuleValue:    "Android",
+		TargetUrl:    "https://example.com/android-v2",
+	})
+	if err != nil {
+		t.Errorf("修改跳转规则失败: %v", err)
+		return
+	}
+
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri, Os: "Android"})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if resp.OriginUrl != "https://example.com/android-v2" {
+		t.Errorf("期望跳转到修改后的链接，实际为 %s", resp.OriginUrl)
+	}
+}
@@ -2,18 +2,25 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
+	"shorterurl/link/rpc/internal/cachekey"
 	"shorterurl/link/rpc/internal/consumer"
+	"shorterurl/link/rpc/internal/repo"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
 
 	"crypto/md5"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/threading"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -23,13 +30,46 @@ import (
 // Redis键模板常量
 const (
 	// 短链接跳转前缀Key
-	ShortLinkGotoKey = "short-link:goto:%s"
+	ShortLinkGotoKey = cachekey.ShortLinkGotoKey
 	// 短链接空值跳转前缀Key
-	ShortLinkIsNullGotoKey = "short-link:is-null:goto_%s"
+	ShortLinkIsNullGotoKey = cachekey.ShortLinkIsNullGotoKey
 	// 短链接跳转锁前缀Key
 	ShortLinkLockGotoKey = "short-link:lock:goto:%s"
+	// 已验证自定义域名前缀Key
+	UserDomainVerifiedKey = "short-link:domain:verified:%s"
+	// 短链接剩余访问次数前缀Key
+	ShortLinkClicksRemainingKey = cachekey.ShortLinkClicksRemainingKey
+	// 短链接社交分享预览前缀Key
+	ShortLinkPreviewKey = cachekey.ShortLinkPreviewKey
+	// 目标页面预览信息前缀Key，按目标链接的MD5缓存
+	ShortLinkPreviewPageKey = "short-link:preview:page:%s"
+	// 短链接二维码前缀Key，按短链接和渲染参数的MD5缓存
+	ShortLinkQrCodeKey = "short-link:qrcode:%s"
+	// 分组操作锁前缀Key
+	ShortLinkLockGroupKey = "short-link:lock:group:%s"
+	// 短链接导入任务前缀Key
+	ShortLinkImportJobKey = "short-link:import:job:%s"
+	// 短链接导出任务前缀Key
+	ShortLinkExportJobKey = "short-link:export:job:%s"
+	// 短链接导出文件下载令牌前缀Key
+	ShortLinkExportTokenKey = "short-link:export:token:%s"
+	// 短链接导出文件分块前缀Key，按任务ID和分块序号保存
+	ShortLinkExportFileKey = "short-link:export:file:%s:%d"
 )
 
+// consumeClickScript 原子扣减剩余访问次数
+// 返回 -2：计数器不存在，需要从数据库初始化；-1：次数已用完；其他：扣减后的剩余次数
+var consumeClickScript = redis.NewScript(`
+local remaining = redis.call("GET", KEYS[1])
+if not remaining then
+	return -2
+end
+if tonumber(remaining) <= 0 then
+	return -1
+end
+return redis.call("DECR", KEYS[1])
+`)
+
 type RestoreUrlLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -53,19 +93,18 @@ func (l *RestoreUrlLogic) RestoreUrl(in *pb.RestoreUrlRequest) (*pb.RestoreUrlRe
 
 	l.Logger.Infof("开始处理短链接跳转请求: %s", in.ShortUri)
 
-	// 构建完整短链接 - 从配置中获取域名
-	domain := l.svcCtx.Config.DefaultDomain
+	// 构建完整短链接 - 请求的Host为已验证的自定义域名时使用该域名，否则使用配置中的默认域名
+	domain := resolveDomain(l.ctx, l.svcCtx, in.Host)
 	fullShortUrl := fmt.Sprintf("%s/%s", domain, in.ShortUri)
 
 	// 1. 首先尝试从Redis缓存中获取原始链接
 	cacheKey := fmt.Sprintf(ShortLinkGotoKey, fullShortUrl)
-	originUrl, err := l.svcCtx.BizRedis.Get(cacheKey)
-	if err == nil && originUrl != "" {
-		// 找到缓存的原始链接，进行访问统计并返回
-		l.asyncRecordStats(fullShortUrl, in.ShortUri)
-		return &pb.RestoreUrlResponse{
-			OriginUrl: originUrl,
-		}, nil
+	cached, err := l.svcCtx.BizRedis.Get(cacheKey)
+	if err == nil && cached != "" {
+		if value := parseGotoCache(cached); value != nil && value.OriginUrl != "" {
+			// 找到缓存的原始链接，进行访问统计并返回
+			return l.respond(in, fullShortUrl, value)
+		}
 	}
 
 	// 2. 检查空值缓存，避免无效短链接的重复查询
@@ -106,12 +145,28 @@ func (l *RestoreUrlLogic) RestoreUrl(in *pb.RestoreUrlRequest) (*pb.RestoreUrlRe
 		return nil, status.Error(codes.PermissionDenied, "短链接已被禁用")
 	}
 
-	// 7. 检查链接是否过期
+	// 7. 检查链接是否过期，宽限期内继续跳转
 	now := time.Now()
-	if !link.ValidDate.IsZero() && link.ValidDate.Before(now) {
+	expiryPolicy := mergeExpiryPolicy(link, findGroupExpiryPolicy(l.ctx, l.svcCtx, link.Gid))
+	expireAt := util.GetLinkExpireTime(link.ValidDate, expiryPolicy.GraceDays)
+	if !link.ValidDate.IsZero() && expireAt.Before(now) {
 		l.Logger.Errorf("链接已过期")
-		l.svcCtx.BizRedis.Setex(nullCacheKey, "-", 30*60) // 缓存30分钟
-		return nil, status.Error(codes.PermissionDenied, "短链接已过期")
+		if expiryPolicy.ExpiredUrl == "" && expiryPolicy.ExpiredMessage == "" {
+			l.svcCtx.BizRedis.Setex(nullCacheKey, "-", 30*60) // 缓存30分钟
+			return nil, status.Error(codes.PermissionDenied, "短链接已过期")
+		}
+
+		// 配置了过期处理方式时缓存过期信息，由跳转接口展示提示或跳转到指定链接
+		value := &gotoCacheValue{
+			OriginUrl:      link.OriginUrl,
+			ExpireAt:       &expireAt,
+			ExpiredUrl:     expiryPolicy.ExpiredUrl,
+			ExpiredMessage: expiryPolicy.ExpiredMessage,
+		}
+		if data, err := json.Marshal(value); err == nil {
+			l.svcCtx.BizRedis.Setex(cacheKey, string(data), 30*60) // 缓存30分钟
+		}
+		return l.respond(in, fullShortUrl, value)
 	}
 
 	// 8. 将有效链接缓存到Redis，计算缓存过期时间
@@ -120,25 +175,331 @@ func (l *RestoreUrlLogic) RestoreUrl(in *pb.RestoreUrlRequest) (*pb.RestoreUrlRe
 		// 无过期时间，默认缓存一天
 		cacheExpireSeconds = 24 * 60 * 60
 	} else {
-		// 计算链接的剩余有效时间（秒）
-		cacheExpireSeconds = int(link.ValidDate.Sub(now).Seconds())
+		// 计算链接的剩余有效时间（秒），包含宽限期
+		cacheExpireSeconds = int(expireAt.Sub(now).Seconds())
 		if cacheExpireSeconds <= 0 {
 			cacheExpireSeconds = 1 // 至少缓存1秒
 		}
 	}
-	l.svcCtx.BizRedis.Setex(cacheKey, link.OriginUrl, cacheExpireSeconds)
+	// 尚未生效的链接只缓存到生效时间，到达生效时间后重新加载
+	if util.IsLinkNotYetActive(link.ValidFrom) {
+		if seconds := int(link.ValidFrom.Sub(now).Seconds()); seconds < cacheExpireSeconds {
+			cacheExpireSeconds = seconds
+		}
+		if cacheExpireSeconds <= 0 {
+			cacheExpireSeconds = 1 // 至少缓存1秒
+		}
+	}
+	value := &gotoCacheValue{
+		OriginUrl:        link.OriginUrl,
+		PasswordRequired: link.Password != "",
+		PasswordVersion:  util.PasswordVersion(link.Password),
+		MaxClicks:        link.MaxClicks,
+		ValidFrom:        link.ValidFrom,
+		QueryParamPolicy: link.QueryParamPolicy,
+		ExpiredUrl:       expiryPolicy.ExpiredUrl,
+		ExpiredMessage:   expiryPolicy.ExpiredMessage,
+		RedirectType:     link.RedirectType,
+		DeepLink: util.DeepLink{
+			IosUrl:         link.IosDeepLink,
+			AndroidPackage: link.AndroidPackage,
+			AndroidUrl:     link.AndroidDeepLink,
+			FallbackUrl:    link.DeepLinkFallbackUrl,
+		},
+		Utm: util.UtmTemplate{
+			Source:   link.UtmSource,
+			Medium:   link.UtmMedium,
+			Campaign: link.UtmCampaign,
+		},
+	}
+	if !link.ValidDate.IsZero() {
+		value.ExpireAt = &expireAt
+	}
+	// 创建后命中安全检测的短链接，跳转前提示访问者
+	if link.SafetyStatus == urlsafety.SafetyStatusWarning {
+		value.SafetyWarning = link.SafetyReason
+		if value.SafetyWarning == "" {
+			value.SafetyWarning = "目标链接存在安全风险"
+		}
+	}
+
+	// 跳转规则及A/B分流版本与原始链接一起缓存，查询失败时仅使用原始链接且不缓存
+	rules, err := l.svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败: %v", err)
+		return l.respond(in, fullShortUrl, value)
+	}
+	for _, rule := range rules {
+		value.Rules = append(value.Rules, gotoCacheRule{
+			Type:      rule.RuleType,
+			Value:     rule.RuleValue,
+			TargetUrl: rule.TargetUrl,
+		})
+	}
 
-	// 9. 记录访问统计
-	l.asyncRecordStats(fullShortUrl, in.ShortUri)
+	variants, err := l.svcCtx.RepoManager.Variant.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询A/B分流版本失败: %v", err)
+		return l.respond(in, fullShortUrl, value)
+	}
+	for _, variant := range variants {
+		value.Variants = append(value.Variants, gotoCacheVariant{
+			Name:      variant.Name,
+			TargetUrl: variant.TargetUrl,
+			Weight:    variant.Weight,
+		})
+	}
+	if data, err := json.Marshal(value); err == nil {
+		l.svcCtx.BizRedis.Setex(cacheKey, string(data), cacheExpireSeconds)
+	}
+
+	// 9. 记录访问统计并返回原始链接
+	return l.respond(in, fullShortUrl, value)
+}
+
+// gotoCacheValue 跳转缓存内容
+type gotoCacheValue struct {
+	OriginUrl        string             `json:"originUrl"`
+	PasswordRequired bool               `json:"passwordRequired,omitempty"`
+	PasswordVersion  string             `json:"passwordVersion,omitempty"`
+	MaxClicks        int                `json:"maxClicks,omitempty"`
+	ValidFrom        *time.Time         `json:"validFrom,omitempty"`
+	ExpireAt         *time.Time         `json:"expireAt,omitempty"`
+	ExpiredUrl       string             `json:"expiredUrl,omitempty"`
+	ExpiredMessage   string             `json:"expiredMessage,omitempty"`
+	RedirectType     int                `json:"redirectType,omitempty"`
+	DeepLink         util.DeepLink      `json:"deepLink"`
+	QueryParamPolicy int                `json:"queryParamPolicy,omitempty"`
+	Utm              util.UtmTemplate   `json:"utm"`
+	Rules            []gotoCacheRule    `json:"rules,omitempty"`
+	Variants         []gotoCacheVariant `json:"variants,omitempty"`
+	SafetyWarning    string             `json:"safetyWarning,omitempty"`
+}
 
-	// 10. 返回原始链接
+// gotoCacheRule 缓存的跳转规则，按优先级排序
+type gotoCacheRule struct {
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	TargetUrl string `json:"targetUrl"`
+}
+
+// gotoCacheVariant 缓存的A/B分流版本
+type gotoCacheVariant struct {
+	Name      string `json:"name"`
+	TargetUrl string `json:"targetUrl"`
+	Weight    int    `json:"weight"`
+}
+
+// parseGotoCache 解析跳转缓存，兼容只缓存原始链接的旧格式
+func parseGotoCache(value string) *gotoCacheValue {
+	if !strings.HasPrefix(value, "{") {
+		return &gotoCacheValue{OriginUrl: value}
+	}
+
+	var v gotoCacheValue
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil
+	}
+	return &v
+}
+
+// respond 构建跳转响应，尚未生效、已过期或需要密码且访问者未验证时不返回原始链接，也不记录访问统计
+func (l *RestoreUrlLogic) respond(in *pb.RestoreUrlRequest, fullShortUrl string, value *gotoCacheValue) (*pb.RestoreUrlResponse, error) {
+	if util.IsLinkNotYetActive(value.ValidFrom) {
+		return &pb.RestoreUrlResponse{
+			NotYetActive: true,
+			ValidFrom:    value.ValidFrom.Format(time.RFC3339),
+		}, nil
+	}
+
+	// 已过期时返回过期处理方式，未配置时拒绝访问
+	if value.ExpireAt != nil && value.ExpireAt.Before(time.Now()) {
+		if value.ExpiredUrl == "" && value.ExpiredMessage == "" {
+			return nil, status.Error(codes.PermissionDenied, "短链接已过期")
+		}
+		return &pb.RestoreUrlResponse{
+			Expired:        true,
+			ExpiredUrl:     value.ExpiredUrl,
+			ExpiredMessage: value.ExpiredMessage,
+		}, nil
+	}
+
+	if value.PasswordRequired && !util.VerifyUnlockToken(l.svcCtx.Config.LinkPassword.UnlockSecret,
+		fullShortUrl, value.PasswordVersion, in.UnlockToken) {
+		return &pb.RestoreUrlResponse{
+			PasswordRequired: true,
+		}, nil
+	}
+
+	// 限制访问次数的短链接需要先扣减剩余次数
+	if value.MaxClicks > 0 && !l.consumeClick(fullShortUrl) {
+		return nil, status.Error(codes.PermissionDenied, "短链接访问次数已达上限")
+	}
+
+	// 渠道标记只用于访问统计，不传递给目标链接
+	channel, query := util.ExtractChannel(in.Query)
+	targetUrl, variant := l.matchTargetUrl(in, fullShortUrl, value)
+	targetUrl = l.applyQueryParams(in, value, targetUrl, variant, query)
+	// 爬虫访问不计入访问统计
+	if !in.Crawler {
+		l.asyncRecordStats(fullShortUrl, in.ShortUri, variant, channel)
+	}
+	// 深度链接原样返回，由网关根据访问设备选择打开应用或跳转兜底链接
 	return &pb.RestoreUrlResponse{
-		OriginUrl: link.OriginUrl,
+		OriginUrl:           targetUrl,
+		RedirectType:        int32(value.RedirectType),
+		IosDeepLink:         value.DeepLink.IosUrl,
+		AndroidPackage:      value.DeepLink.AndroidPackage,
+		AndroidDeepLink:     value.DeepLink.AndroidUrl,
+		DeepLinkFallbackUrl: value.DeepLink.FallbackUrl,
+		SafetyWarning:       value.SafetyWarning != "",
+		SafetyReason:        value.SafetyWarning,
 	}, nil
 }
 
-// 异步记录访问统计
-func (l *RestoreUrlLogic) asyncRecordStats(fullShortUrl, shortUri string) {
+// applyQueryParams 追加UTM参数，并按查询参数策略合并访问时携带的查询参数
+// 目标链接无法解析时返回原目标链接，保证跳转可用
+func (l *RestoreUrlLogic) applyQueryParams(in *pb.RestoreUrlRequest, value *gotoCacheValue, targetUrl, variant, query string) string {
+	os := in.Os
+	if os == "" && strings.Contains(value.Utm.Source+value.Utm.Medium+value.Utm.Campaign, util.UtmPlaceholderOs) {
+		_, os, _ = parseUserAgent(l.getValueFromContext(l.ctx, "user-agent", ""))
+	}
+
+	result, err := util.ApplyUtmTemplate(targetUrl, value.Utm, in.ShortUri, os, variant)
+	if err != nil {
+		l.Logger.Errorf("追加UTM参数失败: %v", err)
+		return targetUrl
+	}
+
+	merged, err := util.MergeQueryParams(result, query, value.QueryParamPolicy)
+	if err != nil {
+		l.Logger.Errorf("合并查询参数失败: %v", err)
+		return result
+	}
+	return merged
+}
+
+// matchTargetUrl 选择跳转目标，返回目标链接和命中的A/B分流版本
+// 优先按顺序匹配跳转规则，未命中时按访问者标识选择A/B分流版本，否则返回原始链接
+func (l *RestoreUrlLogic) matchTargetUrl(in *pb.RestoreUrlRequest, fullShortUrl string, value *gotoCacheValue) (string, string) {
+	if targetUrl := l.matchRules(in, value); targetUrl != "" {
+		return targetUrl, ""
+	}
+
+	if len(value.Variants) > 0 {
+		weights := make([]int, 0, len(value.Variants))
+		for _, v := range value.Variants {
+			weights = append(weights, v.Weight)
+		}
+		user := l.getUserIdentifier(l.getValueFromContext(l.ctx, "ip", ""), l.getValueFromContext(l.ctx, "user-agent", ""))
+		picked := value.Variants[util.PickVariant(fullShortUrl, user, weights)]
+		return picked.TargetUrl, picked.Name
+	}
+
+	return value.OriginUrl, ""
+}
+
+// matchRules 按优先级匹配跳转规则，返回第一条命中规则的目标链接，未命中时返回空
+func (l *RestoreUrlLogic) matchRules(in *pb.RestoreUrlRequest, value *gotoCacheValue) string {
+	if len(value.Rules) == 0 {
+		return ""
+	}
+
+	visitor := util.RedirectVisitor{
+		Os:             in.Os,
+		Province:       in.Province,
+		AcceptLanguage: in.AcceptLanguage,
+	}
+	// 未携带操作系统时从User-Agent中解析
+	if visitor.Os == "" {
+		_, visitor.Os, _ = parseUserAgent(l.getValueFromContext(l.ctx, "user-agent", ""))
+	}
+
+	for _, rule := range value.Rules {
+		if util.MatchRedirectRule(rule.Type, rule.Value, visitor) {
+			return rule.TargetUrl
+		}
+	}
+	return ""
+}
+
+// consumeClick 扣减一次剩余访问次数，次数已用完时返回false
+// 计数器不存在时从数据库初始化，Redis异常时放行
+func (l *RestoreUrlLogic) consumeClick(fullShortUrl string) bool {
+	key := fmt.Sprintf(ShortLinkClicksRemainingKey, fullShortUrl)
+	for i := 0; i < 2; i++ {
+		val, err := l.svcCtx.BizRedis.ScriptRunCtx(l.ctx, consumeClickScript, []string{key})
+		if err != nil {
+			l.Logger.Errorf("扣减剩余访问次数失败: %v", err)
+			return true
+		}
+
+		remaining, _ := val.(int64)
+		if remaining == -1 {
+			return false
+		}
+		if remaining != -2 || i > 0 {
+			return true
+		}
+
+		if err := l.initRemainingClicks(key, fullShortUrl); err != nil {
+			l.Logger.Errorf("初始化剩余访问次数失败: %v", err)
+			return true
+		}
+	}
+	return true
+}
+
+// initRemainingClicks 根据最大访问次数和已访问次数初始化剩余访问次数计数器，已存在时不覆盖
+func (l *RestoreUrlLogic) initRemainingClicks(key, fullShortUrl string) error {
+	linkGoto, err := l.svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		return err
+	}
+	link, err := l.svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(l.ctx, fullShortUrl, linkGoto.Gid)
+	if err != nil {
+		return err
+	}
+
+	// 已取消访问次数限制，不再初始化计数器
+	if link.MaxClicks <= 0 {
+		return nil
+	}
+
+	remaining := link.MaxClicks - link.ClickNum
+	if remaining < 0 {
+		remaining = 0
+	}
+	expire := util.GetLinkCacheValidSeconds(nil, link.ValidDate)
+	_, err = l.svcCtx.BizRedis.SetnxExCtx(l.ctx, key, strconv.Itoa(remaining), expire)
+	return err
+}
+
+// resolveDomain 解析请求Host对应的短链接域名
+// 未注册或未验证的Host回退到默认域名，避免任意Host产生新的空值缓存
+func resolveDomain(ctx context.Context, svcCtx *svc.ServiceContext, host string) string {
+	defaultDomain := svcCtx.Config.DefaultDomain
+	host = util.NormalizeHost(host)
+	if host == "" || host == util.NormalizeHost(defaultDomain) {
+		return defaultDomain
+	}
+
+	// 只缓存已验证的域名，未知Host不写入缓存
+	cacheKey := fmt.Sprintf(UserDomainVerifiedKey, host)
+	if verified, _ := svcCtx.BizRedis.GetCtx(ctx, cacheKey); verified != "" {
+		return host
+	}
+
+	userDomain, err := svcCtx.RepoManager.UserDomain.FindByDomain(ctx, host)
+	if err != nil || userDomain.VerifyStatus != repo.DomainVerifyStatusVerified {
+		return defaultDomain
+	}
+	svcCtx.BizRedis.SetexCtx(ctx, cacheKey, "1", 10*60) // 缓存10分钟
+	return host
+}
+
+// 异步记录访问统计，variant为命中的A/B分流版本，channel为访问渠道
+func (l *RestoreUrlLogic) asyncRecordStats(fullShortUrl, shortUri, variant, channel string) {
 	threading.GoSafe(func() {
 		// 创建新的上下文
 		ctx := context.Background()
@@ -201,6 +562,8 @@ func (l *RestoreUrlLogic) asyncRecordStats(fullShortUrl, shortUri string) {
 			Os:           os,
 			Device:       device,
 			Network:      network,
+			Variant:      variant,
+			Channel:      channel,
 			CurrentDate:  time.Now(),
 		}
 
@@ -257,18 +620,18 @@ func parseUserAgent(userAgent string) (browser, os, device string) {
 		browser = "Internet Explorer"
 	}
 
-	// 检测操作系统
+	// 检测操作系统，移动系统的UA同时包含linux或mac os x，需要先判断
 	switch {
+	case strings.Contains(ua, "android"):
+		os = "Android"
+	case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad") || strings.Contains(ua, "ipod"):
+		os = "iOS"
 	case strings.Contains(ua, "windows"):
 		os = "Windows"
 	case strings.Contains(ua, "macintosh") || strings.Contains(ua, "mac os x"):
 		os = "macOS"
 	case strings.Contains(ua, "linux"):
 		os = "Linux"
-	case strings.Contains(ua, "android"):
-		os = "Android"
-	case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad") || strings.Contains(ua, "ipod"):
-		os = "iOS"
 	}
 
 	// 检测设备类型
@@ -362,7 +725,7 @@ func (l *RestoreUrlLogic) getUserIdentifier(ip, userAgent string) string {
 
 // 检查是否是新的 UV
 func (l *RestoreUrlLogic) checkFirstUv(fullShortUrl, user string) bool {
-	key := fmt.Sprintf("short-link:stats:uv:%s", fullShortUrl)
+	key := fmt.Sprintf(cachekey.ShortLinkStatsUvKey, fullShortUrl)
 	added, err := l.svcCtx.BizRedis.Sadd(key, user)
 	if err != nil {
 		logx.Errorf("检查UV失败: %v", err)
@@ -378,7 +741,7 @@ func (l *RestoreUrlLogic) checkFirstUip(fullShortUrl, ip string) bool {
 	if ip == "" {
 		return false
 	}
-	key := fmt.Sprintf("short-link:stats:uip:%s", fullShortUrl)
+	key := fmt.Sprintf(cachekey.ShortLinkStatsUipKey, fullShortUrl)
 	added, err := l.svcCtx.BizRedis.Sadd(key, ip)
 	if err != nil {
 		logx.Errorf("检查UIP失败: %v", err)
@@ -1,9 +1,13 @@
 package logic_test
 
 import (
+	"fmt"
 	"shorterurl/link/rpc/internal/logic"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestRestoreUrl_Normal 测试正常情况下的短链接跳转
@@ -99,6 +103,426 @@ func TestRestoreUrl_EmptyInput(t *testing.T) {
 	t.Logf("正确处理空短链接: %v", err)
 }
 
+// TestRestoreUrl_WithHost 测试根据请求Host解析短链接
+func TestRestoreUrl_WithHost(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试Host跳转的短链接",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+
+	// Host带端口且大小写不同，也应解析到默认域名下的短链接
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	restoreResp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{
+		ShortUri: shortUri,
+		Host:     strings.ToUpper(svcCtx.Config.DefaultDomain) + ":8000",
+	})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	t.Logf("携带Host跳转成功，原始链接: %s", restoreResp.OriginUrl)
+
+	// 未注册的域名回退到默认域名解析
+	restoreResp, err = restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{
+		ShortUri: shortUri,
+		Host:     "other.example.com",
+	})
+	if err != nil {
+		t.Errorf("未注册域名回退默认域名跳转失败: %v", err)
+		return
+	}
+	t.Logf("未注册域名回退默认域名，原始链接: %s", restoreResp.OriginUrl)
+}
+
+// TestRestoreUrl_MaxClicks 测试限制访问次数的短链接，次数用完后拒绝跳转
+func TestRestoreUrl_MaxClicks(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试限制访问次数的短链接",
+		MaxClicks: 2,
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+
+	// 前两次访问正常跳转
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	for i := 0; i < 2; i++ {
+		if _, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri}); err != nil {
+			t.Errorf("第%d次跳转失败: %v", i+1, err)
+			return
+		}
+	}
+
+	// 第三次访问应被拒绝
+	_, err = restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri})
+	if err == nil {
+		t.Error("期望访问次数用完后跳转失败，但实际成功")
+		return
+	}
+	t.Logf("访问次数用完后正确拒绝跳转: %v", err)
+}
+
+// TestRestoreUrl_Variants 测试A/B分流短链接的跳转
+func TestRestoreUrl_Variants(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 权重之和不为100时创建失败
+	_, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试A/B分流的短链接",
+		Variants: []*pb.LinkVariant{
+			{Name: "A", TargetUrl: "https://github.com/zeromicro/go-zero", Weight: 50},
+			{Name: "B", TargetUrl: "https://go-zero.dev", Weight: 30},
+		},
+	})
+	if err == nil {
+		t.Error("期望权重之和不为100时创建失败，但实际成功")
+		return
+	}
+	t.Logf("权重之和不为100正确拒绝: %v", err)
+
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试A/B分流的短链接",
+		Variants: []*pb.LinkVariant{
+			{Name: "A", TargetUrl: "https://github.com/zeromicro/go-zero", Weight: 50},
+			{Name: "B", TargetUrl: "https://go-zero.dev", Weight: 50},
+		},
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+
+	// 同一访客多次访问应跳转到同一版本
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	var firstUrl string
+	for i := 0; i < 3; i++ {
+		resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri})
+		if err != nil {
+			t.Errorf("第%d次跳转失败: %v", i+1, err)
+			return
+		}
+		if resp.OriginUrl != "https://github.com/zeromicro/go-zero" && resp.OriginUrl != "https://go-zero.dev" {
+			t.Errorf("跳转链接不属于任何版本: %s", resp.OriginUrl)
+			return
+		}
+		if i == 0 {
+			firstUrl = resp.OriginUrl
+		} else if resp.OriginUrl != firstUrl {
+			t.Errorf("同一访客跳转版本不一致, 期望 %s, 实际 %s", firstUrl, resp.OriginUrl)
+			return
+		}
+	}
+	t.Logf("A/B分流跳转成功，命中链接: %s", firstUrl)
+}
+
+// TestRestoreUrl_QueryParams 测试查询参数策略和UTM模板
+func TestRestoreUrl_QueryParams(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 使用不支持的占位符时创建失败
+	_, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试UTM模板的短链接",
+		UtmSource: "{unknown}",
+	})
+	if err == nil {
+		t.Error("期望UTM模板校验失败，但实际成功")
+		return
+	}
+	t.Logf("无效UTM模板正确拒绝: %v", err)
+
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:        "https://github.com/zeromicro/go-zero?tab=readme",
+		Gid:              "test-restore",
+		Describe:         "测试UTM模板的短链接",
+		QueryParamPolicy: util.QueryParamPolicyMerge,
+		UtmSource:        "shortlink",
+		UtmCampaign:      "{shortUri}",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+
+	// 合并模式下访问时携带的参数覆盖同名参数
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{
+		ShortUri: shortUri,
+		Query:    "tab=code&utm_source=wechat",
+	})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+
+	for _, expected := range []string{"tab=code", "utm_source=wechat", "utm_campaign=" + shortUri} {
+		if !strings.Contains(resp.OriginUrl, expected) {
+			t.Errorf("期望跳转链接包含 %s, 实际为 %s", expected, resp.OriginUrl)
+			return
+		}
+	}
+	t.Logf("查询参数合并成功，跳转链接: %s", resp.OriginUrl)
+}
+
+// TestRestoreUrl_NotYetActive 测试尚未到生效时间的短链接跳转
+func TestRestoreUrl_NotYetActive(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试定时生效的短链接",
+		ValidFrom: time.Now().Add(time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+
+	// 生效前访问不返回原始链接
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if !resp.NotYetActive || resp.OriginUrl != "" || resp.ValidFrom == "" {
+		t.Errorf("期望返回尚未生效状态且不返回原始链接，实际: %+v", resp)
+		return
+	}
+	t.Logf("尚未生效的短链接正确拒绝跳转，生效时间: %s", resp.ValidFrom)
+
+	// 生效时间晚于有效期时创建失败
+	_, err = createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:     "https://github.com/zeromicro/go-zero",
+		Gid:           "test-restore",
+		Describe:      "测试定时生效的短链接",
+		ValidDateType: 1,
+		ValidDate:     time.Now().Add(time.Hour).Format(time.RFC3339),
+		ValidFrom:     time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+	})
+	if err == nil {
+		t.Error("期望生效时间晚于有效期时创建失败，但实际成功")
+		return
+	}
+	t.Logf("生效时间晚于有效期正确拒绝: %v", err)
+}
+
+// TestRestoreUrl_NullCacheClearedOnCreate 测试创建短链接前访问留下的空值缓存不影响创建后的跳转
+func TestRestoreUrl_NullCacheClearedOnCreate(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	customUri := fmt.Sprintf("launch%d", time.Now().UnixNano()%1000000)
+
+	// 创建前访问，写入空值缓存
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	if _, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: customUri}); err == nil {
+		t.Error("期望短链接创建前跳转失败，但实际成功")
+		return
+	}
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	if _, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-restore",
+		Describe:  "测试提前公布的短链接",
+		CustomUri: customUri,
+	}); err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+
+	// 创建后立即可以访问
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: customUri})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	t.Logf("创建后跳转成功，原始链接: %s", resp.OriginUrl)
+}
+
+// TestRestoreUrl_Expired 测试过期短链接的宽限期及过期后跳转
+func TestRestoreUrl_Expired(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	expiredDate := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	// 宽限期内继续跳转到原始链接
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:     "https://github.com/zeromicro/go-zero",
+		Gid:           "test-restore",
+		Describe:      "测试过期宽限期的短链接",
+		ValidDateType: 1,
+		ValidDate:     expiredDate,
+		GraceDays:     1,
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: extractShortUri(createResp.FullShortUrl)})
+	if err != nil || resp.OriginUrl != "https://github.com/zeromicro/go-zero" {
+		t.Errorf("期望宽限期内正常跳转, resp: %+v, err: %v", resp, err)
+		return
+	}
+
+	// 超过有效期后跳转到过期链接
+	createResp, err = createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:     "https://github.com/zeromicro/go-zero",
+		Gid:           "test-restore",
+		Describe:      "测试过期跳转的短链接",
+		ValidDateType: 1,
+		ValidDate:     expiredDate,
+		ExpiredUrl:    "https://github.com/expired",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	resp, err = restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: extractShortUri(createResp.FullShortUrl)})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if !resp.Expired || resp.ExpiredUrl != "https://github.com/expired" || resp.OriginUrl != "" {
+		t.Errorf("期望返回过期跳转链接且不返回原始链接，实际: %+v", resp)
+		return
+	}
+
+	t.Logf("过期短链接跳转到: %s", resp.ExpiredUrl)
+}
+
+func TestRestoreUrl_RedirectType(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 不支持的跳转类型
+	_, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:    "https://github.com/zeromicro/go-zero",
+		Gid:          "test-restore",
+		Describe:     "测试非法跳转类型的短链接",
+		RedirectType: 9,
+	})
+	if err == nil {
+		t.Error("期望不支持的跳转类型创建失败")
+		return
+	}
+
+	// 中间页跳转
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:    "https://github.com/zeromicro/go-zero",
+		Gid:          "test-restore",
+		Describe:     "测试中间页跳转的短链接",
+		RedirectType: 3,
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: extractShortUri(createResp.FullShortUrl)})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if resp.RedirectType != 3 || resp.OriginUrl != "https://github.com/zeromicro/go-zero" {
+		t.Errorf("期望返回中间页跳转类型，实际: %+v", resp)
+		return
+	}
+
+	t.Logf("短链接跳转类型: %d", resp.RedirectType)
+}
+
+func TestRestoreUrl_DeepLink(t *testing.T) {
+	// 设置测试环境
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 包名格式错误
+	_, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:      "https://github.com/zeromicro/go-zero",
+		Gid:            "test-restore",
+		Describe:       "测试非法包名的短链接",
+		AndroidPackage: "not a package",
+	})
+	if err == nil {
+		t.Error("期望包名格式错误时创建失败")
+		return
+	}
+
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:           "https://github.com/zeromicro/go-zero",
+		Gid:                 "test-restore",
+		Describe:            "测试深度链接的短链接",
+		IosDeepLink:         "gozero://repo/go-zero",
+		AndroidPackage:      "com.example.gozero",
+		AndroidDeepLink:     "gozero://repo/go-zero",
+		DeepLinkFallbackUrl: "https://example.com/download",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	resp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: extractShortUri(createResp.FullShortUrl)})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if resp.OriginUrl != "https://github.com/zeromicro/go-zero" ||
+		resp.IosDeepLink != "gozero://repo/go-zero" ||
+		resp.AndroidPackage != "com.example.gozero" ||
+		resp.DeepLinkFallbackUrl != "https://example.com/download" {
+		t.Errorf("期望返回深度链接配置，实际: %+v", resp)
+		return
+	}
+
+	t.Logf("短链接深度链接: %s", resp.IosDeepLink)
+}
+
 // 辅助函数：从完整短链接中提取短链接后缀
 func extractShortUri(fullShortUrl string) string {
 	if fullShortUrl == "" {
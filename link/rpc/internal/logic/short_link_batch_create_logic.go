@@ -7,7 +7,7 @@ import (
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/internal/types/errorx"
 	"shorterurl/link/rpc/pb"
-	"shorterurl/link/rpc/pkg/hash"
+	"shorterurl/link/rpc/pkg/urlsafety"
 	"shorterurl/link/rpc/pkg/util"
 	"time"
 
@@ -38,12 +38,30 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 		return nil, status.Error(codes.InvalidArgument, "原始链接列表不能为空")
 	}
 
+	// 使用模板填充未填写的字段，模板中的跳转配置和标签应用到每个短链接
+	var template *model.LinkTemplate
+	if in.TemplateId > 0 {
+		var err error
+		if template, err = findLinkTemplate(l.ctx, l.svcCtx, in.TemplateId); err != nil {
+			return nil, err
+		}
+		applyBatchLinkTemplate(template, in, time.Now())
+	}
+	if in.Gid == "" {
+		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+
 	// 获取域名，如果没有提供，使用配置中的默认域名
-	domain := in.Domain
+	domain := util.NormalizeHost(in.Domain)
 	if domain == "" {
 		domain = l.svcCtx.Config.DefaultDomain
 	}
 
+	// 非默认域名需要是当前用户已验证的自定义域名
+	if err := verifyUserDomain(l.ctx, l.svcCtx, domain); err != nil {
+		return nil, err
+	}
+
 	// 解析有效期
 	var validDate time.Time
 	var err error
@@ -58,6 +76,12 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 		validDate = time.Now().AddDate(10, 0, 0)
 	}
 
+	// 解析生效时间
+	validFrom, err := parseValidFrom(in.ValidFrom, validDate)
+	if err != nil {
+		return nil, err
+	}
+
 	// 开始事务
 	tx := l.svcCtx.RepoManager.GetLinkDB().Begin()
 	defer func() {
@@ -74,6 +98,11 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 	// 创建批量短链接
 	var links []*model.Link
 	var linkGotos []*model.LinkGoto
+	// 命中安全检测的短链接
+	flagged := make(map[string]*urlsafety.Result)
+
+	// 分组过期策略的过期跳转链接同样需要安全检测
+	groupExpiry := findGroupExpiryPolicy(l.ctx, l.svcCtx, in.Gid)
 
 	// 使用for循环批量处理
 	for _, originUrl := range in.OriginUrls {
@@ -88,7 +117,7 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 		}
 
 		// 生成短链接后缀
-		shortUri, err := l.generateShortUri(originUrl)
+		shortUri, err := l.generateShortUri(domain, originUrl)
 		if err != nil {
 			l.Logger.Errorf("生成短链接失败: %v", err)
 			tx.Rollback()
@@ -109,6 +138,7 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 			EnableStatus:  0, // 默认启用
 			CreatedType:   0, // 默认接口创建
 			ValidDateType: int(in.ValidDateType),
+			ValidFrom:     validFrom,
 			ValidDate:     validDate,
 			Describe:      in.Describe,
 			ClickNum:      0,
@@ -120,6 +150,24 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 			DelFlag:       0,
 			DelTime:       0,
 		}
+		if template != nil {
+			link.QueryParamPolicy = template.QueryParamPolicy
+			link.UtmSource = template.UtmSource
+			link.UtmMedium = template.UtmMedium
+			link.UtmCampaign = template.UtmCampaign
+			link.RedirectType = template.RedirectType
+			link.ExpiredUrl = template.ExpiredUrl
+			link.ExpiredMessage = template.ExpiredMessage
+			link.GraceDays = template.GraceDays
+		}
+		// 目标链接安全检测，命中时禁用短链接并等待审核
+		safety := checkUrlSafety(l.ctx, l.svcCtx, linkTargetUrls(link, nil, nil, groupExpiry))
+		if safety.Flagged {
+			link.EnableStatus = 1
+			link.SafetyReason = safety.Reason
+			link.SafetyStatus = urlsafety.SafetyStatusPending
+			flagged[fullShortUrl] = safety
+		}
 
 		// 创建短链接跳转对象
 		linkGoto := &model.LinkGoto{
@@ -132,9 +180,11 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 
 		// 添加到响应结果
 		resp.Results = append(resp.Results, &pb.BatchCreateResult{
-			FullShortUrl: "http://" + fullShortUrl,
-			OriginUrl:    originUrl,
-			Gid:          in.Gid,
+			FullShortUrl:  "http://" + fullShortUrl,
+			OriginUrl:     originUrl,
+			Gid:           in.Gid,
+			PendingReview: safety.Flagged,
+			Describe:      in.Describe,
 		})
 	}
 
@@ -167,20 +217,41 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 		return nil, status.Error(codes.Internal, "提交事务失败")
 	}
 
-	// 异步添加到布隆过滤器和Redis缓存
+	histories := make([]*model.LinkHistory, 0, len(links))
+	for _, link := range links {
+		if result, ok := flagged[link.FullShortUrl]; ok {
+			submitModeration(l.ctx, l.svcCtx, link, result)
+		}
+		if history := newLinkHistory(l.ctx, l.svcCtx, LinkHistoryCreate, nil, link); history != nil {
+			histories = append(histories, history)
+		}
+	}
+	// 记录创建失败不影响批量创建结果
+	if err := l.svcCtx.RepoManager.History.BatchCreate(l.ctx, histories); err != nil {
+		l.Logger.Errorf("记录短链接创建失败: %v", err)
+	}
+
+	// 保存模板中的标签
+	if template != nil {
+		if tags := linkTemplateTags(template); len(tags) > 0 {
+			for _, link := range links {
+				if err := l.svcCtx.RepoManager.Tag.ReplaceByFullShortUrl(l.ctx, link.Gid, link.FullShortUrl, tags); err != nil {
+					l.Logger.Errorf("保存短链接标签失败: %s, %v", link.FullShortUrl, err)
+				}
+			}
+		}
+	}
+
+	// 异步添加到布隆过滤器并清除空值缓存，跳转缓存在首次访问时加载
 	threading.GoSafe(func() {
-		for i, link := range links {
+		for _, link := range links {
 			// 添加到布隆过滤器
 			if err := l.svcCtx.BloomFilterMgr.Add(context.Background(), link.FullShortUrl); err != nil {
 				l.Logger.Errorf("添加到布隆过滤器失败: %v", err)
 			}
 
-			// 设置Redis缓存
-			cacheKey := fmt.Sprintf("link:goto:%s", link.FullShortUrl)
-			cacheExpire := util.GetLinkCacheValidTime(validDate)
-			if err := l.svcCtx.BizRedis.Setex(cacheKey, in.OriginUrls[i], int(cacheExpire/1000)); err != nil {
-				l.Logger.Errorf("设置Redis缓存失败: %v", err)
-			}
+			// 清除创建前访问留下的空值缓存
+			deleteGotoCache(context.Background(), l.svcCtx, link.FullShortUrl)
 		}
 	})
 
@@ -189,6 +260,11 @@ func (l *ShortLinkBatchCreateLogic) ShortLinkBatchCreate(in *pb.BatchCreateShort
 
 // 验证白名单
 func (l *ShortLinkBatchCreateLogic) verificationWhitelist(originUrl string) error {
+	// 只允许http(s)协议，不受白名单开关影响
+	if !util.IsHttpUrl(originUrl) {
+		return status.Error(codes.InvalidArgument, "跳转链接仅支持http或https协议")
+	}
+
 	// 检查白名单是否启用
 	if !l.svcCtx.Config.GotoDomainWhiteList.Enable {
 		return nil
@@ -218,20 +294,22 @@ func (l *ShortLinkBatchCreateLogic) verificationWhitelist(originUrl string) erro
 }
 
 // generateShortUri 生成短链接后缀
-func (l *ShortLinkBatchCreateLogic) generateShortUri(originUrl string) (string, error) {
+func (l *ShortLinkBatchCreateLogic) generateShortUri(domain, originUrl string) (string, error) {
 	maxRetries := 10
 	for i := 0; i < maxRetries; i++ {
-		// 每次尝试时，为了避免冲突，添加一些随机性
-		suffix := hash.HashToBase62(originUrl + fmt.Sprintf("%d", time.Now().UnixNano()))
+		// 使用配置的生成策略生成候选后缀
+		suffix, err := l.svcCtx.ShortCodeGen.Generate(l.ctx, originUrl)
+		if err != nil {
+			return "", err
+		}
 
 		// 检查短链接是否已存在（先检查布隆过滤器，再查数据库）
-		domain := l.svcCtx.Config.DefaultDomain
 		fullShortUrl := util.Create(domain).Append("/").Append(suffix).String()
 
 		// 检查布隆过滤器
 		if exists, _ := l.svcCtx.BloomFilterMgr.Exists(l.ctx, fullShortUrl); exists {
 			// 如果布隆过滤器中存在，则进一步检查数据库
-			_, err := l.svcCtx.RepoManager.Link.FindByShortUri(l.ctx, suffix)
+			_, err = l.svcCtx.RepoManager.Link.FindByShortUri(l.ctx, suffix)
 			if err == nil {
 				// 如果数据库中确实存在，则继续下一次尝试
 				continue
@@ -243,9 +321,28 @@ func (l *ShortLinkBatchCreateLogic) generateShortUri(originUrl string) (string,
 		}
 
 		// 如果布隆过滤器中不存在，或者数据库中不存在（布隆过滤器误判），则可以使用该短链接
+		l.svcCtx.ShortCodeGen.Confirm(l.ctx)
 		return suffix, nil
 	}
 
 	// 如果多次尝试后仍然无法生成唯一的短链接
 	return "", errorx.NewCodeError(errorx.ErrShortLinkExists, errorx.ErrShortLinkExists, "短链接生成重复，请稍后再试")
 }
+
+// applyBatchLinkTemplate 使用模板填充批量创建请求中未填写的分组、域名、有效期和描述
+func applyBatchLinkTemplate(template *model.LinkTemplate, in *pb.BatchCreateShortLinkRequest, now time.Time) {
+	if in.Gid == "" {
+		in.Gid = template.Gid
+	}
+	if in.Domain == "" {
+		in.Domain = template.Domain
+	}
+	if in.ValidDateType == util.ValidDateTypePermanent && in.ValidDate == "" {
+		if validDate := templateValidDate(template, now); validDate != "" {
+			in.ValidDateType, in.ValidDate = util.ValidDateTypeCustom, validDate
+		}
+	}
+	if in.Describe == "" {
+		in.Describe = renderTemplateDescribe(template.Describe, now)
+	}
+}
@@ -0,0 +1,410 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 批量操作类型
+const (
+	BulkActionEnable      = "enable"
+	BulkActionDisable     = "disable"
+	BulkActionRecycle     = "recycle"
+	BulkActionSetExpiry   = "set_expiry"
+	BulkActionSetDescribe = "set_describe"
+)
+
+const (
+	// 单次批量操作的最大短链接数量
+	BulkActionMaxCount = 1000
+	// 短链接描述最大长度
+	LinkDescribeMaxLength = 1024
+	// 每批更新的短链接数量
+	bulkActionChunkSize = 100
+)
+
+type ShortLinkBulkActionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkBulkActionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkBulkActionLogic {
+	return &ShortLinkBulkActionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// bulkAction 批量操作的具体内容
+type bulkAction struct {
+	// 更新的字段
+	fields map[string]interface{}
+	// 将更新的字段同步到内存中的短链接，用于生成变更记录
+	apply func(link *model.Link)
+	// 变更记录的操作类型
+	history string
+	// 检查单个短链接能否执行操作，不能执行时返回原因
+	check func(link *model.Link) string
+	// 更新成功后对单个短链接的额外处理
+	after func(link *model.Link)
+}
+
+// 批量启用、停用、移至回收站、设置有效期或描述
+// 按分组加锁后分批更新，返回每个短链接的结果，并删除受影响短链接的跳转缓存
+// 停用与移至回收站都将短链接设置为未启用状态，未启用的短链接在回收站中展示
+func (l *ShortLinkBulkActionLogic) ShortLinkBulkAction(in *pb.BulkActionRequest) (*pb.BulkActionResponse, error) {
+	if in.Selector == nil {
+		return nil, status.Error(codes.InvalidArgument, "选择条件不能为空")
+	}
+	action, err := l.buildAction(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 选中的短链接按分组归类，结果按选择顺序返回
+	results := make(map[string]*pb.BulkActionItemResult)
+	var order []string
+	groups := make(map[string][]*model.Link)
+	var gids []string
+	addLink := func(link *model.Link) {
+		if _, ok := groups[link.Gid]; !ok {
+			gids = append(gids, link.Gid)
+		}
+		groups[link.Gid] = append(groups[link.Gid], link)
+	}
+
+	if len(in.Selector.FullShortUrls) > 0 {
+		fullShortUrls := normalizeFullShortUrls(in.Selector.FullShortUrls)
+		if len(fullShortUrls) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+		}
+		if len(fullShortUrls) > BulkActionMaxCount {
+			return nil, status.Errorf(codes.InvalidArgument, "单次最多操作%d个短链接", BulkActionMaxCount)
+		}
+		links, err := l.findByFullShortUrls(fullShortUrls)
+		if err != nil {
+			return nil, err
+		}
+		for _, fullShortUrl := range fullShortUrls {
+			order = append(order, fullShortUrl)
+			if link, ok := links[fullShortUrl]; ok {
+				addLink(link)
+			} else {
+				results[fullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: fullShortUrl, Reason: "短链接不存在"}
+			}
+		}
+	} else {
+		links, err := l.findByFilter(in.Selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range links {
+			order = append(order, link.FullShortUrl)
+			addLink(link)
+		}
+	}
+	if len(order) == 0 {
+		return &pb.BulkActionResponse{}, nil
+	}
+
+	for _, gid := range gids {
+		if err := l.applyToGroup(gid, groups[gid], action, results); err != nil {
+			// 分组被其他操作锁定时，该分组的短链接全部失败，其他分组继续处理
+			for _, link := range groups[gid] {
+				results[link.FullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: link.FullShortUrl, Reason: status.Convert(err).Message()}
+			}
+		}
+	}
+
+	resp := &pb.BulkActionResponse{
+		Total:   int32(len(order)),
+		Results: make([]*pb.BulkActionItemResult, 0, len(order)),
+	}
+	for _, fullShortUrl := range order {
+		result := results[fullShortUrl]
+		if result.Success {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	l.Logger.Infof("批量操作短链接完成: %s, 成功: %d, 失败: %d", in.Action, resp.Succeeded, resp.Failed)
+
+	return resp, nil
+}
+
+// buildAction 校验操作参数并生成批量操作内容
+func (l *ShortLinkBulkActionLogic) buildAction(in *pb.BulkActionRequest) (*bulkAction, error) {
+	now := time.Now()
+	switch in.Action {
+	case BulkActionEnable:
+		return &bulkAction{
+			fields: map[string]interface{}{"enable_status": 0, "recycle_time": nil, "update_time": now},
+			apply: func(link *model.Link) {
+				link.EnableStatus = 0
+				link.RecycleTime = nil
+			},
+			history: LinkHistoryEnable,
+			check: func(link *model.Link) string {
+				// 安全检测禁用的短链接需审核通过后才能启用
+				switch link.SafetyStatus {
+				case urlsafety.SafetyStatusPending:
+					return "短链接待安全审核，暂不能启用"
+				case urlsafety.SafetyStatusBlocked:
+					return "短链接未通过安全审核，无法启用"
+				}
+				return ""
+			},
+		}, nil
+	case BulkActionDisable:
+		return &bulkAction{
+			fields:  map[string]interface{}{"enable_status": 1, "update_time": now},
+			apply:   func(link *model.Link) { link.EnableStatus = 1 },
+			history: LinkHistoryDisable,
+		}, nil
+	case BulkActionRecycle:
+		// 记录移入回收站的时间，回收站中的短链接按该时间到期清除
+		return &bulkAction{
+			fields: map[string]interface{}{"enable_status": 1, "recycle_time": now, "update_time": now},
+			apply: func(link *model.Link) {
+				link.EnableStatus = 1
+				link.RecycleTime = &now
+			},
+			history: LinkHistoryRecycle,
+		}, nil
+	case BulkActionSetExpiry:
+		// 与修改短链接一致，永久有效时有效期设置为10年后
+		validDateType, validDate := util.ValidDateTypePermanent, now.AddDate(10, 0, 0)
+		if in.ValidDate != "" {
+			t, err := time.Parse(time.RFC3339, in.ValidDate)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "有效期格式错误，请使用ISO-8601格式")
+			}
+			if !t.After(now) {
+				return nil, status.Error(codes.InvalidArgument, "有效期必须晚于当前时间")
+			}
+			validDateType, validDate = util.ValidDateTypeCustom, t
+		}
+		return &bulkAction{
+			fields: map[string]interface{}{"valid_date_type": validDateType, "valid_date": validDate, "update_time": now},
+			apply: func(link *model.Link) {
+				link.ValidDateType = validDateType
+				link.ValidDate = validDate
+			},
+			history: LinkHistoryUpdate,
+			check: func(link *model.Link) string {
+				if link.ValidFrom != nil && !link.ValidFrom.Before(validDate) {
+					return "有效期不能早于生效时间"
+				}
+				return ""
+			},
+			after: func(link *model.Link) {
+				// 剩余访问次数的缓存时间与有效期一致
+				if link.MaxClicks > 0 {
+					resetRemainingClicks(l.ctx, l.svcCtx, link)
+				}
+			},
+		}, nil
+	case BulkActionSetDescribe:
+		describe := strings.TrimSpace(in.Describe)
+		if utf8.RuneCountInString(describe) > LinkDescribeMaxLength {
+			return nil, status.Errorf(codes.InvalidArgument, "描述不能超过%d个字符", LinkDescribeMaxLength)
+		}
+		return &bulkAction{
+			fields:  map[string]interface{}{"describe": describe, "update_time": now},
+			apply:   func(link *model.Link) { link.Describe = describe },
+			history: LinkHistoryUpdate,
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "不支持的批量操作类型")
+	}
+}
+
+// findByFullShortUrls 根据跳转表查询短链接所属分组，只返回属于当前用户分组的短链接
+func (l *ShortLinkBulkActionLogic) findByFullShortUrls(fullShortUrls []string) (map[string]*model.Link, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	owned := make(map[string]bool)
+	byGid := make(map[string][]string)
+	var gids []string
+	for _, fullShortUrl := range fullShortUrls {
+		linkGoto, err := l.svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(l.ctx, fullShortUrl)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				l.Logger.Errorf("查询短链接跳转记录失败: %s, %v", fullShortUrl, err)
+			}
+			continue
+		}
+		ok, checked := owned[linkGoto.Gid]
+		if !checked {
+			if ok, err = l.svcCtx.RepoManager.Group.CheckGroupBelongToUser(l.ctx, linkGoto.Gid, username); err != nil {
+				l.Logger.Errorf("查询分组失败: %v", err)
+				return nil, status.Error(codes.Internal, "查询分组失败")
+			}
+			owned[linkGoto.Gid] = ok
+			if ok {
+				gids = append(gids, linkGoto.Gid)
+			}
+		}
+		if ok {
+			byGid[linkGoto.Gid] = append(byGid[linkGoto.Gid], fullShortUrl)
+		}
+	}
+
+	links := make(map[string]*model.Link, len(fullShortUrls))
+	for _, gid := range gids {
+		found, err := l.svcCtx.RepoManager.Link.FindByGidAndFilter(l.ctx, gid, repo.LinkFilter{FullShortUrls: byGid[gid]}, len(byGid[gid]))
+		if err != nil {
+			l.Logger.Errorf("查询短链接失败: %s, %v", gid, err)
+			return nil, status.Error(codes.Internal, "查询短链接失败")
+		}
+		for _, link := range found {
+			links[link.FullShortUrl] = link
+		}
+	}
+	return links, nil
+}
+
+// findByFilter 按分组、创建时间和标签筛选短链接，超过单次操作上限时返回错误
+func (l *ShortLinkBulkActionLogic) findByFilter(selector *pb.BulkActionSelector) ([]*model.Link, error) {
+	if err := checkGroupOwner(l.ctx, l.svcCtx, selector.Gid); err != nil {
+		return nil, err
+	}
+
+	var filter repo.LinkFilter
+	if selector.CreateStart != "" {
+		t, err := time.Parse(time.RFC3339, selector.CreateStart)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "创建时间起始格式错误，请使用ISO-8601格式")
+		}
+		filter.CreateStart = &t
+	}
+	if selector.CreateEnd != "" {
+		t, err := time.Parse(time.RFC3339, selector.CreateEnd)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "创建时间截止格式错误，请使用ISO-8601格式")
+		}
+		filter.CreateEnd = &t
+	}
+	if filter.CreateStart != nil && filter.CreateEnd != nil && filter.CreateEnd.Before(*filter.CreateStart) {
+		return nil, status.Error(codes.InvalidArgument, "创建时间截止不能早于起始时间")
+	}
+	if tag := strings.TrimSpace(selector.Tag); tag != "" {
+		fullShortUrls, err := l.svcCtx.RepoManager.Tag.FindFullShortUrlsByGidAndTag(l.ctx, selector.Gid, tag)
+		if err != nil {
+			l.Logger.Errorf("查询标签短链接失败: %v", err)
+			return nil, status.Error(codes.Internal, "查询短链接失败")
+		}
+		filter.FullShortUrls = fullShortUrls
+	}
+
+	links, err := l.svcCtx.RepoManager.Link.FindByGidAndFilter(l.ctx, selector.Gid, filter, BulkActionMaxCount+1)
+	if err != nil {
+		l.Logger.Errorf("筛选短链接失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接失败")
+	}
+	if len(links) > BulkActionMaxCount {
+		return nil, status.Errorf(codes.FailedPrecondition, "匹配的短链接超过%d个，请缩小筛选范围", BulkActionMaxCount)
+	}
+	return links, nil
+}
+
+// applyToGroup 锁定分组后分批更新分组内的短链接，并记录每个短链接的结果
+// 加锁后重新加载选中的短链接，检查和变更记录基于最新状态，加锁前已被移出分组或删除的短链接不再处理
+func (l *ShortLinkBulkActionLogic) applyToGroup(gid string, selected []*model.Link, action *bulkAction, results map[string]*pb.BulkActionItemResult) error {
+	lock, err := lockGroup(l.ctx, l.svcCtx, gid)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	fullShortUrls := make([]string, 0, len(selected))
+	for _, link := range selected {
+		fullShortUrls = append(fullShortUrls, link.FullShortUrl)
+	}
+	found, err := l.svcCtx.RepoManager.Link.FindByGidAndFilter(l.ctx, gid, repo.LinkFilter{FullShortUrls: fullShortUrls}, len(fullShortUrls))
+	if err != nil {
+		l.Logger.Errorf("查询短链接失败: %s, %v", gid, err)
+		return status.Error(codes.Internal, "查询短链接失败")
+	}
+	current := make(map[string]*model.Link, len(found))
+	for _, link := range found {
+		current[link.FullShortUrl] = link
+	}
+	links := make([]*model.Link, 0, len(found))
+	for _, fullShortUrl := range fullShortUrls {
+		if link, ok := current[fullShortUrl]; ok {
+			links = append(links, link)
+		} else {
+			results[fullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: fullShortUrl, Reason: "短链接不存在"}
+		}
+	}
+
+	for start := 0; start < len(links); start += bulkActionChunkSize {
+		end := start + bulkActionChunkSize
+		if end > len(links) {
+			end = len(links)
+		}
+
+		ids := make([]int64, 0, end-start)
+		eligible := make([]*model.Link, 0, end-start)
+		for _, link := range links[start:end] {
+			if action.check != nil {
+				if reason := action.check(link); reason != "" {
+					results[link.FullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: link.FullShortUrl, Reason: reason}
+					continue
+				}
+			}
+			ids = append(ids, link.ID)
+			eligible = append(eligible, link)
+		}
+
+		if err := l.svcCtx.RepoManager.Link.BatchUpdateFields(l.ctx, gid, ids, action.fields); err != nil {
+			l.Logger.Errorf("批量更新短链接失败: %s, %v", gid, err)
+			for _, link := range eligible {
+				results[link.FullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: link.FullShortUrl, Reason: "更新短链接失败"}
+			}
+			continue
+		}
+		histories := make([]*model.LinkHistory, 0, len(eligible))
+		for _, link := range eligible {
+			results[link.FullShortUrl] = &pb.BulkActionItemResult{FullShortUrl: link.FullShortUrl, Success: true}
+			deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+			before := linkhistory.NewSnapshot(link)
+			action.apply(link)
+			if history := newLinkHistory(l.ctx, l.svcCtx, action.history, before, link); history != nil {
+				histories = append(histories, history)
+			}
+			if action.after != nil {
+				action.after(link)
+			}
+		}
+		// 记录变更失败不影响批量操作结果
+		if err := l.svcCtx.RepoManager.History.BatchCreate(l.ctx, histories); err != nil {
+			l.Logger.Errorf("记录短链接变更失败: %s, %v", gid, err)
+		}
+	}
+	return nil
+}
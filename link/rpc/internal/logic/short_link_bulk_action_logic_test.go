@@ -0,0 +1,136 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkBulkAction_InvalidParams 测试批量操作参数校验
+func TestShortLinkBulkAction_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-bulk-user"))
+	l := logic.NewShortLinkBulkActionLogic(userCtx, svcCtx)
+
+	selector := &pb.BulkActionSelector{Gid: "test-bulk-not-exist"}
+	cases := map[string]struct {
+		req  *pb.BulkActionRequest
+		code codes.Code
+	}{
+		"选择条件为空": {
+			req:  &pb.BulkActionRequest{Action: logic.BulkActionDisable},
+			code: codes.InvalidArgument,
+		},
+		"操作类型不支持": {
+			req:  &pb.BulkActionRequest{Selector: selector, Action: "delete"},
+			code: codes.InvalidArgument,
+		},
+		"有效期已过": {
+			req:  &pb.BulkActionRequest{Selector: selector, Action: logic.BulkActionSetExpiry, ValidDate: "2000-01-01T00:00:00Z"},
+			code: codes.InvalidArgument,
+		},
+		"描述过长": {
+			req:  &pb.BulkActionRequest{Selector: selector, Action: logic.BulkActionSetDescribe, Describe: strings.Repeat("描", logic.LinkDescribeMaxLength+1)},
+			code: codes.InvalidArgument,
+		},
+		"筛选时分组为空": {
+			req:  &pb.BulkActionRequest{Selector: &pb.BulkActionSelector{Tag: "test"}, Action: logic.BulkActionDisable},
+			code: codes.InvalidArgument,
+		},
+		"分组不属于当前用户": {
+			req:  &pb.BulkActionRequest{Selector: selector, Action: logic.BulkActionDisable},
+			code: codes.NotFound,
+		},
+	}
+	for name, c := range cases {
+		_, err := l.ShortLinkBulkAction(c.req)
+		if status.Code(err) != c.code {
+			t.Errorf("%s: 期望 %v，实际: %v", name, c.code, err)
+		}
+	}
+
+	// 指定的短链接不存在时返回单个短链接的失败结果
+	resp, err := l.ShortLinkBulkAction(&pb.BulkActionRequest{
+		Selector: &pb.BulkActionSelector{FullShortUrls: []string{"test.example.com/bulk-none", "https://test.example.com/bulk-none"}},
+		Action:   logic.BulkActionDisable,
+	})
+	if err != nil {
+		t.Fatalf("批量操作失败: %v", err)
+	}
+	if resp.Total != 1 || resp.Failed != 1 || resp.Results[0].Success {
+		t.Errorf("不存在的短链接期望失败，实际: %+v", resp)
+	}
+}
+
+// TestShortLinkBulkAction_FilterByGid 测试按分组筛选后批量停用和启用
+func TestShortLinkBulkAction_FilterByGid(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-bulk-user"
+	gid := "test-bulk"
+	fullShortUrl := "test.example.com/bulk1"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+		Gid:        gid,
+		Name:       "批量操作测试分组",
+		Username:   username,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}); err != nil {
+		t.Fatalf("创建分组失败: %v", err)
+	}
+	cleanSpecificTestData(t, svcCtx, ctx, fullShortUrl, gid)
+	if err := svcCtx.RepoManager.Link.Create(ctx, &model.Link{
+		Domain:       "test.example.com",
+		ShortUri:     "bulk1",
+		FullShortUrl: fullShortUrl,
+		OriginUrl:    "https://github.com/zeromicro/go-zero",
+		Gid:          gid,
+		CreateTime:   time.Now(),
+		UpdateTime:   time.Now(),
+		ValidDate:    time.Now().AddDate(10, 0, 0),
+	}); err != nil {
+		t.Fatalf("创建测试链接失败: %v", err)
+	}
+	t.Cleanup(func() {
+		cleanSpecificTestData(t, svcCtx, ctx, fullShortUrl, gid)
+		if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, gid, username); err != nil {
+			t.Logf("清理分组失败: %v", err)
+		}
+	})
+
+	l := logic.NewShortLinkBulkActionLogic(userCtx, svcCtx)
+	for _, c := range []struct {
+		action       string
+		enableStatus int
+	}{
+		{logic.BulkActionDisable, 1},
+		{logic.BulkActionEnable, 0},
+	} {
+		resp, err := l.ShortLinkBulkAction(&pb.BulkActionRequest{
+			Selector: &pb.BulkActionSelector{Gid: gid},
+			Action:   c.action,
+		})
+		if err != nil {
+			t.Fatalf("%s: 批量操作失败: %v", c.action, err)
+		}
+		if resp.Total != 1 || resp.Succeeded != 1 {
+			t.Errorf("%s: 期望成功1个，实际: %+v", c.action, resp)
+		}
+		link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+		if err != nil {
+			t.Fatalf("查询短链接失败: %v", err)
+		}
+		if link.EnableStatus != c.enableStatus {
+			t.Errorf("%s: 期望启用状态 %d，实际: %d", c.action, c.enableStatus, link.EnableStatus)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortLinkCloneLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkCloneLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkCloneLogic {
+	return &ShortLinkCloneLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 复制短链接的目标链接、有效期、访问密码、跳转配置、A/B分流、跳转规则和标签到新的短链接
+// 新短链接使用源短链接的域名，按创建短链接的流程重新校验和安全检测，访问统计和变更记录不复制
+// 生效时间和有效期按源短链接创建时的相对时间顺延到当前时间
+func (l *ShortLinkCloneLogic) ShortLinkClone(in *pb.CloneShortLinkRequest) (*pb.CloneShortLinkResponse, error) {
+	source, err := findOwnedLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+	if source.SafetyStatus == urlsafety.SafetyStatusBlocked {
+		return nil, status.Error(codes.FailedPrecondition, "短链接未通过安全审核，无法复制")
+	}
+
+	targetGid := strings.TrimSpace(in.TargetGid)
+	if targetGid == "" {
+		targetGid = source.Gid
+	} else if targetGid != source.Gid {
+		if err := checkGroupOwner(l.ctx, l.svcCtx, targetGid); err != nil {
+			return nil, err
+		}
+	}
+
+	variants, err := l.svcCtx.RepoManager.Variant.FindByFullShortUrl(l.ctx, source.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询A/B分流版本失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询A/B分流版本失败")
+	}
+	rules, err := l.svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(l.ctx, source.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询跳转规则失败")
+	}
+	tags, err := l.svcCtx.RepoManager.Tag.FindByFullShortUrls(l.ctx, []string{source.FullShortUrl})
+	if err != nil {
+		l.Logger.Errorf("查询短链接标签失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接标签失败")
+	}
+
+	req := cloneCreateRequest(source, targetGid, variants, tags[source.FullShortUrl], time.Now())
+	req.CustomUri = strings.TrimSpace(in.CustomUri)
+	if describe := strings.TrimSpace(in.Describe); describe != "" {
+		req.Describe = describe
+	}
+
+	// 沿用源短链接的密码哈希，跳转规则随短链接一起创建
+	createResp, err := NewShortLinkCreateLogic(l.ctx, l.svcCtx).createLink(req, linkCreateOptions{
+		passwordHash: source.Password,
+		rules:        rules,
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.Logger.Infof("复制短链接: %s -> %s", source.FullShortUrl, createResp.FullShortUrl)
+
+	return &pb.CloneShortLinkResponse{
+		FullShortUrl:  createResp.FullShortUrl,
+		OriginUrl:     createResp.OriginUrl,
+		Gid:           createResp.Gid,
+		PendingReview: createResp.PendingReview,
+	}, nil
+}
+
+// cloneCreateRequest 根据源短链接的配置生成创建短链接请求
+// 生效时间和有效期按源短链接的创建时间平移到now，避免复制出已过期的短链接
+func cloneCreateRequest(source *model.Link, gid string, variants []*model.LinkVariant, tags []string, now time.Time) *pb.CreateShortLinkRequest {
+	req := &pb.CreateShortLinkRequest{
+		Domain:              source.Domain,
+		OriginUrl:           source.OriginUrl,
+		Gid:                 gid,
+		ValidDateType:       int32(source.ValidDateType),
+		Describe:            source.Describe,
+		CreatedType:         int32(source.CreatedType),
+		MaxClicks:           int32(source.MaxClicks),
+		QueryParamPolicy:    int32(source.QueryParamPolicy),
+		UtmSource:           source.UtmSource,
+		UtmMedium:           source.UtmMedium,
+		UtmCampaign:         source.UtmCampaign,
+		ExpiredUrl:          source.ExpiredUrl,
+		ExpiredMessage:      source.ExpiredMessage,
+		GraceDays:           int32(source.GraceDays),
+		RedirectType:        int32(source.RedirectType),
+		IosDeepLink:         source.IosDeepLink,
+		AndroidPackage:      source.AndroidPackage,
+		AndroidDeepLink:     source.AndroidDeepLink,
+		DeepLinkFallbackUrl: source.DeepLinkFallbackUrl,
+		OgTitle:             source.OgTitle,
+		OgDescription:       source.OgDescription,
+		OgImage:             source.OgImage,
+		Tags:                tags,
+	}
+	shift := now.Sub(source.CreateTime)
+	if shift < 0 {
+		shift = 0
+	}
+	if source.ValidDateType == util.ValidDateTypeCustom {
+		req.ValidDate = source.ValidDate.Add(shift).Format(time.RFC3339)
+	}
+	if source.ValidFrom != nil {
+		req.ValidFrom = source.ValidFrom.Add(shift).Format(time.RFC3339)
+	}
+	for _, variant := range variants {
+		req.Variants = append(req.Variants, &pb.LinkVariant{
+			Name:      variant.Name,
+			TargetUrl: variant.TargetUrl,
+			Weight:    int32(variant.Weight),
+		})
+	}
+	return req
+}
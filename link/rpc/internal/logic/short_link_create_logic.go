@@ -2,17 +2,22 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"shorterurl/link/rpc/internal/model"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/internal/types/errorx"
 	"shorterurl/link/rpc/pb"
-	"shorterurl/link/rpc/pkg/hash"
+	"shorterurl/link/rpc/pkg/urlsafety"
 	"shorterurl/link/rpc/pkg/util"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/zeromicro/go-zero/core/logx"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -24,6 +29,12 @@ type ShortLinkCreateLogic struct {
 	logx.Logger
 }
 
+// linkCreateOptions 复制短链接等内部场景创建短链接时的附加参数
+type linkCreateOptions struct {
+	passwordHash string                    // 沿用的访问密码哈希，不为空时忽略请求中的访问密码
+	rules        []*model.LinkRedirectRule // 随短链接一起创建的跳转规则，分组和短链接在创建时填充
+}
+
 func NewShortLinkCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkCreateLogic {
 	return &ShortLinkCreateLogic{
 		ctx:    ctx,
@@ -34,32 +45,103 @@ func NewShortLinkCreateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *S
 
 // --------------------- 短链接管理接口 ---------------------
 func (l *ShortLinkCreateLogic) ShortLinkCreate(in *pb.CreateShortLinkRequest) (*pb.CreateShortLinkResponse, error) {
+	return l.createLink(in, linkCreateOptions{})
+}
+
+// createLink 校验参数并创建短链接，保存A/B分流版本、标签和跳转规则失败时删除已创建的短链接
+func (l *ShortLinkCreateLogic) createLink(in *pb.CreateShortLinkRequest, opts linkCreateOptions) (*pb.CreateShortLinkResponse, error) {
 	// 参数校验
 	if in.OriginUrl == "" {
 		return nil, status.Error(codes.InvalidArgument, "原始链接不能为空")
 	}
 
+	// 使用模板填充未填写的字段
+	if in.TemplateId > 0 {
+		template, err := findLinkTemplate(l.ctx, l.svcCtx, in.TemplateId)
+		if err != nil {
+			return nil, err
+		}
+		applyLinkTemplate(template, in, time.Now())
+	}
+	if in.Gid == "" {
+		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+
 	// 验证白名单
 	if err := l.verificationWhitelist(in.OriginUrl); err != nil {
 		return nil, err
 	}
+	for _, variant := range in.Variants {
+		if err := l.verificationWhitelist(variant.TargetUrl); err != nil {
+			return nil, err
+		}
+	}
+	for _, rule := range opts.rules {
+		if err := verifyTargetWhitelist(l.svcCtx, rule.TargetUrl); err != nil {
+			return nil, err
+		}
+	}
 
 	// 获取域名，如果没有提供，使用配置中的默认域名
-	domain := in.Domain
+	domain := util.NormalizeHost(in.Domain)
 	if domain == "" {
 		domain = l.svcCtx.Config.DefaultDomain
 	}
 
-	// 生成短链接后缀
-	shortUri, err := l.generateShortUri(in.OriginUrl)
-	if err != nil {
-		l.Logger.Errorf("生成短链接失败: %v", err)
-		return nil, status.Error(codes.Internal, "生成短链接失败")
+	// 非默认域名需要是当前用户已验证的自定义域名
+	if err := verifyUserDomain(l.ctx, l.svcCtx, domain); err != nil {
+		return nil, err
+	}
+
+	// 生成短链接后缀，指定了自定义后缀时校验通过后直接使用
+	var shortUri string
+	var err error
+	if in.CustomUri != "" {
+		if err = l.checkCustomUri(domain, in.CustomUri); err != nil {
+			return nil, err
+		}
+		shortUri = in.CustomUri
+	} else {
+		shortUri, err = l.generateShortUri(domain, in.OriginUrl)
+		if err != nil {
+			l.Logger.Errorf("生成短链接失败: %v", err)
+			return nil, status.Error(codes.Internal, "生成短链接失败")
+		}
 	}
 
 	// 构建完整的短链接
 	fullShortUrl := util.Create(domain).Append("/").Append(shortUri).String()
 
+	// 处理访问密码
+	passwordHash := opts.passwordHash
+	if passwordHash == "" {
+		if passwordHash, err = hashLinkPassword(in.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.MaxClicks < 0 {
+		return nil, status.Error(codes.InvalidArgument, "最大访问次数不能为负数")
+	}
+
+	// 校验A/B分流版本
+	variants, err := buildLinkVariants(in.Gid, fullShortUrl, in.Variants)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验标签
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 校验查询参数策略和UTM模板
+	utm := util.UtmTemplate{Source: in.UtmSource, Medium: in.UtmMedium, Campaign: in.UtmCampaign}
+	if err := validateQueryParamSettings(int(in.QueryParamPolicy), utm); err != nil {
+		return nil, err
+	}
+
 	// 解析有效期
 	var validDate time.Time
 	if in.ValidDateType == util.ValidDateTypeCustom && in.ValidDate != "" {
@@ -73,27 +155,101 @@ func (l *ShortLinkCreateLogic) ShortLinkCreate(in *pb.CreateShortLinkRequest) (*
 		validDate = time.Now().AddDate(10, 0, 0)
 	}
 
+	// 解析生效时间
+	validFrom, err := parseValidFrom(in.ValidFrom, validDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验过期处理配置
+	if err := validateExpirySettings(l.svcCtx, in.ExpiredUrl, in.ExpiredMessage, int(in.GraceDays)); err != nil {
+		return nil, err
+	}
+
+	// 校验跳转类型
+	if !util.IsValidRedirectType(int(in.RedirectType)) {
+		return nil, status.Error(codes.InvalidArgument, "不支持的跳转类型")
+	}
+
+	// 校验深度链接配置
+	deepLink := util.DeepLink{
+		IosUrl:         strings.TrimSpace(in.IosDeepLink),
+		AndroidPackage: strings.TrimSpace(in.AndroidPackage),
+		AndroidUrl:     strings.TrimSpace(in.AndroidDeepLink),
+		FallbackUrl:    strings.TrimSpace(in.DeepLinkFallbackUrl),
+	}
+	if err := validateDeepLinkSettings(deepLink); err != nil {
+		return nil, err
+	}
+
+	// 校验社交分享预览配置
+	ogTitle, ogDescription, ogImage := strings.TrimSpace(in.OgTitle), strings.TrimSpace(in.OgDescription), strings.TrimSpace(in.OgImage)
+	if err := validatePreviewSettings(ogTitle, ogDescription, ogImage); err != nil {
+		return nil, err
+	}
+
 	// 创建短链接对象
 	link := &model.Link{
-		Domain:        domain,
-		ShortUri:      shortUri,
-		FullShortUrl:  fullShortUrl,
-		OriginUrl:     in.OriginUrl,
-		Gid:           in.Gid,
-		Favicon:       util.GetFavicon(in.OriginUrl),
-		EnableStatus:  0, // 默认启用
-		CreatedType:   int(in.CreatedType),
-		ValidDateType: int(in.ValidDateType),
-		ValidDate:     validDate,
-		Describe:      in.Describe,
-		ClickNum:      0,
-		TotalPv:       0,
-		TotalUv:       0,
-		TotalUip:      0,
-		CreateTime:    time.Now(),
-		UpdateTime:    time.Now(),
-		DelFlag:       0,
-		DelTime:       0,
+		Domain:              domain,
+		ShortUri:            shortUri,
+		FullShortUrl:        fullShortUrl,
+		OriginUrl:           in.OriginUrl,
+		Gid:                 in.Gid,
+		Favicon:             util.GetFavicon(in.OriginUrl),
+		EnableStatus:        0, // 默认启用
+		CreatedType:         int(in.CreatedType),
+		ValidDateType:       int(in.ValidDateType),
+		ValidFrom:           validFrom,
+		ValidDate:           validDate,
+		ExpiredUrl:          in.ExpiredUrl,
+		ExpiredMessage:      strings.TrimSpace(in.ExpiredMessage),
+		GraceDays:           int(in.GraceDays),
+		RedirectType:        int(in.RedirectType),
+		IosDeepLink:         deepLink.IosUrl,
+		AndroidPackage:      deepLink.AndroidPackage,
+		AndroidDeepLink:     deepLink.AndroidUrl,
+		DeepLinkFallbackUrl: deepLink.FallbackUrl,
+		OgTitle:             ogTitle,
+		OgDescription:       ogDescription,
+		OgImage:             ogImage,
+		Describe:            in.Describe,
+		Password:            passwordHash,
+		ClickNum:            0,
+		MaxClicks:           int(in.MaxClicks),
+		QueryParamPolicy:    int(in.QueryParamPolicy),
+		UtmSource:           utm.Source,
+		UtmMedium:           utm.Medium,
+		UtmCampaign:         utm.Campaign,
+		TotalPv:             0,
+		TotalUv:             0,
+		TotalUip:            0,
+		CreateTime:          time.Now(),
+		UpdateTime:          time.Now(),
+		DelFlag:             0,
+		DelTime:             0,
+	}
+
+	// 跳转规则归属新建的短链接
+	rules := make([]*model.LinkRedirectRule, 0, len(opts.rules))
+	for _, rule := range opts.rules {
+		rules = append(rules, &model.LinkRedirectRule{
+			Gid:          in.Gid,
+			FullShortUrl: fullShortUrl,
+			RuleType:     rule.RuleType,
+			RuleValue:    rule.RuleValue,
+			TargetUrl:    rule.TargetUrl,
+			SortOrder:    rule.SortOrder,
+			CreateTime:   time.Now(),
+			UpdateTime:   time.Now(),
+		})
+	}
+
+	// 目标链接安全检测，可疑链接创建后禁用并进入审核队列
+	safety := checkUrlSafety(l.ctx, l.svcCtx, linkTargetUrls(link, variants, rules, findGroupExpiryPolicy(l.ctx, l.svcCtx, in.Gid)))
+	if safety.Flagged {
+		link.EnableStatus = 1
+		link.SafetyStatus = urlsafety.SafetyStatusPending
+		link.SafetyReason = safety.Reason
 	}
 
 	// 创建短链接跳转对象
@@ -113,6 +269,9 @@ func (l *ShortLinkCreateLogic) ShortLinkCreate(in *pb.CreateShortLinkRequest) (*
 	// 创建短链接记录
 	if err := l.svcCtx.RepoManager.Link.Create(l.ctx, link); err != nil {
 		tx.Rollback()
+		if isDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "短链接已存在")
+		}
 		l.Logger.Errorf("创建短链接记录失败: %v", err)
 		return nil, status.Error(codes.Internal, "创建短链接记录失败")
 	}
@@ -120,6 +279,13 @@ func (l *ShortLinkCreateLogic) ShortLinkCreate(in *pb.CreateShortLinkRequest) (*
 	// 创建短链接跳转记录
 	if err := l.svcCtx.RepoManager.LinkGoto.Create(l.ctx, linkGoto); err != nil {
 		tx.Rollback()
+		if isDuplicateKeyError(err) {
+			// 跳转表按完整短链接全局唯一，并发创建同一短链接时撤销刚写入的短链接记录
+			if err := l.svcCtx.RepoManager.Link.Delete(l.ctx, link.ID, link.Gid); err != nil {
+				l.Logger.Errorf("撤销短链接记录失败: %s, %v", fullShortUrl, err)
+			}
+			return nil, status.Error(codes.AlreadyExists, "短链接已存在")
+		}
 		l.Logger.Errorf("创建短链接跳转记录失败: %v", err)
 		return nil, status.Error(codes.Internal, "创建短链接跳转记录失败")
 	}
@@ -130,30 +296,81 @@ func (l *ShortLinkCreateLogic) ShortLinkCreate(in *pb.CreateShortLinkRequest) (*
 		return nil, status.Error(codes.Internal, "提交事务失败")
 	}
 
+	// 保存A/B分流版本
+	if len(variants) > 0 {
+		if err := l.svcCtx.RepoManager.Variant.ReplaceByFullShortUrl(l.ctx, fullShortUrl, variants); err != nil {
+			l.Logger.Errorf("保存A/B分流版本失败: %v", err)
+			l.discardLink(link)
+			return nil, status.Error(codes.Internal, "保存A/B分流版本失败")
+		}
+	}
+
+	// 保存标签
+	if len(tags) > 0 {
+		if err := l.svcCtx.RepoManager.Tag.ReplaceByFullShortUrl(l.ctx, in.Gid, fullShortUrl, tags); err != nil {
+			l.Logger.Errorf("保存短链接标签失败: %v", err)
+			l.discardLink(link)
+			return nil, status.Error(codes.Internal, "保存短链接标签失败")
+		}
+	}
+
+	// 保存跳转规则
+	if len(rules) > 0 {
+		if err := l.svcCtx.RepoManager.RedirectRule.BatchCreate(l.ctx, rules); err != nil {
+			l.Logger.Errorf("保存跳转规则失败: %v", err)
+			l.discardLink(link)
+			return nil, status.Error(codes.Internal, "保存跳转规则失败")
+		}
+	}
+
 	// 添加到布隆过滤器
 	if err := l.svcCtx.BloomFilterMgr.Add(l.ctx, fullShortUrl); err != nil {
 		l.Logger.Errorf("添加到布隆过滤器失败: %v", err)
 		// 继续执行，不影响主流程
 	}
 
-	// 设置Redis缓存
-	cacheKey := fmt.Sprintf("link:goto:%s", fullShortUrl)
-	cacheExpire := util.GetLinkCacheValidTime(validDate)
-	if err := l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, in.OriginUrl, int(cacheExpire/1000)); err != nil {
-		l.Logger.Errorf("设置Redis缓存失败: %v", err)
-		// 继续执行，不影响主流程
+	// 清除创建前访问留下的空值缓存，避免提前公布的短链接在生效后仍无法访问
+	deleteGotoCache(l.ctx, l.svcCtx, fullShortUrl)
+
+	// 可疑链接进入审核队列，跳转缓存在首次访问时加载
+	if safety.Flagged {
+		submitModeration(l.ctx, l.svcCtx, link, safety)
 	}
 
+	// 初始化剩余访问次数
+	resetRemainingClicks(l.ctx, l.svcCtx, link)
+
+	recordLinkHistory(l.ctx, l.svcCtx, LinkHistoryCreate, nil, link)
+
 	// 返回结果
 	return &pb.CreateShortLinkResponse{
-		FullShortUrl: "http://" + fullShortUrl,
-		OriginUrl:    in.OriginUrl,
-		Gid:          in.Gid,
+		FullShortUrl:  "http://" + fullShortUrl,
+		OriginUrl:     in.OriginUrl,
+		Gid:           in.Gid,
+		PendingReview: safety.Flagged,
 	}, nil
 }
 
+// discardLink 删除创建到一半的短链接及其关联数据，避免留下缺少配置的短链接
+func (l *ShortLinkCreateLogic) discardLink(link *model.Link) {
+	if err := l.svcCtx.RepoManager.Retention.PurgeRelated(l.ctx, link.FullShortUrl); err != nil {
+		l.Logger.Errorf("删除短链接关联数据失败: %s, %v", link.FullShortUrl, err)
+	}
+	if err := l.svcCtx.RepoManager.Retention.PurgeGoto(l.ctx, link.FullShortUrl); err != nil {
+		l.Logger.Errorf("删除短链接跳转记录失败: %s, %v", link.FullShortUrl, err)
+	}
+	if err := l.svcCtx.RepoManager.Retention.PurgeLink(l.ctx, link); err != nil {
+		l.Logger.Errorf("删除短链接失败: %s, %v", link.FullShortUrl, err)
+	}
+}
+
 // 验证白名单
 func (l *ShortLinkCreateLogic) verificationWhitelist(originUrl string) error {
+	// 只允许http(s)协议，不受白名单开关影响
+	if !util.IsHttpUrl(originUrl) {
+		return status.Error(codes.InvalidArgument, "跳转链接仅支持http或https协议")
+	}
+
 	// 检查白名单是否启用
 	if !l.svcCtx.Config.GotoDomainWhiteList.Enable {
 		return nil
@@ -189,21 +406,291 @@ func (l *ShortLinkCreateLogic) verificationWhitelist(originUrl string) error {
 	return status.Error(codes.PermissionDenied, errMsg)
 }
 
+// verifyUserDomain 校验短链接域名，默认域名直接放行，其他域名必须是当前用户已验证的自定义域名
+func verifyUserDomain(ctx context.Context, svcCtx *svc.ServiceContext, domain string) error {
+	if domain == svcCtx.Config.DefaultDomain {
+		return nil
+	}
+
+	username, err := svcCtx.RepoManager.GetCurrentUsername(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	_, err = svcCtx.RepoManager.UserDomain.FindVerifiedByUsernameAndDomain(ctx, username, domain)
+	if err == gorm.ErrRecordNotFound {
+		return status.Errorf(codes.PermissionDenied, "域名 %s 未验证或不属于当前用户", domain)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询用户自定义域名失败: %v", err)
+		return status.Error(codes.Internal, "校验域名失败")
+	}
+
+	return nil
+}
+
+// checkUrlSafety 依次检测短链接的跳转目标，命中任一目标时返回可疑结果
+// 检测服务异常时默认放行，配置了FailClosed时按可疑链接处理
+func checkUrlSafety(ctx context.Context, svcCtx *svc.ServiceContext, targetUrls []string) *urlsafety.Result {
+	for _, targetUrl := range targetUrls {
+		result, err := svcCtx.UrlSafety.Check(ctx, targetUrl)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("目标链接安全检测失败: %s, %v", targetUrl, err)
+			if svcCtx.Config.UrlSafety.FailClosed {
+				return &urlsafety.Result{Flagged: true, Reason: "安全检测服务不可用，需人工审核"}
+			}
+			continue
+		}
+		if result.Flagged {
+			return result
+		}
+	}
+	return &urlsafety.Result{}
+}
+
+// linkTargetUrls 收集短链接跳转时可能到达的所有目标链接，用于安全检测
+// 包括原始链接、A/B分流版本、跳转规则、短链接及分组的过期跳转链接、深度链接及兜底链接
+// 自定义协议的深度链接只用于打开应用，不参与检测
+func linkTargetUrls(link *model.Link, variants []*model.LinkVariant, rules []*model.LinkRedirectRule, group *model.GroupExpiryPolicy) []string {
+	targetUrls := []string{link.OriginUrl}
+	for _, variant := range variants {
+		targetUrls = append(targetUrls, variant.TargetUrl)
+	}
+	for _, rule := range rules {
+		targetUrls = append(targetUrls, rule.TargetUrl)
+	}
+	candidates := []string{link.ExpiredUrl, link.DeepLinkFallbackUrl, link.IosDeepLink, link.AndroidDeepLink}
+	if group != nil {
+		candidates = append(candidates, group.ExpiredUrl)
+	}
+	for _, targetUrl := range candidates {
+		if util.IsHttpUrl(targetUrl) {
+			targetUrls = append(targetUrls, targetUrl)
+		}
+	}
+	return targetUrls
+}
+
+// buildLinkVariants 校验A/B分流版本并构建模型，未传入版本时返回空
+// 至少需要两个版本，版本名称不能重复，权重之和必须为100
+func buildLinkVariants(gid, fullShortUrl string, in []*pb.LinkVariant) ([]*model.LinkVariant, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if len(in) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "A/B分流至少需要两个版本")
+	}
+
+	names := make(map[string]struct{}, len(in))
+	variants := make([]*model.LinkVariant, 0, len(in))
+	totalWeight := 0
+	now := time.Now()
+	for i, v := range in {
+		name := strings.TrimSpace(v.Name)
+		if name == "" {
+			// 未命名时按顺序命名为 A、B、C...
+			name = string(rune('A' + i))
+		}
+		if len(name) > 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "版本名称过长: %s", name)
+		}
+		if _, ok := names[name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "版本名称重复: %s", name)
+		}
+		names[name] = struct{}{}
+
+		if !util.IsHttpUrl(v.TargetUrl) {
+			return nil, status.Errorf(codes.InvalidArgument, "版本 %s 的目标链接格式错误", name)
+		}
+		if v.Weight <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "版本 %s 的权重必须大于0", name)
+		}
+		totalWeight += int(v.Weight)
+
+		variants = append(variants, &model.LinkVariant{
+			Gid:          gid,
+			FullShortUrl: fullShortUrl,
+			Name:         name,
+			TargetUrl:    v.TargetUrl,
+			Weight:       int(v.Weight),
+			CreateTime:   now,
+			UpdateTime:   now,
+			DelFlag:      0,
+		})
+	}
+
+	if totalWeight != util.VariantTotalWeight {
+		return nil, status.Errorf(codes.InvalidArgument, "A/B分流权重之和必须为%d，当前为%d", util.VariantTotalWeight, totalWeight)
+	}
+	return variants, nil
+}
+
+// parseValidFrom 解析生效时间，为空表示立即生效，生效时间必须早于有效期
+func parseValidFrom(value string, validDate time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	validFrom, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "生效时间格式错误，请使用ISO-8601格式")
+	}
+	if !validDate.IsZero() && !validFrom.Before(validDate) {
+		return nil, status.Error(codes.InvalidArgument, "生效时间必须早于有效期")
+	}
+	return &validFrom, nil
+}
+
+// validateQueryParamSettings 校验查询参数策略和UTM模板
+func validateQueryParamSettings(policy int, utm util.UtmTemplate) error {
+	if !util.IsValidQueryParamPolicy(policy) {
+		return status.Error(codes.InvalidArgument, "不支持的查询参数策略")
+	}
+	for _, tpl := range []string{utm.Source, utm.Medium, utm.Campaign} {
+		if err := util.ValidateUtmTemplate(tpl); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateDeepLinkSettings 校验深度链接配置，应用链接支持自定义Scheme和http(s)链接
+func validateDeepLinkSettings(deepLink util.DeepLink) error {
+	if deepLink.IosUrl != "" && !util.IsValidAppUrl(deepLink.IosUrl) {
+		return status.Error(codes.InvalidArgument, "iOS深度链接格式错误")
+	}
+	if deepLink.AndroidPackage != "" && !util.IsValidAndroidPackage(deepLink.AndroidPackage) {
+		return status.Error(codes.InvalidArgument, "Android应用包名格式错误")
+	}
+	if deepLink.AndroidUrl != "" && !util.IsValidAppUrl(deepLink.AndroidUrl) {
+		return status.Error(codes.InvalidArgument, "Android深度链接格式错误")
+	}
+	if deepLink.FallbackUrl != "" {
+		if deepLink.IsEmpty() {
+			return status.Error(codes.InvalidArgument, "未配置深度链接时不能设置兜底链接")
+		}
+		if len(deepLink.FallbackUrl) > util.DeepLinkMaxLength || !util.IsHttpUrl(deepLink.FallbackUrl) {
+			return status.Error(codes.InvalidArgument, "兜底链接格式错误")
+		}
+	}
+	return nil
+}
+
+// validatePreviewSettings 校验社交分享预览的标题、描述和图片
+func validatePreviewSettings(title, description, image string) error {
+	if utf8.RuneCountInString(title) > OgTitleMaxLength {
+		return status.Errorf(codes.InvalidArgument, "预览标题不能超过%d个字符", OgTitleMaxLength)
+	}
+	if utf8.RuneCountInString(description) > OgDescriptionMaxLength {
+		return status.Errorf(codes.InvalidArgument, "预览描述不能超过%d个字符", OgDescriptionMaxLength)
+	}
+	if image != "" {
+		if len(image) > OgImageMaxLength || !util.IsHttpUrl(image) {
+			return status.Error(codes.InvalidArgument, "预览图片链接格式错误")
+		}
+	}
+	return nil
+}
+
+// resetRemainingClicks 根据最大访问次数和已访问次数重置剩余访问次数计数器，不限制时删除计数器
+func resetRemainingClicks(ctx context.Context, svcCtx *svc.ServiceContext, link *model.Link) {
+	key := fmt.Sprintf(ShortLinkClicksRemainingKey, link.FullShortUrl)
+	if link.MaxClicks <= 0 {
+		if _, err := svcCtx.BizRedis.DelCtx(ctx, key); err != nil {
+			logx.WithContext(ctx).Errorf("删除剩余访问次数失败: %v", err)
+		}
+		return
+	}
+
+	remaining := link.MaxClicks - link.ClickNum
+	if remaining < 0 {
+		remaining = 0
+	}
+	expire := util.GetLinkCacheValidSeconds(nil, link.ValidDate)
+	if err := svcCtx.BizRedis.SetexCtx(ctx, key, strconv.Itoa(remaining), expire); err != nil {
+		logx.WithContext(ctx).Errorf("设置剩余访问次数失败: %v", err)
+	}
+}
+
+// hashLinkPassword 校验并生成访问密码的bcrypt哈希，密码为空时返回空字符串
+func hashLinkPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+
+	// bcrypt最多只处理72字节
+	if len(password) < 4 || len(password) > 64 {
+		return "", status.Error(codes.InvalidArgument, "访问密码长度需在4到64个字符之间")
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logx.Errorf("生成访问密码哈希失败: %v", err)
+		return "", status.Error(codes.Internal, "设置访问密码失败")
+	}
+
+	return string(hashed), nil
+}
+
+// checkCustomUri 校验自定义短链接后缀的格式、保留字及唯一性
+func (l *ShortLinkCreateLogic) checkCustomUri(domain, customUri string) error {
+	cfg := l.svcCtx.Config.CustomUri
+	reserved := append(append([]string{}, util.DefaultReservedUris...), cfg.ReservedWords...)
+	if err := util.ValidateCustomUri(customUri, cfg.MinLength, cfg.MaxLength, reserved); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 先检查布隆过滤器，布隆过滤器中存在时再查数据库确认
+	fullShortUrl := util.Create(domain).Append("/").Append(customUri).String()
+	exists, err := l.svcCtx.BloomFilterMgr.Exists(l.ctx, fullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("检查布隆过滤器失败: %v", err)
+		return status.Error(codes.Internal, "检查自定义短链接失败")
+	}
+	if !exists {
+		return nil
+	}
+
+	_, err = l.svcCtx.RepoManager.Link.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err == nil {
+		return status.Error(codes.AlreadyExists, "自定义短链接已被占用")
+	}
+	if err != gorm.ErrRecordNotFound {
+		l.Logger.Errorf("查询自定义短链接失败: %v", err)
+		return status.Error(codes.Internal, "检查自定义短链接失败")
+	}
+
+	// 布隆过滤器误判，数据库中不存在
+	return nil
+}
+
+// isDuplicateKeyError 检查是否是唯一键冲突错误
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// MySQL错误码1062表示重复键错误
+		return mysqlErr.Number == 1062
+	}
+	return false
+}
+
 // generateShortUri 生成短链接后缀
-func (l *ShortLinkCreateLogic) generateShortUri(originUrl string) (string, error) {
+func (l *ShortLinkCreateLogic) generateShortUri(domain, originUrl string) (string, error) {
 	maxRetries := 10
 	for i := 0; i < maxRetries; i++ {
-		// 每次尝试时，为了避免冲突，添加一些随机性
-		suffix := hash.HashToBase62(originUrl + fmt.Sprintf("%d", time.Now().UnixNano()))
+		// 使用配置的生成策略生成候选后缀
+		suffix, err := l.svcCtx.ShortCodeGen.Generate(l.ctx, originUrl)
+		if err != nil {
+			return "", err
+		}
 
 		// 检查短链接是否已存在（先检查布隆过滤器，再查数据库）
-		domain := l.svcCtx.Config.DefaultDomain
 		fullShortUrl := util.Create(domain).Append("/").Append(suffix).String()
 
 		// 检查布隆过滤器
 		if exists, _ := l.svcCtx.BloomFilterMgr.Exists(l.ctx, fullShortUrl); exists {
 			// 如果布隆过滤器中存在，则进一步检查数据库
-			_, err := l.svcCtx.RepoManager.Link.FindByShortUri(l.ctx, suffix)
+			_, err = l.svcCtx.RepoManager.Link.FindByShortUri(l.ctx, suffix)
 			if err == nil {
 				// 如果数据库中确实存在，则继续下一次尝试
 				continue
@@ -215,6 +702,7 @@ func (l *ShortLinkCreateLogic) generateShortUri(originUrl string) (string, error
 		}
 
 		// 如果布隆过滤器中不存在，或者数据库中不存在（布隆过滤器误判），则可以使用该短链接
+		l.svcCtx.ShortCodeGen.Confirm(l.ctx)
 		return suffix, nil
 	}
 
@@ -12,9 +12,11 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
@@ -221,6 +223,148 @@ func TestShortLinkCreate_InvalidParam(t *testing.T) {
 	}
 
 	t.Logf("无效日期验证正确拒绝: %v", err)
+
+	// 测试非http(s)协议的跳转链接
+	_, err = l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "javascript://example.com/%0aalert(document.cookie)",
+		Gid:       "test",
+		Describe:  "测试无效参数",
+	})
+
+	if err == nil {
+		t.Error("期望非http(s)链接验证失败，但实际成功")
+		return
+	}
+
+	t.Logf("非http(s)链接验证正确拒绝: %v", err)
+}
+
+// TestShortLinkCreate_CustomUri 测试自定义短链接后缀
+func TestShortLinkCreate_CustomUri(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	l := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 测试保留字
+	_, err := l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test",
+		Describe:  "测试自定义短链接",
+		CustomUri: "admin",
+	})
+	if err == nil {
+		t.Error("期望保留字验证失败，但实际成功")
+		return
+	}
+	t.Logf("保留字验证正确拒绝: %v", err)
+
+	// 测试非法字符
+	_, err = l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test",
+		Describe:  "测试自定义短链接",
+		CustomUri: "spring/sale",
+	})
+	if err == nil {
+		t.Error("期望非法字符验证失败，但实际成功")
+		return
+	}
+	t.Logf("非法字符验证正确拒绝: %v", err)
+
+	// 测试正常创建
+	customUri := fmt.Sprintf("spring-sale-%d", time.Now().UnixNano()%100000)
+	resp, err := l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test",
+		Describe:  "测试自定义短链接",
+		CustomUri: customUri,
+	})
+	if err != nil {
+		t.Errorf("创建自定义短链接失败: %v", err)
+		return
+	}
+
+	if !strings.HasSuffix(resp.FullShortUrl, "/"+customUri) {
+		t.Errorf("自定义短链接后缀不符合预期: %s", resp.FullShortUrl)
+		return
+	}
+
+	fullShortUrl := strings.TrimPrefix(resp.FullShortUrl, "http://")
+	t.Cleanup(func() {
+		cacheKey := fmt.Sprintf("link:goto:%s", fullShortUrl)
+		if _, err := svcCtx.BizRedis.Del(cacheKey); err != nil {
+			t.Logf("清理短链接缓存失败: %v", err)
+		}
+	})
+
+	// 测试重复创建
+	_, err = l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test",
+		Describe:  "测试自定义短链接",
+		CustomUri: customUri,
+	})
+	if err == nil {
+		t.Error("期望重复自定义短链接验证失败，但实际成功")
+		return
+	}
+
+	t.Logf("自定义短链接创建成功: %s，重复创建正确拒绝: %v", resp.FullShortUrl, err)
+}
+
+// TestShortLinkCreate_UnverifiedDomain 测试使用未验证的自定义域名
+func TestShortLinkCreate_UnverifiedDomain(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	l := logic.NewShortLinkCreateLogic(metadata.NewIncomingContext(ctx, metadata.Pairs("username", "admin")), svcCtx)
+	_, err := l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Domain:    "unverified.example.com",
+		Gid:       "test",
+		Describe:  "测试未验证域名",
+	})
+	if err == nil {
+		t.Error("期望未验证域名校验失败，但实际成功")
+		return
+	}
+
+	t.Logf("未验证域名正确拒绝: %v", err)
+}
+
+// TestShortLinkCreate_UnsafeDeepLink 测试深度链接命中安全检测时短链接进入审核
+func TestShortLinkCreate_UnsafeDeepLink(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	denyDomain := fmt.Sprintf("deny-%d.gitee.com", time.Now().UnixNano())
+	denyKey := svcCtx.Config.UrlSafety.RedisDenyKey
+	if _, err := svcCtx.BizRedis.Sadd(denyKey, denyDomain); err != nil {
+		t.Errorf("写入安全检测黑名单失败: %v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if _, err := svcCtx.BizRedis.Srem(denyKey, denyDomain); err != nil {
+			t.Logf("清理安全检测黑名单失败: %v", err)
+		}
+	})
+
+	l := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	resp, err := l.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:           "https://github.com/zeromicro/go-zero",
+		Gid:                 "test",
+		Describe:            "测试深度链接安全检测",
+		IosDeepLink:         "https://" + denyDomain + "/app",
+		DeepLinkFallbackUrl: "https://github.com/zeromicro",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	if !resp.PendingReview {
+		t.Error("期望iOS深度链接命中黑名单时短链接进入审核")
+		return
+	}
+
+	t.Logf("深度链接命中安全检测，短链接进入审核: %s", resp.FullShortUrl)
 }
 
 // TestMain 主测试函数
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/xlsx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortLinkExportDownloadLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkExportDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkExportDownloadLogic {
+	return &ShortLinkExportDownloadLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 根据下载令牌分块读取导出文件，调用方从偏移量0开始依次读取直到eof
+// 导出文件保存在Redis中，下载请求可以由任意实例处理
+// 下载令牌在任务完成时生成，与导出任务同时过期，持有令牌即可下载，无需登录
+func (l *ShortLinkExportDownloadLogic) ShortLinkExportDownload(in *pb.DownloadExportRequest) (*pb.DownloadExportResponse, error) {
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "下载令牌不能为空")
+	}
+	if in.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "读取偏移量不能小于0")
+	}
+
+	jobId, err := l.svcCtx.BizRedis.GetCtx(l.ctx, fmt.Sprintf(ShortLinkExportTokenKey, in.Token))
+	if err != nil {
+		l.Logger.Errorf("查询下载令牌失败: %v", err)
+		return nil, status.Error(codes.Internal, "下载导出文件失败")
+	}
+	if jobId == "" {
+		return nil, status.Error(codes.NotFound, "下载令牌无效或已过期")
+	}
+	record, err := findExportJob(l.ctx, l.svcCtx, jobId)
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "下载导出文件失败")
+	}
+	if record == nil || record.Job.DownloadToken != in.Token {
+		return nil, status.Error(codes.NotFound, "下载令牌无效或已过期")
+	}
+
+	if record.Job.Status != ExportJobFinished || record.ChunkBytes <= 0 {
+		return nil, status.Error(codes.NotFound, "导出文件不存在或已过期")
+	}
+	fileSize := record.Job.FileSize
+	if in.Offset > fileSize {
+		return nil, status.Error(codes.InvalidArgument, "读取偏移量超出文件大小")
+	}
+
+	// 导出文件按块保存在Redis中，返回偏移量所在分块的剩余部分
+	var data []byte
+	if in.Offset < fileSize {
+		chunkBytes := int64(record.ChunkBytes)
+		chunk, err := l.svcCtx.BizRedis.GetCtx(l.ctx, fmt.Sprintf(ShortLinkExportFileKey, record.Job.JobId, in.Offset/chunkBytes))
+		if err != nil {
+			l.Logger.Errorf("读取导出文件失败: %v", err)
+			return nil, status.Error(codes.Internal, "下载导出文件失败")
+		}
+		start := in.Offset % chunkBytes
+		if chunk == "" || start >= int64(len(chunk)) {
+			return nil, status.Error(codes.NotFound, "导出文件不存在或已过期")
+		}
+		data = []byte(chunk[start:])
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if record.Job.Format == ExportFormatXlsx {
+		contentType = xlsx.ContentType
+	}
+	return &pb.DownloadExportResponse{
+		Data:        data,
+		Eof:         in.Offset+int64(len(data)) >= fileSize,
+		FileName:    record.Job.FileName,
+		ContentType: contentType,
+		FileSize:    fileSize,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortLinkExportJobGetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkExportJobGetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkExportJobGetLogic {
+	return &ShortLinkExportJobGetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询短链接导出任务的进度，任务完成后返回下载令牌，只能查询当前用户创建的任务
+func (l *ShortLinkExportJobGetLogic) ShortLinkExportJobGet(in *pb.GetExportJobRequest) (*pb.GetExportJobResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	if in.JobId == "" {
+		return nil, status.Error(codes.InvalidArgument, "任务ID不能为空")
+	}
+
+	record, err := findExportJob(l.ctx, l.svcCtx, in.JobId)
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询导出任务失败")
+	}
+	if record == nil || record.Username != username {
+		return nil, status.Error(codes.NotFound, "导出任务不存在或已过期")
+	}
+
+	return &pb.GetExportJobResponse{
+		Job: record.Job,
+	}, nil
+}
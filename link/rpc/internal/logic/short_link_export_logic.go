@@ -0,0 +1,526 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/xlsx"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 导出文件格式
+const (
+	ExportFormatCsv  = "csv"
+	ExportFormatXlsx = "xlsx"
+)
+
+// 导出任务状态
+const (
+	ExportJobRunning  = "running"
+	ExportJobFinished = "finished"
+	ExportJobFailed   = "failed"
+)
+
+// exportDateLayout 每日访问数据的日期格式
+const exportDateLayout = "2006-01-02"
+
+// exportLinkColumns 导出文件中短链接信息的列
+var exportLinkColumns = []interface{}{
+	"full_short_url", "origin_url", "domain", "gid", "describe", "tags", "create_time", "valid_date",
+	"enable_status", "click_num", "max_clicks", "total_pv", "total_uv", "total_uip",
+}
+
+// exportDailyColumns 导出每日访问数据时追加的列
+var exportDailyColumns = []interface{}{"date", "pv", "uv", "uip"}
+
+type ShortLinkExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkExportLogic {
+	return &ShortLinkExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出分组内的短链接及访问数据，短链接较少时同步生成文件，否则创建后台任务
+// 按分组和ID分批查询并逐行写入文件，不在内存中保存全部数据，完成后生成下载令牌
+func (l *ShortLinkExportLogic) ShortLinkExport(in *pb.ExportShortLinkRequest) (*pb.ExportShortLinkResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	cfg := l.svcCtx.Config.Export
+	gids := normalizeGids(in.Gids)
+	if len(gids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+	if len(gids) > cfg.MaxGids {
+		return nil, status.Errorf(codes.InvalidArgument, "单次最多导出%d个分组", cfg.MaxGids)
+	}
+
+	format := strings.ToLower(strings.TrimSpace(in.Format))
+	if format == "" {
+		format = ExportFormatCsv
+	}
+	if format != ExportFormatCsv && format != ExportFormatXlsx {
+		return nil, status.Error(codes.InvalidArgument, "不支持的导出格式，仅支持csv和xlsx")
+	}
+
+	var startDate, endDate string
+	if in.IncludeDailyStats {
+		if startDate, endDate, err = l.statsDateRange(in.StartDate, in.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	var total int64
+	for _, gid := range gids {
+		if err := checkGroupOwner(l.ctx, l.svcCtx, gid); err != nil {
+			return nil, err
+		}
+		count, err := l.svcCtx.RepoManager.Link.CountByGidWithCondition(l.ctx, gid, map[string]interface{}{"del_flag": 0})
+		if err != nil {
+			l.Logger.Errorf("统计分组短链接数量失败: %s, %v", gid, err)
+			return nil, status.Error(codes.Internal, "统计短链接数量失败")
+		}
+		total += count
+	}
+
+	now := time.Now()
+	job := &pb.ShortLinkExportJob{
+		JobId:      uuid.NewString(),
+		Status:     ExportJobRunning,
+		Format:     format,
+		Total:      int32(total),
+		FileName:   fmt.Sprintf("short-link-export-%s.%s", now.Format("20060102150405"), format),
+		CreateTime: now.Format(time.RFC3339),
+		UpdateTime: now.Format(time.RFC3339),
+	}
+	record := &exportJobRecord{
+		Username:   username,
+		ChunkBytes: cfg.ChunkBytes,
+		Job:        job,
+	}
+	exporter := &shortLinkExporter{
+		svcCtx:    l.svcCtx,
+		gids:      gids,
+		format:    format,
+		daily:     in.IncludeDailyStats,
+		startDate: startDate,
+		endDate:   endDate,
+	}
+
+	if total <= int64(cfg.SyncLinks) {
+		l.runExport(l.ctx, exporter, record, nil)
+		if job.Status == ExportJobFailed {
+			return nil, status.Error(codes.Internal, job.Error)
+		}
+		return &pb.ExportShortLinkResponse{
+			Job: job,
+		}, nil
+	}
+
+	if err := saveExportJob(l.ctx, l.svcCtx, record); err != nil {
+		l.Logger.Errorf("保存导出任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建导出任务失败")
+	}
+	// 复制一份任务信息用于响应，后台任务会继续修改job
+	resp := &pb.ShortLinkExportJob{
+		JobId:      job.JobId,
+		Status:     job.Status,
+		Format:     job.Format,
+		Total:      job.Total,
+		FileName:   job.FileName,
+		CreateTime: job.CreateTime,
+		UpdateTime: job.UpdateTime,
+	}
+	threading.GoSafe(func() {
+		ctx := context.Background()
+		save := func() {
+			job.UpdateTime = time.Now().Format(time.RFC3339)
+			if err := saveExportJob(ctx, l.svcCtx, record); err != nil {
+				logx.Errorf("保存导出任务进度失败: %s, %v", job.JobId, err)
+			}
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				logx.Errorf("导出任务异常中断: %s, %v", job.JobId, r)
+				removeExportFile(ctx, l.svcCtx, job.JobId)
+				job.Status = ExportJobFailed
+				job.Error = "导出任务异常中断"
+				save()
+			}
+		}()
+		l.runExport(ctx, exporter, record, save)
+		logx.Infof("导出任务完成: %s, 状态: %s, 短链接: %d", job.JobId, job.Status, job.Processed)
+	})
+
+	return &pb.ExportShortLinkResponse{
+		Job: resp,
+	}, nil
+}
+
+// statsDateRange 解析每日访问数据的日期范围，未指定时默认最近DefaultStatsDays天
+func (l *ShortLinkExportLogic) statsDateRange(startDate, endDate string) (string, string, error) {
+	cfg := l.svcCtx.Config.Export
+	if startDate == "" && endDate == "" {
+		end := time.Now()
+		start := end.AddDate(0, 0, -(cfg.DefaultStatsDays - 1))
+		return start.Format(exportDateLayout), end.Format(exportDateLayout), nil
+	}
+	if startDate == "" || endDate == "" {
+		return "", "", status.Error(codes.InvalidArgument, "开始日期和结束日期需同时指定")
+	}
+	start, err := time.Parse(exportDateLayout, startDate)
+	if err != nil {
+		return "", "", status.Error(codes.InvalidArgument, "开始日期格式错误，应为yyyy-MM-dd")
+	}
+	end, err := time.Parse(exportDateLayout, endDate)
+	if err != nil {
+		return "", "", status.Error(codes.InvalidArgument, "结束日期格式错误，应为yyyy-MM-dd")
+	}
+	if end.Before(start) {
+		return "", "", status.Error(codes.InvalidArgument, "结束日期不能早于开始日期")
+	}
+	if int(end.Sub(start).Hours()/24)+1 > cfg.MaxStatsDays {
+		return "", "", status.Errorf(codes.InvalidArgument, "每日访问数据最多导出%d天", cfg.MaxStatsDays)
+	}
+	return startDate, endDate, nil
+}
+
+// runExport 生成导出文件并按块保存到Redis，成功后生成下载令牌
+// 导出文件不保存在本地磁盘，多实例部署时任意实例都可以处理下载请求
+func (l *ShortLinkExportLogic) runExport(ctx context.Context, exporter *shortLinkExporter, record *exportJobRecord, save func()) {
+	job := record.Job
+	fail := func(reason string, err error) {
+		logx.WithContext(ctx).Errorf("%s: %s, %v", reason, job.JobId, err)
+		removeExportFile(ctx, l.svcCtx, job.JobId)
+		job.Status = ExportJobFailed
+		job.Error = reason
+		job.UpdateTime = time.Now().Format(time.RFC3339)
+		if err := saveExportJob(ctx, l.svcCtx, record); err != nil {
+			logx.WithContext(ctx).Errorf("保存导出任务失败: %s, %v", job.JobId, err)
+		}
+	}
+
+	w := newExportFileWriter(ctx, l.svcCtx, job.JobId, record.ChunkBytes)
+	progress := func(processed int) {
+		job.Processed = int32(processed)
+		if save != nil {
+			save()
+		}
+	}
+	err := exporter.export(ctx, w, progress)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		fail("生成导出文件失败", err)
+		return
+	}
+
+	token := strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err := l.svcCtx.BizRedis.SetexCtx(ctx, fmt.Sprintf(ShortLinkExportTokenKey, token), job.JobId, l.svcCtx.Config.Export.JobExpireSeconds); err != nil {
+		fail("生成下载令牌失败", err)
+		return
+	}
+	job.Status = ExportJobFinished
+	job.FileSize = w.size
+	job.DownloadToken = token
+	job.UpdateTime = time.Now().Format(time.RFC3339)
+	if err := saveExportJob(ctx, l.svcCtx, record); err != nil {
+		logx.WithContext(ctx).Errorf("保存导出任务失败: %s, %v", job.JobId, err)
+	}
+}
+
+// exportFileWriter 将导出文件按固定大小分块写入Redis，分块与导出任务同时过期
+type exportFileWriter struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	jobId  string
+	buf    []byte
+	chunks int
+	size   int64
+}
+
+func newExportFileWriter(ctx context.Context, svcCtx *svc.ServiceContext, jobId string, chunkBytes int) *exportFileWriter {
+	return &exportFileWriter{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		jobId:  jobId,
+		buf:    make([]byte, 0, chunkBytes),
+	}
+}
+
+// Write 写满一块后保存到Redis
+func (w *exportFileWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := cap(w.buf) - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Close 保存最后一块
+func (w *exportFileWriter) Close() error {
+	return w.flush()
+}
+
+func (w *exportFileWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	key := fmt.Sprintf(ShortLinkExportFileKey, w.jobId, w.chunks)
+	if err := w.svcCtx.BizRedis.SetexCtx(w.ctx, key, string(w.buf), w.svcCtx.Config.Export.JobExpireSeconds); err != nil {
+		return err
+	}
+	w.chunks++
+	w.size += int64(len(w.buf))
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// removeExportFile 删除导出任务已写入的文件分块
+func removeExportFile(ctx context.Context, svcCtx *svc.ServiceContext, jobId string) {
+	for i := 0; ; i++ {
+		n, err := svcCtx.BizRedis.DelCtx(ctx, fmt.Sprintf(ShortLinkExportFileKey, jobId, i))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("删除导出文件失败: %s, %v", jobId, err)
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// shortLinkExporter 按分组依次分批查询短链接并写入导出文件
+type shortLinkExporter struct {
+	svcCtx    *svc.ServiceContext
+	gids      []string
+	format    string
+	daily     bool
+	startDate string
+	endDate   string
+}
+
+// exportRowWriter 导出文件的逐行写入器
+type exportRowWriter interface {
+	WriteRow(cells []interface{}) error
+	Close() error
+}
+
+// export 写入表头和全部数据行，每写完一批调用progress报告已导出的短链接数
+func (e *shortLinkExporter) export(ctx context.Context, w io.Writer, progress func(processed int)) error {
+	writer, err := newExportRowWriter(w, e.format)
+	if err != nil {
+		return err
+	}
+	header := exportLinkColumns
+	if e.daily {
+		header = append(append([]interface{}{}, exportLinkColumns...), exportDailyColumns...)
+	}
+	if err := writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	batchSize := e.svcCtx.Config.Export.BatchSize
+	processed := 0
+	for _, gid := range e.gids {
+		var afterID int64
+		for {
+			links, err := e.svcCtx.RepoManager.Link.FindByGidAfterID(ctx, gid, afterID, batchSize)
+			if err != nil {
+				return fmt.Errorf("查询短链接失败: %w", err)
+			}
+			if len(links) == 0 {
+				break
+			}
+			afterID = links[len(links)-1].ID
+
+			fullShortUrls := make([]string, 0, len(links))
+			for _, link := range links {
+				fullShortUrls = append(fullShortUrls, link.FullShortUrl)
+			}
+			tags, err := e.svcCtx.RepoManager.Tag.FindByFullShortUrls(ctx, fullShortUrls)
+			if err != nil {
+				return fmt.Errorf("查询短链接标签失败: %w", err)
+			}
+			daily := make(map[string][]*repo.LinkDailyStats)
+			if e.daily {
+				stats, err := e.svcCtx.RepoManager.LinkAccessStats.ListDailyStatsByShortLinks(ctx, fullShortUrls, e.startDate, e.endDate)
+				if err != nil {
+					return fmt.Errorf("查询每日访问数据失败: %w", err)
+				}
+				for _, s := range stats {
+					daily[s.FullShortUrl] = append(daily[s.FullShortUrl], s)
+				}
+			}
+
+			for _, link := range links {
+				row := []interface{}{
+					link.FullShortUrl, link.OriginUrl, link.Domain, link.Gid, link.Describe,
+					strings.Join(tags[link.FullShortUrl], "|"),
+					link.CreateTime.Format(time.RFC3339), link.ValidDate.Format(time.RFC3339),
+					link.EnableStatus, link.ClickNum, link.MaxClicks, link.TotalPv, link.TotalUv, link.TotalUip,
+				}
+				if !e.daily {
+					if err := writer.WriteRow(row); err != nil {
+						return err
+					}
+					continue
+				}
+				// 每日访问数据按日期展开为多行，没有访问数据的短链接保留一行
+				days := daily[link.FullShortUrl]
+				if len(days) == 0 {
+					if err := writer.WriteRow(append(row, "", "", "", "")); err != nil {
+						return err
+					}
+					continue
+				}
+				for _, day := range days {
+					cells := append(append([]interface{}{}, row...), day.Date.Format(exportDateLayout), day.Pv, day.Uv, day.Uip)
+					if err := writer.WriteRow(cells); err != nil {
+						return err
+					}
+				}
+			}
+
+			processed += len(links)
+			progress(processed)
+			if len(links) < batchSize {
+				break
+			}
+		}
+	}
+	return writer.Close()
+}
+
+// newExportRowWriter 根据导出格式创建写入器
+func newExportRowWriter(w io.Writer, format string) (exportRowWriter, error) {
+	if format == ExportFormatXlsx {
+		return xlsx.NewStreamWriter(w, "short-links")
+	}
+	return newCsvExportWriter(w)
+}
+
+// csvExportWriter CSV导出写入器
+type csvExportWriter struct {
+	buf *bufio.Writer
+	w   *csv.Writer
+}
+
+// newCsvExportWriter 创建CSV写入器，写入UTF-8 BOM以便表格软件正确识别中文
+func newCsvExportWriter(w io.Writer) (*csvExportWriter, error) {
+	buf := bufio.NewWriter(w)
+	if _, err := buf.WriteString("\ufeff"); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{
+		buf: buf,
+		w:   csv.NewWriter(buf),
+	}, nil
+}
+
+// WriteRow 写入一行，字符串以公式字符开头时添加单引号，避免表格软件将其作为公式执行
+func (c *csvExportWriter) WriteRow(cells []interface{}) error {
+	record := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		value := fmt.Sprint(cell)
+		if s, ok := cell.(string); ok && s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+			value = "'" + s
+		}
+		record = append(record, value)
+	}
+	return c.w.Write(record)
+}
+
+// Close 刷新缓冲区，不关闭底层的io.Writer
+func (c *csvExportWriter) Close() error {
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return err
+	}
+	return c.buf.Flush()
+}
+
+// exportJobRecord 保存在Redis中的导出任务
+type exportJobRecord struct {
+	Username   string                 `json:"username"`
+	ChunkBytes int                    `json:"chunk_bytes"` // 导出文件分块大小
+	Job        *pb.ShortLinkExportJob `json:"job"`
+}
+
+// saveExportJob 保存导出任务进度和结果
+func saveExportJob(ctx context.Context, svcCtx *svc.ServiceContext, record *exportJobRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return svcCtx.BizRedis.SetexCtx(ctx, fmt.Sprintf(ShortLinkExportJobKey, record.Job.JobId), string(data), svcCtx.Config.Export.JobExpireSeconds)
+}
+
+// findExportJob 查询导出任务，任务不存在或已过期时返回nil
+func findExportJob(ctx context.Context, svcCtx *svc.ServiceContext, jobId string) (*exportJobRecord, error) {
+	data, err := svcCtx.BizRedis.GetCtx(ctx, fmt.Sprintf(ShortLinkExportJobKey, jobId))
+	if err != nil {
+		return nil, err
+	}
+	if data == "" {
+		return nil, nil
+	}
+	var record exportJobRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// normalizeGids 去除空值和重复的分组标识
+func normalizeGids(gids []string) []string {
+	seen := make(map[string]struct{}, len(gids))
+	result := make([]string, 0, len(gids))
+	for _, gid := range gids {
+		gid = strings.TrimSpace(gid)
+		if gid == "" {
+			continue
+		}
+		if _, ok := seen[gid]; ok {
+			continue
+		}
+		seen[gid] = struct{}{}
+		result = append(result, gid)
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkExport_InvalidParams 测试导出参数校验及分组归属校验
+func TestShortLinkExport_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-export-user"))
+	l := logic.NewShortLinkExportLogic(userCtx, svcCtx)
+
+	cases := map[string]struct {
+		req  *pb.ExportShortLinkRequest
+		code codes.Code
+	}{
+		"分组为空": {
+			req:  &pb.ExportShortLinkRequest{Gids: []string{" "}},
+			code: codes.InvalidArgument,
+		},
+		"格式不支持": {
+			req:  &pb.ExportShortLinkRequest{Gids: []string{"test-export"}, Format: "pdf"},
+			code: codes.InvalidArgument,
+		},
+		"只指定开始日期": {
+			req:  &pb.ExportShortLinkRequest{Gids: []string{"test-export"}, IncludeDailyStats: true, StartDate: "2024-01-01"},
+			code: codes.InvalidArgument,
+		},
+		"结束日期早于开始日期": {
+			req:  &pb.ExportShortLinkRequest{Gids: []string{"test-export"}, IncludeDailyStats: true, StartDate: "2024-02-01", EndDate: "2024-01-01"},
+			code: codes.InvalidArgument,
+		},
+		"分组不属于当前用户": {
+			req:  &pb.ExportShortLinkRequest{Gids: []string{"test-export-not-exist"}, Format: logic.ExportFormatXlsx},
+			code: codes.NotFound,
+		},
+	}
+	for name, c := range cases {
+		_, err := l.ShortLinkExport(c.req)
+		if status.Code(err) != c.code {
+			t.Errorf("%s: 期望 %v，实际: %v", name, c.code, err)
+		}
+	}
+}
+
+// TestShortLinkExport_JobNotFound 测试查询和下载不存在的导出任务
+func TestShortLinkExport_JobNotFound(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-export-user"))
+
+	if _, err := logic.NewShortLinkExportJobGetLogic(userCtx, svcCtx).ShortLinkExportJobGet(&pb.GetExportJobRequest{
+		JobId: "test-export-not-exist",
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("查询不存在的导出任务期望失败，实际: %v", err)
+	}
+	if _, err := logic.NewShortLinkExportDownloadLogic(ctx, svcCtx).ShortLinkExportDownload(&pb.DownloadExportRequest{
+		Token: "test-export-invalid-token",
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("使用无效令牌下载期望失败，实际: %v", err)
+	}
+	if _, err := logic.NewShortLinkExportDownloadLogic(ctx, svcCtx).ShortLinkExportDownload(&pb.DownloadExportRequest{
+		Token:  "test-export-invalid-token",
+		Offset: -1,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("偏移量小于0期望失败，实际: %v", err)
+	}
+}
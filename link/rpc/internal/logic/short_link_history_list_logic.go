@@ -0,0 +1,141 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 短链接变更操作类型
+const (
+	LinkHistoryCreate   = linkhistory.ActionCreate
+	LinkHistoryUpdate   = linkhistory.ActionUpdate
+	LinkHistoryEnable   = linkhistory.ActionEnable
+	LinkHistoryDisable  = linkhistory.ActionDisable
+	LinkHistoryRecycle  = linkhistory.ActionRecycle
+	LinkHistoryRecover  = linkhistory.ActionRecover
+	LinkHistoryRollback = linkhistory.ActionRollback
+)
+
+type ShortLinkHistoryListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkHistoryListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkHistoryListLogic {
+	return &ShortLinkHistoryListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询短链接变更记录，按时间倒序分页返回
+func (l *ShortLinkHistoryListLogic) ShortLinkHistoryList(in *pb.ListLinkHistoryRequest) (*pb.ListLinkHistoryResponse, error) {
+	link, err := findOwnedLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	page := int(in.Current)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(in.Size)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	histories, total, err := l.svcCtx.RepoManager.History.PageByFullShortUrl(l.ctx, link.FullShortUrl, link.Gid, link.CreateTime, page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("查询短链接变更记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接变更记录失败")
+	}
+
+	records := make([]*pb.LinkHistoryRecord, 0, len(histories))
+	for _, history := range histories {
+		records = append(records, toLinkHistoryRecord(history))
+	}
+	return &pb.ListLinkHistoryResponse{
+		Records: records,
+		Total:   int32(total),
+		Size:    int32(pageSize),
+		Current: int32(page),
+	}, nil
+}
+
+// findOwnedLink 校验分组属于当前用户，并查询分组中未永久删除的短链接，包括回收站中的短链接
+func findOwnedLink(ctx context.Context, svcCtx *svc.ServiceContext, fullShortUrl, gid string) (*model.Link, error) {
+	fullShortUrl = strings.TrimPrefix(strings.TrimPrefix(fullShortUrl, "http://"), "https://")
+	if fullShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+	if err := checkGroupOwner(ctx, svcCtx, gid); err != nil {
+		return nil, err
+	}
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+	return link, nil
+}
+
+// newLinkHistory 根据变更前的属性和短链接当前的属性生成变更记录，属性没有变化或生成失败时返回nil
+// 创建短链接时before传nil，记录创建时的属性，使最初的版本也可以回滚
+func newLinkHistory(ctx context.Context, svcCtx *svc.ServiceContext, action string, before *linkhistory.Snapshot, link *model.Link) *model.LinkHistory {
+	// 后台任务等没有登录用户的操作不记录操作人
+	operator, _ := svcCtx.RepoManager.GetCurrentUsername(ctx)
+	history, err := linkhistory.New(action, operator, before, link)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("生成短链接变更记录失败: %s, %v", link.FullShortUrl, err)
+		return nil
+	}
+	return history
+}
+
+// recordLinkHistory 记录单个短链接的变更，记录失败不影响变更本身
+func recordLinkHistory(ctx context.Context, svcCtx *svc.ServiceContext, action string, before *linkhistory.Snapshot, link *model.Link) *model.LinkHistory {
+	history := newLinkHistory(ctx, svcCtx, action, before, link)
+	if history == nil {
+		return nil
+	}
+	if err := svcCtx.RepoManager.History.Create(ctx, history); err != nil {
+		logx.WithContext(ctx).Errorf("记录短链接变更失败: %s, %v", link.FullShortUrl, err)
+		return nil
+	}
+	return history
+}
+
+// toLinkHistoryRecord 转换变更记录，内容无效的字段变更被忽略
+func toLinkHistoryRecord(history *model.LinkHistory) *pb.LinkHistoryRecord {
+	record := &pb.LinkHistoryRecord{
+		Id:           history.ID,
+		FullShortUrl: history.FullShortUrl,
+		Gid:          history.Gid,
+		Operator:     history.Operator,
+		Action:       history.Action,
+		CreateTime:   history.CreateTime.Format(time.RFC3339),
+	}
+	var changes []linkhistory.Change
+	if err := json.Unmarshal([]byte(history.Changes), &changes); err == nil {
+		for _, change := range changes {
+			record.Changes = append(record.Changes, &pb.LinkHistoryChange{
+				Field:    change.Field,
+				OldValue: change.OldValue,
+				NewValue: change.NewValue,
+			})
+		}
+	}
+	return record
+}
@@ -0,0 +1,217 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkHistory_InvalidParams 测试变更记录查询和回滚的参数校验
+func TestShortLinkHistory_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-history-user"))
+
+	_, err := logic.NewShortLinkHistoryListLogic(userCtx, svcCtx).ShortLinkHistoryList(&pb.ListLinkHistoryRequest{Gid: "test-history"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("短链接为空时期望 InvalidArgument，实际: %v", err)
+	}
+
+	_, err = logic.NewShortLinkHistoryListLogic(userCtx, svcCtx).ShortLinkHistoryList(&pb.ListLinkHistoryRequest{
+		FullShortUrl: "test.example.com/history-none",
+		Gid:          "test-history-not-exist",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("分组不存在时期望 NotFound，实际: %v", err)
+	}
+
+	_, err = logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: "test.example.com/history-none",
+		Gid:          "test-history",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("变更记录ID为空时期望 InvalidArgument，实际: %v", err)
+	}
+}
+
+// TestShortLinkHistory_UpdateAndRollback 测试修改短链接生成变更记录，并回滚到修改前的版本
+func TestShortLinkHistory_UpdateAndRollback(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-history-user"
+	gid := "test-history"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+		Gid:        gid,
+		Name:       "变更记录测试分组",
+		Username:   username,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}); err != nil {
+		t.Fatalf("创建分组失败: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, gid, username); err != nil {
+			t.Logf("清理分组失败: %v", err)
+		}
+	})
+
+	createResp, err := logic.NewShortLinkCreateLogic(userCtx, svcCtx).ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       gid,
+		Describe:  "修改前的描述",
+	})
+	if err != nil {
+		t.Fatalf("创建短链接失败: %v", err)
+	}
+	fullShortUrl := strings.TrimPrefix(createResp.FullShortUrl, "http://")
+	t.Cleanup(func() {
+		cleanSpecificTestData(t, svcCtx, ctx, fullShortUrl, gid)
+	})
+
+	// 修改两次，第一次修改后的版本作为回滚目标
+	for _, describe := range []string{"第一次修改", "第二次修改"} {
+		_, err = logic.NewShortLinkUpdateLogic(userCtx, svcCtx).ShortLinkUpdate(&pb.UpdateShortLinkRequest{
+			FullShortUrl: fullShortUrl,
+			OriginUrl:    "https://github.com/zeromicro/go-zero",
+			Gid:          gid,
+			Describe:     describe,
+		})
+		if err != nil {
+			t.Fatalf("修改短链接失败: %v", err)
+		}
+	}
+
+	listResp, err := logic.NewShortLinkHistoryListLogic(userCtx, svcCtx).ShortLinkHistoryList(&pb.ListLinkHistoryRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+	})
+	if err != nil {
+		t.Fatalf("查询变更记录失败: %v", err)
+	}
+	if listResp.Total != 3 || len(listResp.Records) != 3 {
+		t.Fatalf("期望1条创建记录和2条变更记录，实际: %+v", listResp)
+	}
+	latest, first, created := listResp.Records[0], listResp.Records[1], listResp.Records[2]
+	if created.Action != logic.LinkHistoryCreate {
+		t.Errorf("最早的记录应为创建记录: %+v", created)
+	}
+	if latest.Action != logic.LinkHistoryUpdate || latest.Operator != username {
+		t.Errorf("变更记录操作类型或操作人不符合预期: %+v", latest)
+	}
+	if len(latest.Changes) != 1 || latest.Changes[0].Field != "describe" ||
+		latest.Changes[0].OldValue != "第一次修改" || latest.Changes[0].NewValue != "第二次修改" {
+		t.Errorf("字段变更不符合预期: %+v", latest.Changes)
+	}
+
+	// 回滚到第一次修改后的版本
+	rollbackResp, err := logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		HistoryId:    first.Id,
+	})
+	if err != nil {
+		t.Fatalf("回滚短链接失败: %v", err)
+	}
+	if rollbackResp.History == nil || rollbackResp.History.Action != logic.LinkHistoryRollback {
+		t.Errorf("回滚未生成变更记录: %+v", rollbackResp)
+	}
+
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+	if err != nil {
+		t.Fatalf("查询短链接失败: %v", err)
+	}
+	if link.Describe != "第一次修改" {
+		t.Errorf("回滚后描述不符合预期: %s", link.Describe)
+	}
+
+	// 回滚到创建时的版本
+	if _, err = logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		HistoryId:    created.Id,
+	}); err != nil {
+		t.Fatalf("回滚到创建时的版本失败: %v", err)
+	}
+	link, err = svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, gid)
+	if err != nil {
+		t.Fatalf("查询短链接失败: %v", err)
+	}
+	if link.Describe != "修改前的描述" {
+		t.Errorf("回滚到创建时的版本后描述不符合预期: %s", link.Describe)
+	}
+
+	// 回滚与修改使用相同的校验，不在白名单中的版本不能回滚
+	invalid := &model.LinkHistory{
+		Gid:          gid,
+		FullShortUrl: fullShortUrl,
+		Operator:     username,
+		Action:       logic.LinkHistoryUpdate,
+		Changes:      "[]",
+		Snapshot:     `{"originUrl":"https://example.com/history","describe":"白名单外的版本"}`,
+		CreateTime:   time.Now(),
+	}
+	if err := svcCtx.RepoManager.History.Create(ctx, invalid); err != nil {
+		t.Fatalf("写入变更记录失败: %v", err)
+	}
+	_, err = logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		HistoryId:    invalid.ID,
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("回滚到白名单外的版本时期望 PermissionDenied，实际: %v", err)
+	}
+
+	// 其他短链接的变更记录不能用于回滚
+	_, err = logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		HistoryId:    first.Id + 1000000,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("变更记录不存在时期望 NotFound，实际: %v", err)
+	}
+	// 短链接创建之前的记录属于已永久删除的同名短链接，不能查询和回滚
+	stale := &model.LinkHistory{
+		Gid:          gid,
+		FullShortUrl: fullShortUrl,
+		Operator:     "previous-owner",
+		Action:       logic.LinkHistoryUpdate,
+		Changes:      "[]",
+		Snapshot:     `{"originUrl":"https://github.com/previous-owner","describe":"已删除短链接的版本"}`,
+		CreateTime:   link.CreateTime.Add(-time.Hour),
+	}
+	if err := svcCtx.RepoManager.History.Create(ctx, stale); err != nil {
+		t.Fatalf("写入变更记录失败: %v", err)
+	}
+	_, err = logic.NewShortLinkRollbackLogic(userCtx, svcCtx).ShortLinkRollback(&pb.RollbackShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		HistoryId:    stale.ID,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("回滚到短链接创建之前的记录时期望 NotFound，实际: %v", err)
+	}
+	listResp, err = logic.NewShortLinkHistoryListLogic(userCtx, svcCtx).ShortLinkHistoryList(&pb.ListLinkHistoryRequest{
+		FullShortUrl: fullShortUrl,
+		Gid:          gid,
+		Current:      1,
+		Size:         100,
+	})
+	if err != nil {
+		t.Fatalf("查询变更记录失败: %v", err)
+	}
+	for _, record := range listResp.Records {
+		if record.Id == stale.ID {
+			t.Errorf("变更记录中不应包含短链接创建之前的记录")
+		}
+	}
+}
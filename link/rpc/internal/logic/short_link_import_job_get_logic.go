@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortLinkImportJobGetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkImportJobGetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkImportJobGetLogic {
+	return &ShortLinkImportJobGetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询短链接导入任务的进度和行级结果，只能查询当前用户创建的任务
+func (l *ShortLinkImportJobGetLogic) ShortLinkImportJobGet(in *pb.GetImportJobRequest) (*pb.GetImportJobResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	if in.JobId == "" {
+		return nil, status.Error(codes.InvalidArgument, "任务ID不能为空")
+	}
+
+	data, err := l.svcCtx.BizRedis.GetCtx(l.ctx, fmt.Sprintf(ShortLinkImportJobKey, in.JobId))
+	if err != nil {
+		l.Logger.Errorf("查询导入任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询导入任务失败")
+	}
+	if data == "" {
+		return nil, status.Error(codes.NotFound, "导入任务不存在或已过期")
+	}
+
+	var record importJobRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		l.Logger.Errorf("解析导入任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询导入任务失败")
+	}
+	if record.Username != username {
+		return nil, status.Error(codes.NotFound, "导入任务不存在或已过期")
+	}
+
+	return &pb.GetImportJobResponse{
+		Job: record.Job,
+	}, nil
+}
@@ -0,0 +1,441 @@
+package logic
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 导入文件格式
+const (
+	ImportFormatCsv    = "csv"
+	ImportFormatNdjson = "ndjson"
+)
+
+// 导入行处理结果
+const (
+	ImportRowCreated = "created"
+	ImportRowSkipped = "skipped"
+	ImportRowFailed  = "failed"
+)
+
+// 导入任务状态
+const (
+	ImportJobRunning  = "running"
+	ImportJobFinished = "finished"
+	ImportJobFailed   = "failed"
+)
+
+// 后台导入时每处理多少行保存一次进度
+const importProgressInterval = 100
+
+// importValidDateLayouts 导入文件中有效期支持的时间格式
+var importValidDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+type ShortLinkImportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkImportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkImportLogic {
+	return &ShortLinkImportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// importRow 导入文件中的一行
+type importRow struct {
+	Line      int      `json:"-"`
+	OriginUrl string   `json:"origin_url"`
+	Alias     string   `json:"alias"`
+	Gid       string   `json:"gid"`
+	ValidDate string   `json:"valid_date"`
+	Describe  string   `json:"describe"`
+	Tags      []string `json:"tags"`
+	// 解析失败原因
+	Err string `json:"-"`
+}
+
+// 批量导入短链接，行数较少时同步处理，否则创建后台任务处理，任务进度和结果保存在Redis中
+func (l *ShortLinkImportLogic) ShortLinkImport(in *pb.ImportShortLinkRequest) (*pb.ImportShortLinkResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	cfg := l.svcCtx.Config.Import
+	if len(in.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "导入文件不能为空")
+	}
+	if len(in.Content) > cfg.MaxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "导入文件不能超过%d字节", cfg.MaxBytes)
+	}
+
+	var rows []*importRow
+	switch strings.ToLower(strings.TrimSpace(in.Format)) {
+	case "", ImportFormatCsv:
+		rows, err = parseImportCsv(in.Content)
+	case ImportFormatNdjson:
+		rows, err = parseImportNdjson(in.Content, cfg.MaxBytes)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "不支持的导入格式，仅支持csv和ndjson")
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "导入文件没有数据行")
+	}
+	if len(rows) > cfg.MaxRows {
+		return nil, status.Errorf(codes.InvalidArgument, "单次最多导入%d行", cfg.MaxRows)
+	}
+
+	// 域名对所有行生效，先行校验
+	domain := util.NormalizeHost(in.Domain)
+	if domain == "" {
+		domain = l.svcCtx.Config.DefaultDomain
+	}
+	if err := verifyUserDomain(l.ctx, l.svcCtx, domain); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	job := &pb.ShortLinkImportJob{
+		JobId:      uuid.NewString(),
+		Status:     ImportJobRunning,
+		DryRun:     in.DryRun,
+		Total:      int32(len(rows)),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+
+	// 导入在请求结束后可能仍在进行，使用独立的上下文并携带当前用户
+	jobCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("username", username))
+	importer := newShortLinkImporter(jobCtx, l.svcCtx, domain, in.DefaultGid, in.DryRun)
+
+	if len(rows) <= cfg.SyncRows {
+		importer.run(job, rows, nil)
+		if err := saveImportJob(l.ctx, l.svcCtx, username, job); err != nil {
+			l.Logger.Errorf("保存导入任务失败: %v", err)
+		}
+		return &pb.ImportShortLinkResponse{
+			Job: job,
+		}, nil
+	}
+
+	if err := saveImportJob(l.ctx, l.svcCtx, username, job); err != nil {
+		l.Logger.Errorf("保存导入任务失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建导入任务失败")
+	}
+	// 后台任务启动后只由后台任务读写job，返回给调用方的任务信息需要在启动前复制
+	respJob := &pb.ShortLinkImportJob{
+		JobId:      job.JobId,
+		Status:     job.Status,
+		DryRun:     job.DryRun,
+		Total:      job.Total,
+		CreateTime: job.CreateTime,
+		UpdateTime: job.UpdateTime,
+	}
+	threading.GoSafe(func() {
+		save := func() {
+			job.UpdateTime = time.Now().Format(time.RFC3339)
+			if err := saveImportJob(jobCtx, l.svcCtx, username, job); err != nil {
+				logx.Errorf("保存导入任务进度失败: %s, %v", job.JobId, err)
+			}
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				logx.Errorf("导入任务异常中断: %s, %v", job.JobId, r)
+				job.Status = ImportJobFailed
+				job.Error = "导入任务异常中断"
+				save()
+			}
+		}()
+		importer.run(job, rows, save)
+		save()
+		logx.Infof("导入任务完成: %s, 创建: %d, 跳过: %d, 失败: %d", job.JobId, job.Created, job.Skipped, job.Failed)
+	})
+
+	// 后台处理时只返回任务信息，行级结果通过任务查询接口获取
+	return &pb.ImportShortLinkResponse{
+		Job: respJob,
+	}, nil
+}
+
+// parseImportCsv 解析CSV导入文件，首行为表头
+func parseImportCsv(content []byte) ([]*importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("CSV表头格式错误: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["origin_url"]; !ok {
+		return nil, errors.New("CSV表头缺少origin_url列")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV格式错误: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, &importRow{
+			Line:      line,
+			OriginUrl: field(record, "origin_url"),
+			Alias:     field(record, "alias"),
+			Gid:       field(record, "gid"),
+			ValidDate: field(record, "valid_date"),
+			Describe:  field(record, "describe"),
+			Tags:      strings.Split(field(record, "tags"), "|"),
+		})
+	}
+	return rows, nil
+}
+
+// parseImportNdjson 解析NDJSON导入文件，每行一个JSON对象，空行忽略
+func parseImportNdjson(content []byte, maxBytes int) ([]*importRow, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBytes)
+
+	var rows []*importRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		row := &importRow{}
+		if err := json.Unmarshal(text, row); err != nil {
+			row = &importRow{Err: "JSON格式错误"}
+		}
+		row.Line = line
+		row.OriginUrl = strings.TrimSpace(row.OriginUrl)
+		row.Alias = strings.TrimSpace(row.Alias)
+		row.Gid = strings.TrimSpace(row.Gid)
+		row.ValidDate = strings.TrimSpace(row.ValidDate)
+		row.Describe = strings.TrimSpace(row.Describe)
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("NDJSON格式错误: %v", err)
+	}
+	return rows, nil
+}
+
+// parseImportValidDate 解析导入文件中的有效期
+func parseImportValidDate(value string) (time.Time, error) {
+	for _, layout := range importValidDateLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("有效期格式错误，支持ISO-8601、yyyy-MM-dd HH:mm:ss和yyyy-MM-dd")
+}
+
+// shortLinkImporter 逐行校验并创建短链接
+type shortLinkImporter struct {
+	ctx        context.Context
+	svcCtx     *svc.ServiceContext
+	creator    *ShortLinkCreateLogic
+	domain     string
+	defaultGid string
+	dryRun     bool
+	// 分组归属校验结果，同一分组只校验一次
+	groups map[string]error
+	// 文件中已出现的自定义后缀
+	aliases map[string]struct{}
+}
+
+func newShortLinkImporter(ctx context.Context, svcCtx *svc.ServiceContext, domain, defaultGid string, dryRun bool) *shortLinkImporter {
+	return &shortLinkImporter{
+		ctx:        ctx,
+		svcCtx:     svcCtx,
+		creator:    NewShortLinkCreateLogic(ctx, svcCtx),
+		domain:     domain,
+		defaultGid: strings.TrimSpace(defaultGid),
+		dryRun:     dryRun,
+		groups:     make(map[string]error),
+		aliases:    make(map[string]struct{}),
+	}
+}
+
+// run 依次处理所有行并累计结果，save不为空时定期保存进度
+func (p *shortLinkImporter) run(job *pb.ShortLinkImportJob, rows []*importRow, save func()) {
+	for _, row := range rows {
+		result := p.importRow(row)
+		job.Rows = append(job.Rows, result)
+		job.Processed++
+		switch result.Status {
+		case ImportRowCreated:
+			job.Created++
+		case ImportRowSkipped:
+			job.Skipped++
+		default:
+			job.Failed++
+		}
+		if save != nil && job.Processed%importProgressInterval == 0 {
+			save()
+		}
+	}
+	job.Status = ImportJobFinished
+	job.UpdateTime = time.Now().Format(time.RFC3339)
+}
+
+// importRow 校验并创建一行短链接
+func (p *shortLinkImporter) importRow(row *importRow) *pb.ImportRowResult {
+	result := &pb.ImportRowResult{
+		Line:      int32(row.Line),
+		OriginUrl: row.OriginUrl,
+	}
+	fail := func(rowStatus, reason string) *pb.ImportRowResult {
+		result.Status = rowStatus
+		result.Reason = reason
+		return result
+	}
+
+	if row.Err != "" {
+		return fail(ImportRowFailed, row.Err)
+	}
+	if row.OriginUrl == "" {
+		return fail(ImportRowFailed, "目标链接不能为空")
+	}
+	if !util.IsHttpUrl(row.OriginUrl) {
+		return fail(ImportRowFailed, "目标链接格式错误")
+	}
+	if err := p.creator.verificationWhitelist(row.OriginUrl); err != nil {
+		return fail(ImportRowFailed, status.Convert(err).Message())
+	}
+
+	gid := row.Gid
+	if gid == "" {
+		gid = p.defaultGid
+	}
+	if gid == "" {
+		return fail(ImportRowFailed, "分组标识不能为空")
+	}
+	groupErr, ok := p.groups[gid]
+	if !ok {
+		groupErr = checkGroupOwner(p.ctx, p.svcCtx, gid)
+		p.groups[gid] = groupErr
+	}
+	if groupErr != nil {
+		return fail(ImportRowFailed, status.Convert(groupErr).Message())
+	}
+
+	validDateType, validDate := util.ValidDateTypePermanent, ""
+	if row.ValidDate != "" {
+		t, err := parseImportValidDate(row.ValidDate)
+		if err != nil {
+			return fail(ImportRowFailed, err.Error())
+		}
+		if !t.After(time.Now()) {
+			return fail(ImportRowFailed, "有效期已过")
+		}
+		validDateType, validDate = util.ValidDateTypeCustom, t.Format(time.RFC3339)
+	}
+
+	tags, err := normalizeTags(row.Tags)
+	if err != nil {
+		return fail(ImportRowFailed, err.Error())
+	}
+
+	// 自定义后缀在文件内重复或已被占用时跳过
+	if row.Alias != "" {
+		if _, ok := p.aliases[row.Alias]; ok {
+			return fail(ImportRowSkipped, "文件中自定义后缀重复")
+		}
+		p.aliases[row.Alias] = struct{}{}
+		if err := p.creator.checkCustomUri(p.domain, row.Alias); err != nil {
+			if status.Code(err) == codes.AlreadyExists {
+				return fail(ImportRowSkipped, status.Convert(err).Message())
+			}
+			return fail(ImportRowFailed, status.Convert(err).Message())
+		}
+		result.FullShortUrl = "http://" + util.Create(p.domain).Append("/").Append(row.Alias).String()
+	}
+
+	if p.dryRun {
+		result.Status = ImportRowCreated
+		return result
+	}
+
+	resp, err := p.creator.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		Domain:        p.domain,
+		OriginUrl:     row.OriginUrl,
+		Gid:           gid,
+		ValidDateType: int32(validDateType),
+		ValidDate:     validDate,
+		Describe:      row.Describe,
+		CustomUri:     row.Alias,
+		Tags:          tags,
+	})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return fail(ImportRowSkipped, status.Convert(err).Message())
+		}
+		return fail(ImportRowFailed, status.Convert(err).Message())
+	}
+	result.Status = ImportRowCreated
+	result.FullShortUrl = resp.FullShortUrl
+	if resp.PendingReview {
+		result.Reason = "目标链接未通过安全检测，短链接已禁用并等待审核"
+	}
+	return result
+}
+
+// importJobRecord 保存在Redis中的导入任务
+type importJobRecord struct {
+	Username string                 `json:"username"`
+	Job      *pb.ShortLinkImportJob `json:"job"`
+}
+
+// saveImportJob 保存导入任务进度和结果
+func saveImportJob(ctx context.Context, svcCtx *svc.ServiceContext, username string, job *pb.ShortLinkImportJob) error {
+	data, err := json.Marshal(&importJobRecord{Username: username, Job: job})
+	if err != nil {
+		return err
+	}
+	return svcCtx.BizRedis.SetexCtx(ctx, fmt.Sprintf(ShortLinkImportJobKey, job.JobId), string(data), svcCtx.Config.Import.JobExpireSeconds)
+}
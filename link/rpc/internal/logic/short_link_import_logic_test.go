@@ -0,0 +1,70 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkImport_InvalidParams 测试导入文件校验
+func TestShortLinkImport_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-import-user"))
+	l := logic.NewShortLinkImportLogic(userCtx, svcCtx)
+
+	cases := map[string]*pb.ImportShortLinkRequest{
+		"文件为空":      {Format: logic.ImportFormatCsv},
+		"格式不支持":     {Format: "xml", Content: []byte("<links/>")},
+		"缺少目标链接列":   {Format: logic.ImportFormatCsv, Content: []byte("alias,gid\nabc,g1\n")},
+		"只有表头没有数据行": {Format: logic.ImportFormatCsv, Content: []byte("origin_url,alias\n")},
+	}
+	for name, req := range cases {
+		_, err := l.ShortLinkImport(req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: 期望 InvalidArgument，实际: %v", name, err)
+		}
+	}
+}
+
+// TestShortLinkImport_DryRunRowErrors 测试试运行时的行级错误
+func TestShortLinkImport_DryRunRowErrors(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-import-user"))
+
+	content := "origin_url,alias,gid,valid_date\n" +
+		"https://example.com/a,,,\n" +
+		"https://example.com/b,,test-import-not-exist,\n" +
+		"not-a-url,,test-import-not-exist,\n" +
+		"https://example.com/c,,test-import-not-exist,2000-01-01\n"
+	resp, err := logic.NewShortLinkImportLogic(userCtx, svcCtx).ShortLinkImport(&pb.ImportShortLinkRequest{
+		Format:  logic.ImportFormatCsv,
+		Content: []byte(content),
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatalf("试运行导入失败: %v", err)
+	}
+	job := resp.Job
+	if job.Status != logic.ImportJobFinished || job.Total != 4 || job.Failed != 4 {
+		t.Fatalf("试运行结果不符合预期: %+v", job)
+	}
+	for i, row := range job.Rows {
+		if row.Line != int32(i+2) || row.Status != logic.ImportRowFailed || row.Reason == "" {
+			t.Errorf("第%d行结果不符合预期: %+v", i+2, row)
+		}
+	}
+
+	// 任务结果可通过任务ID查询，其他用户不可见
+	got, err := logic.NewShortLinkImportJobGetLogic(userCtx, svcCtx).ShortLinkImportJobGet(&pb.GetImportJobRequest{JobId: job.JobId})
+	if err != nil || got.Job.Failed != job.Failed {
+		t.Errorf("查询导入任务失败: %v", err)
+	}
+	otherCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-import-other"))
+	if _, err := logic.NewShortLinkImportJobGetLogic(otherCtx, svcCtx).ShortLinkImportJobGet(&pb.GetImportJobRequest{JobId: job.JobId}); status.Code(err) != codes.NotFound {
+		t.Errorf("查询其他用户的导入任务期望失败，实际: %v", err)
+	}
+}
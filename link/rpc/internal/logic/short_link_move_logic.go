@@ -0,0 +1,200 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// 单次移动短链接的最大数量
+	ShortLinkMoveMaxCount = 100
+	// 分组操作锁超时时间（秒）
+	groupLockExpireSeconds = 30
+)
+
+type ShortLinkMoveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkMoveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkMoveLogic {
+	return &ShortLinkMoveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 移动短链接到其他分组
+// 短链接表以分组ID分片，需在事务中删除原分片记录并写入目标分片，同时更新跳转表、分流版本、跳转规则、审核记录和标签中的分组ID
+func (l *ShortLinkMoveLogic) ShortLinkMove(in *pb.MoveShortLinkRequest) (*pb.MoveShortLinkResponse, error) {
+	if in.OriginGid == "" || in.TargetGid == "" {
+		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
+	}
+	if in.OriginGid == in.TargetGid {
+		return nil, status.Error(codes.InvalidArgument, "目标分组不能与原分组相同")
+	}
+	fullShortUrls := normalizeFullShortUrls(in.FullShortUrls)
+	if len(fullShortUrls) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+	if len(fullShortUrls) > ShortLinkMoveMaxCount {
+		return nil, status.Errorf(codes.InvalidArgument, "单次最多移动%d个短链接", ShortLinkMoveMaxCount)
+	}
+
+	// 原分组和目标分组都必须属于当前用户
+	if err := checkGroupOwner(l.ctx, l.svcCtx, in.OriginGid); err != nil {
+		return nil, err
+	}
+	if err := checkGroupOwner(l.ctx, l.svcCtx, in.TargetGid); err != nil {
+		return nil, err
+	}
+
+	// 锁定两个分组，避免与分组转让或其他移动操作并发
+	for _, gid := range []string{in.OriginGid, in.TargetGid} {
+		lock, err := lockGroup(l.ctx, l.svcCtx, gid)
+		if err != nil {
+			return nil, err
+		}
+		defer lock.Release()
+	}
+
+	links := make([]*model.Link, 0, len(fullShortUrls))
+	for _, fullShortUrl := range fullShortUrls {
+		link, err := l.svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(l.ctx, fullShortUrl, in.OriginGid)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "短链接不存在: %s", fullShortUrl)
+			}
+			l.Logger.Errorf("查询短链接失败: %s, %v", fullShortUrl, err)
+			return nil, status.Error(codes.Internal, "查询短链接失败")
+		}
+		links = append(links, link)
+	}
+
+	// 短链接表、跳转表和公共表位于不同的数据库对象，分片表复用公共表的事务连接，所有写入在同一事务中提交
+	commonTx := l.svcCtx.DBs.Common.WithContext(l.ctx).Begin()
+	if commonTx.Error != nil {
+		l.Logger.Errorf("开启事务失败: %v", commonTx.Error)
+		return nil, status.Error(codes.Internal, "开启事务失败")
+	}
+	linkTx := svc.JoinTx(l.svcCtx.DBs.LinkDB, commonTx)
+	gotoTx := svc.JoinTx(l.svcCtx.DBs.GotoLinkDB, commonTx)
+	rollback := func() {
+		commonTx.Rollback()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			rollback()
+			panic(r)
+		}
+	}()
+
+	linkRepo := repo.NewLinkRepo(linkTx)
+	gotoRepo := repo.NewLinkGotoRepo(gotoTx)
+	variantRepo := repo.NewLinkVariantRepo(commonTx)
+	ruleRepo := repo.NewLinkRedirectRuleRepo(commonTx)
+	moderationRepo := repo.NewLinkModerationRepo(commonTx)
+	tagRepo := repo.NewLinkTagRepo(commonTx)
+	historyRepo := repo.NewLinkHistoryRepo(commonTx)
+	for _, link := range links {
+		if err := linkRepo.MoveToGroup(l.ctx, link, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("移动短链接记录失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "移动短链接记录失败")
+		}
+		if err := gotoRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新短链接跳转记录失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新短链接跳转记录失败")
+		}
+		if err := variantRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新A/B分流版本失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新A/B分流版本失败")
+		}
+		if err := ruleRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新跳转规则失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新跳转规则失败")
+		}
+		if err := moderationRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新安全审核记录失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新安全审核记录失败")
+		}
+		if err := tagRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新短链接标签失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新短链接标签失败")
+		}
+		if err := historyRepo.UpdateGid(l.ctx, link.FullShortUrl, in.TargetGid); err != nil {
+			rollback()
+			l.Logger.Errorf("更新短链接变更记录失败: %s, %v", link.FullShortUrl, err)
+			return nil, status.Error(codes.Internal, "更新短链接变更记录失败")
+		}
+	}
+
+	if err := commonTx.Commit().Error; err != nil {
+		l.Logger.Errorf("提交移动短链接事务失败: %v", err)
+		return nil, status.Error(codes.Internal, "提交事务失败")
+	}
+
+	// 跳转缓存中包含分组ID及分组过期策略，移动后需重新加载
+	for _, link := range links {
+		deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+	}
+	l.Logger.Infof("移动短链接完成: %d个, %s -> %s", len(links), in.OriginGid, in.TargetGid)
+
+	return &pb.MoveShortLinkResponse{
+		Moved: int32(len(links)),
+	}, nil
+}
+
+// normalizeFullShortUrls 去除协议前缀、空值和重复的短链接
+func normalizeFullShortUrls(fullShortUrls []string) []string {
+	seen := make(map[string]struct{}, len(fullShortUrls))
+	result := make([]string, 0, len(fullShortUrls))
+	for _, fullShortUrl := range fullShortUrls {
+		fullShortUrl = strings.TrimSpace(fullShortUrl)
+		fullShortUrl = strings.TrimPrefix(strings.TrimPrefix(fullShortUrl, "http://"), "https://")
+		if fullShortUrl == "" {
+			continue
+		}
+		if _, ok := seen[fullShortUrl]; ok {
+			continue
+		}
+		seen[fullShortUrl] = struct{}{}
+		result = append(result, fullShortUrl)
+	}
+	return result
+}
+
+// lockGroup 获取分组操作锁，分组内短链接移动、分组转让等操作互斥
+func lockGroup(ctx context.Context, svcCtx *svc.ServiceContext, gid string) (*redis.RedisLock, error) {
+	lock := redis.NewRedisLock(svcCtx.BizRedis, fmt.Sprintf(ShortLinkLockGroupKey, gid))
+	lock.SetExpire(groupLockExpireSeconds)
+	ok, err := lock.AcquireCtx(ctx)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("获取分组锁失败: %s, %v", gid, err)
+		return nil, status.Error(codes.Internal, "获取分组锁失败")
+	}
+	if !ok {
+		return nil, status.Error(codes.Aborted, "分组正在处理其他操作，请稍后再试")
+	}
+	return lock, nil
+}
@@ -0,0 +1,91 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkMove_InvalidParams 测试移动短链接参数校验及分组归属校验
+func TestShortLinkMove_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-move-user"
+	gid := "test-move"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+		Gid:        gid,
+		Name:       "移动测试分组",
+		Username:   username,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}); err != nil {
+		t.Errorf("创建分组失败: %v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, gid, username); err != nil {
+			t.Logf("清理分组失败: %v", err)
+		}
+	})
+
+	l := logic.NewShortLinkMoveLogic(userCtx, svcCtx)
+	cases := map[string]struct {
+		req  *pb.MoveShortLinkRequest
+		code codes.Code
+	}{
+		"目标分组为空": {
+			req:  &pb.MoveShortLinkRequest{FullShortUrls: []string{"s.xleft.cn/abc"}, OriginGid: gid},
+			code: codes.InvalidArgument,
+		},
+		"分组相同": {
+			req:  &pb.MoveShortLinkRequest{FullShortUrls: []string{"s.xleft.cn/abc"}, OriginGid: gid, TargetGid: gid},
+			code: codes.InvalidArgument,
+		},
+		"短链接为空": {
+			req:  &pb.MoveShortLinkRequest{FullShortUrls: []string{" "}, OriginGid: gid, TargetGid: "test-move-other"},
+			code: codes.InvalidArgument,
+		},
+		"目标分组不属于当前用户": {
+			req:  &pb.MoveShortLinkRequest{FullShortUrls: []string{"s.xleft.cn/abc"}, OriginGid: gid, TargetGid: "test-move-other"},
+			code: codes.NotFound,
+		},
+	}
+	for name, c := range cases {
+		_, err := l.ShortLinkMove(c.req)
+		if status.Code(err) != c.code {
+			t.Errorf("%s: 期望 %v，实际: %v", name, c.code, err)
+		}
+	}
+}
+
+// TestGroupTransfer_InvalidParams 测试分组转让参数校验
+func TestGroupTransfer_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-transfer-user"))
+
+	if _, err := logic.NewGroupTransferCreateLogic(userCtx, svcCtx).GroupTransferCreate(&pb.CreateGroupTransferRequest{
+		Gid:        "test-transfer-not-exist",
+		ToUsername: "other-user",
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("转让不属于当前用户的分组期望失败，实际: %v", err)
+	}
+	if _, err := logic.NewGroupTransferRespondLogic(userCtx, svcCtx).GroupTransferRespond(&pb.RespondGroupTransferRequest{
+		Id:     -1,
+		Accept: true,
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("接受不存在的转让期望失败，实际: %v", err)
+	}
+	if _, err := logic.NewGroupTransferCancelLogic(userCtx, svcCtx).GroupTransferCancel(&pb.CancelGroupTransferRequest{
+		Id: -1,
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("取消不存在的转让期望失败，实际: %v", err)
+	}
+}
@@ -2,8 +2,14 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/linkhealth"
+	"shorterurl/link/rpc/pkg/util"
+	"sort"
 	"time"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -11,6 +17,18 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// 分页查询排序字段，均按降序排列
+const (
+	PageOrderTodayPv  = "todayPv"
+	PageOrderTodayUv  = "todayUv"
+	PageOrderTodayUip = "todayUip"
+	PageOrderTotalPv  = "totalPv"
+	PageOrderTotalUv  = "totalUv"
+)
+
+// 按今日访问数据排序时分组内最多参与排序的短链接数
+const PageTodayOrderMaxLinks = 10000
+
 type ShortLinkPageLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -42,35 +60,224 @@ func (l *ShortLinkPageLogic) ShortLinkPage(in *pb.PageShortLinkRequest) (*pb.Pag
 		pageSize = 10
 	}
 
-	// 查询数据
-	links, total, err := l.svcCtx.RepoManager.Link.FindByGid(l.ctx, in.Gid, page, pageSize)
+	// 正常状态的链接，可只查询目标链接已失效的短链接
+	enableStatus := 0
+	filter := repo.LinkFilter{EnableStatus: &enableStatus}
+	if in.BrokenOnly {
+		healthStatus := linkhealth.HealthStatusBroken
+		filter.HealthStatus = &healthStatus
+	}
+
+	// 查询数据，累计访问数据在短链接表中按索引排序，今日访问数据在统计表中，需在内存中排序
+	var links []*model.Link
+	var total int64
+	var err error
+	switch in.OrderTag {
+	case "":
+		if in.BrokenOnly {
+			links, total, err = l.svcCtx.RepoManager.Link.FindByGidAndHealthStatus(l.ctx, in.Gid, linkhealth.HealthStatusBroken, page, pageSize)
+		} else {
+			links, total, err = l.svcCtx.RepoManager.Link.FindByGid(l.ctx, in.Gid, page, pageSize)
+		}
+	case PageOrderTotalPv:
+		links, total, err = l.svcCtx.RepoManager.Link.PageByGidAndFilter(l.ctx, in.Gid, filter, repo.LinkOrder{Field: repo.LinkOrderTotalPv}, page, pageSize)
+	case PageOrderTotalUv:
+		links, total, err = l.svcCtx.RepoManager.Link.PageByGidAndFilter(l.ctx, in.Gid, filter, repo.LinkOrder{Field: repo.LinkOrderTotalUv}, page, pageSize)
+	case PageOrderTodayPv, PageOrderTodayUv, PageOrderTodayUip:
+		if links, total, err = l.pageByToday(in.Gid, filter, in.OrderTag, page, pageSize); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "不支持的排序字段")
+	}
 	if err != nil {
 		l.Logger.Errorf("查询短链接列表失败: %v", err)
 		return nil, status.Error(codes.Internal, "查询短链接列表失败")
 	}
 
-	// 构建响应
+	return &pb.PageShortLinkResponse{
+		Total:   int32(total),
+		Size:    int32(pageSize),
+		Current: int32(page),
+		Records: buildShortLinkRecords(l.ctx, l.svcCtx, links),
+	}, nil
+}
+
+// pageByToday 取出分组内全部匹配的短链接，按今日访问数据降序排序后分页
+// 同一分组的短链接在同一分片中，统计表按完整短链接和日期唯一，排序结果与分片无关
+func (l *ShortLinkPageLogic) pageByToday(gid string, filter repo.LinkFilter, orderTag string, page, pageSize int) ([]*model.Link, int64, error) {
+	total, err := l.svcCtx.RepoManager.Link.CountByGidAndFilter(l.ctx, gid, filter)
+	if err != nil {
+		l.Logger.Errorf("统计短链接数量失败: %v", err)
+		return nil, 0, status.Error(codes.Internal, "查询短链接列表失败")
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+	if total > PageTodayOrderMaxLinks {
+		return nil, 0, status.Errorf(codes.FailedPrecondition, "分组内短链接超过%d个，暂不支持按今日访问数据排序", PageTodayOrderMaxLinks)
+	}
+
+	candidates, err := l.svcCtx.RepoManager.Link.FindBriefByGidAndFilter(l.ctx, gid, filter, PageTodayOrderMaxLinks)
+	if err != nil {
+		l.Logger.Errorf("查询短链接列表失败: %v", err)
+		return nil, 0, status.Error(codes.Internal, "查询短链接列表失败")
+	}
+	fullShortUrls := make([]string, 0, len(candidates))
+	for _, link := range candidates {
+		fullShortUrls = append(fullShortUrls, link.FullShortUrl)
+	}
+	stats, err := l.svcCtx.RepoManager.StatsToday.FindByFullShortUrls(l.ctx, fullShortUrls, time.Now())
+	if err != nil {
+		l.Logger.Errorf("查询今日统计失败: %v", err)
+		return nil, 0, status.Error(codes.Internal, "查询今日统计失败")
+	}
+
+	// 今日访问数据相同时按ID倒序，与默认排序一致
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := todayMetric(stats[candidates[i].FullShortUrl], orderTag), todayMetric(stats[candidates[j].FullShortUrl], orderTag)
+		if a != b {
+			return a > b
+		}
+		return candidates[i].ID > candidates[j].ID
+	})
+	links, err := loadLinksInOrder(l.ctx, l.svcCtx, pageLinks(candidates, (page-1)*pageSize, pageSize))
+	if err != nil {
+		l.Logger.Errorf("查询短链接详情失败: %v", err)
+		return nil, 0, status.Error(codes.Internal, "查询短链接列表失败")
+	}
+	return links, total, nil
+}
+
+// todayMetric 返回当日统计中排序字段的值，没有访问时为0
+func todayMetric(stat *model.LinkStatsToday, orderTag string) int {
+	if stat == nil {
+		return 0
+	}
+	switch orderTag {
+	case PageOrderTodayUv:
+		return stat.TodayUV
+	case PageOrderTodayUip:
+		return stat.TodayUIP
+	default:
+		return stat.TodayPV
+	}
+}
+
+// loadLinksInOrder 按分组查询短链接详情，按传入的顺序返回，已不存在的短链接被跳过
+func loadLinksInOrder(ctx context.Context, svcCtx *svc.ServiceContext, briefs []*model.Link) ([]*model.Link, error) {
+	gidUrls := make(map[string][]string)
+	for _, link := range briefs {
+		gidUrls[link.Gid] = append(gidUrls[link.Gid], link.FullShortUrl)
+	}
+	details := make(map[string]*model.Link, len(briefs))
+	for gid, urls := range gidUrls {
+		links, err := svcCtx.RepoManager.Link.FindByGidAndFilter(ctx, gid, repo.LinkFilter{FullShortUrls: urls}, len(urls))
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range links {
+			details[link.FullShortUrl] = link
+		}
+	}
+
+	links := make([]*model.Link, 0, len(briefs))
+	for _, link := range briefs {
+		if detail, ok := details[link.FullShortUrl]; ok {
+			links = append(links, detail)
+		}
+	}
+	return links, nil
+}
+
+// buildShortLinkRecords 构建短链接记录，附带分组过期策略计算的宽限期状态、标签和今日访问数据
+func buildShortLinkRecords(ctx context.Context, svcCtx *svc.ServiceContext, links []*model.Link) []*pb.ShortLinkRecord {
+	// 查询分组过期策略，用于标记处于宽限期的短链接
+	groupExpiry := make(map[string]*model.GroupExpiryPolicy)
+	fullShortUrls := make([]string, 0, len(links))
+	for _, link := range links {
+		if _, ok := groupExpiry[link.Gid]; !ok {
+			groupExpiry[link.Gid] = findGroupExpiryPolicy(ctx, svcCtx, link.Gid)
+		}
+		fullShortUrls = append(fullShortUrls, link.FullShortUrl)
+	}
+
+	// 查询标签和今日访问数据失败时不影响列表展示
+	tags, err := svcCtx.RepoManager.Tag.FindByFullShortUrls(ctx, fullShortUrls)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询短链接标签失败: %v", err)
+	}
+	todayStats, err := svcCtx.RepoManager.StatsToday.FindByFullShortUrls(ctx, fullShortUrls, time.Now())
+	if err != nil {
+		logx.WithContext(ctx).Errorf("查询今日统计失败: %v", err)
+	}
+
 	records := make([]*pb.ShortLinkRecord, 0, len(links))
 	for _, link := range links {
+		expiryPolicy := mergeExpiryPolicy(link, groupExpiry[link.Gid])
 		record := &pb.ShortLinkRecord{
-			FullShortUrl: link.FullShortUrl,
-			OriginUrl:    link.OriginUrl,
-			Domain:       link.Domain,
-			Gid:          link.Gid,
-			CreateTime:   link.CreateTime.Format(time.RFC3339),
-			ValidDate:    link.ValidDate.Format(time.RFC3339),
-			Describe:     link.Describe,
-			TotalPv:      int32(link.TotalPv),
-			TotalUv:      int32(link.TotalUv),
-			TotalUip:     int32(link.TotalUip),
+			FullShortUrl:        link.FullShortUrl,
+			OriginUrl:           link.OriginUrl,
+			Domain:              link.Domain,
+			Gid:                 link.Gid,
+			CreateTime:          link.CreateTime.Format(time.RFC3339),
+			ValidDate:           link.ValidDate.Format(time.RFC3339),
+			Describe:            link.Describe,
+			TotalPv:             int32(link.TotalPv),
+			TotalUv:             int32(link.TotalUv),
+			TotalUip:            int32(link.TotalUip),
+			EnableStatus:        int32(link.EnableStatus),
+			MaxClicks:           int32(link.MaxClicks),
+			ClickNum:            int32(link.ClickNum),
+			QueryParamPolicy:    int32(link.QueryParamPolicy),
+			UtmSource:           link.UtmSource,
+			UtmMedium:           link.UtmMedium,
+			UtmCampaign:         link.UtmCampaign,
+			ExpiredUrl:          link.ExpiredUrl,
+			ExpiredMessage:      link.ExpiredMessage,
+			GraceDays:           int32(link.GraceDays),
+			InGracePeriod:       util.IsInGracePeriod(link.ValidDate, expiryPolicy.GraceDays),
+			RedirectType:        int32(link.RedirectType),
+			IosDeepLink:         link.IosDeepLink,
+			AndroidPackage:      link.AndroidPackage,
+			AndroidDeepLink:     link.AndroidDeepLink,
+			DeepLinkFallbackUrl: link.DeepLinkFallbackUrl,
+			OgTitle:             link.OgTitle,
+			OgDescription:       link.OgDescription,
+			OgImage:             link.OgImage,
+			SafetyStatus:        int32(link.SafetyStatus),
+			HealthStatus:        int32(link.HealthStatus),
+			HealthCode:          int32(link.HealthCode),
+			HealthLatency:       int32(link.HealthLatency),
+			HealthRedirects:     decodeHealthRedirects(link.HealthRedirects),
+			HealthError:         link.HealthError,
+			Tags:                tags[link.FullShortUrl],
+		}
+		if stat, ok := todayStats[link.FullShortUrl]; ok {
+			record.TodayPv = int32(stat.TodayPV)
+			record.TodayUv = int32(stat.TodayUV)
+			record.TodayUip = int32(stat.TodayUIP)
+		}
+		if link.ValidFrom != nil {
+			record.ValidFrom = link.ValidFrom.Format(time.RFC3339)
+		}
+		if link.HealthCheckTime != nil {
+			record.HealthCheckTime = link.HealthCheckTime.Format(time.RFC3339)
 		}
 		records = append(records, record)
 	}
 
-	return &pb.PageShortLinkResponse{
-		Total:   int32(total),
-		Size:    int32(pageSize),
-		Current: int32(page),
-		Records: records,
-	}, nil
+	return records
+}
+
+// decodeHealthRedirects 解析保存的重定向链路，内容无效时返回空
+func decodeHealthRedirects(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var redirects []string
+	if err := json.Unmarshal([]byte(value), &redirects); err != nil {
+		return nil
+	}
+	return redirects
 }
@@ -2,8 +2,14 @@ package logic_test
 
 import (
 	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/linkhealth"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TestShortLinkPage_Normal 测试正常分页查询短链接
@@ -171,3 +177,98 @@ func TestShortLinkPage_InvalidParams(t *testing.T) {
 
 	t.Logf("无效分页参数正确处理，使用默认值：当前页 %d, 每页大小 %d", resp.Current, resp.Size)
 }
+
+// TestShortLinkPage_BrokenOnly 测试只查询目标链接已失效的短链接
+func TestShortLinkPage_BrokenOnly(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	pageLogic := logic.NewShortLinkPageLogic(ctx, svcCtx)
+	resp, err := pageLogic.ShortLinkPage(&pb.PageShortLinkRequest{
+		Gid:        "test-page",
+		Current:    1,
+		Size:       10,
+		BrokenOnly: true,
+	})
+	if err != nil {
+		t.Errorf("分页查询失效短链接失败: %v", err)
+		return
+	}
+
+	// 新创建的短链接尚未检测，不应出现在结果中
+	for _, record := range resp.Records {
+		if record.HealthStatus != linkhealth.HealthStatusBroken {
+			t.Errorf("短链接 %s 健康状态为 %d，不应出现在失效列表中", record.FullShortUrl, record.HealthStatus)
+		}
+	}
+}
+
+// TestShortLinkPage_OrderTag 测试按今日和累计访问数据排序
+func TestShortLinkPage_OrderTag(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	gid := "test-page-order"
+	pageLogic := logic.NewShortLinkPageLogic(ctx, svcCtx)
+
+	_, err := pageLogic.ShortLinkPage(&pb.PageShortLinkRequest{Gid: gid, OrderTag: "createTime"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("不支持的排序字段期望 InvalidArgument，实际: %v", err)
+	}
+
+	// 累计访问量递增，今日访问量递减
+	now := time.Now()
+	today, _ := time.Parse("2006-01-02", now.Format("2006-01-02"))
+	var links []*model.Link
+	for i, shortUri := range []string{"order1", "order2", "order3"} {
+		link := &model.Link{
+			Domain:       "test.example.com",
+			ShortUri:     shortUri,
+			FullShortUrl: "test.example.com/" + shortUri,
+			OriginUrl:    "https://github.com/zeromicro/go-zero",
+			Gid:          gid,
+			TotalPv:      (i + 1) * 10,
+			CreateTime:   now,
+			UpdateTime:   now,
+			ValidDate:    now.AddDate(10, 0, 0),
+		}
+		cleanSpecificTestData(t, svcCtx, ctx, link.FullShortUrl, gid)
+		svcCtx.RepoManager.GetCommonDB().Where("full_short_url = ?", link.FullShortUrl).Delete(&model.LinkStatsToday{})
+		if err := svcCtx.RepoManager.Link.Create(ctx, link); err != nil {
+			t.Fatalf("创建测试链接失败: %v", err)
+		}
+		if err := svcCtx.RepoManager.GetCommonDB().Create(&model.LinkStatsToday{
+			FullShortUrl: link.FullShortUrl,
+			Date:         today,
+			TodayPV:      3 - i,
+			TodayUV:      3 - i,
+			TodayUIP:     3 - i,
+			CreateTime:   now,
+			UpdateTime:   now,
+		}).Error; err != nil {
+			t.Fatalf("创建今日统计失败: %v", err)
+		}
+		links = append(links, link)
+	}
+	t.Cleanup(func() {
+		for _, link := range links {
+			cleanSpecificTestData(t, svcCtx, ctx, link.FullShortUrl, gid)
+			svcCtx.RepoManager.GetCommonDB().Where("full_short_url = ?", link.FullShortUrl).Delete(&model.LinkStatsToday{})
+		}
+	})
+
+	for orderTag, first := range map[string]*model.Link{
+		logic.PageOrderTodayPv: links[0],
+		logic.PageOrderTodayUv: links[0],
+		logic.PageOrderTotalPv: links[2],
+	} {
+		resp, err := pageLogic.ShortLinkPage(&pb.PageShortLinkRequest{Gid: gid, Current: 1, Size: 10, OrderTag: orderTag})
+		if err != nil {
+			t.Fatalf("%s: 分页查询失败: %v", orderTag, err)
+		}
+		if len(resp.Records) != 3 || resp.Records[0].FullShortUrl != first.FullShortUrl {
+			t.Errorf("%s: 排序结果不符合预期: %+v", orderTag, resp.Records)
+			continue
+		}
+		if resp.Records[0].TodayPv == 0 {
+			t.Errorf("%s: 期望返回今日访问量", orderTag)
+		}
+	}
+}
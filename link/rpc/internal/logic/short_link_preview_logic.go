@@ -0,0 +1,193 @@
+package logic
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 社交分享预览配置限制
+const (
+	// 预览标题最大长度
+	OgTitleMaxLength = 256
+	// 预览描述最大长度
+	OgDescriptionMaxLength = 512
+	// 预览图片链接最大长度
+	OgImageMaxLength = 1024
+)
+
+// 社交分享预览缓存时间（秒）
+const (
+	// 短链接预览缓存1小时
+	previewCacheSeconds = 60 * 60
+	// 目标页面预览信息缓存1天
+	previewPageCacheSeconds = 24 * 60 * 60
+	// 目标页面获取失败时缓存10分钟，避免频繁请求目标站点
+	previewPageFailCacheSeconds = 10 * 60
+)
+
+type ShortLinkPreviewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkPreviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkPreviewLogic {
+	return &ShortLinkPreviewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 短链接社交分享预览，短链接单独配置的内容优先，未配置的内容从目标页面获取
+// 预览不记录访问统计，也不扣减访问次数
+func (l *ShortLinkPreviewLogic) ShortLinkPreview(in *pb.ShortLinkPreviewRequest) (*pb.ShortLinkPreviewResponse, error) {
+	if in.ShortUri == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+
+	domain := resolveDomain(l.ctx, l.svcCtx, in.Host)
+	fullShortUrl := fmt.Sprintf("%s/%s", domain, in.ShortUri)
+
+	cacheKey := fmt.Sprintf(ShortLinkPreviewKey, fullShortUrl)
+	if cached, err := l.svcCtx.BizRedis.GetCtx(l.ctx, cacheKey); err == nil && cached != "" {
+		var resp pb.ShortLinkPreviewResponse
+		if err := json.Unmarshal([]byte(cached), &resp); err == nil {
+			return &resp, nil
+		}
+	}
+
+	linkGoto, err := l.svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "未找到对应的短链接")
+	}
+	link, err := l.svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(l.ctx, fullShortUrl, linkGoto.Gid)
+	if err != nil || link.DelFlag > 0 {
+		return nil, status.Error(codes.NotFound, "未找到对应的短链接详情")
+	}
+	if link.EnableStatus > 0 {
+		return nil, status.Error(codes.PermissionDenied, "短链接已被禁用")
+	}
+	// 与跳转使用相同的可用性检查，不可访问的短链接不返回原始链接也不获取目标页面
+	cacheSeconds, err := l.checkAvailable(link)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ShortLinkPreviewResponse{
+		Title:       link.OgTitle,
+		Description: link.OgDescription,
+		Image:       link.OgImage,
+	}
+
+	// 需要访问密码的短链接不获取目标页面，避免泄露受保护的内容
+	if link.Password == "" {
+		resp.OriginUrl = link.OriginUrl
+		if resp.Title == "" || resp.Description == "" || resp.Image == "" {
+			if meta := l.getPageMeta(link.OriginUrl); meta != nil {
+				if resp.Title == "" {
+					resp.Title = meta.Title
+				}
+				if resp.Description == "" {
+					resp.Description = meta.Description
+				}
+				if resp.Image == "" {
+					resp.Image = meta.Image
+				}
+			}
+		}
+	}
+	if resp.Title == "" {
+		resp.Title = link.Describe
+	}
+
+	if data, err := json.Marshal(resp); err == nil && cacheSeconds > 0 {
+		l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, string(data), cacheSeconds)
+	}
+	return resp, nil
+}
+
+// checkAvailable 检查短链接当前是否可以访问，返回预览缓存时间
+// 尚未生效、已过期（含宽限期）、访问次数用完或安全审核未通过时返回错误
+// 限制访问次数的短链接不缓存预览，保证次数用完后立即失效
+func (l *ShortLinkPreviewLogic) checkAvailable(link *model.Link) (int, error) {
+	switch link.SafetyStatus {
+	case urlsafety.SafetyStatusPending:
+		return 0, status.Error(codes.PermissionDenied, "短链接正在审核中")
+	case urlsafety.SafetyStatusBlocked:
+		return 0, status.Error(codes.PermissionDenied, "短链接目标链接存在安全风险")
+	}
+
+	if util.IsLinkNotYetActive(link.ValidFrom) {
+		return 0, status.Error(codes.FailedPrecondition, "短链接尚未生效")
+	}
+
+	cacheSeconds := previewCacheSeconds
+	if !link.ValidDate.IsZero() {
+		expiryPolicy := mergeExpiryPolicy(link, findGroupExpiryPolicy(l.ctx, l.svcCtx, link.Gid))
+		expireAt := util.GetLinkExpireTime(link.ValidDate, expiryPolicy.GraceDays)
+		if !expireAt.After(time.Now()) {
+			return 0, status.Error(codes.PermissionDenied, "短链接已过期")
+		}
+		if seconds := int(time.Until(expireAt).Seconds()); seconds < cacheSeconds {
+			cacheSeconds = seconds
+		}
+	}
+
+	if link.MaxClicks > 0 {
+		if l.clicksExhausted(link) {
+			return 0, status.Error(codes.PermissionDenied, "短链接访问次数已达上限")
+		}
+		cacheSeconds = 0
+	}
+	return cacheSeconds, nil
+}
+
+// clicksExhausted 判断短链接访问次数是否已用完，优先使用剩余访问次数计数器，计数器不存在时按数据库中的访问次数判断
+func (l *ShortLinkPreviewLogic) clicksExhausted(link *model.Link) bool {
+	key := fmt.Sprintf(ShortLinkClicksRemainingKey, link.FullShortUrl)
+	if val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, key); err == nil && val != "" {
+		if remaining, err := strconv.Atoi(val); err == nil {
+			return remaining <= 0
+		}
+	}
+	return link.ClickNum >= link.MaxClicks
+}
+
+// getPageMeta 获取目标页面的预览信息并按目标链接缓存，获取失败时返回空
+func (l *ShortLinkPreviewLogic) getPageMeta(originUrl string) *pageMeta {
+	sum := md5.Sum([]byte(originUrl))
+	cacheKey := fmt.Sprintf(ShortLinkPreviewPageKey, hex.EncodeToString(sum[:]))
+	if cached, err := l.svcCtx.BizRedis.GetCtx(l.ctx, cacheKey); err == nil && cached != "" {
+		var meta pageMeta
+		if err := json.Unmarshal([]byte(cached), &meta); err == nil {
+			return &meta
+		}
+	}
+
+	meta, err := NewUrlTitleGetLogic(l.ctx, l.svcCtx).FetchPageMeta(originUrl)
+	if err != nil {
+		l.Logger.Errorf("获取目标页面预览信息失败: %v", err)
+		l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, "{}", previewPageFailCacheSeconds)
+		return nil
+	}
+	if data, err := json.Marshal(meta); err == nil {
+		l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, string(data), previewPageCacheSeconds)
+	}
+	return meta
+}
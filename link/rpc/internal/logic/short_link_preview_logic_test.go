@@ -0,0 +1,133 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"testing"
+	"time"
+)
+
+// TestShortLinkPreview_Override 测试短链接单独配置的社交分享预览
+func TestShortLinkPreview_Override(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+
+	// 预览图片必须是http(s)链接
+	if _, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-preview",
+		Describe:  "测试非法预览图片的短链接",
+		OgImage:   "javascript:alert(1)",
+	}); err == nil {
+		t.Error("期望预览图片链接格式错误时创建失败")
+		return
+	}
+
+	// 需要访问密码的短链接只使用单独配置的预览内容
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:     "https://github.com/zeromicro/go-zero",
+		Gid:           "test-preview",
+		Describe:      "测试社交分享预览的短链接",
+		Password:      "123456",
+		OgTitle:       "go-zero 微服务框架",
+		OgDescription: "集成各种工程实践的Web和RPC框架",
+		OgImage:       "https://example.com/go-zero.png",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+
+	previewLogic := logic.NewShortLinkPreviewLogic(ctx, svcCtx)
+	resp, err := previewLogic.ShortLinkPreview(&pb.ShortLinkPreviewRequest{ShortUri: extractShortUri(createResp.FullShortUrl)})
+	if err != nil {
+		t.Errorf("获取短链接预览失败: %v", err)
+		return
+	}
+	if resp.Title != "go-zero 微服务框架" || resp.Image != "https://example.com/go-zero.png" {
+		t.Errorf("期望返回单独配置的预览内容，实际: %+v", resp)
+		return
+	}
+	if resp.OriginUrl != "" {
+		t.Errorf("需要访问密码的短链接不应返回原始链接，实际: %s", resp.OriginUrl)
+		return
+	}
+
+	t.Logf("短链接预览: %+v", resp)
+}
+
+// TestShortLinkPreview_Unavailable 测试不可访问的短链接不返回预览
+func TestShortLinkPreview_Unavailable(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	previewLogic := logic.NewShortLinkPreviewLogic(ctx, svcCtx)
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+
+	create := func(req *pb.CreateShortLinkRequest) string {
+		req.OriginUrl = "https://github.com/zeromicro/go-zero"
+		req.Gid = "test-preview"
+		createResp, err := createLogic.ShortLinkCreate(req)
+		if err != nil {
+			t.Fatalf("创建短链接失败: %v", err)
+		}
+		return createResp.FullShortUrl
+	}
+	expectDenied := func(name, fullShortUrl string) {
+		resp, err := previewLogic.ShortLinkPreview(&pb.ShortLinkPreviewRequest{ShortUri: extractShortUri(fullShortUrl)})
+		if err == nil {
+			t.Errorf("%s: 期望获取预览失败，实际: %+v", name, resp)
+			return
+		}
+		t.Logf("%s: 正确拒绝获取预览: %v", name, err)
+	}
+
+	// 尚未生效
+	expectDenied("尚未生效", create(&pb.CreateShortLinkRequest{
+		Describe:  "测试尚未生效的短链接预览",
+		ValidFrom: time.Now().Add(time.Hour).Format(time.RFC3339),
+	}))
+
+	// 已过期
+	expectDenied("已过期", create(&pb.CreateShortLinkRequest{
+		Describe:      "测试已过期的短链接预览",
+		ValidDateType: 1,
+		ValidDate:     time.Now().Add(-time.Hour).Format(time.RFC3339),
+	}))
+
+	// 访问次数已用完
+	fullShortUrl := create(&pb.CreateShortLinkRequest{
+		Describe:  "测试访问次数用完的短链接预览",
+		MaxClicks: 1,
+	})
+	if _, err := previewLogic.ShortLinkPreview(&pb.ShortLinkPreviewRequest{ShortUri: extractShortUri(fullShortUrl)}); err != nil {
+		t.Errorf("访问次数未用完时获取预览失败: %v", err)
+		return
+	}
+	if _, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: extractShortUri(fullShortUrl)}); err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	expectDenied("访问次数已用完", fullShortUrl)
+
+	// 安全审核中及已拦截
+	for name, safetyStatus := range map[string]int{
+		"安全审核中": urlsafety.SafetyStatusPending,
+		"已拦截":   urlsafety.SafetyStatusBlocked,
+	} {
+		fullShortUrl := create(&pb.CreateShortLinkRequest{Describe: "测试" + name + "的短链接预览"})
+		link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, "test-preview")
+		if err != nil {
+			t.Errorf("查询短链接失败: %v", err)
+			return
+		}
+		link.SafetyStatus = safetyStatus
+		if err := svcCtx.RepoManager.Link.Update(ctx, link); err != nil {
+			t.Errorf("更新安全状态失败: %v", err)
+			return
+		}
+		expectDenied(name, fullShortUrl)
+	}
+}
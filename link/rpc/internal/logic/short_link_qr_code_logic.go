@@ -0,0 +1,221 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/linkhealth"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/skip2/go-qrcode"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 二维码渲染参数限制
+const (
+	// 静默区最大宽度（模块数）
+	QrCodeMarginMax = 16
+	// 中心图标链接最大长度
+	QrCodeLogoUrlMaxLength = 1024
+)
+
+// 二维码图片类型
+var qrCodeContentTypes = map[string]string{
+	util.QrCodeFormatPng: "image/png",
+	util.QrCodeFormatSvg: "image/svg+xml",
+}
+
+type ShortLinkQrCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkQrCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkQrCodeLogic {
+	return &ShortLinkQrCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 生成短链接二维码，二维码内容为携带 src=qr 渠道标记的短链接，扫码访问单独统计
+func (l *ShortLinkQrCodeLogic) ShortLinkQrCode(in *pb.ShortLinkQrCodeRequest) (*pb.ShortLinkQrCodeResponse, error) {
+	if in.FullShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+
+	// 校验并补全渲染参数
+	format := strings.ToLower(in.Format)
+	if format == "" {
+		format = util.QrCodeFormatPng
+	}
+	contentType, ok := qrCodeContentTypes[format]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "二维码格式只支持png和svg")
+	}
+
+	level, ok := util.ParseQrCodeLevel(in.Ecc)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "纠错等级只支持L、M、Q、H")
+	}
+	// 中心图标会遮挡部分模块，使用最高纠错等级保证可识别
+	if in.LogoUrl != "" {
+		level = qrcode.Highest
+	}
+
+	size := int(in.Size)
+	if size == 0 {
+		size = l.svcCtx.Config.QrCode.DefaultSize
+	}
+	if size < 0 || size > l.svcCtx.Config.QrCode.MaxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "二维码尺寸不能超过%d像素", l.svcCtx.Config.QrCode.MaxSize)
+	}
+	if in.Margin < 0 || in.Margin > QrCodeMarginMax {
+		return nil, status.Errorf(codes.InvalidArgument, "静默区宽度需在0到%d之间", QrCodeMarginMax)
+	}
+
+	if in.ForegroundColor == "" {
+		in.ForegroundColor = "#000000"
+	}
+	if in.BackgroundColor == "" {
+		in.BackgroundColor = "#FFFFFF"
+	}
+	foreground, ok := util.ParseHexColor(in.ForegroundColor)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "前景色格式错误")
+	}
+	background, ok := util.ParseHexColor(in.BackgroundColor)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "背景色格式错误")
+	}
+	if foreground == background {
+		return nil, status.Error(codes.InvalidArgument, "前景色和背景色不能相同")
+	}
+
+	if in.LogoUrl != "" {
+		if len(in.LogoUrl) > QrCodeLogoUrlMaxLength || !util.IsHttpUrl(in.LogoUrl) {
+			return nil, status.Error(codes.InvalidArgument, "中心图标链接格式错误")
+		}
+	}
+
+	// 校验短链接归属
+	fullShortUrl := strings.TrimPrefix(strings.TrimPrefix(in.FullShortUrl, "http://"), "https://")
+	linkGoto, err := l.svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+	if err := checkGroupOwner(l.ctx, l.svcCtx, linkGoto.Gid); err != nil {
+		return nil, err
+	}
+	link, err := l.svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(l.ctx, fullShortUrl, linkGoto.Gid)
+	if err != nil || link.DelFlag > 0 {
+		return nil, status.Error(codes.NotFound, "短链接不存在")
+	}
+
+	sum := md5.Sum([]byte(fmt.Sprintf("%s|%s|%d|%d|%d|%v|%v|%s",
+		fullShortUrl, format, level, size, in.Margin, foreground, background, in.LogoUrl)))
+	cacheKey := fmt.Sprintf(ShortLinkQrCodeKey, hex.EncodeToString(sum[:]))
+	if cached, err := l.svcCtx.BizRedis.GetCtx(l.ctx, cacheKey); err == nil && cached != "" {
+		return &pb.ShortLinkQrCodeResponse{
+			Content:     []byte(cached),
+			ContentType: contentType,
+		}, nil
+	}
+
+	opts := util.QrCodeOptions{
+		Size:       size,
+		Margin:     int(in.Margin),
+		Foreground: foreground,
+		Background: background,
+	}
+	if in.LogoUrl != "" {
+		if opts.Logo, err = l.fetchLogo(in.LogoUrl); err != nil {
+			l.Logger.Errorf("获取二维码中心图标失败: %v", err)
+			return nil, status.Error(codes.InvalidArgument, "获取中心图标失败")
+		}
+	}
+
+	content := fmt.Sprintf("%s://%s?%s=%s", l.svcCtx.Config.QrCode.Scheme, fullShortUrl, util.ChannelParam, util.ChannelQrCode)
+	data, err := util.RenderQrCode(content, level, format, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, string(data), l.svcCtx.Config.QrCode.CacheSeconds); err != nil {
+		l.Logger.Errorf("缓存二维码失败: %v", err)
+	}
+
+	return &pb.ShortLinkQrCodeResponse{
+		Content:     data,
+		ContentType: contentType,
+	}, nil
+}
+
+// 中心图标允许的图片类型
+var qrCodeLogoContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+}
+
+// 下载中心图标的HTTP客户端，只允许连接公网地址，重定向的每一跳同样检查
+var qrCodeLogoClient = &http.Client{
+	Timeout:   5 * time.Second,
+	Transport: linkhealth.NewGuardedTransport(),
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 3 {
+			return fmt.Errorf("重定向次数过多")
+		}
+		return nil
+	},
+}
+
+// fetchLogo 下载并解码中心图标，支持png、jpeg和gif格式
+// 图标链接不能指向内网地址，响应类型必须是支持的图片格式
+func (l *ShortLinkQrCodeLogic) fetchLogo(logoUrl string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(l.ctx, http.MethodGet, logoUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := qrCodeLogoClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP请求失败，状态码: %d", resp.StatusCode)
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !qrCodeLogoContentTypes[mediaType] {
+		return nil, fmt.Errorf("中心图标类型不支持: %s", resp.Header.Get("Content-Type"))
+	}
+
+	maxBytes := l.svcCtx.Config.QrCode.LogoMaxBytes
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, fmt.Errorf("中心图标超过%d字节", maxBytes)
+	}
+
+	logo, _, err := image.Decode(bytes.NewReader(body))
+	return logo, err
+}
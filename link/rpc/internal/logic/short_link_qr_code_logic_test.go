@@ -0,0 +1,32 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkQrCode_InvalidParams 测试二维码渲染参数校验
+func TestShortLinkQrCode_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	l := logic.NewShortLinkQrCodeLogic(ctx, svcCtx)
+	cases := map[string]*pb.ShortLinkQrCodeRequest{
+		"不支持的图片格式":  {FullShortUrl: "s.xleft.cn/abc", Format: "gif"},
+		"不支持的纠错等级":  {FullShortUrl: "s.xleft.cn/abc", Ecc: "X"},
+		"尺寸超过上限":    {FullShortUrl: "s.xleft.cn/abc", Size: 100000},
+		"静默区宽度过大":   {FullShortUrl: "s.xleft.cn/abc", Margin: 100},
+		"颜色格式错误":    {FullShortUrl: "s.xleft.cn/abc", ForegroundColor: "#12345"},
+		"前景色与背景色相同": {FullShortUrl: "s.xleft.cn/abc", ForegroundColor: "#fff", BackgroundColor: "#FFFFFF"},
+		"中心图标链接错误":  {FullShortUrl: "s.xleft.cn/abc", LogoUrl: "javascript:alert(1)"},
+	}
+	for name, req := range cases {
+		_, err := l.ShortLinkQrCode(req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: 期望参数错误，实际: %v", name, err)
+		}
+	}
+}
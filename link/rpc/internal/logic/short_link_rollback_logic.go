@@ -0,0 +1,158 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortLinkRollbackLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkRollbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkRollbackLogic {
+	return &ShortLinkRollbackLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 将短链接属性恢复为指定变更记录完成后的状态，并删除跳转缓存
+// 启用状态通过启用、停用和回收站接口管理，回滚时保持不变；A/B分流和标签不在变更记录中
+func (l *ShortLinkRollbackLogic) ShortLinkRollback(in *pb.RollbackShortLinkRequest) (*pb.RollbackShortLinkResponse, error) {
+	if in.HistoryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "变更记录ID不能为空")
+	}
+	link, err := findOwnedLink(l.ctx, l.svcCtx, in.FullShortUrl, in.Gid)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与查询变更记录一致，只能回滚到当前短链接创建之后、属于当前分组的记录
+	history, err := l.svcCtx.RepoManager.History.FindByID(l.ctx, in.HistoryId)
+	if err != nil || history.FullShortUrl != link.FullShortUrl || history.Gid != link.Gid || history.CreateTime.Before(link.CreateTime) {
+		return nil, status.Error(codes.NotFound, "变更记录不存在")
+	}
+	var snapshot linkhistory.Snapshot
+	if err := json.Unmarshal([]byte(history.Snapshot), &snapshot); err != nil {
+		l.Logger.Errorf("解析变更记录失败: %d, %v", history.ID, err)
+		return nil, status.Error(codes.FailedPrecondition, "变更记录内容无效，无法回滚")
+	}
+
+	before := linkhistory.NewSnapshot(link)
+	originChanged := link.OriginUrl != snapshot.OriginUrl
+	if err := applyLinkSnapshot(link, &snapshot); err != nil {
+		l.Logger.Errorf("解析变更记录失败: %d, %v", history.ID, err)
+		return nil, status.Error(codes.FailedPrecondition, "变更记录内容无效，无法回滚")
+	}
+
+	// 与修改短链接使用相同的校验，白名单或配置规则变化后不再合法的版本不能回滚
+	if err := validateLinkSettings(l.svcCtx, link); err != nil {
+		return nil, err
+	}
+
+	// 恢复后的跳转目标重新进行安全检测，命中时跳转前展示风险提示并加入审核队列
+	variants, err := l.svcCtx.RepoManager.Variant.FindByFullShortUrl(l.ctx, link.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询A/B分流版本失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询A/B分流版本失败")
+	}
+	rules, err := l.svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(l.ctx, link.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询跳转规则失败")
+	}
+	safety := checkUrlSafety(l.ctx, l.svcCtx, linkTargetUrls(link, variants, rules, findGroupExpiryPolicy(l.ctx, l.svcCtx, link.Gid)))
+	if safety.Flagged {
+		if link.SafetyStatus == urlsafety.SafetyStatusNormal {
+			link.SafetyStatus = urlsafety.SafetyStatusWarning
+		}
+		link.SafetyReason = safety.Reason
+	}
+
+	link.UpdateTime = time.Now()
+	if err := l.svcCtx.RepoManager.Link.Update(l.ctx, link); err != nil {
+		l.Logger.Errorf("回滚短链接失败: %v", err)
+		return nil, status.Error(codes.Internal, "回滚短链接失败")
+	}
+	if safety.Flagged {
+		submitModeration(l.ctx, l.svcCtx, link, safety)
+	}
+
+	// 重置健康检测结果，等待下一轮重新检测
+	if originChanged {
+		resetLinkHealth(link)
+		if err := l.svcCtx.RepoManager.Link.UpdateHealth(l.ctx, link); err != nil {
+			l.Logger.Errorf("重置健康检测结果失败: %v", err)
+		}
+	}
+
+	deleteGotoCache(l.ctx, l.svcCtx, link.FullShortUrl)
+	resetRemainingClicks(l.ctx, l.svcCtx, link)
+	l.Logger.Infof("短链接已回滚: %s, 变更记录: %d", link.FullShortUrl, history.ID)
+
+	resp := &pb.RollbackShortLinkResponse{}
+	if record := recordLinkHistory(l.ctx, l.svcCtx, LinkHistoryRollback, before, link); record != nil {
+		resp.History = toLinkHistoryRecord(record)
+	}
+	return resp, nil
+}
+
+// applyLinkSnapshot 将变更记录中的属性写回短链接，不修改启用状态
+func applyLinkSnapshot(link *model.Link, snapshot *linkhistory.Snapshot) error {
+	// 与修改短链接一致，永久有效时有效期设置为10年后
+	validDate := time.Now().AddDate(10, 0, 0)
+	if snapshot.ValidDateType == util.ValidDateTypeCustom {
+		t, err := time.Parse(time.RFC3339, snapshot.ValidDate)
+		if err != nil {
+			return err
+		}
+		validDate = t
+	}
+	var validFrom *time.Time
+	if snapshot.ValidFrom != "" {
+		t, err := time.Parse(time.RFC3339, snapshot.ValidFrom)
+		if err != nil {
+			return err
+		}
+		validFrom = &t
+	}
+
+	link.OriginUrl = snapshot.OriginUrl
+	link.Describe = snapshot.Describe
+	link.ValidDateType = snapshot.ValidDateType
+	link.ValidDate = validDate
+	link.ValidFrom = validFrom
+	link.Password = snapshot.Password
+	link.MaxClicks = snapshot.MaxClicks
+	link.QueryParamPolicy = snapshot.QueryParamPolicy
+	link.UtmSource = snapshot.UtmSource
+	link.UtmMedium = snapshot.UtmMedium
+	link.UtmCampaign = snapshot.UtmCampaign
+	link.ExpiredUrl = snapshot.ExpiredUrl
+	link.ExpiredMessage = snapshot.ExpiredMessage
+	link.GraceDays = snapshot.GraceDays
+	link.RedirectType = snapshot.RedirectType
+	link.IosDeepLink = snapshot.IosDeepLink
+	link.AndroidPackage = snapshot.AndroidPackage
+	link.AndroidDeepLink = snapshot.AndroidDeepLink
+	link.DeepLinkFallbackUrl = snapshot.DeepLinkFallbackUrl
+	link.OgTitle = snapshot.OgTitle
+	link.OgDescription = snapshot.OgDescription
+	link.OgImage = snapshot.OgImage
+	return nil
+}
@@ -0,0 +1,311 @@
+package logic
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 搜索条件取值
+const (
+	SearchStatusEnabled   = "enabled"
+	SearchStatusDisabled  = "disabled"
+	SearchExpiryValid     = "valid"
+	SearchExpiryExpired   = "expired"
+	SearchOrderCreateTime = "create_time"
+	SearchOrderTotalPv    = "total_pv"
+	SearchOrderTodayPv    = "today_pv"
+)
+
+const (
+	// 搜索结果最多可翻页到的条数，各分组按排序取前N条后合并
+	SearchMaxResults = 1000
+	// 按今日访问量排序时最多参与排序的短链接数
+	SearchMaxTodayPvCandidates = 5000
+	// 最多搜索的分组数
+	SearchMaxGroups = 100
+	// 关键字最大长度
+	SearchKeywordMaxLength = 100
+)
+
+type ShortLinkSearchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShortLinkSearchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkSearchLogic {
+	return &ShortLinkSearchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// searchGroup 单个分组的搜索条件和匹配数量
+type searchGroup struct {
+	gid    string
+	filter repo.LinkFilter
+	total  int64
+}
+
+// 在当前用户的分组中搜索短链接，包括未启用的短链接
+// 短链接表按分组分片，每个分组在各自分片内按索引排序取前N条，再在内存中合并排序后分页
+// 今日访问量保存在统计表中，按今日访问量排序时先取出全部匹配的短链接，再查询当日统计排序
+func (l *ShortLinkSearchLogic) ShortLinkSearch(in *pb.SearchShortLinkRequest) (*pb.SearchShortLinkResponse, error) {
+	// 设置默认分页参数
+	page := int(in.Current)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(in.Size)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+	if offset+pageSize > SearchMaxResults {
+		return nil, status.Errorf(codes.InvalidArgument, "最多只能查看前%d条搜索结果，请缩小搜索范围", SearchMaxResults)
+	}
+
+	orderBy := in.OrderBy
+	if orderBy == "" {
+		orderBy = SearchOrderCreateTime
+	}
+	if orderBy != SearchOrderCreateTime && orderBy != SearchOrderTotalPv && orderBy != SearchOrderTodayPv {
+		return nil, status.Error(codes.InvalidArgument, "不支持的排序字段")
+	}
+
+	filter, err := buildSearchFilter(in)
+	if err != nil {
+		return nil, err
+	}
+	gids, err := l.resolveGids(in.Gids)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按分组统计匹配数量，跳过没有匹配的分组
+	var groups []*searchGroup
+	var total int64
+	tag := strings.TrimSpace(in.Tag)
+	for _, gid := range gids {
+		group := &searchGroup{gid: gid, filter: filter}
+		if tag != "" {
+			fullShortUrls, err := l.svcCtx.RepoManager.Tag.FindFullShortUrlsByGidAndTag(l.ctx, gid, tag)
+			if err != nil {
+				l.Logger.Errorf("查询标签短链接失败: %v", err)
+				return nil, status.Error(codes.Internal, "查询标签短链接失败")
+			}
+			if len(fullShortUrls) == 0 {
+				continue
+			}
+			group.filter.FullShortUrls = fullShortUrls
+		}
+		group.total, err = l.svcCtx.RepoManager.Link.CountByGidAndFilter(l.ctx, gid, group.filter)
+		if err != nil {
+			l.Logger.Errorf("统计短链接数量失败: %v", err)
+			return nil, status.Error(codes.Internal, "统计短链接数量失败")
+		}
+		if group.total > 0 {
+			groups = append(groups, group)
+			total += group.total
+		}
+	}
+
+	resp := &pb.SearchShortLinkResponse{
+		Total:   int32(total),
+		Size:    int32(pageSize),
+		Current: int32(page),
+		Records: []*pb.ShortLinkRecord{},
+	}
+	if int64(offset) >= total {
+		return resp, nil
+	}
+
+	var links []*model.Link
+	if orderBy == SearchOrderTodayPv {
+		if total > SearchMaxTodayPvCandidates {
+			return nil, status.Errorf(codes.FailedPrecondition, "匹配的短链接超过%d个，请缩小搜索范围后再按今日访问量排序", SearchMaxTodayPvCandidates)
+		}
+		links, err = l.searchByTodayPv(groups, in.Asc, offset, pageSize)
+	} else {
+		links, err = l.searchByColumn(groups, repo.LinkOrder{Field: orderBy, Asc: in.Asc}, offset, pageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Records = buildShortLinkRecords(l.ctx, l.svcCtx, links)
+	return resp, nil
+}
+
+// buildSearchFilter 根据请求构建各分组通用的筛选条件
+func buildSearchFilter(in *pb.SearchShortLinkRequest) (repo.LinkFilter, error) {
+	var filter repo.LinkFilter
+	filter.Keyword = strings.TrimSpace(in.Keyword)
+	if utf8.RuneCountInString(filter.Keyword) > SearchKeywordMaxLength {
+		return filter, status.Errorf(codes.InvalidArgument, "关键字不能超过%d个字符", SearchKeywordMaxLength)
+	}
+
+	switch in.Status {
+	case "":
+	case SearchStatusEnabled:
+		enableStatus := 0
+		filter.EnableStatus = &enableStatus
+	case SearchStatusDisabled:
+		enableStatus := 1
+		filter.EnableStatus = &enableStatus
+	default:
+		return filter, status.Error(codes.InvalidArgument, "不支持的启用状态")
+	}
+
+	now := time.Now()
+	switch in.Expiry {
+	case "":
+	case SearchExpiryValid:
+		filter.ValidDateAfter = &now
+	case SearchExpiryExpired:
+		filter.ValidDateUntil = &now
+	default:
+		return filter, status.Error(codes.InvalidArgument, "不支持的有效期状态")
+	}
+
+	if in.CreateStart != "" {
+		t, err := time.Parse(time.RFC3339, in.CreateStart)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "创建时间起始格式错误，请使用ISO-8601格式")
+		}
+		filter.CreateStart = &t
+	}
+	if in.CreateEnd != "" {
+		t, err := time.Parse(time.RFC3339, in.CreateEnd)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "创建时间截止格式错误，请使用ISO-8601格式")
+		}
+		filter.CreateEnd = &t
+	}
+	if filter.CreateStart != nil && filter.CreateEnd != nil && filter.CreateEnd.Before(*filter.CreateStart) {
+		return filter, status.Error(codes.InvalidArgument, "创建时间截止不能早于起始时间")
+	}
+	return filter, nil
+}
+
+// resolveGids 校验指定的分组属于当前用户，未指定时返回当前用户的全部分组
+func (l *ShortLinkSearchLogic) resolveGids(gids []string) ([]string, error) {
+	gids = normalizeGids(gids)
+	if len(gids) > SearchMaxGroups {
+		return nil, status.Errorf(codes.InvalidArgument, "最多同时搜索%d个分组", SearchMaxGroups)
+	}
+	if len(gids) > 0 {
+		for _, gid := range gids {
+			if err := checkGroupOwner(l.ctx, l.svcCtx, gid); err != nil {
+				return nil, err
+			}
+		}
+		return gids, nil
+	}
+
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	groups, _, err := l.svcCtx.RepoManager.Group.FindByUsername(l.ctx, username, 1, SearchMaxGroups)
+	if err != nil {
+		l.Logger.Errorf("查询用户分组失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询用户分组失败")
+	}
+	for _, group := range groups {
+		gids = append(gids, group.Gid)
+	}
+	return gids, nil
+}
+
+// searchByColumn 按短链接表中的字段排序，每个分组取前offset+size条后合并
+func (l *ShortLinkSearchLogic) searchByColumn(groups []*searchGroup, order repo.LinkOrder, offset, size int) ([]*model.Link, error) {
+	var merged []*model.Link
+	for _, group := range groups {
+		links, err := l.svcCtx.RepoManager.Link.SearchByGid(l.ctx, group.gid, group.filter, order, offset+size)
+		if err != nil {
+			l.Logger.Errorf("搜索短链接失败: %v", err)
+			return nil, status.Error(codes.Internal, "搜索短链接失败")
+		}
+		merged = append(merged, links...)
+	}
+
+	// 与分片内的排序保持一致，不同分片的ID可能相同，最后按完整短链接排序保证顺序稳定
+	sort.Slice(merged, func(i, j int) bool {
+		a, b := merged[i], merged[j]
+		if order.Field == repo.LinkOrderTotalPv && a.TotalPv != b.TotalPv {
+			return (a.TotalPv < b.TotalPv) == order.Asc
+		}
+		if order.Field != repo.LinkOrderTotalPv && !a.CreateTime.Equal(b.CreateTime) {
+			return a.CreateTime.Before(b.CreateTime) == order.Asc
+		}
+		if a.ID != b.ID {
+			return (a.ID < b.ID) == order.Asc
+		}
+		return (a.FullShortUrl < b.FullShortUrl) == order.Asc
+	})
+	return pageLinks(merged, offset, size), nil
+}
+
+// searchByTodayPv 取出全部匹配的短链接，按当日统计排序后只查询当前页的短链接详情
+func (l *ShortLinkSearchLogic) searchByTodayPv(groups []*searchGroup, asc bool, offset, size int) ([]*model.Link, error) {
+	var candidates []*model.Link
+	for _, group := range groups {
+		links, err := l.svcCtx.RepoManager.Link.FindBriefByGidAndFilter(l.ctx, group.gid, group.filter, SearchMaxTodayPvCandidates)
+		if err != nil {
+			l.Logger.Errorf("搜索短链接失败: %v", err)
+			return nil, status.Error(codes.Internal, "搜索短链接失败")
+		}
+		candidates = append(candidates, links...)
+	}
+
+	fullShortUrls := make([]string, 0, len(candidates))
+	for _, link := range candidates {
+		fullShortUrls = append(fullShortUrls, link.FullShortUrl)
+	}
+	stats, err := l.svcCtx.RepoManager.StatsToday.FindByFullShortUrls(l.ctx, fullShortUrls, time.Now())
+	if err != nil {
+		l.Logger.Errorf("查询今日统计失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询今日统计失败")
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if pa, pb := todayMetric(stats[a.FullShortUrl], PageOrderTodayPv), todayMetric(stats[b.FullShortUrl], PageOrderTodayPv); pa != pb {
+			return (pa < pb) == asc
+		}
+		if a.ID != b.ID {
+			return (a.ID < b.ID) == asc
+		}
+		return (a.FullShortUrl < b.FullShortUrl) == asc
+	})
+	links, err := loadLinksInOrder(l.ctx, l.svcCtx, pageLinks(candidates, offset, size))
+	if err != nil {
+		l.Logger.Errorf("查询短链接详情失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询短链接详情失败")
+	}
+	return links, nil
+}
+
+// pageLinks 截取当前页的短链接
+func pageLinks(links []*model.Link, offset, size int) []*model.Link {
+	if offset >= len(links) {
+		return nil
+	}
+	end := offset + size
+	if end > len(links) {
+		end = len(links)
+	}
+	return links[offset:end]
+}
@@ -0,0 +1,137 @@
+package logic_test
+
+import (
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestShortLinkSearch_InvalidParams 测试搜索参数校验
+func TestShortLinkSearch_InvalidParams(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-search-user"))
+	l := logic.NewShortLinkSearchLogic(userCtx, svcCtx)
+
+	cases := map[string]struct {
+		req  *pb.SearchShortLinkRequest
+		code codes.Code
+	}{
+		"排序字段不支持": {
+			req:  &pb.SearchShortLinkRequest{OrderBy: "origin_url"},
+			code: codes.InvalidArgument,
+		},
+		"启用状态不支持": {
+			req:  &pb.SearchShortLinkRequest{Status: "deleted"},
+			code: codes.InvalidArgument,
+		},
+		"关键字过长": {
+			req:  &pb.SearchShortLinkRequest{Keyword: strings.Repeat("搜", logic.SearchKeywordMaxLength+1)},
+			code: codes.InvalidArgument,
+		},
+		"创建时间截止早于起始": {
+			req:  &pb.SearchShortLinkRequest{CreateStart: "2024-02-01T00:00:00Z", CreateEnd: "2024-01-01T00:00:00Z"},
+			code: codes.InvalidArgument,
+		},
+		"超过最大翻页深度": {
+			req:  &pb.SearchShortLinkRequest{Current: int32(logic.SearchMaxResults/10 + 1), Size: 10},
+			code: codes.InvalidArgument,
+		},
+		"分组不属于当前用户": {
+			req:  &pb.SearchShortLinkRequest{Gids: []string{"test-search-not-exist"}},
+			code: codes.NotFound,
+		},
+	}
+	for name, c := range cases {
+		_, err := l.ShortLinkSearch(c.req)
+		if status.Code(err) != c.code {
+			t.Errorf("%s: 期望 %v，实际: %v", name, c.code, err)
+		}
+	}
+}
+
+// TestShortLinkSearch_KeywordAndTag 测试按关键字和标签搜索当前用户的短链接
+func TestShortLinkSearch_KeywordAndTag(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-search-user"
+	gid := "test-search"
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	if err := svcCtx.RepoManager.Group.Create(ctx, &model.Group{
+		Gid:        gid,
+		Name:       "搜索测试分组",
+		Username:   username,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}); err != nil {
+		t.Fatalf("创建分组失败: %v", err)
+	}
+
+	links := []*model.Link{
+		{ShortUri: "search1", Describe: "春季促销活动", TotalPv: 5},
+		{ShortUri: "search2", Describe: "秋季新品", TotalPv: 20},
+	}
+	for _, link := range links {
+		link.Domain = "test.example.com"
+		link.FullShortUrl = "test.example.com/" + link.ShortUri
+		link.OriginUrl = "https://github.com/zeromicro/go-zero"
+		link.Gid = gid
+		link.CreateTime = time.Now()
+		link.UpdateTime = time.Now()
+		link.ValidDate = time.Now().AddDate(10, 0, 0)
+		cleanSpecificTestData(t, svcCtx, ctx, link.FullShortUrl, gid)
+		if err := svcCtx.RepoManager.Link.Create(ctx, link); err != nil {
+			t.Fatalf("创建测试链接失败: %v", err)
+		}
+	}
+	if err := svcCtx.RepoManager.Tag.ReplaceByFullShortUrl(ctx, gid, links[1].FullShortUrl, []string{"新品"}); err != nil {
+		t.Fatalf("保存标签失败: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, link := range links {
+			cleanSpecificTestData(t, svcCtx, ctx, link.FullShortUrl, gid)
+			if err := svcCtx.RepoManager.Tag.ReplaceByFullShortUrl(ctx, gid, link.FullShortUrl, nil); err != nil {
+				t.Logf("清理标签失败: %v", err)
+			}
+		}
+		if err := svcCtx.RepoManager.Group.DeleteByGidAndUsername(ctx, gid, username); err != nil {
+			t.Logf("清理分组失败: %v", err)
+		}
+	})
+
+	l := logic.NewShortLinkSearchLogic(userCtx, svcCtx)
+
+	// 关键字匹配描述
+	resp, err := l.ShortLinkSearch(&pb.SearchShortLinkRequest{Keyword: "促销", Gids: []string{gid}})
+	if err != nil {
+		t.Fatalf("搜索失败: %v", err)
+	}
+	if resp.Total != 1 || len(resp.Records) != 1 || resp.Records[0].FullShortUrl != links[0].FullShortUrl {
+		t.Errorf("关键字搜索结果不符合预期: %+v", resp)
+	}
+
+	// 标签筛选并返回标签
+	resp, err = l.ShortLinkSearch(&pb.SearchShortLinkRequest{Tag: "新品"})
+	if err != nil {
+		t.Fatalf("搜索失败: %v", err)
+	}
+	if resp.Total != 1 || len(resp.Records) != 1 || len(resp.Records[0].Tags) != 1 || resp.Records[0].Tags[0] != "新品" {
+		t.Errorf("标签搜索结果不符合预期: %+v", resp)
+	}
+
+	// 按总访问量降序
+	resp, err = l.ShortLinkSearch(&pb.SearchShortLinkRequest{Keyword: "search", Gids: []string{gid}, OrderBy: logic.SearchOrderTotalPv})
+	if err != nil {
+		t.Fatalf("搜索失败: %v", err)
+	}
+	if len(resp.Records) != 2 || resp.Records[0].FullShortUrl != links[1].FullShortUrl {
+		t.Errorf("按总访问量排序结果不符合预期: %+v", resp)
+	}
+}
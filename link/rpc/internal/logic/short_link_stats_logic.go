@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"fmt"
+	"shorterurl/link/rpc/internal/cachekey"
 	"shorterurl/link/rpc/internal/consumer"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
@@ -59,7 +60,7 @@ func (l *ShortLinkStatsLogic) ShortLinkStats(in *pb.ShortLinkStatsRequest) (*pb.
 
 // 检查是否是新的 UV
 func (l *ShortLinkStatsLogic) checkFirstUv(fullShortUrl, user string) bool {
-	key := fmt.Sprintf("short-link:stats:uv:%s", fullShortUrl)
+	key := fmt.Sprintf(cachekey.ShortLinkStatsUvKey, fullShortUrl)
 	added, err := l.svcCtx.BizRedis.Sadd(key, user)
 	if err != nil {
 		l.Logger.Errorf("检查UV失败: %v", err)
@@ -75,7 +76,7 @@ func (l *ShortLinkStatsLogic) checkFirstUip(fullShortUrl, ip string) bool {
 	if ip == "" {
 		return false
 	}
-	key := fmt.Sprintf("short-link:stats:uip:%s", fullShortUrl)
+	key := fmt.Sprintf(cachekey.ShortLinkStatsUipKey, fullShortUrl)
 	added, err := l.svcCtx.BizRedis.Sadd(key, ip)
 	if err != nil {
 		l.Logger.Errorf("检查UIP失败: %v", err)
@@ -3,9 +3,12 @@ package logic
 import (
 	"context"
 	"fmt"
+	"shorterurl/link/rpc/internal/linkhistory"
 	"shorterurl/link/rpc/internal/model"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/linkhealth"
+	"shorterurl/link/rpc/pkg/urlsafety"
 	"shorterurl/link/rpc/pkg/util"
 	"strings"
 	"time"
@@ -43,9 +46,11 @@ func (l *ShortLinkUpdateLogic) ShortLinkUpdate(in *pb.UpdateShortLinkRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "分组标识不能为空")
 	}
 
-	// 验证白名单
-	if err := l.verificationWhitelist(in.OriginUrl); err != nil {
-		return nil, err
+	// 验证A/B分流版本的白名单，原始链接与其他属性一起校验
+	for _, variant := range in.Variants {
+		if err := verifyTargetWhitelist(l.svcCtx, variant.TargetUrl); err != nil {
+			return nil, err
+		}
 	}
 
 	l.Logger.Infof("处理短链接更新请求，原始短链接: %s", in.FullShortUrl)
@@ -122,17 +127,104 @@ func (l *ShortLinkUpdateLogic) ShortLinkUpdate(in *pb.UpdateShortLinkRequest) (*
 		validDate = time.Now().AddDate(10, 0, 0)
 	}
 
+	// 解析生效时间，未传入时沿用原值并按新的有效期重新校验
+	validFrom, err := parseValidFrom(optionalString(in.ValidFrom, formatOptionalTime(link.ValidFrom)), validDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 过期处理、跳转类型、深度链接和社交分享预览配置，未传入时沿用原值，与其他属性一起校验
+	expiredUrl := strings.TrimSpace(optionalString(in.ExpiredUrl, link.ExpiredUrl))
+	expiredMessage := strings.TrimSpace(optionalString(in.ExpiredMessage, link.ExpiredMessage))
+	graceDays := optionalInt(in.GraceDays, link.GraceDays)
+	redirectType := optionalInt(in.RedirectType, link.RedirectType)
+	deepLink := util.DeepLink{
+		IosUrl:         strings.TrimSpace(optionalString(in.IosDeepLink, link.IosDeepLink)),
+		AndroidPackage: strings.TrimSpace(optionalString(in.AndroidPackage, link.AndroidPackage)),
+		AndroidUrl:     strings.TrimSpace(optionalString(in.AndroidDeepLink, link.AndroidDeepLink)),
+		FallbackUrl:    strings.TrimSpace(optionalString(in.DeepLinkFallbackUrl, link.DeepLinkFallbackUrl)),
+	}
+	ogTitle := strings.TrimSpace(optionalString(in.OgTitle, link.OgTitle))
+	ogDescription := strings.TrimSpace(optionalString(in.OgDescription, link.OgDescription))
+	ogImage := strings.TrimSpace(optionalString(in.OgImage, link.OgImage))
+
 	// 记录原始分组ID，用于判断是否需要更新t_link_goto表
 	oldGid := link.Gid
+	// 目标链接变更后原有的健康检测结果不再有效
+	originChanged := link.OriginUrl != in.OriginUrl
+	// 记录修改前的属性，用于生成变更记录
+	before := linkhistory.NewSnapshot(link)
 
 	// 更新链接信息
 	link.OriginUrl = in.OriginUrl
 	link.Gid = in.Gid
 	link.ValidDateType = int(in.ValidDateType)
+	link.ValidFrom = validFrom
 	link.ValidDate = validDate
+	link.ExpiredUrl = expiredUrl
+	link.ExpiredMessage = expiredMessage
+	link.GraceDays = graceDays
+	link.RedirectType = redirectType
+	link.IosDeepLink = deepLink.IosUrl
+	link.AndroidPackage = deepLink.AndroidPackage
+	link.AndroidDeepLink = deepLink.AndroidUrl
+	link.DeepLinkFallbackUrl = deepLink.FallbackUrl
+	link.OgTitle = ogTitle
+	link.OgDescription = ogDescription
+	link.OgImage = ogImage
 	link.Describe = in.Describe
 	link.UpdateTime = time.Now()
 
+	// 更新访问密码：清除优先，密码为空时保持不变
+	if in.ClearPassword {
+		link.Password = ""
+	} else if in.Password != "" {
+		passwordHash, err := hashLinkPassword(in.Password)
+		if err != nil {
+			return nil, err
+		}
+		link.Password = passwordHash
+	}
+
+	oldMaxClicks := link.MaxClicks
+	// 更新最大访问次数、查询参数策略和UTM模板
+	link.MaxClicks = optionalInt(in.MaxClicks, link.MaxClicks)
+	link.QueryParamPolicy = optionalInt(in.QueryParamPolicy, link.QueryParamPolicy)
+	link.UtmSource = optionalString(in.UtmSource, link.UtmSource)
+	link.UtmMedium = optionalString(in.UtmMedium, link.UtmMedium)
+	link.UtmCampaign = optionalString(in.UtmCampaign, link.UtmCampaign)
+
+	// 校验修改后的短链接属性
+	if err := validateLinkSettings(l.svcCtx, link); err != nil {
+		return nil, err
+	}
+
+	// 校验A/B分流版本
+	variants, err := buildLinkVariants(in.Gid, fullShortUrl, in.Variants)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验标签
+	tags, err := normalizeTags(in.Tags)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// 目标链接安全检测，命中时跳转前展示风险提示并加入审核队列，已禁用的短链接仅更新原因
+	rules, err := l.svcCtx.RepoManager.RedirectRule.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询跳转规则失败")
+	}
+	safety := checkUrlSafety(l.ctx, l.svcCtx, linkTargetUrls(link, variants, rules, findGroupExpiryPolicy(l.ctx, l.svcCtx, link.Gid)))
+	if safety.Flagged {
+		if link.SafetyStatus == urlsafety.SafetyStatusNormal {
+			link.SafetyStatus = urlsafety.SafetyStatusWarning
+		}
+		link.SafetyReason = safety.Reason
+	}
+
 	// 开始事务，使用正确的分片数据库对象
 	tx := l.svcCtx.DBs.LinkDB.WithContext(l.ctx).Begin()
 	defer func() {
@@ -184,43 +276,131 @@ func (l *ShortLinkUpdateLogic) ShortLinkUpdate(in *pb.UpdateShortLinkRequest) (*
 		return nil, status.Error(codes.Internal, "提交事务失败")
 	}
 
-	// 更新Redis缓存
-	cacheKey := fmt.Sprintf("link:goto:%s", fullShortUrl)
-	cacheExpire := util.GetLinkCacheValidTime(validDate)
-	if err := l.svcCtx.BizRedis.SetexCtx(l.ctx, cacheKey, in.OriginUrl, int(cacheExpire/1000)); err != nil {
-		l.Logger.Errorf("更新Redis缓存失败: %v", err)
-		// 继续执行，不影响主流程
+	if safety.Flagged {
+		submitModeration(l.ctx, l.svcCtx, link, safety)
+	}
+
+	// 重置健康检测结果，等待下一轮重新检测
+	if originChanged {
+		resetLinkHealth(link)
+		if err := l.svcCtx.RepoManager.Link.UpdateHealth(l.ctx, link); err != nil {
+			l.Logger.Errorf("重置健康检测结果失败: %v", err)
+		}
+	}
+
+	// 最大访问次数变更时重置剩余访问次数，未变更时保留正在扣减的计数器
+	if link.MaxClicks != oldMaxClicks {
+		resetRemainingClicks(l.ctx, l.svcCtx, link)
+	}
+	// 更新A/B分流版本：清除优先，未传入版本时保持不变
+	if in.ClearVariants || len(variants) > 0 {
+		if in.ClearVariants {
+			variants = nil
+		}
+		if err := l.svcCtx.RepoManager.Variant.ReplaceByFullShortUrl(l.ctx, fullShortUrl, variants); err != nil {
+			l.Logger.Errorf("更新A/B分流版本失败: %v", err)
+			return nil, status.Error(codes.Internal, "更新A/B分流版本失败")
+		}
+	}
+
+	// 更新标签：清除优先，未传入标签时保持不变，分组变化时同步标签所属分组
+	if in.ClearTags || len(tags) > 0 {
+		if in.ClearTags {
+			tags = nil
+		}
+		if err := l.svcCtx.RepoManager.Tag.ReplaceByFullShortUrl(l.ctx, in.Gid, fullShortUrl, tags); err != nil {
+			l.Logger.Errorf("更新短链接标签失败: %v", err)
+			return nil, status.Error(codes.Internal, "更新短链接标签失败")
+		}
+	} else if oldGid != in.Gid {
+		if err := l.svcCtx.RepoManager.Tag.UpdateGid(l.ctx, fullShortUrl, in.Gid); err != nil {
+			l.Logger.Errorf("更新短链接标签分组失败: %v", err)
+		}
+	}
+
+	recordLinkHistory(l.ctx, l.svcCtx, LinkHistoryUpdate, before, link)
+
+	// 删除跳转缓存及空值缓存，使密码、有效期等变更立即生效
+	if _, err := l.svcCtx.BizRedis.DelCtx(l.ctx,
+		fmt.Sprintf(ShortLinkGotoKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkIsNullGotoKey, fullShortUrl),
+		fmt.Sprintf(ShortLinkPreviewKey, fullShortUrl)); err != nil {
+		l.Logger.Errorf("删除跳转缓存失败: %v", err)
 	}
 
 	return &pb.UpdateShortLinkResponse{}, nil
 }
 
-// 验证白名单
-func (l *ShortLinkUpdateLogic) verificationWhitelist(originUrl string) error {
-	// 检查白名单是否启用
-	if !l.svcCtx.Config.GotoDomainWhiteList.Enable {
-		return nil
+// validateLinkSettings 校验短链接的可修改属性，修改和回滚短链接使用相同的校验
+// 包括原始链接白名单、生效时间与有效期、过期处理、跳转类型、深度链接、社交分享预览、访问次数和查询参数配置
+func validateLinkSettings(svcCtx *svc.ServiceContext, link *model.Link) error {
+	if link.OriginUrl == "" {
+		return status.Error(codes.InvalidArgument, "原始链接不能为空")
+	}
+	if err := verifyTargetWhitelist(svcCtx, link.OriginUrl); err != nil {
+		return err
+	}
+	if link.ValidFrom != nil && !link.ValidDate.IsZero() && !link.ValidFrom.Before(link.ValidDate) {
+		return status.Error(codes.InvalidArgument, "生效时间必须早于有效期")
+	}
+	if err := validateExpirySettings(svcCtx, link.ExpiredUrl, link.ExpiredMessage, link.GraceDays); err != nil {
+		return err
+	}
+	if !util.IsValidRedirectType(link.RedirectType) {
+		return status.Error(codes.InvalidArgument, "不支持的跳转类型")
+	}
+	if err := validateDeepLinkSettings(util.DeepLink{
+		IosUrl:         link.IosDeepLink,
+		AndroidPackage: link.AndroidPackage,
+		AndroidUrl:     link.AndroidDeepLink,
+		FallbackUrl:    link.DeepLinkFallbackUrl,
+	}); err != nil {
+		return err
 	}
+	if err := validatePreviewSettings(link.OgTitle, link.OgDescription, link.OgImage); err != nil {
+		return err
+	}
+	if link.MaxClicks < 0 {
+		return status.Error(codes.InvalidArgument, "最大访问次数不能为负数")
+	}
+	return validateQueryParamSettings(link.QueryParamPolicy, util.UtmTemplate{
+		Source:   link.UtmSource,
+		Medium:   link.UtmMedium,
+		Campaign: link.UtmCampaign,
+	})
+}
 
-	// 提取域名
-	domain := util.ExtractDomain(originUrl)
-	if domain == "" {
-		return status.Error(codes.InvalidArgument, "跳转链接填写错误")
+// optionalString 返回传入的字段值，未传入时返回原值
+func optionalString(value *string, current string) string {
+	if value == nil {
+		return current
 	}
+	return *value
+}
 
-	// 检查域名是否在白名单中
-	details := l.svcCtx.Config.GotoDomainWhiteList.Details
-	if len(details) == 0 {
-		return nil
+// optionalInt 返回传入的字段值，未传入时返回原值
+func optionalInt(value *int32, current int) int {
+	if value == nil {
+		return current
 	}
+	return int(*value)
+}
 
-	for _, whiteDomain := range details {
-		if whiteDomain == domain {
-			return nil
-		}
+// formatOptionalTime 格式化可为空的时间，为空时返回空字符串
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.Format(time.RFC3339)
+}
 
-	// 如果不在白名单中，返回错误
-	errMsg := fmt.Sprintf("演示环境为避免恶意攻击，请生成以下网站跳转链接：%s", l.svcCtx.Config.GotoDomainWhiteList.Names)
-	return status.Error(codes.PermissionDenied, errMsg)
+// resetLinkHealth 清空目标链接健康检测结果
+func resetLinkHealth(link *model.Link) {
+	link.HealthStatus = linkhealth.HealthStatusUnknown
+	link.HealthCode = 0
+	link.HealthLatency = 0
+	link.HealthRedirects = ""
+	link.HealthError = ""
+	link.HealthFailCount = 0
+	link.HealthCheckTime = nil
 }
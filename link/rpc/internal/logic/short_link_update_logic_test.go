@@ -1,6 +1,7 @@
 package logic_test
 
 import (
+	"fmt"
 	"shorterurl/link/rpc/internal/logic"
 	"shorterurl/link/rpc/pb"
 	"strings"
@@ -264,3 +265,81 @@ func TestShortLinkUpdate_WithCustomValidDate(t *testing.T) {
 
 	t.Logf("更新短链接有效期成功: %s, 到期时间: %s", fullShortUrl, validDateStr)
 }
+
+// TestShortLinkUpdate_KeepOmittedFields 测试未传入的字段保持不变，传入空值时清除，最大访问次数未变更时不重置剩余次数
+func TestShortLinkUpdate_KeepOmittedFields(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	testGid := "test-update-keep-group"
+
+	createResp, err := logic.NewShortLinkCreateLogic(ctx, svcCtx).ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl:      "https://github.com/zeromicro/go-zero",
+		Gid:            testGid,
+		Describe:       "测试保留字段的短链接",
+		MaxClicks:      10,
+		RedirectType:   1,
+		UtmSource:      "newsletter",
+		ExpiredMessage: "活动已结束",
+		OgTitle:        "预览标题",
+	})
+	if err != nil {
+		t.Fatalf("创建短链接失败: %v", err)
+	}
+	fullShortUrl := strings.TrimPrefix(createResp.FullShortUrl, "http://")
+	t.Cleanup(func() {
+		cleanSpecificTestData(t, svcCtx, ctx, fullShortUrl, testGid)
+	})
+
+	// 模拟已扣减的剩余访问次数
+	remainingKey := fmt.Sprintf(logic.ShortLinkClicksRemainingKey, fullShortUrl)
+	if err := svcCtx.BizRedis.SetexCtx(ctx, remainingKey, "3", 3600); err != nil {
+		t.Fatalf("设置剩余访问次数失败: %v", err)
+	}
+
+	// 只修改描述，其他字段保持不变
+	updateLogic := logic.NewShortLinkUpdateLogic(ctx, svcCtx)
+	if _, err := updateLogic.ShortLinkUpdate(&pb.UpdateShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		OriginUrl:    "https://github.com/zeromicro/go-zero",
+		Gid:          testGid,
+		Describe:     "只修改描述",
+	}); err != nil {
+		t.Fatalf("更新短链接失败: %v", err)
+	}
+
+	link, err := svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, testGid)
+	if err != nil {
+		t.Fatalf("查询短链接失败: %v", err)
+	}
+	if link.MaxClicks != 10 || link.RedirectType != 1 || link.UtmSource != "newsletter" ||
+		link.ExpiredMessage != "活动已结束" || link.OgTitle != "预览标题" {
+		t.Errorf("未传入的字段被覆盖: %+v", link)
+	}
+	if remaining, _ := svcCtx.BizRedis.GetCtx(ctx, remainingKey); remaining != "3" {
+		t.Errorf("最大访问次数未变更时不应重置剩余次数，实际: %s", remaining)
+	}
+
+	// 传入空值和0时清除配置
+	empty, zero := "", int32(0)
+	if _, err := updateLogic.ShortLinkUpdate(&pb.UpdateShortLinkRequest{
+		FullShortUrl: fullShortUrl,
+		OriginUrl:    "https://github.com/zeromicro/go-zero",
+		Gid:          testGid,
+		Describe:     "清除配置",
+		MaxClicks:    &zero,
+		RedirectType: &zero,
+		OgTitle:      &empty,
+	}); err != nil {
+		t.Fatalf("更新短链接失败: %v", err)
+	}
+
+	link, err = svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(ctx, fullShortUrl, testGid)
+	if err != nil {
+		t.Fatalf("查询短链接失败: %v", err)
+	}
+	if link.MaxClicks != 0 || link.RedirectType != 0 || link.OgTitle != "" || link.UtmSource != "newsletter" {
+		t.Errorf("传入的字段未按预期更新: %+v", link)
+	}
+	if exists, _ := svcCtx.BizRedis.ExistsCtx(ctx, remainingKey); exists {
+		t.Error("取消访问次数限制后应删除剩余次数计数器")
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"strconv"
 	"time"
 
+	"shorterurl/link/rpc/internal/repo"
 	"shorterurl/link/rpc/internal/svc"
 	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -441,6 +443,27 @@ func (l *StatsGetSingleLogic) StatsGetSingle(in *pb.GetSingleStatsRequest) (*pb.
 		})
 	}
 
+	// 12. 获取A/B分流各版本访问详情
+	variantStatsResult, err := l.variantStats(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 13. 获取访问渠道详情
+	channelStats, err := l.svcCtx.RepoManager.LinkAccessLogs.ListChannelStatsByShortLink(l.ctx, in.FullShortUrl, in.StartDate, in.EndDate, util.ChannelDirect)
+	if err != nil {
+		l.Logger.Errorf("获取短链接访问渠道详情失败: %v", err)
+		return nil, status.Error(codes.Internal, "获取短链接访问渠道详情失败")
+	}
+	channelStatsResult := make([]*pb.ChannelStat, 0, len(channelStats))
+	for _, stat := range channelStats {
+		channelStatsResult = append(channelStatsResult, &pb.ChannelStat{
+			Channel: stat.Channel,
+			Pv:      int32(stat.Pv),
+			Uv:      int32(stat.Uv),
+		})
+	}
+
 	// 构建并返回结果
 	return &pb.GetSingleStatsResponse{
 		Pv:            pvUvUip.Pv,
@@ -456,9 +479,57 @@ func (l *StatsGetSingleLogic) StatsGetSingle(in *pb.GetSingleStatsRequest) (*pb.
 		UvTypeStats:   uvTypeStatsResult,
 		DeviceStats:   deviceStatsResult,
 		NetworkStats:  networkStatsResult,
+		VariantStats:  variantStatsResult,
+		ChannelStats:  channelStatsResult,
 	}, nil
 }
 
+// variantStats 获取A/B分流各版本的PV/UV，当前配置的版本即使没有访问也会返回，已删除的版本仍保留历史数据
+func (l *StatsGetSingleLogic) variantStats(in *pb.GetSingleStatsRequest) ([]*pb.VariantStat, error) {
+	stats, err := l.svcCtx.RepoManager.LinkAccessLogs.ListVariantStatsByShortLink(l.ctx, in.FullShortUrl, in.StartDate, in.EndDate)
+	if err != nil {
+		l.Logger.Errorf("获取短链接A/B分流访问详情失败: %v", err)
+		return nil, status.Error(codes.Internal, "获取短链接A/B分流访问详情失败")
+	}
+
+	variants, err := l.svcCtx.RepoManager.Variant.FindByFullShortUrl(l.ctx, in.FullShortUrl)
+	if err != nil {
+		l.Logger.Errorf("查询A/B分流版本失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询A/B分流版本失败")
+	}
+
+	statMap := make(map[string]*repo.VariantStatDO, len(stats))
+	for _, stat := range stats {
+		statMap[stat.Variant] = stat
+	}
+
+	result := make([]*pb.VariantStat, 0, len(variants)+len(stats))
+	for _, variant := range variants {
+		item := &pb.VariantStat{
+			Variant:   variant.Name,
+			TargetUrl: variant.TargetUrl,
+			Weight:    int32(variant.Weight),
+		}
+		if stat, ok := statMap[variant.Name]; ok {
+			item.Pv = int32(stat.Pv)
+			item.Uv = int32(stat.Uv)
+			delete(statMap, variant.Name)
+		}
+		result = append(result, item)
+	}
+	for _, stat := range stats {
+		if _, ok := statMap[stat.Variant]; ok {
+			result = append(result, &pb.VariantStat{
+				Variant: stat.Variant,
+				Pv:      int32(stat.Pv),
+				Uv:      int32(stat.Uv),
+			})
+		}
+	}
+
+	return result, nil
+}
+
 // checkGroupBelongToUser 检查分组是否属于当前用户
 func (l *StatsGetSingleLogic) checkGroupBelongToUser(gid string) error {
 	// 获取当前登录用户
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"shorterurl/link/rpc/internal/svc"
@@ -14,6 +15,9 @@ import (
 	"golang.org/x/net/html"
 )
 
+// 解析网页预览信息时最多读取的字节数
+const pageMetaMaxBytes = 1 << 20
+
 type UrlTitleGetLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -35,10 +39,46 @@ func (l *UrlTitleGetLogic) UrlTitleGet(in *pb.GetUrlTitleRequest) (*pb.GetUrlTit
 		return nil, errors.New("URL不能为空")
 	}
 
+	// 最多重试3次
+	resp, err := l.fetchPage(in.Url, 3)
+	if err != nil {
+		l.Logger.Errorf("获取URL内容失败: %v", err)
+		return &pb.GetUrlTitleResponse{
+			Title: "无法获取页面标题",
+		}, nil
+	}
+	defer resp.Body.Close()
+
+	// 解析HTML并提取标题
+	title, err := l.extractTitle(resp.Body)
+	if err != nil {
+		l.Logger.Errorf("解析HTML标题失败: %v", err)
+		return &pb.GetUrlTitleResponse{
+			Title: "无法解析页面标题",
+		}, nil
+	}
+
+	// 清理标题中的多余空白字符
+	title = strings.TrimSpace(title)
+	title = strings.ReplaceAll(title, "\n", "")
+	title = strings.ReplaceAll(title, "\r", "")
+	title = strings.ReplaceAll(title, "\t", "")
+
+	// 如果标题为空，返回默认值
+	if title == "" {
+		title = "未找到页面标题"
+	}
+
+	return &pb.GetUrlTitleResponse{
+		Title: title,
+	}, nil
+}
+
+// fetchPage 获取网页内容，请求失败时最多尝试attempts次，非200状态码返回错误
+func (l *UrlTitleGetLogic) fetchPage(pageUrl string, attempts int) (*http.Response, error) {
 	// 确保URL包含协议
-	url := in.Url
-	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
-		url = "http://" + url
+	if !strings.HasPrefix(pageUrl, "http://") && !strings.HasPrefix(pageUrl, "https://") {
+		pageUrl = "http://" + pageUrl
 	}
 
 	// 创建带超时的HTTP客户端
@@ -47,12 +87,10 @@ func (l *UrlTitleGetLogic) UrlTitleGet(in *pb.GetUrlTitleRequest) (*pb.GetUrlTit
 	}
 
 	// 创建请求
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest("GET", pageUrl, nil)
 	if err != nil {
 		l.Logger.Errorf("创建HTTP请求失败: %v", err)
-		return &pb.GetUrlTitleResponse{
-			Title: "无法获取页面标题",
-		}, nil
+		return nil, err
 	}
 
 	// 设置请求头
@@ -60,56 +98,97 @@ func (l *UrlTitleGetLogic) UrlTitleGet(in *pb.GetUrlTitleRequest) (*pb.GetUrlTit
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 
-	// 最多重试3次
 	var resp *http.Response
-	for i := 0; i < 3; i++ {
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(time.Second) // 等待1秒后重试
+		}
 		resp, err = client.Do(req)
 		if err == nil {
 			break
 		}
 		l.Logger.Errorf("第%d次获取URL内容失败: %v", i+1, err)
-		time.Sleep(time.Second) // 等待1秒后重试
 	}
-
 	if err != nil {
-		l.Logger.Errorf("获取URL内容失败: %v", err)
-		return &pb.GetUrlTitleResponse{
-			Title: "无法获取页面标题",
-		}, nil
+		return nil, err
 	}
-	defer resp.Body.Close()
 
 	// 检查响应状态码
 	if resp.StatusCode != http.StatusOK {
-		l.Logger.Errorf("HTTP请求失败，状态码: %d", resp.StatusCode)
-		return &pb.GetUrlTitleResponse{
-			Title: "无法获取页面标题",
-		}, nil
+		resp.Body.Close()
+		return nil, fmt.Errorf("HTTP请求失败，状态码: %d", resp.StatusCode)
 	}
+	return resp, nil
+}
 
-	// 解析HTML并提取标题
-	title, err := l.extractTitle(resp.Body)
+// pageMeta 网页的社交分享预览信息
+type pageMeta struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// FetchPageMeta 获取网页的预览信息，优先使用Open Graph标签，其次使用title和description
+func (l *UrlTitleGetLogic) FetchPageMeta(pageUrl string) (*pageMeta, error) {
+	resp, err := l.fetchPage(pageUrl, 1)
 	if err != nil {
-		l.Logger.Errorf("解析HTML标题失败: %v", err)
-		return &pb.GetUrlTitleResponse{
-			Title: "无法解析页面标题",
-		}, nil
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// 清理标题中的多余空白字符
-	title = strings.TrimSpace(title)
-	title = strings.ReplaceAll(title, "\n", "")
-	title = strings.ReplaceAll(title, "\r", "")
-	title = strings.ReplaceAll(title, "\t", "")
+	// 只解析网页头部内容，避免读取过大的页面
+	doc, err := html.Parse(io.LimitReader(resp.Body, pageMetaMaxBytes))
+	if err != nil {
+		return nil, err
+	}
 
-	// 如果标题为空，返回默认值
-	if title == "" {
-		title = "未找到页面标题"
+	meta := &pageMeta{}
+	var title, description string
+	var crawler func(*html.Node)
+	crawler = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "title" && n.FirstChild != nil && title == "" {
+			title = n.FirstChild.Data
+		}
+		if n.Type == html.ElementNode && n.Data == "meta" {
+			var key, content string
+			for _, attr := range n.Attr {
+				switch attr.Key {
+				case "property", "name":
+					key = strings.ToLower(attr.Val)
+				case "content":
+					content = strings.TrimSpace(attr.Val)
+				}
+			}
+			switch key {
+			case "og:title":
+				meta.Title = content
+			case "og:description":
+				meta.Description = content
+			case "og:image":
+				meta.Image = content
+			case "description":
+				description = content
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			crawler(c)
+		}
 	}
+	crawler(doc)
 
-	return &pb.GetUrlTitleResponse{
-		Title: title,
-	}, nil
+	if meta.Title == "" {
+		meta.Title = strings.Join(strings.Fields(title), " ")
+	}
+	if meta.Description == "" {
+		meta.Description = description
+	}
+	// 相对路径的图片按最终访问的页面地址补全
+	if meta.Image != "" {
+		if imageUrl, err := resp.Request.URL.Parse(meta.Image); err == nil {
+			meta.Image = imageUrl.String()
+		}
+	}
+	return meta, nil
 }
 
 // extractTitle 从HTML内容中提取标题
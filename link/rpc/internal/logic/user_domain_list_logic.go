@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserDomainListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserDomainListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UserDomainListLogic {
+	return &UserDomainListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询当前用户添加的自定义域名，未验证的域名返回需要配置的TXT记录
+func (l *UserDomainListLogic) UserDomainList(in *pb.ListUserDomainRequest) (*pb.ListUserDomainResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	userDomains, err := l.svcCtx.RepoManager.UserDomain.FindByUsername(l.ctx, username)
+	if err != nil {
+		l.Logger.Errorf("查询自定义域名失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询自定义域名失败")
+	}
+
+	domains := make([]*pb.UserDomain, 0, len(userDomains))
+	for _, userDomain := range userDomains {
+		domains = append(domains, toPbUserDomain(userDomain))
+	}
+	return &pb.ListUserDomainResponse{
+		Domains: domains,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UserDomainRegisterLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserDomainRegisterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UserDomainRegisterLogic {
+	return &UserDomainRegisterLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 添加自定义域名并生成验证令牌，用户在域名DNS中配置TXT记录后调用验证接口
+// 已验证的域名不能重复添加；未验证的域名由最后申请的用户重新获取令牌，以DNS验证结果确定归属
+func (l *UserDomainRegisterLogic) UserDomainRegister(in *pb.RegisterUserDomainRequest) (*pb.RegisterUserDomainResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+
+	domain := util.NormalizeHost(in.Domain)
+	if !util.IsValidCustomDomain(domain) {
+		return nil, status.Error(codes.InvalidArgument, "域名格式错误")
+	}
+	if domain == util.NormalizeHost(l.svcCtx.Config.DefaultDomain) {
+		return nil, status.Error(codes.InvalidArgument, "不能添加默认短链接域名")
+	}
+
+	token, err := newDomainVerifyToken()
+	if err != nil {
+		l.Logger.Errorf("生成域名验证令牌失败: %v", err)
+		return nil, status.Error(codes.Internal, "添加自定义域名失败")
+	}
+
+	userDomain, err := l.svcCtx.RepoManager.UserDomain.FindByDomain(l.ctx, domain)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		now := time.Now()
+		userDomain = &model.UserDomain{
+			Username:     username,
+			Domain:       domain,
+			VerifyStatus: repo.DomainVerifyStatusPending,
+			VerifyToken:  token,
+			CreateTime:   now,
+			UpdateTime:   now,
+		}
+		if err := l.svcCtx.RepoManager.UserDomain.Create(l.ctx, userDomain); err != nil {
+			l.Logger.Errorf("添加自定义域名失败: %v", err)
+			return nil, status.Error(codes.Internal, "添加自定义域名失败")
+		}
+	case err != nil:
+		l.Logger.Errorf("查询自定义域名失败: %v", err)
+		return nil, status.Error(codes.Internal, "添加自定义域名失败")
+	case userDomain.VerifyStatus == repo.DomainVerifyStatusVerified:
+		if userDomain.Username == username {
+			return &pb.RegisterUserDomainResponse{Domain: toPbUserDomain(userDomain)}, nil
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "域名 %s 已被其他用户验证", domain)
+	default:
+		if err := l.svcCtx.RepoManager.UserDomain.UpdateClaim(l.ctx, userDomain.ID, username, token); err != nil {
+			l.Logger.Errorf("更新自定义域名失败: %v", err)
+			return nil, status.Error(codes.Internal, "添加自定义域名失败")
+		}
+		userDomain.Username = username
+		userDomain.VerifyToken = token
+	}
+
+	return &pb.RegisterUserDomainResponse{
+		Domain: toPbUserDomain(userDomain),
+	}, nil
+}
+
+// newDomainVerifyToken 生成随机的域名验证令牌
+func newDomainVerifyToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// toPbUserDomain 转换为接口返回的域名信息，已验证的域名不再返回验证记录
+func toPbUserDomain(userDomain *model.UserDomain) *pb.UserDomain {
+	result := &pb.UserDomain{
+		Domain:       userDomain.Domain,
+		VerifyStatus: int32(userDomain.VerifyStatus),
+		CreateTime:   userDomain.CreateTime.Format(time.RFC3339),
+	}
+	if userDomain.VerifyStatus != repo.DomainVerifyStatusVerified {
+		result.VerifyRecord, result.VerifyValue = util.DomainVerifyRecord(userDomain.Domain, userDomain.VerifyToken)
+	}
+	return result
+}
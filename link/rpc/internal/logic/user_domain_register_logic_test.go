@@ -0,0 +1,94 @@
+package logic_test
+
+import (
+	"fmt"
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestUserDomainRegister_InvalidDomain 测试添加格式错误的自定义域名
+func TestUserDomainRegister_InvalidDomain(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "test-domain-user"))
+
+	for _, domain := range []string{"", "localhost", "127.0.0.1", "bad_domain.com", "-bad.example.com"} {
+		_, err := logic.NewUserDomainRegisterLogic(userCtx, svcCtx).UserDomainRegister(&pb.RegisterUserDomainRequest{Domain: domain})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("域名 %q 期望 InvalidArgument，实际: %v", domain, err)
+		}
+	}
+}
+
+// TestUserDomainRegister_RegisterAndVerify 测试添加自定义域名、查询列表以及未配置TXT记录时验证失败
+func TestUserDomainRegister_RegisterAndVerify(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	username := "test-domain-user"
+	// .invalid 顶级域名保证不存在DNS记录
+	domain := fmt.Sprintf("domain-%d.invalid", time.Now().UnixNano())
+	userCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", username))
+
+	resp, err := logic.NewUserDomainRegisterLogic(userCtx, svcCtx).UserDomainRegister(&pb.RegisterUserDomainRequest{
+		Domain: strings.ToUpper(domain) + ":443",
+	})
+	if err != nil {
+		t.Fatalf("添加自定义域名失败: %v", err)
+	}
+	t.Cleanup(func() {
+		userDomain, err := svcCtx.RepoManager.UserDomain.FindByDomain(ctx, domain)
+		if err != nil {
+			return
+		}
+		if err := svcCtx.RepoManager.GetCommonDB().Delete(userDomain).Error; err != nil {
+			t.Logf("清理域名失败: %v", err)
+		}
+	})
+	if resp.Domain.Domain != domain || resp.Domain.VerifyStatus != repo.DomainVerifyStatusPending {
+		t.Errorf("添加的域名不符合预期: %+v", resp.Domain)
+	}
+	if resp.Domain.VerifyRecord != "_shorterurl-verify."+domain || !strings.HasPrefix(resp.Domain.VerifyValue, "shorterurl-verify=") {
+		t.Errorf("验证记录不符合预期: %+v", resp.Domain)
+	}
+
+	listResp, err := logic.NewUserDomainListLogic(userCtx, svcCtx).UserDomainList(&pb.ListUserDomainRequest{})
+	if err != nil {
+		t.Fatalf("查询自定义域名失败: %v", err)
+	}
+	found := false
+	for _, d := range listResp.Domains {
+		if d.Domain == domain {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("域名列表中未找到新添加的域名: %v", listResp.Domains)
+	}
+
+	// 其他用户不能验证该域名
+	otherCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("username", "other-user"))
+	_, err = logic.NewUserDomainVerifyLogic(otherCtx, svcCtx).UserDomainVerify(&pb.VerifyUserDomainRequest{Domain: domain})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("非域名申请者验证期望 NotFound，实际: %v", err)
+	}
+
+	// 未配置TXT记录时验证失败，域名保持待验证
+	_, err = logic.NewUserDomainVerifyLogic(userCtx, svcCtx).UserDomainVerify(&pb.VerifyUserDomainRequest{Domain: domain})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("未配置TXT记录时期望 FailedPrecondition，实际: %v", err)
+	}
+	userDomain, err := svcCtx.RepoManager.UserDomain.FindByDomain(ctx, domain)
+	if err != nil {
+		t.Fatalf("查询自定义域名失败: %v", err)
+	}
+	if userDomain.VerifyStatus != repo.DomainVerifyStatusPending {
+		t.Errorf("验证失败后域名状态不应改变: %d", userDomain.VerifyStatus)
+	}
+}
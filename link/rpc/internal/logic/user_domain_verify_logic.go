@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UserDomainVerifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUserDomainVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UserDomainVerifyLogic {
+	return &UserDomainVerifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 查询域名的DNS TXT记录，记录值与验证令牌一致时将域名标记为已验证
+func (l *UserDomainVerifyLogic) UserDomainVerify(in *pb.VerifyUserDomainRequest) (*pb.VerifyUserDomainResponse, error) {
+	username, err := l.svcCtx.RepoManager.GetCurrentUsername(l.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "用户未登录")
+	}
+	domain := util.NormalizeHost(in.Domain)
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "域名不能为空")
+	}
+
+	userDomain, err := l.svcCtx.RepoManager.UserDomain.FindByDomain(l.ctx, domain)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && userDomain.Username != username) {
+		return nil, status.Error(codes.NotFound, "域名不存在，请先添加域名")
+	}
+	if err != nil {
+		l.Logger.Errorf("查询自定义域名失败: %v", err)
+		return nil, status.Error(codes.Internal, "验证自定义域名失败")
+	}
+	if userDomain.VerifyStatus == repo.DomainVerifyStatusVerified {
+		return &pb.VerifyUserDomainResponse{Domain: toPbUserDomain(userDomain)}, nil
+	}
+
+	record, value := util.DomainVerifyRecord(userDomain.Domain, userDomain.VerifyToken)
+	txts, err := net.DefaultResolver.LookupTXT(l.ctx, record)
+	if err != nil {
+		l.Logger.Infof("查询域名验证记录失败: %s, %v", record, err)
+		return nil, status.Errorf(codes.FailedPrecondition, "未查询到TXT记录 %s，请确认DNS配置已生效", record)
+	}
+	matched := false
+	for _, txt := range txts {
+		if strings.TrimSpace(txt) == value {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return nil, status.Errorf(codes.FailedPrecondition, "TXT记录 %s 的值与验证令牌不一致", record)
+	}
+
+	if err := l.svcCtx.RepoManager.UserDomain.UpdateVerifyStatus(l.ctx, userDomain.ID, repo.DomainVerifyStatusVerified); err != nil {
+		l.Logger.Errorf("更新域名验证状态失败: %v", err)
+		return nil, status.Error(codes.Internal, "验证自定义域名失败")
+	}
+	userDomain.VerifyStatus = repo.DomainVerifyStatusVerified
+	l.Logger.Infof("自定义域名已验证: %s, 用户: %s", domain, username)
+
+	return &pb.VerifyUserDomainResponse{
+		Domain: toPbUserDomain(userDomain),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"shorterurl/link/rpc/internal/svc"
+	"shorterurl/link/rpc/pb"
+	"shorterurl/link/rpc/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 短链接密码错误次数前缀Key，按短链接和访问IP统计
+	LinkPasswordFailKey = "short-link:password:fail:%s:%s"
+	// 短链接密码错误次数前缀Key，按短链接统计所有IP的错误，防止更换IP暴力破解
+	LinkPasswordLinkFailKey = "short-link:password:fail:%s"
+)
+
+// passwordAttemptScript 原子记录一次密码尝试，首次尝试时设置统计窗口，返回窗口内的尝试次数
+var passwordAttemptScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("EXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// refundAttemptScript 退还一次密码尝试，计数已过期时不处理，避免生成没有过期时间的计数
+var refundAttemptScript = redis.NewScript(`
+local count = tonumber(redis.call("GET", KEYS[1]) or "0")
+if count > 0 then
+	return redis.call("DECR", KEYS[1])
+end
+return 0
+`)
+
+type VerifyLinkPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyLinkPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyLinkPasswordLogic {
+	return &VerifyLinkPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 验证短链接访问密码
+func (l *VerifyLinkPasswordLogic) VerifyLinkPassword(in *pb.VerifyLinkPasswordRequest) (*pb.VerifyLinkPasswordResponse, error) {
+	// 参数校验
+	if in.ShortUri == "" {
+		return nil, status.Error(codes.InvalidArgument, "短链接不能为空")
+	}
+
+	// 构建完整短链接，与跳转接口使用相同的域名解析规则
+	domain := resolveDomain(l.ctx, l.svcCtx, in.Host)
+	fullShortUrl := fmt.Sprintf("%s/%s", domain, in.ShortUri)
+
+	// 先原子记录本次尝试再判断是否超限，避免并发请求同时通过检查
+	// 同时限制单个IP和单个短链接的错误次数，更换IP也无法绕过短链接维度的限制
+	cfg := l.svcCtx.Config.LinkPassword
+	failKey := fmt.Sprintf(LinkPasswordFailKey, fullShortUrl, in.Ip)
+	attempts, err := l.recordAttempt(failKey)
+	if err != nil {
+		l.Logger.Errorf("记录密码尝试次数失败: %v", err)
+		return nil, status.Error(codes.Internal, "验证密码失败")
+	}
+	linkFailKey := fmt.Sprintf(LinkPasswordLinkFailKey, fullShortUrl)
+	linkAttempts, err := l.recordAttempt(linkFailKey)
+	if err != nil {
+		l.Logger.Errorf("记录密码尝试次数失败: %v", err)
+		return nil, status.Error(codes.Internal, "验证密码失败")
+	}
+	if attempts > cfg.MaxAttempts || linkAttempts > cfg.MaxLinkAttempts {
+		return nil, status.Error(codes.ResourceExhausted, "密码错误次数过多，请稍后再试")
+	}
+
+	// 查询短链接
+	linkGoto, err := l.svcCtx.RepoManager.LinkGoto.FindByFullShortUrl(l.ctx, fullShortUrl)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "未找到对应的短链接")
+	}
+
+	link, err := l.svcCtx.RepoManager.Link.FindByFullShortUrlAndGid(l.ctx, fullShortUrl, linkGoto.Gid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "未找到对应的短链接详情")
+	}
+
+	if link.EnableStatus > 0 {
+		return nil, status.Error(codes.PermissionDenied, "短链接已被禁用")
+	}
+
+	// 未设置密码的短链接直接通过
+	if link.Password == "" {
+		return &pb.VerifyLinkPasswordResponse{Success: true}, nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(link.Password), []byte(in.Password)); err != nil {
+		remaining := cfg.MaxAttempts - attempts
+		if linkRemaining := cfg.MaxLinkAttempts - linkAttempts; linkRemaining < remaining {
+			remaining = linkRemaining
+		}
+		if remaining < 0 {
+			remaining = 0
+		}
+		l.Logger.Infof("短链接 %s 密码验证失败, IP: %s, 剩余次数: %d", fullShortUrl, in.Ip, remaining)
+
+		return &pb.VerifyLinkPasswordResponse{
+			Success:           false,
+			RemainingAttempts: int32(remaining),
+		}, nil
+	}
+
+	// 验证通过，清除该IP的错误次数，并退还本次占用的短链接尝试次数，正常访问者不计入短链接维度的限制
+	if _, err := l.svcCtx.BizRedis.DelCtx(l.ctx, failKey); err != nil {
+		l.Logger.Errorf("清除密码错误次数失败: %v", err)
+	}
+	if _, err := l.svcCtx.BizRedis.ScriptRunCtx(l.ctx, refundAttemptScript, []string{linkFailKey}); err != nil {
+		l.Logger.Errorf("退还短链接密码尝试次数失败: %v", err)
+	}
+
+	// 签发解锁令牌，签名包含密码哈希摘要，修改密码后令牌失效
+	maxAge := cfg.UnlockMaxAge
+	token := util.SignUnlockToken(cfg.UnlockSecret, fullShortUrl,
+		util.PasswordVersion(link.Password), time.Now().Add(time.Duration(maxAge)*time.Second))
+
+	return &pb.VerifyLinkPasswordResponse{
+		Success:           true,
+		RemainingAttempts: int32(cfg.MaxAttempts),
+		UnlockToken:       token,
+		UnlockMaxAge:      int32(maxAge),
+	}, nil
+}
+
+// recordAttempt 原子记录一次密码尝试，首次记录时设置统计窗口，返回窗口内的次数
+func (l *VerifyLinkPasswordLogic) recordAttempt(key string) (int, error) {
+	val, err := l.svcCtx.BizRedis.ScriptRunCtx(l.ctx, passwordAttemptScript, []string{key},
+		strconv.Itoa(l.svcCtx.Config.LinkPassword.WindowSeconds))
+	if err != nil {
+		return 0, err
+	}
+	attempts, _ := val.(int64)
+	return int(attempts), nil
+}
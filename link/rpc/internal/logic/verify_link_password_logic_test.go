@@ -0,0 +1,152 @@
+package logic_test
+
+import (
+	"fmt"
+	"shorterurl/link/rpc/internal/logic"
+	"shorterurl/link/rpc/pb"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestVerifyLinkPassword_Normal 测试带密码短链接的跳转及密码验证
+func TestVerifyLinkPassword_Normal(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+
+	// 1. 创建带访问密码的短链接
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-password",
+		Describe:  "测试密码访问的短链接",
+		Password:  "secret123",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+	ip := "127.0.0.1"
+
+	t.Cleanup(func() {
+		fullShortUrl := svcCtx.Config.DefaultDomain + "/" + shortUri
+		failKey := fmt.Sprintf(logic.LinkPasswordFailKey, fullShortUrl, ip)
+		linkFailKey := fmt.Sprintf(logic.LinkPasswordLinkFailKey, fullShortUrl)
+		if _, err := svcCtx.BizRedis.Del(failKey, linkFailKey); err != nil {
+			t.Logf("清理密码错误次数失败: %v", err)
+		}
+	})
+
+	// 2. 未验证时跳转不返回原始链接
+	restoreLogic := logic.NewRestoreUrlLogic(ctx, svcCtx)
+	restoreResp, err := restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if !restoreResp.PasswordRequired || restoreResp.OriginUrl != "" {
+		t.Errorf("期望需要输入密码且不返回原始链接，实际: %+v", restoreResp)
+		return
+	}
+
+	// 3. 错误密码
+	verifyLogic := logic.NewVerifyLinkPasswordLogic(ctx, svcCtx)
+	verifyResp, err := verifyLogic.VerifyLinkPassword(&pb.VerifyLinkPasswordRequest{
+		ShortUri: shortUri,
+		Password: "wrong",
+		Ip:       ip,
+	})
+	if err != nil {
+		t.Errorf("验证密码失败: %v", err)
+		return
+	}
+	if verifyResp.Success {
+		t.Error("期望错误密码验证失败，但实际成功")
+		return
+	}
+	t.Logf("错误密码正确拒绝，剩余次数: %d", verifyResp.RemainingAttempts)
+
+	// 4. 正确密码
+	verifyResp, err = verifyLogic.VerifyLinkPassword(&pb.VerifyLinkPasswordRequest{
+		ShortUri: shortUri,
+		Password: "secret123",
+		Ip:       ip,
+	})
+	if err != nil || !verifyResp.Success {
+		t.Errorf("期望正确密码验证成功, err: %v", err)
+		return
+	}
+
+	// 5. 伪造的解锁令牌无效
+	restoreResp, err = restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri, UnlockToken: "9999999999.forged"})
+	if err != nil || !restoreResp.PasswordRequired {
+		t.Errorf("期望伪造的解锁令牌无效, resp: %+v, err: %v", restoreResp, err)
+		return
+	}
+
+	// 6. 携带解锁令牌跳转返回原始链接
+	restoreResp, err = restoreLogic.RestoreUrl(&pb.RestoreUrlRequest{ShortUri: shortUri, UnlockToken: verifyResp.UnlockToken})
+	if err != nil {
+		t.Errorf("短链接跳转失败: %v", err)
+		return
+	}
+	if restoreResp.OriginUrl != "https://github.com/zeromicro/go-zero" {
+		t.Errorf("期望原始链接为 %s, 实际为 %s", "https://github.com/zeromicro/go-zero", restoreResp.OriginUrl)
+		return
+	}
+
+	t.Logf("密码验证通过后跳转成功，原始链接: %s", restoreResp.OriginUrl)
+}
+
+// TestVerifyLinkPassword_LinkAttemptLimit 测试更换IP也无法绕过短链接维度的错误次数限制
+func TestVerifyLinkPassword_LinkAttemptLimit(t *testing.T) {
+	svcCtx, ctx := setupTest(t)
+	svcCtx.Config.LinkPassword.MaxLinkAttempts = 2
+
+	createLogic := logic.NewShortLinkCreateLogic(ctx, svcCtx)
+	createResp, err := createLogic.ShortLinkCreate(&pb.CreateShortLinkRequest{
+		OriginUrl: "https://github.com/zeromicro/go-zero",
+		Gid:       "test-password",
+		Describe:  "测试密码错误次数限制的短链接",
+		Password:  "secret123",
+	})
+	if err != nil {
+		t.Errorf("创建短链接失败: %v", err)
+		return
+	}
+	shortUri := extractShortUri(createResp.FullShortUrl)
+	fullShortUrl := svcCtx.Config.DefaultDomain + "/" + shortUri
+	ips := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}
+
+	t.Cleanup(func() {
+		keys := []string{fmt.Sprintf(logic.LinkPasswordLinkFailKey, fullShortUrl)}
+		for _, ip := range ips {
+			keys = append(keys, fmt.Sprintf(logic.LinkPasswordFailKey, fullShortUrl, ip))
+		}
+		if _, err := svcCtx.BizRedis.Del(keys...); err != nil {
+			t.Logf("清理密码错误次数失败: %v", err)
+		}
+	})
+
+	verifyLogic := logic.NewVerifyLinkPasswordLogic(ctx, svcCtx)
+	for i, ip := range ips {
+		_, err := verifyLogic.VerifyLinkPassword(&pb.VerifyLinkPasswordRequest{
+			ShortUri: shortUri,
+			Password: "wrong",
+			Ip:       ip,
+		})
+		if i < svcCtx.Config.LinkPassword.MaxLinkAttempts {
+			if err != nil {
+				t.Errorf("第%d次验证不应被限制: %v", i+1, err)
+				return
+			}
+			continue
+		}
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("期望超过短链接错误次数后被限制，实际: %v", err)
+			return
+		}
+	}
+	t.Log("更换IP后仍然受到短链接维度的错误次数限制")
+}
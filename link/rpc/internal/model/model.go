@@ -6,26 +6,54 @@ import (
 
 // Link 短链接表模型
 type Link struct {
-	ID            int64     `gorm:"primaryKey;column:id;comment:ID"`
-	Domain        string    `gorm:"column:domain;comment:域名"`
-	ShortUri      string    `gorm:"column:short_uri;comment:短链接"`
-	FullShortUrl  string    `gorm:"column:full_short_url;comment:完整短链接;index"`
-	OriginUrl     string    `gorm:"column:origin_url;comment:原始链接"`
-	ClickNum      int       `gorm:"column:click_num;default:0;comment:点击量"`
-	Gid           string    `gorm:"column:gid;default:default;comment:分组标识;index"`
-	Favicon       string    `gorm:"column:favicon;comment:网站图标"`
-	EnableStatus  int       `gorm:"column:enable_status;comment:启用标识 0：启用 1：未启用"`
-	CreatedType   int       `gorm:"column:created_type;comment:创建类型 0：接口创建 1：控制台创建"`
-	ValidDateType int       `gorm:"column:valid_date_type;comment:有效期类型 0：永久有效 1：自定义"`
-	ValidDate     time.Time `gorm:"column:valid_date;comment:有效期"`
-	Describe      string    `gorm:"column:describe;comment:描述"`
-	TotalPv       int       `gorm:"column:total_pv;comment:历史PV"`
-	TotalUv       int       `gorm:"column:total_uv;comment:历史UV"`
-	TotalUip      int       `gorm:"column:total_uip;comment:历史UIP"`
-	CreateTime    time.Time `gorm:"column:create_time;comment:创建时间"`
-	UpdateTime    time.Time `gorm:"column:update_time;comment:更新时间"`
-	DelTime       int64     `gorm:"column:del_time;default:0;comment:删除时间戳"`
-	DelFlag       int       `gorm:"column:del_flag;comment:删除标识 0：未删除 1：已删除"`
+	ID                  int64      `gorm:"primaryKey;column:id;comment:ID"`
+	Domain              string     `gorm:"column:domain;comment:域名"`
+	ShortUri            string     `gorm:"column:short_uri;comment:短链接"`
+	FullShortUrl        string     `gorm:"column:full_short_url;comment:完整短链接;index"`
+	OriginUrl           string     `gorm:"column:origin_url;comment:原始链接"`
+	ClickNum            int        `gorm:"column:click_num;default:0;comment:点击量"`
+	MaxClicks           int        `gorm:"column:max_clicks;default:0;comment:最大访问次数 0：不限制"`
+	Gid                 string     `gorm:"column:gid;default:default;comment:分组标识;index"`
+	Favicon             string     `gorm:"column:favicon;comment:网站图标"`
+	EnableStatus        int        `gorm:"column:enable_status;comment:启用标识 0：启用 1：未启用"`
+	CreatedType         int        `gorm:"column:created_type;comment:创建类型 0：接口创建 1：控制台创建"`
+	ValidDateType       int        `gorm:"column:valid_date_type;comment:有效期类型 0：永久有效 1：自定义"`
+	ValidFrom           *time.Time `gorm:"column:valid_from;comment:生效时间，为空表示立即生效"`
+	ValidDate           time.Time  `gorm:"column:valid_date;comment:有效期"`
+	ExpiredUrl          string     `gorm:"column:expired_url;comment:过期后跳转链接"`
+	ExpiredMessage      string     `gorm:"column:expired_message;comment:过期后提示信息"`
+	GraceDays           int        `gorm:"column:grace_days;default:0;comment:过期宽限天数，宽限期内继续跳转"`
+	RedirectType        int        `gorm:"column:redirect_type;default:0;comment:跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转"`
+	IosDeepLink         string     `gorm:"column:ios_deep_link;comment:iOS深度链接，自定义Scheme或通用链接"`
+	AndroidPackage      string     `gorm:"column:android_package;comment:Android应用包名"`
+	AndroidDeepLink     string     `gorm:"column:android_deep_link;comment:Android深度链接，自定义Scheme或App Links链接"`
+	DeepLinkFallbackUrl string     `gorm:"column:deep_link_fallback_url;comment:未安装应用时的兜底链接，如应用商店或网页"`
+	OgTitle             string     `gorm:"column:og_title;comment:社交分享预览标题"`
+	OgDescription       string     `gorm:"column:og_description;comment:社交分享预览描述"`
+	OgImage             string     `gorm:"column:og_image;comment:社交分享预览图片"`
+	SafetyStatus        int        `gorm:"column:safety_status;default:0;comment:安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）"`
+	SafetyReason        string     `gorm:"column:safety_reason;comment:安全检测命中原因"`
+	HealthStatus        int        `gorm:"column:health_status;default:0;comment:目标链接健康状态 0：未检测 1：正常 2：失效"`
+	HealthCode          int        `gorm:"column:health_code;default:0;comment:最近一次检测的HTTP状态码，请求失败时为0"`
+	HealthLatency       int        `gorm:"column:health_latency;default:0;comment:最近一次检测的响应耗时（毫秒）"`
+	HealthRedirects     string     `gorm:"column:health_redirects;comment:最近一次检测的重定向链路（JSON数组）"`
+	HealthError         string     `gorm:"column:health_error;comment:最近一次检测的失败原因"`
+	HealthFailCount     int        `gorm:"column:health_fail_count;default:0;comment:连续检测失败次数"`
+	HealthCheckTime     *time.Time `gorm:"column:health_check_time;comment:最近一次检测时间"`
+	Describe            string     `gorm:"column:describe;comment:描述"`
+	Password            string     `gorm:"column:password;comment:访问密码（bcrypt哈希）"`
+	QueryParamPolicy    int        `gorm:"column:query_param_policy;default:0;comment:查询参数策略 0：忽略 1：透传 2：合并"`
+	UtmSource           string     `gorm:"column:utm_source;comment:utm_source模板"`
+	UtmMedium           string     `gorm:"column:utm_medium;comment:utm_medium模板"`
+	UtmCampaign         string     `gorm:"column:utm_campaign;comment:utm_campaign模板"`
+	TotalPv             int        `gorm:"column:total_pv;comment:历史PV"`
+	TotalUv             int        `gorm:"column:total_uv;comment:历史UV"`
+	TotalUip            int        `gorm:"column:total_uip;comment:历史UIP"`
+	CreateTime          time.Time  `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime          time.Time  `gorm:"column:update_time;comment:更新时间"`
+	RecycleTime         *time.Time `gorm:"column:recycle_time;comment:移入回收站时间，不在回收站中时为空"`
+	DelTime             int64      `gorm:"column:del_time;default:0;comment:删除时间戳"`
+	DelFlag             int        `gorm:"column:del_flag;comment:删除标识 0：未删除 1：已删除"`
 }
 
 // TableName 表名
@@ -62,6 +90,61 @@ func (Group) TableName() string {
 	return "t_group"
 }
 
+// GroupExpiryPolicy 分组过期策略表模型，作为分组内短链接过期处理的默认配置
+type GroupExpiryPolicy struct {
+	ID             int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid            string    `gorm:"column:gid;comment:分组标识;uniqueIndex"`
+	ExpiredUrl     string    `gorm:"column:expired_url;comment:过期后跳转链接"`
+	ExpiredMessage string    `gorm:"column:expired_message;comment:过期后提示信息"`
+	GraceDays      int       `gorm:"column:grace_days;default:0;comment:过期宽限天数，宽限期内继续跳转"`
+	CreateTime     time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime     time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag        int       `gorm:"column:del_flag;default:0;comment:删除标识 0：未删除 1：已删除"`
+}
+
+// TableName 表名
+func (GroupExpiryPolicy) TableName() string {
+	return "t_group_expiry_policy"
+}
+
+// GroupTransfer 分组转让表模型，接收用户接受后分组归属变更
+type GroupTransfer struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	GroupName    string    `gorm:"column:group_name;comment:发起转让时的分组名称"`
+	FromUsername string    `gorm:"column:from_username;comment:转出用户名"`
+	ToUsername   string    `gorm:"column:to_username;comment:接收用户名"`
+	Status       int       `gorm:"column:status;default:0;comment:转让状态 0：待接受 1：已接受 2：已拒绝 3：已取消"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime   time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag      int       `gorm:"column:del_flag;default:0;comment:删除标识 0：未删除 1：已删除"`
+}
+
+// TableName 表名
+func (GroupTransfer) TableName() string {
+	return "t_group_transfer"
+}
+
+// LinkModeration 短链接安全审核表模型
+type LinkModeration struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	FullShortUrl string    `gorm:"column:full_short_url;comment:完整短链接;index"`
+	OriginUrl    string    `gorm:"column:origin_url;comment:被标记的目标链接"`
+	Provider     string    `gorm:"column:provider;comment:检测来源 blocklist/redis/http/manual"`
+	Reason       string    `gorm:"column:reason;comment:标记原因"`
+	Status       int       `gorm:"column:status;default:0;comment:审核状态 0：待审核 1：已通过 2：已拒绝;index"`
+	Reviewer     string    `gorm:"column:reviewer;comment:审核人"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime   time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag      int       `gorm:"column:del_flag;default:0;comment:删除标识 0：未删除 1：已删除"`
+}
+
+// TableName 表名
+func (LinkModeration) TableName() string {
+	return "t_link_moderation"
+}
+
 // User 用户表模型
 type User struct {
 	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
@@ -92,6 +175,8 @@ type LinkAccessLog struct {
 	Network      string    `gorm:"column:network;comment:访问网络"`
 	Device       string    `gorm:"column:device;comment:访问设备"`
 	Locale       string    `gorm:"column:locale;comment:地区"`
+	Variant      string    `gorm:"column:variant;comment:A/B分流版本"`
+	Channel      string    `gorm:"column:channel;comment:访问渠道"`
 	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
 	UpdateTime   time.Time `gorm:"column:update_time;comment:更新时间"`
 	DelFlag      int       `gorm:"column:del_flag;comment:删除标识 0：未删除 1：已删除"`
@@ -156,6 +241,23 @@ func (LinkDeviceStats) TableName() string {
 	return "t_link_device_stats"
 }
 
+// LinkHistory 短链接变更历史表模型
+type LinkHistory struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	FullShortUrl string    `gorm:"column:full_short_url;comment:完整短链接"`
+	Operator     string    `gorm:"column:operator;comment:操作人"`
+	Action       string    `gorm:"column:action;comment:操作类型 create/update/enable/disable/recycle/recover/rollback"`
+	Changes      string    `gorm:"column:changes;comment:字段变更，JSON数组"`
+	Snapshot     string    `gorm:"column:snapshot;comment:变更后的短链接属性，JSON对象，用于回滚"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+}
+
+// TableName 表名
+func (LinkHistory) TableName() string {
+	return "t_link_history"
+}
+
 // LinkLocaleStats 链接地区统计表模型
 type LinkLocaleStats struct {
 	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
@@ -210,6 +312,25 @@ func (LinkOsStats) TableName() string {
 	return "t_link_os_stats"
 }
 
+// LinkRedirectRule 短链接跳转规则表模型
+type LinkRedirectRule struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	FullShortUrl string    `gorm:"column:full_short_url;comment:完整短链接;index"`
+	RuleType     string    `gorm:"column:rule_type;comment:规则类型 os：操作系统 province：省份 language：语言"`
+	RuleValue    string    `gorm:"column:rule_value;comment:匹配值"`
+	TargetUrl    string    `gorm:"column:target_url;comment:目标链接"`
+	SortOrder    int       `gorm:"column:sort_order;default:0;comment:排序，越小越优先"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime   time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag      int       `gorm:"column:del_flag;default:0;comment:删除标识 0：未删除 1：已删除"`
+}
+
+// TableName 表名
+func (LinkRedirectRule) TableName() string {
+	return "t_link_redirect_rule"
+}
+
 // LinkStatsToday 链接当日统计表模型
 type LinkStatsToday struct {
 	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
@@ -228,6 +349,65 @@ func (LinkStatsToday) TableName() string {
 	return "t_link_stats_today"
 }
 
+// LinkVariant 短链接A/B分流目标链接表模型
+type LinkVariant struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	FullShortUrl string    `gorm:"column:full_short_url;comment:完整短链接;index"`
+	Name         string    `gorm:"column:name;comment:版本名称"`
+	TargetUrl    string    `gorm:"column:target_url;comment:目标链接"`
+	Weight       int       `gorm:"column:weight;default:0;comment:流量权重（百分比）"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime   time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag      int       `gorm:"column:del_flag;default:0;comment:删除标识 0：未删除 1：已删除"`
+}
+
+// TableName 表名
+func (LinkVariant) TableName() string {
+	return "t_link_variant"
+}
+
+// LinkTag 短链接标签表模型
+type LinkTag struct {
+	ID           int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Gid          string    `gorm:"column:gid;comment:分组标识"`
+	FullShortUrl string    `gorm:"column:full_short_url;comment:完整短链接"`
+	Tag          string    `gorm:"column:tag;comment:标签"`
+	CreateTime   time.Time `gorm:"column:create_time;comment:创建时间"`
+}
+
+// TableName 表名
+func (LinkTag) TableName() string {
+	return "t_link_tag"
+}
+
+// LinkTemplate 短链接模板表模型，创建短链接时作为未填写字段的默认值
+type LinkTemplate struct {
+	ID               int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Username         string    `gorm:"column:username;comment:用户名"`
+	Name             string    `gorm:"column:name;comment:模板名称"`
+	Gid              string    `gorm:"column:gid;comment:默认分组标识"`
+	Domain           string    `gorm:"column:domain;comment:默认域名"`
+	ValidDays        int       `gorm:"column:valid_days;default:0;comment:有效天数，从创建时开始计算，0表示永久有效"`
+	Describe         string    `gorm:"column:describe;comment:描述模板，支持{date}和{week}占位符"`
+	QueryParamPolicy int       `gorm:"column:query_param_policy;default:0;comment:查询参数策略 0：忽略 1：透传 2：合并"`
+	UtmSource        string    `gorm:"column:utm_source;comment:utm_source模板"`
+	UtmMedium        string    `gorm:"column:utm_medium;comment:utm_medium模板"`
+	UtmCampaign      string    `gorm:"column:utm_campaign;comment:utm_campaign模板"`
+	RedirectType     int       `gorm:"column:redirect_type;default:0;comment:跳转类型"`
+	ExpiredUrl       string    `gorm:"column:expired_url;comment:过期后跳转链接"`
+	ExpiredMessage   string    `gorm:"column:expired_message;comment:过期后提示信息"`
+	GraceDays        int       `gorm:"column:grace_days;default:0;comment:过期宽限天数"`
+	Tags             string    `gorm:"column:tags;comment:标签，JSON数组"`
+	CreateTime       time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime       time.Time `gorm:"column:update_time;comment:更新时间"`
+}
+
+// TableName 表名
+func (LinkTemplate) TableName() string {
+	return "t_link_template"
+}
+
 // GroupUnique 分组唯一标识表
 type GroupUnique struct {
 	ID  int64  `gorm:"primaryKey;column:id;comment:ID"`
@@ -238,3 +418,23 @@ type GroupUnique struct {
 func (GroupUnique) TableName() string {
 	return "t_group_unique"
 }
+
+// UserDomain 用户自定义域名表模型
+type UserDomain struct {
+	ID                      int64     `gorm:"primaryKey;column:id;comment:ID"`
+	Username                string    `gorm:"column:username;comment:用户名;index"`
+	Domain                  string    `gorm:"column:domain;comment:自定义域名;index"`
+	VerifyStatus            int       `gorm:"column:verify_status;comment:验证状态 0：未验证 1：已验证"`
+	VerifyToken             string    `gorm:"column:verify_token;comment:域名验证令牌，配置在DNS TXT记录中"`
+	CreateTime              time.Time `gorm:"column:create_time;comment:创建时间"`
+	UpdateTime              time.Time `gorm:"column:update_time;comment:更新时间"`
+	DelFlag                 int       `gorm:"column:del_flag;comment:删除标识 0：未删除 1：已删除"`
+	AppleAppIds             string    `gorm:"column:apple_app_ids;comment:iOS应用标识列表（TeamID.BundleID），逗号分隔"`
+	AndroidPackage          string    `gorm:"column:android_package;comment:Android应用包名"`
+	AndroidCertFingerprints string    `gorm:"column:android_cert_fingerprints;comment:Android应用签名证书SHA256指纹列表，逗号分隔"`
+}
+
+// TableName 表名
+func (UserDomain) TableName() string {
+	return "t_user_domain"
+}
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GroupExpiryPolicyRepo 分组过期策略仓库接口
+type GroupExpiryPolicyRepo interface {
+	// 根据分组标识查询过期策略
+	FindByGid(ctx context.Context, gid string) (*model.GroupExpiryPolicy, error)
+	// 保存分组过期策略，不存在时创建
+	Save(ctx context.Context, policy *model.GroupExpiryPolicy) error
+}
+
+// groupExpiryPolicyRepo 分组过期策略仓库实现
+type groupExpiryPolicyRepo struct {
+	db *gorm.DB
+}
+
+// NewGroupExpiryPolicyRepo 创建分组过期策略仓库
+func NewGroupExpiryPolicyRepo(db *gorm.DB) GroupExpiryPolicyRepo {
+	return &groupExpiryPolicyRepo{
+		db: db,
+	}
+}
+
+// FindByGid 根据分组标识查询过期策略
+func (r *groupExpiryPolicyRepo) FindByGid(ctx context.Context, gid string) (*model.GroupExpiryPolicy, error) {
+	var policy model.GroupExpiryPolicy
+	err := r.db.WithContext(ctx).
+		Where("gid = ? AND del_flag = 0", gid).
+		First(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Save 保存分组过期策略，不存在时创建
+// 分组标识唯一，已存在的记录（包括已删除的）直接覆盖
+func (r *groupExpiryPolicyRepo) Save(ctx context.Context, policy *model.GroupExpiryPolicy) error {
+	var existing model.GroupExpiryPolicy
+	err := r.db.WithContext(ctx).
+		Where("gid = ?", policy.Gid).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		now := time.Now()
+		policy.CreateTime = now
+		policy.UpdateTime = now
+		policy.DelFlag = 0
+		return r.db.WithContext(ctx).Create(policy).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	policy.ID = existing.ID
+	policy.CreateTime = existing.CreateTime
+	return r.db.WithContext(ctx).
+		Model(&model.GroupExpiryPolicy{}).
+		Where("id = ?", existing.ID).
+		Updates(map[string]interface{}{
+			"expired_url":     policy.ExpiredUrl,
+			"expired_message": policy.ExpiredMessage,
+			"grace_days":      policy.GraceDays,
+			"update_time":     time.Now(),
+			"del_flag":        0,
+		}).Error
+}
@@ -37,6 +37,12 @@ type GroupRepo interface {
 
 	// 检查分组是否属于用户
 	CheckGroupBelongToUser(ctx context.Context, gid, username string) (bool, error)
+
+	// 根据 GID 和 Username 查询分组
+	FindByGidAndUsername(ctx context.Context, gid, username string) (*model.Group, error)
+
+	// 将分组转让给其他用户，username是分片键，需删除原分片记录后在接收用户分片重新写入
+	Transfer(ctx context.Context, group *model.Group, toUsername string) error
 }
 
 // groupRepo 分组仓库实现
@@ -180,3 +186,38 @@ func (r *groupRepo) CheckGroupBelongToUser(ctx context.Context, gid, username st
 	}
 	return count > 0, nil
 }
+
+// FindByGidAndUsername 根据 GID 和 Username 查询分组
+func (r *groupRepo) FindByGidAndUsername(ctx context.Context, gid, username string) (*model.Group, error) {
+	var group model.Group
+	err := r.db.WithContext(ctx).
+		Where("gid = ? AND username = ?", gid, username).
+		Where("del_flag = ?", 0).
+		First(&group).Error
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// Transfer 将分组转让给其他用户
+// username是分片键，不能直接更新，需删除原分片中的记录后在接收用户分片重新写入，gid保持不变
+func (r *groupRepo) Transfer(ctx context.Context, group *model.Group, toUsername string) error {
+	result := r.db.WithContext(ctx).Unscoped().
+		Where("id = ? AND username = ?", group.ID, group.Username).
+		Delete(&model.Group{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	now := time.Now()
+	group.ID = 0
+	group.Username = toUsername
+	group.SortOrder = 0
+	group.CreateTime = now
+	group.UpdateTime = now
+	return r.db.WithContext(ctx).Create(group).Error
+}
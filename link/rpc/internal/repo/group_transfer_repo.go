@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 分组转让状态
+const (
+	// 待接受
+	GroupTransferStatusPending = 0
+	// 已接受
+	GroupTransferStatusAccepted = 1
+	// 已拒绝
+	GroupTransferStatusRejected = 2
+	// 已取消
+	GroupTransferStatusCancelled = 3
+)
+
+// GroupTransferRepo 分组转让仓库接口
+type GroupTransferRepo interface {
+	// 创建转让记录
+	Create(ctx context.Context, transfer *model.GroupTransfer) error
+	// 根据ID查询转让记录
+	FindByID(ctx context.Context, id int64) (*model.GroupTransfer, error)
+	// 查询分组待接受的转让记录
+	FindPendingByGid(ctx context.Context, gid string) (*model.GroupTransfer, error)
+	// 查询用户收到的待接受转让记录，按创建时间倒序
+	FindPendingByToUsername(ctx context.Context, username string) ([]*model.GroupTransfer, error)
+	// 查询用户发起的待接受转让记录，按创建时间倒序
+	FindPendingByFromUsername(ctx context.Context, username string) ([]*model.GroupTransfer, error)
+	// 更新转让状态，仅更新待接受的记录，返回是否更新成功
+	UpdateStatus(ctx context.Context, id int64, status int) (bool, error)
+}
+
+// groupTransferRepo 分组转让仓库实现
+type groupTransferRepo struct {
+	db *gorm.DB
+}
+
+// NewGroupTransferRepo 创建分组转让仓库
+func NewGroupTransferRepo(db *gorm.DB) GroupTransferRepo {
+	return &groupTransferRepo{
+		db: db,
+	}
+}
+
+// Create 创建转让记录
+func (r *groupTransferRepo) Create(ctx context.Context, transfer *model.GroupTransfer) error {
+	now := time.Now()
+	transfer.Status = GroupTransferStatusPending
+	transfer.CreateTime = now
+	transfer.UpdateTime = now
+	transfer.DelFlag = 0
+	return r.db.WithContext(ctx).Create(transfer).Error
+}
+
+// FindByID 根据ID查询转让记录
+func (r *groupTransferRepo) FindByID(ctx context.Context, id int64) (*model.GroupTransfer, error) {
+	var transfer model.GroupTransfer
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND del_flag = 0", id).
+		First(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// FindPendingByGid 查询分组待接受的转让记录
+func (r *groupTransferRepo) FindPendingByGid(ctx context.Context, gid string) (*model.GroupTransfer, error) {
+	var transfer model.GroupTransfer
+	err := r.db.WithContext(ctx).
+		Where("gid = ? AND status = ? AND del_flag = 0", gid, GroupTransferStatusPending).
+		First(&transfer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// FindPendingByToUsername 查询用户收到的待接受转让记录，按创建时间倒序
+func (r *groupTransferRepo) FindPendingByToUsername(ctx context.Context, username string) ([]*model.GroupTransfer, error) {
+	var transfers []*model.GroupTransfer
+	err := r.db.WithContext(ctx).
+		Where("to_username = ? AND status = ? AND del_flag = 0", username, GroupTransferStatusPending).
+		Order("create_time DESC, id DESC").
+		Find(&transfers).Error
+	return transfers, err
+}
+
+// FindPendingByFromUsername 查询用户发起的待接受转让记录，按创建时间倒序
+func (r *groupTransferRepo) FindPendingByFromUsername(ctx context.Context, username string) ([]*model.GroupTransfer, error) {
+	var transfers []*model.GroupTransfer
+	err := r.db.WithContext(ctx).
+		Where("from_username = ? AND status = ? AND del_flag = 0", username, GroupTransferStatusPending).
+		Order("create_time DESC, id DESC").
+		Find(&transfers).Error
+	return transfers, err
+}
+
+// UpdateStatus 更新转让状态，仅更新待接受的记录，返回是否更新成功
+func (r *groupTransferRepo) UpdateStatus(ctx context.Context, id int64, status int) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.GroupTransfer{}).
+		Where("id = ? AND status = ? AND del_flag = 0", id, GroupTransferStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"update_time": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
@@ -20,9 +20,25 @@ type LinkAccessLogDO struct {
 	Device       string    `gorm:"column:device"`
 	Network      string    `gorm:"column:network"`
 	Locale       string    `gorm:"column:locale"`
+	Variant      string    `gorm:"column:variant"`
+	Channel      string    `gorm:"column:channel"`
 	CreateTime   time.Time `gorm:"column:create_time"`
 }
 
+// VariantStatDO A/B分流版本统计数据对象
+type VariantStatDO struct {
+	Variant string `gorm:"column:variant"`
+	Pv      int    `gorm:"column:pv"`
+	Uv      int    `gorm:"column:uv"`
+}
+
+// ChannelStatDO 访问渠道统计数据对象
+type ChannelStatDO struct {
+	Channel string `gorm:"column:channel"`
+	Pv      int    `gorm:"column:pv"`
+	Uv      int    `gorm:"column:uv"`
+}
+
 // TableName 表名
 func (LinkAccessLogDO) TableName() string {
 	return "t_link_access_logs"
@@ -53,6 +69,12 @@ type LinkAccessLogsRepo interface {
 
 	// SelectGroupUvTypeByUsers 查询分组用户的访客类型
 	SelectGroupUvTypeByUsers(ctx context.Context, gid, startDate, endDate string, userList []string) ([]map[string]interface{}, error)
+
+	// ListVariantStatsByShortLink 获取短链接A/B分流各版本的PV/UV
+	ListVariantStatsByShortLink(ctx context.Context, fullShortUrl, startDate, endDate string) ([]*VariantStatDO, error)
+
+	// ListChannelStatsByShortLink 获取短链接各访问渠道的PV/UV，未携带渠道标记的访问计为直接访问
+	ListChannelStatsByShortLink(ctx context.Context, fullShortUrl, startDate, endDate, directChannel string) ([]*ChannelStatDO, error)
 }
 
 // linkAccessLogsRepo 链接访问日志仓库实现
@@ -373,3 +395,43 @@ func (r *linkAccessLogsRepo) SelectGroupUvTypeByUsers(ctx context.Context, gid,
 
 	return results, nil
 }
+
+// ListVariantStatsByShortLink 获取短链接A/B分流各版本的PV/UV
+func (r *linkAccessLogsRepo) ListVariantStatsByShortLink(ctx context.Context, fullShortUrl, startDate, endDate string) ([]*VariantStatDO, error) {
+	var results []*VariantStatDO
+
+	query := r.db.WithContext(ctx).Table(LinkAccessLogDO{}.TableName())
+	query = query.Select("variant, COUNT(*) as pv, COUNT(DISTINCT user) as uv")
+	query = query.Where("full_short_url = ? AND variant IS NOT NULL AND variant <> ''", fullShortUrl)
+
+	// 日期过滤
+	if startDate != "" && endDate != "" {
+		startTime, _ := time.Parse("2006-01-02", startDate)
+		endTime, _ := time.Parse("2006-01-02", endDate)
+		endTime = endTime.Add(24 * time.Hour)
+		query = query.Where("create_time >= ? AND create_time < ?", startTime, endTime)
+	}
+
+	err := query.Group("variant").Order("variant ASC").Scan(&results).Error
+	return results, err
+}
+
+// ListChannelStatsByShortLink 获取短链接各访问渠道的PV/UV，未携带渠道标记的访问计为直接访问
+func (r *linkAccessLogsRepo) ListChannelStatsByShortLink(ctx context.Context, fullShortUrl, startDate, endDate, directChannel string) ([]*ChannelStatDO, error) {
+	var results []*ChannelStatDO
+
+	query := r.db.WithContext(ctx).Table(LinkAccessLogDO{}.TableName())
+	query = query.Select("IFNULL(NULLIF(channel, ''), ?) as channel, COUNT(*) as pv, COUNT(DISTINCT user) as uv", directChannel)
+	query = query.Where("full_short_url = ?", fullShortUrl)
+
+	// 日期过滤
+	if startDate != "" && endDate != "" {
+		startTime, _ := time.Parse("2006-01-02", startDate)
+		endTime, _ := time.Parse("2006-01-02", endDate)
+		endTime = endTime.Add(24 * time.Hour)
+		query = query.Where("create_time >= ? AND create_time < ?", startTime, endTime)
+	}
+
+	err := query.Group("1").Order("pv DESC").Scan(&results).Error
+	return results, err
+}
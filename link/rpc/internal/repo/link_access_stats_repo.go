@@ -53,6 +53,9 @@ type LinkAccessStatsRepo interface {
 
 	// ListWeekdayStatsByGroup 获取分组一周访问详情
 	ListWeekdayStatsByGroup(ctx context.Context, gid, startDate, endDate string) ([]*WeekdayStats, error)
+
+	// ListDailyStatsByShortLinks 批量获取多个短链接的每日访问详情
+	ListDailyStatsByShortLinks(ctx context.Context, fullShortUrls []string, startDate, endDate string) ([]*LinkDailyStats, error)
 }
 
 // PvUvUipStats PV、UV、UIP统计结果
@@ -70,6 +73,15 @@ type DailyStats struct {
 	Uip  int32
 }
 
+// LinkDailyStats 单个短链接的每日统计
+type LinkDailyStats struct {
+	FullShortUrl string
+	Date         time.Time
+	Pv           int32
+	Uv           int32
+	Uip          int32
+}
+
 // HourStats 小时统计
 type HourStats struct {
 	Hour int32
@@ -329,3 +341,25 @@ func (r *linkAccessStatsRepo) ListWeekdayStatsByGroup(ctx context.Context, gid,
 	err = query.Scan(&results).Error
 	return results, err
 }
+
+// ListDailyStatsByShortLinks 批量获取多个短链接的每日访问详情，按短链接和日期排序
+func (r *linkAccessStatsRepo) ListDailyStatsByShortLinks(ctx context.Context, fullShortUrls []string, startDate, endDate string) ([]*LinkDailyStats, error) {
+	var results []*LinkDailyStats
+	if len(fullShortUrls) == 0 {
+		return results, nil
+	}
+
+	query := r.db.WithContext(ctx).Table(LinkAccessStatsDO{}.TableName())
+	query = query.Select("full_short_url, date, IFNULL(SUM(pv), 0) as pv, IFNULL(SUM(uv), 0) as uv, IFNULL(SUM(uip), 0) as uip")
+	query = query.Where("full_short_url IN (?)", fullShortUrls)
+
+	// 日期过滤
+	if startDate != "" && endDate != "" {
+		query = query.Where("date >= ? AND date <= ?", startDate, endDate)
+	}
+
+	query = query.Group("full_short_url, date").Order("full_short_url ASC, date ASC")
+
+	err := query.Scan(&results).Error
+	return results, err
+}
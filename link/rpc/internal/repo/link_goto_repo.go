@@ -19,6 +19,8 @@ type LinkGotoRepo interface {
 	BatchCreate(ctx context.Context, linkGotos []*model.LinkGoto) error
 	// 根据分组ID和完整短链接删除记录
 	DeleteByGidAndFullShortUrl(ctx context.Context, gid string, fullShortUrl string) error
+	// 更新短链接所属分组
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
 }
 
 // linkGotoRepo 链接跳转仓库实现
@@ -79,3 +81,12 @@ func (r *linkGotoRepo) DeleteByGidAndFullShortUrl(ctx context.Context, gid strin
 		Where("gid = ? AND full_short_url = ?", gid, fullShortUrl).
 		Delete(&model.LinkGoto{}).Error
 }
+
+// UpdateGid 更新短链接所属分组
+// 注意：full_short_url是分片键，分组变更不影响记录所在分片
+func (r *linkGotoRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkGoto{}).
+		Where("full_short_url = ?", fullShortUrl).
+		Update("gid", gid).Error
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LinkHistoryRepo 短链接变更历史仓库接口
+type LinkHistoryRepo interface {
+	// 创建变更历史
+	Create(ctx context.Context, history *model.LinkHistory) error
+	// 批量创建变更历史
+	BatchCreate(ctx context.Context, histories []*model.LinkHistory) error
+	// 根据ID查询变更历史
+	FindByID(ctx context.Context, id int64) (*model.LinkHistory, error)
+	// 分页查询短链接在分组中since之后的变更历史，按时间倒序
+	PageByFullShortUrl(ctx context.Context, fullShortUrl, gid string, since time.Time, page, pageSize int) ([]*model.LinkHistory, int64, error)
+	// 更新短链接变更历史的分组ID
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
+}
+
+// linkHistoryRepo 短链接变更历史仓库实现
+type linkHistoryRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkHistoryRepo 创建短链接变更历史仓库
+func NewLinkHistoryRepo(db *gorm.DB) LinkHistoryRepo {
+	return &linkHistoryRepo{
+		db: db,
+	}
+}
+
+// Create 创建变更历史
+func (r *linkHistoryRepo) Create(ctx context.Context, history *model.LinkHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
+
+// BatchCreate 批量创建变更历史
+func (r *linkHistoryRepo) BatchCreate(ctx context.Context, histories []*model.LinkHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&histories).Error
+}
+
+// FindByID 根据ID查询变更历史
+func (r *linkHistoryRepo) FindByID(ctx context.Context, id int64) (*model.LinkHistory, error) {
+	var history model.LinkHistory
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// PageByFullShortUrl 分页查询短链接在分组中since之后的变更历史，按时间倒序
+// 永久删除的短链接后缀可能被重新使用，使用分组ID和短链接创建时间限定为当前短链接的记录
+func (r *linkHistoryRepo) PageByFullShortUrl(ctx context.Context, fullShortUrl, gid string, since time.Time, page, pageSize int) ([]*model.LinkHistory, int64, error) {
+	var histories []*model.LinkHistory
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.LinkHistory{}).
+		Where("full_short_url = ? AND gid = ? AND create_time >= ?", fullShortUrl, gid, since).
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("full_short_url = ? AND gid = ? AND create_time >= ?", fullShortUrl, gid, since).
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&histories).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return histories, count, nil
+}
+
+// UpdateGid 更新短链接变更历史的分组ID
+func (r *linkHistoryRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkHistory{}).
+		Where("full_short_url = ?", fullShortUrl).
+		Update("gid", gid).Error
+}
@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 安全审核状态
+const (
+	// 待审核
+	ModerationStatusPending = 0
+	// 已通过
+	ModerationStatusApproved = 1
+	// 已拒绝
+	ModerationStatusRejected = 2
+)
+
+// LinkModerationRepo 短链接安全审核仓库接口
+type LinkModerationRepo interface {
+	// 根据ID查询审核记录
+	FindByID(ctx context.Context, id int64) (*model.LinkModeration, error)
+	// 保存待审核记录，同一短链接已有待审核记录时更新标记内容
+	SavePending(ctx context.Context, moderation *model.LinkModeration) error
+	// 按审核状态分页查询审核记录，按创建时间倒序
+	FindPage(ctx context.Context, status, page, pageSize int) ([]*model.LinkModeration, int64, error)
+	// 更新审核结果，仅更新待审核的记录，返回是否更新成功
+	Review(ctx context.Context, id int64, status int, reviewer string) (bool, error)
+	// 更新短链接审核记录所属分组
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
+}
+
+// linkModerationRepo 短链接安全审核仓库实现
+type linkModerationRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkModerationRepo 创建短链接安全审核仓库
+func NewLinkModerationRepo(db *gorm.DB) LinkModerationRepo {
+	return &linkModerationRepo{
+		db: db,
+	}
+}
+
+// FindByID 根据ID查询审核记录
+func (r *linkModerationRepo) FindByID(ctx context.Context, id int64) (*model.LinkModeration, error) {
+	var moderation model.LinkModeration
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND del_flag = 0", id).
+		First(&moderation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &moderation, nil
+}
+
+// SavePending 保存待审核记录，同一短链接已有待审核记录时更新标记内容
+func (r *linkModerationRepo) SavePending(ctx context.Context, moderation *model.LinkModeration) error {
+	var existing model.LinkModeration
+	err := r.db.WithContext(ctx).
+		Where("full_short_url = ? AND status = ? AND del_flag = 0", moderation.FullShortUrl, ModerationStatusPending).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		now := time.Now()
+		moderation.Status = ModerationStatusPending
+		moderation.CreateTime = now
+		moderation.UpdateTime = now
+		moderation.DelFlag = 0
+		return r.db.WithContext(ctx).Create(moderation).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	moderation.ID = existing.ID
+	return r.db.WithContext(ctx).
+		Model(&model.LinkModeration{}).
+		Where("id = ?", existing.ID).
+		Updates(map[string]interface{}{
+			"gid":         moderation.Gid,
+			"origin_url":  moderation.OriginUrl,
+			"provider":    moderation.Provider,
+			"reason":      moderation.Reason,
+			"update_time": time.Now(),
+		}).Error
+}
+
+// FindPage 按审核状态分页查询审核记录，按创建时间倒序
+func (r *linkModerationRepo) FindPage(ctx context.Context, status, page, pageSize int) ([]*model.LinkModeration, int64, error) {
+	var total int64
+	query := r.db.WithContext(ctx).
+		Model(&model.LinkModeration{}).
+		Where("status = ? AND del_flag = 0", status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var records []*model.LinkModeration
+	err := query.
+		Order("create_time DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&records).Error
+	return records, total, err
+}
+
+// Review 更新审核结果，仅更新待审核的记录，返回是否更新成功
+func (r *linkModerationRepo) Review(ctx context.Context, id int64, status int, reviewer string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.LinkModeration{}).
+		Where("id = ? AND status = ? AND del_flag = 0", id, ModerationStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer":    reviewer,
+			"update_time": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateGid 更新短链接审核记录所属分组
+func (r *linkModerationRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkModeration{}).
+		Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+		Updates(map[string]interface{}{
+			"gid":         gid,
+			"update_time": time.Now(),
+		}).Error
+}
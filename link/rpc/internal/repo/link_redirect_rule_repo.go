@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LinkRedirectRuleRepo 短链接跳转规则仓库接口
+type LinkRedirectRuleRepo interface {
+	// 按优先级查询短链接的所有跳转规则
+	FindByFullShortUrl(ctx context.Context, fullShortUrl string) ([]*model.LinkRedirectRule, error)
+	// 根据ID查询跳转规则
+	FindByID(ctx context.Context, id int64) (*model.LinkRedirectRule, error)
+	// 创建跳转规则
+	Create(ctx context.Context, rule *model.LinkRedirectRule) error
+	// 批量创建跳转规则，全部成功或全部失败
+	BatchCreate(ctx context.Context, rules []*model.LinkRedirectRule) error
+	// 更新跳转规则
+	Update(ctx context.Context, rule *model.LinkRedirectRule) error
+	// 删除跳转规则
+	Delete(ctx context.Context, id int64) error
+	// 更新短链接跳转规则所属分组
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
+}
+
+// linkRedirectRuleRepo 短链接跳转规则仓库实现
+type linkRedirectRuleRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkRedirectRuleRepo 创建短链接跳转规则仓库
+func NewLinkRedirectRuleRepo(db *gorm.DB) LinkRedirectRuleRepo {
+	return &linkRedirectRuleRepo{
+		db: db,
+	}
+}
+
+// FindByFullShortUrl 按优先级查询短链接的所有跳转规则
+func (r *linkRedirectRuleRepo) FindByFullShortUrl(ctx context.Context, fullShortUrl string) ([]*model.LinkRedirectRule, error) {
+	var rules []*model.LinkRedirectRule
+	err := r.db.WithContext(ctx).
+		Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+		Order("sort_order ASC, id ASC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// FindByID 根据ID查询跳转规则
+func (r *linkRedirectRuleRepo) FindByID(ctx context.Context, id int64) (*model.LinkRedirectRule, error) {
+	var rule model.LinkRedirectRule
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND del_flag = 0", id).
+		First(&rule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// Create 创建跳转规则
+func (r *linkRedirectRuleRepo) Create(ctx context.Context, rule *model.LinkRedirectRule) error {
+	return r.db.WithContext(ctx).Create(rule).Error
+}
+
+// BatchCreate 在一个事务中批量创建跳转规则
+func (r *linkRedirectRuleRepo) BatchCreate(ctx context.Context, rules []*model.LinkRedirectRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&rules).Error
+	})
+}
+
+// Update 更新跳转规则
+func (r *linkRedirectRuleRepo) Update(ctx context.Context, rule *model.LinkRedirectRule) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkRedirectRule{}).
+		Where("id = ?", rule.ID).
+		Updates(map[string]interface{}{
+			"rule_type":   rule.RuleType,
+			"rule_value":  rule.RuleValue,
+			"target_url":  rule.TargetUrl,
+			"sort_order":  rule.SortOrder,
+			"update_time": gorm.Expr("NOW()"),
+		}).Error
+}
+
+// Delete 删除跳转规则（软删除）
+func (r *linkRedirectRuleRepo) Delete(ctx context.Context, id int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkRedirectRule{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"del_flag":    1,
+			"update_time": gorm.Expr("NOW()"),
+		}).Error
+}
+
+// UpdateGid 更新短链接跳转规则所属分组
+func (r *linkRedirectRuleRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkRedirectRule{}).
+		Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+		Updates(map[string]interface{}{
+			"gid":         gid,
+			"update_time": time.Now(),
+		}).Error
+}
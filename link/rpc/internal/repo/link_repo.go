@@ -2,7 +2,9 @@ package repo
 
 import (
 	"context"
+	"fmt"
 	"shorterurl/link/rpc/internal/model"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -60,8 +62,93 @@ type LinkRepo interface {
 
 	// 根据完整短链接和分组ID查询回收站中的链接（未启用状态的链接，即enable_status=1且del_flag=0）
 	FindRecycleBinByFullShortUrlAndGid(ctx context.Context, fullShortUrl, gid string) (*model.Link, error)
+
+	// 根据分组ID和目标链接健康状态分页查询短链接（正常状态的链接，即enable_status=0且del_flag=0）
+	FindByGidAndHealthStatus(ctx context.Context, gid string, healthStatus, page, pageSize int) ([]*model.Link, int64, error)
+
+	// 按ID顺序查询指定分片中需要检测目标链接的短链接（正常状态且上次检测早于checkedBefore）
+	FindHealthCheckBatch(ctx context.Context, shard int, afterID int64, checkedBefore time.Time, limit int) ([]*model.Link, error)
+
+	// 更新目标链接检测结果，只更新检测相关字段
+	UpdateHealth(ctx context.Context, link *model.Link) error
+
+	// 将短链接移动到其他分组，分组ID是分片键，需删除原分片记录后在目标分片重新写入
+	MoveToGroup(ctx context.Context, link *model.Link, targetGid string) error
+
+	// 按ID顺序查询分组中的短链接（包括未启用的链接，只排除永久删除的），用于导出时分批遍历
+	FindByGidAfterID(ctx context.Context, gid string, afterID int64, limit int) ([]*model.Link, error)
+
+	// 按筛选条件查询分组中的短链接（包括未启用的链接，只排除永久删除的），按ID顺序最多返回limit条
+	FindByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, limit int) ([]*model.Link, error)
+
+	// 批量更新分组中指定ID的短链接字段
+	BatchUpdateFields(ctx context.Context, gid string, ids []int64, fields map[string]interface{}) error
+
+	// 按筛选条件查询分组中的短链接并排序（包括未启用的链接，只排除永久删除的），最多返回limit条
+	SearchByGid(ctx context.Context, gid string, filter LinkFilter, order LinkOrder, limit int) ([]*model.Link, error)
+
+	// 按筛选条件统计分组中的短链接数量（包括未启用的链接，只排除永久删除的）
+	CountByGidAndFilter(ctx context.Context, gid string, filter LinkFilter) (int64, error)
+
+	// 按筛选条件查询分组中短链接的ID、分组和完整短链接，最多返回limit条，用于在内存中排序
+	FindBriefByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, limit int) ([]*model.Link, error)
+
+	// 按筛选条件分页查询分组中的短链接并排序（包括未启用的链接，只排除永久删除的）
+	PageByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, order LinkOrder, page, pageSize int) ([]*model.Link, int64, error)
+}
+
+// LinkFilter 短链接筛选条件，零值字段不参与筛选
+type LinkFilter struct {
+	// 创建时间起始（包含）
+	CreateStart *time.Time
+	// 创建时间截止（包含）
+	CreateEnd *time.Time
+	// 限定的短链接列表，非nil时只查询列表中的短链接
+	FullShortUrls []string
+	// 关键字，模糊匹配描述、原始链接和短链接后缀
+	Keyword string
+	// 启用状态 0：启用 1：未启用
+	EnableStatus *int
+	// 有效期晚于该时间（不包含），即未过期
+	ValidDateAfter *time.Time
+	// 有效期不晚于该时间（包含），即已过期
+	ValidDateUntil *time.Time
+	// 目标链接健康状态
+	HealthStatus *int
 }
 
+// 短链接排序字段
+const (
+	LinkOrderCreateTime = "create_time"
+	LinkOrderTotalPv    = "total_pv"
+	LinkOrderTotalUv    = "total_uv"
+)
+
+// LinkOrder 短链接排序方式，相同排序值按ID排序保证分页稳定
+type LinkOrder struct {
+	// 排序字段，只支持LinkOrderCreateTime、LinkOrderTotalPv和LinkOrderTotalUv，其他值按创建时间排序
+	Field string
+	// 是否升序
+	Asc bool
+}
+
+// clause 生成排序语句，排序字段使用白名单避免注入
+func (o LinkOrder) clause() string {
+	field := LinkOrderCreateTime
+	switch o.Field {
+	case LinkOrderTotalPv, LinkOrderTotalUv:
+		field = o.Field
+	}
+	direction := "DESC"
+	if o.Asc {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, id %s", field, direction, direction)
+}
+
+// likeEscaper 转义LIKE中的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 // linkRepo 短链接仓库实现
 type linkRepo struct {
 	db *gorm.DB
@@ -217,25 +304,46 @@ func (r *linkRepo) Update(ctx context.Context, link *model.Link) error {
 		Table(link.TableName()).
 		Where("id = ? AND gid = ?", link.ID, link.Gid). // 使用ID和分片键gid作为条件
 		Updates(map[string]interface{}{
-			"domain":          link.Domain,
-			"short_uri":       link.ShortUri,
-			"full_short_url":  link.FullShortUrl,
-			"origin_url":      link.OriginUrl,
-			"click_num":       link.ClickNum,
-			"gid":             link.Gid, // 包含分片键
-			"favicon":         link.Favicon,
-			"enable_status":   link.EnableStatus,
-			"created_type":    link.CreatedType,
-			"valid_date_type": link.ValidDateType,
-			"valid_date":      link.ValidDate,
-			"describe":        link.Describe,
-			"total_pv":        link.TotalPv,
-			"total_uv":        link.TotalUv,
-			"total_uip":       link.TotalUip,
-			"create_time":     link.CreateTime,
-			"update_time":     link.UpdateTime,
-			"del_time":        link.DelTime,
-			"del_flag":        link.DelFlag,
+			"domain":                 link.Domain,
+			"short_uri":              link.ShortUri,
+			"full_short_url":         link.FullShortUrl,
+			"origin_url":             link.OriginUrl,
+			"click_num":              link.ClickNum,
+			"max_clicks":             link.MaxClicks,
+			"gid":                    link.Gid, // 包含分片键
+			"favicon":                link.Favicon,
+			"enable_status":          link.EnableStatus,
+			"created_type":           link.CreatedType,
+			"valid_date_type":        link.ValidDateType,
+			"valid_from":             link.ValidFrom,
+			"valid_date":             link.ValidDate,
+			"expired_url":            link.ExpiredUrl,
+			"expired_message":        link.ExpiredMessage,
+			"grace_days":             link.GraceDays,
+			"redirect_type":          link.RedirectType,
+			"ios_deep_link":          link.IosDeepLink,
+			"android_package":        link.AndroidPackage,
+			"android_deep_link":      link.AndroidDeepLink,
+			"deep_link_fallback_url": link.DeepLinkFallbackUrl,
+			"og_title":               link.OgTitle,
+			"og_description":         link.OgDescription,
+			"og_image":               link.OgImage,
+			"safety_status":          link.SafetyStatus,
+			"safety_reason":          link.SafetyReason,
+			"describe":               link.Describe,
+			"password":               link.Password,
+			"query_param_policy":     link.QueryParamPolicy,
+			"utm_source":             link.UtmSource,
+			"utm_medium":             link.UtmMedium,
+			"utm_campaign":           link.UtmCampaign,
+			"total_pv":               link.TotalPv,
+			"total_uv":               link.TotalUv,
+			"total_uip":              link.TotalUip,
+			"create_time":            link.CreateTime,
+			"update_time":            link.UpdateTime,
+			"recycle_time":           link.RecycleTime,
+			"del_time":               link.DelTime,
+			"del_flag":               link.DelFlag,
 		}).Error
 }
 
@@ -348,3 +456,201 @@ func (r *linkRepo) FindRecycleBinByFullShortUrlAndGid(ctx context.Context, fullS
 	}
 	return &link, nil
 }
+
+// FindByGidAndHealthStatus 根据分组ID和目标链接健康状态分页查询短链接（正常状态，非回收站）
+func (r *linkRepo) FindByGidAndHealthStatus(ctx context.Context, gid string, healthStatus, page, pageSize int) ([]*model.Link, int64, error) {
+	var links []*model.Link
+	var count int64
+
+	query := r.db.WithContext(ctx).Model(&model.Link{}).
+		Where("gid = ?", gid).
+		Where("del_flag = ?", 0).      // 未被永久删除
+		Where("enable_status = ?", 0). // 正常状态，不在回收站中
+		Where("health_status = ?", healthStatus)
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Order("id DESC").
+		Find(&links).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return links, count, nil
+}
+
+// FindHealthCheckBatch 按ID顺序查询指定分片中需要检测目标链接的短链接
+// 遍历所有分组，没有分片键，因此直接指定分片表名
+func (r *linkRepo) FindHealthCheckBatch(ctx context.Context, shard int, afterID int64, checkedBefore time.Time, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	err := r.db.WithContext(ctx).
+		Table(fmt.Sprintf("%s_%d", model.Link{}.TableName(), shard)).
+		Where("id > ?", afterID).
+		Where("del_flag = ?", 0).      // 未被永久删除
+		Where("enable_status = ?", 0). // 正常状态，不在回收站中
+		Where("(health_check_time IS NULL OR health_check_time < ?)", checkedBefore).
+		Order("id ASC").
+		Limit(limit).
+		Find(&links).Error
+	return links, err
+}
+
+// UpdateHealth 更新目标链接检测结果，只更新检测相关字段，避免覆盖用户同时修改的内容
+func (r *linkRepo) UpdateHealth(ctx context.Context, link *model.Link) error {
+	return r.db.WithContext(ctx).
+		Table(link.TableName()).
+		Where("id = ? AND gid = ?", link.ID, link.Gid). // 使用ID和分片键gid作为条件
+		Updates(map[string]interface{}{
+			"health_status":     link.HealthStatus,
+			"health_code":       link.HealthCode,
+			"health_latency":    link.HealthLatency,
+			"health_redirects":  link.HealthRedirects,
+			"health_error":      link.HealthError,
+			"health_fail_count": link.HealthFailCount,
+			"health_check_time": link.HealthCheckTime,
+		}).Error
+}
+
+// MoveToGroup 将短链接移动到其他分组
+// 分组ID是分片键，不能直接更新，需删除原分片中的记录后在目标分片重新写入，保留原有ID及统计数据
+func (r *linkRepo) MoveToGroup(ctx context.Context, link *model.Link, targetGid string) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND gid = ?", link.ID, link.Gid).
+		Delete(&model.Link{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	link.Gid = targetGid
+	link.UpdateTime = time.Now()
+	return r.db.WithContext(ctx).Create(link).Error
+}
+
+// FindByGidAfterID 按ID顺序查询分组中ID大于afterID的短链接，包括未启用的链接
+func (r *linkRepo) FindByGidAfterID(ctx context.Context, gid string, afterID int64, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	err := r.db.WithContext(ctx).
+		Where("gid = ?", gid).
+		Where("id > ?", afterID).
+		Where("del_flag = ?", 0). // 未被永久删除
+		Order("id ASC").
+		Limit(limit).
+		Find(&links).Error
+	return links, err
+}
+
+// FindByGidAndFilter 按筛选条件查询分组中的短链接，包括未启用的链接
+func (r *linkRepo) FindByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	if filter.FullShortUrls != nil && len(filter.FullShortUrls) == 0 {
+		return links, nil
+	}
+
+	err := r.filterQuery(ctx, gid, filter).Order("id ASC").Limit(limit).Find(&links).Error
+	return links, err
+}
+
+// BatchUpdateFields 批量更新分组中指定ID的短链接字段，必须传入分片键gid
+func (r *linkRepo) BatchUpdateFields(ctx context.Context, gid string, ids []int64, fields map[string]interface{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&model.Link{}).
+		Where("gid = ? AND id IN ? AND del_flag = 0", gid, ids).
+		Updates(fields).Error
+}
+
+// SearchByGid 按筛选条件查询分组中的短链接并排序，包括未启用的链接
+func (r *linkRepo) SearchByGid(ctx context.Context, gid string, filter LinkFilter, order LinkOrder, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	if filter.FullShortUrls != nil && len(filter.FullShortUrls) == 0 {
+		return links, nil
+	}
+	err := r.filterQuery(ctx, gid, filter).Order(order.clause()).Limit(limit).Find(&links).Error
+	return links, err
+}
+
+// CountByGidAndFilter 按筛选条件统计分组中的短链接数量，包括未启用的链接
+func (r *linkRepo) CountByGidAndFilter(ctx context.Context, gid string, filter LinkFilter) (int64, error) {
+	var count int64
+	if filter.FullShortUrls != nil && len(filter.FullShortUrls) == 0 {
+		return 0, nil
+	}
+	err := r.filterQuery(ctx, gid, filter).Model(&model.Link{}).Count(&count).Error
+	return count, err
+}
+
+// FindBriefByGidAndFilter 按筛选条件查询分组中短链接的ID、分组和完整短链接
+func (r *linkRepo) FindBriefByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	if filter.FullShortUrls != nil && len(filter.FullShortUrls) == 0 {
+		return links, nil
+	}
+	err := r.filterQuery(ctx, gid, filter).
+		Select("id", "gid", "full_short_url").
+		Order("id ASC").
+		Limit(limit).
+		Find(&links).Error
+	return links, err
+}
+
+// PageByGidAndFilter 按筛选条件分页查询分组中的短链接并排序，包括未启用的链接
+func (r *linkRepo) PageByGidAndFilter(ctx context.Context, gid string, filter LinkFilter, order LinkOrder, page, pageSize int) ([]*model.Link, int64, error) {
+	var links []*model.Link
+	count, err := r.CountByGidAndFilter(ctx, gid, filter)
+	if err != nil || count == 0 {
+		return links, count, err
+	}
+
+	err = r.filterQuery(ctx, gid, filter).
+		Order(order.clause()).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&links).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return links, count, nil
+}
+
+// filterQuery 构建分组内按筛选条件查询未永久删除短链接的语句，必须带上分片键gid
+func (r *linkRepo) filterQuery(ctx context.Context, gid string, filter LinkFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Where("gid = ?", gid).
+		Where("del_flag = ?", 0) // 未被永久删除
+	if filter.CreateStart != nil {
+		query = query.Where("create_time >= ?", *filter.CreateStart)
+	}
+	if filter.CreateEnd != nil {
+		query = query.Where("create_time <= ?", *filter.CreateEnd)
+	}
+	if filter.FullShortUrls != nil {
+		query = query.Where("full_short_url IN ?", filter.FullShortUrls)
+	}
+	if filter.Keyword != "" {
+		// 分片插件的SQL解析器不支持MATCH AGAINST，使用LIKE模糊匹配
+		keyword := "%" + likeEscaper.Replace(filter.Keyword) + "%"
+		query = query.Where("(`describe` LIKE ? OR origin_url LIKE ? OR short_uri LIKE ?)", keyword, keyword, keyword)
+	}
+	if filter.EnableStatus != nil {
+		query = query.Where("enable_status = ?", *filter.EnableStatus)
+	}
+	if filter.ValidDateAfter != nil {
+		query = query.Where("valid_date > ?", *filter.ValidDateAfter)
+	}
+	if filter.ValidDateUntil != nil {
+		query = query.Where("valid_date <= ?", *filter.ValidDateUntil)
+	}
+	if filter.HealthStatus != nil {
+		query = query.Where("health_status = ?", *filter.HealthStatus)
+	}
+	return query
+}
@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LinkRetentionRepo 短链接数据保留仓库接口，供后台清理任务按分片遍历和清除短链接数据
+type LinkRetentionRepo interface {
+	// 按ID顺序查询指定分片中有效期早于expiredBefore的正常状态短链接
+	FindExpiredBatch(ctx context.Context, shard int, afterID int64, expiredBefore time.Time, limit int) ([]*model.Link, error)
+	// 按ID顺序查询指定分片中移入回收站时间早于recycledBefore的短链接，待审核的短链接除外
+	FindRecycleBinBatch(ctx context.Context, shard int, afterID int64, recycledBefore time.Time, limit int) ([]*model.Link, error)
+	// 按ID顺序查询指定分片中删除时间早于deletedBefore的已永久删除短链接
+	FindRemovedBatch(ctx context.Context, shard int, afterID int64, deletedBefore time.Time, limit int) ([]*model.Link, error)
+	// 清除短链接的访问统计、跳转规则、A/B分流、标签和变更记录
+	PurgeRelated(ctx context.Context, fullShortUrl string) error
+	// 删除短链接跳转记录
+	PurgeGoto(ctx context.Context, fullShortUrl string) error
+	// 删除短链接记录
+	PurgeLink(ctx context.Context, link *model.Link) error
+}
+
+// linkRetentionRepo 短链接数据保留仓库实现
+type linkRetentionRepo struct {
+	commonDB   *gorm.DB
+	linkDB     *gorm.DB
+	gotoLinkDB *gorm.DB
+}
+
+// NewLinkRetentionRepo 创建短链接数据保留仓库
+func NewLinkRetentionRepo(commonDB, linkDB, gotoLinkDB *gorm.DB) LinkRetentionRepo {
+	return &linkRetentionRepo{
+		commonDB:   commonDB,
+		linkDB:     linkDB,
+		gotoLinkDB: gotoLinkDB,
+	}
+}
+
+// shardQuery 遍历所有分组，没有分片键，因此直接指定分片表名
+func (r *linkRetentionRepo) shardQuery(ctx context.Context, shard int, afterID int64, limit int) *gorm.DB {
+	return r.linkDB.WithContext(ctx).
+		Table(fmt.Sprintf("%s_%d", model.Link{}.TableName(), shard)).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit)
+}
+
+// FindExpiredBatch 按ID顺序查询指定分片中有效期早于expiredBefore的正常状态短链接
+func (r *linkRetentionRepo) FindExpiredBatch(ctx context.Context, shard int, afterID int64, expiredBefore time.Time, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	err := r.shardQuery(ctx, shard, afterID, limit).
+		Where("del_flag = ?", 0).        // 未被永久删除
+		Where("enable_status = ?", 0).   // 正常状态，不在回收站中
+		Where("valid_date_type = ?", 1). // 自定义有效期
+		Where("valid_date < ?", expiredBefore).
+		Find(&links).Error
+	return links, err
+}
+
+// FindRecycleBinBatch 按ID顺序查询指定分片中移入回收站时间早于recycledBefore的短链接
+// 只有移入回收站的短链接记录了recycle_time，停用的短链接不会被清除；待审核的短链接由安全审核人员处理，不自动清除
+func (r *linkRetentionRepo) FindRecycleBinBatch(ctx context.Context, shard int, afterID int64, recycledBefore time.Time, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	err := r.shardQuery(ctx, shard, afterID, limit).
+		Where("del_flag = ?", 0).      // 未被永久删除
+		Where("enable_status = ?", 1). // 在回收站中
+		Where("safety_status <> ?", 1).
+		Where("recycle_time < ?", recycledBefore).
+		Find(&links).Error
+	return links, err
+}
+
+// FindRemovedBatch 按ID顺序查询指定分片中删除时间早于deletedBefore的已永久删除短链接
+func (r *linkRetentionRepo) FindRemovedBatch(ctx context.Context, shard int, afterID int64, deletedBefore time.Time, limit int) ([]*model.Link, error) {
+	var links []*model.Link
+	err := r.shardQuery(ctx, shard, afterID, limit).
+		Where("del_flag = ?", 1). // 已永久删除
+		Where("del_time < ?", deletedBefore.Unix()).
+		Find(&links).Error
+	return links, err
+}
+
+// PurgeRelated 在一个事务中清除短链接的访问统计、跳转规则、A/B分流、标签和变更记录
+// 安全审核记录用于追溯违规链接，不清除
+func (r *linkRetentionRepo) PurgeRelated(ctx context.Context, fullShortUrl string) error {
+	return r.commonDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, value := range []interface{}{
+			&model.LinkAccessLog{},
+			&model.LinkAccessStats{},
+			&model.LinkBrowserStats{},
+			&model.LinkDeviceStats{},
+			&model.LinkLocaleStats{},
+			&model.LinkNetworkStats{},
+			&model.LinkOsStats{},
+			&model.LinkStatsToday{},
+			&model.LinkRedirectRule{},
+			&model.LinkVariant{},
+			&model.LinkTag{},
+			&model.LinkHistory{},
+		} {
+			if err := tx.Where("full_short_url = ?", fullShortUrl).Delete(value).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PurgeGoto 删除短链接跳转记录
+// 注意：full_short_url是分片键，这个删除操作会被正确路由到对应的分片
+func (r *linkRetentionRepo) PurgeGoto(ctx context.Context, fullShortUrl string) error {
+	return r.gotoLinkDB.WithContext(ctx).
+		Where("full_short_url = ?", fullShortUrl).
+		Delete(&model.LinkGoto{}).Error
+}
+
+// PurgeLink 删除短链接记录，使用ID和分片键gid作为条件
+func (r *linkRetentionRepo) PurgeLink(ctx context.Context, link *model.Link) error {
+	return r.linkDB.WithContext(ctx).
+		Where("id = ? AND gid = ?", link.ID, link.Gid).
+		Delete(&model.Link{}).Error
+}
@@ -0,0 +1,164 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupRetentionDB 创建内存数据库，t_link_0作为分片表，其余表与短链接表共用同一个连接
+func setupRetentionDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("打开数据库失败: %v", err)
+	}
+	// 内存数据库每个连接相互独立，只使用一个连接
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("获取数据库连接失败: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.Table("t_link_0").AutoMigrate(&model.Link{}); err != nil {
+		t.Fatalf("创建短链接分片表失败: %v", err)
+	}
+	if err := db.AutoMigrate(&model.LinkRedirectRule{}, &model.LinkTag{}, &model.LinkHistory{}); err != nil {
+		t.Fatalf("创建关联表失败: %v", err)
+	}
+	return db
+}
+
+func TestLinkRetentionRepo_FindRecycleBinBatch(t *testing.T) {
+	db := setupRetentionDB(t)
+	ctx := context.Background()
+	now := time.Now()
+	recycledAt := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+
+	links := []*model.Link{
+		{FullShortUrl: "s.cn/old", EnableStatus: 1, RecycleTime: recycledAt(40)},
+		{FullShortUrl: "s.cn/recent", EnableStatus: 1, RecycleTime: recycledAt(10)},
+		// 停用的短链接没有移入回收站时间，即使很久没有修改也不清除
+		{FullShortUrl: "s.cn/disabled", EnableStatus: 1, UpdateTime: now.AddDate(0, 0, -100)},
+		{FullShortUrl: "s.cn/pending", EnableStatus: 1, SafetyStatus: 1, RecycleTime: recycledAt(40)},
+		{FullShortUrl: "s.cn/removed", EnableStatus: 1, DelFlag: 1, RecycleTime: recycledAt(40)},
+		{FullShortUrl: "s.cn/recovered", EnableStatus: 0},
+	}
+	if err := db.Table("t_link_0").Create(&links).Error; err != nil {
+		t.Fatalf("写入短链接失败: %v", err)
+	}
+
+	r := NewLinkRetentionRepo(db, db, db)
+	found, err := r.FindRecycleBinBatch(ctx, 0, 0, now.AddDate(0, 0, -30), 10)
+	if err != nil {
+		t.Fatalf("查询回收站短链接失败: %v", err)
+	}
+	if len(found) != 1 || found[0].FullShortUrl != "s.cn/old" {
+		t.Errorf("期望只命中s.cn/old，实际: %v", fullShortUrls(found))
+	}
+
+	// 按ID分批遍历
+	found, err = r.FindRecycleBinBatch(ctx, 0, found[0].ID, now.AddDate(0, 0, -30), 10)
+	if err != nil {
+		t.Fatalf("查询回收站短链接失败: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("下一批不应再命中，实际: %v", fullShortUrls(found))
+	}
+}
+
+func TestLinkRetentionRepo_FindExpiredAndRemovedBatch(t *testing.T) {
+	db := setupRetentionDB(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	links := []*model.Link{
+		{FullShortUrl: "s.cn/expired", ValidDateType: 1, ValidDate: now.AddDate(0, 0, -100)},
+		{FullShortUrl: "s.cn/permanent", ValidDateType: 0, ValidDate: now.AddDate(0, 0, -100)},
+		{FullShortUrl: "s.cn/valid", ValidDateType: 1, ValidDate: now.AddDate(0, 0, 10)},
+		{FullShortUrl: "s.cn/deleted", DelFlag: 1, DelTime: now.AddDate(0, 0, -10).Unix()},
+		{FullShortUrl: "s.cn/just-deleted", DelFlag: 1, DelTime: now.Unix()},
+	}
+	if err := db.Table("t_link_0").Create(&links).Error; err != nil {
+		t.Fatalf("写入短链接失败: %v", err)
+	}
+
+	r := NewLinkRetentionRepo(db, db, db)
+	expired, err := r.FindExpiredBatch(ctx, 0, 0, now.AddDate(0, 0, -90), 10)
+	if err != nil {
+		t.Fatalf("查询过期短链接失败: %v", err)
+	}
+	if len(expired) != 1 || expired[0].FullShortUrl != "s.cn/expired" {
+		t.Errorf("期望只命中s.cn/expired，实际: %v", fullShortUrls(expired))
+	}
+
+	removed, err := r.FindRemovedBatch(ctx, 0, 0, now.AddDate(0, 0, -7), 10)
+	if err != nil {
+		t.Fatalf("查询已删除短链接失败: %v", err)
+	}
+	if len(removed) != 1 || removed[0].FullShortUrl != "s.cn/deleted" {
+		t.Errorf("期望只命中s.cn/deleted，实际: %v", fullShortUrls(removed))
+	}
+}
+
+func TestLinkRetentionRepo_PurgeRelated(t *testing.T) {
+	db := setupRetentionDB(t)
+	ctx := context.Background()
+
+	for _, fullShortUrl := range []string{"s.cn/purge", "s.cn/keep"} {
+		if err := db.Create(&model.LinkRedirectRule{FullShortUrl: fullShortUrl, RuleType: "os", RuleValue: "iOS"}).Error; err != nil {
+			t.Fatalf("写入跳转规则失败: %v", err)
+		}
+		if err := db.Create(&model.LinkHistory{FullShortUrl: fullShortUrl, Action: "create"}).Error; err != nil {
+			t.Fatalf("写入变更记录失败: %v", err)
+		}
+	}
+
+	// A/B分流表不存在，删除跳转规则后事务失败，已删除的跳转规则应被回滚
+	if err := db.AutoMigrate(
+		&model.LinkAccessLog{}, &model.LinkAccessStats{}, &model.LinkBrowserStats{}, &model.LinkDeviceStats{},
+		&model.LinkLocaleStats{}, &model.LinkNetworkStats{}, &model.LinkOsStats{}, &model.LinkStatsToday{},
+	); err != nil {
+		t.Fatalf("创建统计表失败: %v", err)
+	}
+	r := NewLinkRetentionRepo(db, db, db)
+	if err := r.PurgeRelated(ctx, "s.cn/purge"); err == nil {
+		t.Fatal("缺少A/B分流表时期望清除失败")
+	}
+	var count int64
+	db.Model(&model.LinkRedirectRule{}).Where("full_short_url = ?", "s.cn/purge").Count(&count)
+	if count != 1 {
+		t.Errorf("事务失败后跳转规则不应被删除，剩余: %d", count)
+	}
+
+	if err := db.AutoMigrate(&model.LinkVariant{}); err != nil {
+		t.Fatalf("创建A/B分流表失败: %v", err)
+	}
+	if err := r.PurgeRelated(ctx, "s.cn/purge"); err != nil {
+		t.Fatalf("清除关联数据失败: %v", err)
+	}
+	for _, value := range []interface{}{&model.LinkRedirectRule{}, &model.LinkHistory{}} {
+		var purged, kept int64
+		db.Model(value).Where("full_short_url = ?", "s.cn/purge").Count(&purged)
+		db.Model(value).Where("full_short_url = ?", "s.cn/keep").Count(&kept)
+		if purged != 0 || kept != 1 {
+			t.Errorf("%T 清除结果不符合预期, 已清除短链接剩余: %d, 其他短链接剩余: %d", value, purged, kept)
+		}
+	}
+}
+
+func fullShortUrls(links []*model.Link) []string {
+	urls := make([]string, 0, len(links))
+	for _, link := range links {
+		urls = append(urls, link.FullShortUrl)
+	}
+	return urls
+}
@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 每次按短链接批量查询当日统计的最大数量
+const statsTodayQueryChunkSize = 1000
+
+// LinkStatsTodayRepo 短链接当日统计仓库接口
+type LinkStatsTodayRepo interface {
+	// 批量查询短链接指定日期的统计，返回完整短链接到统计的映射，没有访问的短链接不在结果中
+	FindByFullShortUrls(ctx context.Context, fullShortUrls []string, date time.Time) (map[string]*model.LinkStatsToday, error)
+}
+
+// linkStatsTodayRepo 短链接当日统计仓库实现
+type linkStatsTodayRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkStatsTodayRepo 创建短链接当日统计仓库
+func NewLinkStatsTodayRepo(db *gorm.DB) LinkStatsTodayRepo {
+	return &linkStatsTodayRepo{
+		db: db,
+	}
+}
+
+// FindByFullShortUrls 批量查询短链接指定日期的统计，短链接较多时分批查询
+func (r *linkStatsTodayRepo) FindByFullShortUrls(ctx context.Context, fullShortUrls []string, date time.Time) (map[string]*model.LinkStatsToday, error) {
+	result := make(map[string]*model.LinkStatsToday, len(fullShortUrls))
+	day := date.Format("2006-01-02")
+	for start := 0; start < len(fullShortUrls); start += statsTodayQueryChunkSize {
+		end := start + statsTodayQueryChunkSize
+		if end > len(fullShortUrls) {
+			end = len(fullShortUrls)
+		}
+
+		var stats []*model.LinkStatsToday
+		err := r.db.WithContext(ctx).
+			Where("full_short_url IN ?", fullShortUrls[start:end]).
+			Where("date = ?", day).
+			Find(&stats).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, stat := range stats {
+			result[stat.FullShortUrl] = stat
+		}
+	}
+	return result, nil
+}
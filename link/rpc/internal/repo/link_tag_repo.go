@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LinkTagRepo 短链接标签仓库接口
+type LinkTagRepo interface {
+	// 批量查询短链接的标签，返回完整短链接到标签列表的映射
+	FindByFullShortUrls(ctx context.Context, fullShortUrls []string) (map[string][]string, error)
+	// 替换短链接的所有标签，tags为空时仅删除
+	ReplaceByFullShortUrl(ctx context.Context, gid, fullShortUrl string, tags []string) error
+	// 更新短链接标签所属分组
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
+	// 查询分组中带有指定标签的短链接
+	FindFullShortUrlsByGidAndTag(ctx context.Context, gid, tag string) ([]string, error)
+}
+
+// linkTagRepo 短链接标签仓库实现
+type linkTagRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkTagRepo 创建短链接标签仓库
+func NewLinkTagRepo(db *gorm.DB) LinkTagRepo {
+	return &linkTagRepo{
+		db: db,
+	}
+}
+
+// FindByFullShortUrls 批量查询短链接的标签，返回完整短链接到标签列表的映射
+func (r *linkTagRepo) FindByFullShortUrls(ctx context.Context, fullShortUrls []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(fullShortUrls))
+	if len(fullShortUrls) == 0 {
+		return result, nil
+	}
+
+	var tags []*model.LinkTag
+	err := r.db.WithContext(ctx).
+		Where("full_short_url IN ?", fullShortUrls).
+		Order("id ASC").
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		result[tag.FullShortUrl] = append(result[tag.FullShortUrl], tag.Tag)
+	}
+	return result, nil
+}
+
+// ReplaceByFullShortUrl 替换短链接的所有标签，tags为空时仅删除
+func (r *linkTagRepo) ReplaceByFullShortUrl(ctx context.Context, gid, fullShortUrl string, tags []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("full_short_url = ?", fullShortUrl).
+			Delete(&model.LinkTag{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(tags) == 0 {
+			return nil
+		}
+		now := time.Now()
+		records := make([]*model.LinkTag, 0, len(tags))
+		for _, tag := range tags {
+			records = append(records, &model.LinkTag{
+				Gid:          gid,
+				FullShortUrl: fullShortUrl,
+				Tag:          tag,
+				CreateTime:   now,
+			})
+		}
+		return tx.Create(&records).Error
+	})
+}
+
+// UpdateGid 更新短链接标签所属分组
+func (r *linkTagRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkTag{}).
+		Where("full_short_url = ?", fullShortUrl).
+		Update("gid", gid).Error
+}
+
+// FindFullShortUrlsByGidAndTag 查询分组中带有指定标签的短链接
+func (r *linkTagRepo) FindFullShortUrlsByGidAndTag(ctx context.Context, gid, tag string) ([]string, error) {
+	fullShortUrls := make([]string, 0)
+	err := r.db.WithContext(ctx).
+		Model(&model.LinkTag{}).
+		Where("gid = ? AND tag = ?", gid, tag).
+		Pluck("full_short_url", &fullShortUrls).Error
+	return fullShortUrls, err
+}
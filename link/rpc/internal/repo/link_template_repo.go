@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// LinkTemplateRepo 短链接模板仓库接口
+type LinkTemplateRepo interface {
+	// 创建模板
+	Create(ctx context.Context, template *model.LinkTemplate) error
+	// 更新模板
+	Update(ctx context.Context, template *model.LinkTemplate) error
+	// 删除用户的模板
+	Delete(ctx context.Context, id int64, username string) error
+	// 根据ID查询用户的模板
+	FindByIDAndUsername(ctx context.Context, id int64, username string) (*model.LinkTemplate, error)
+	// 根据名称查询用户的模板
+	FindByUsernameAndName(ctx context.Context, username, name string) (*model.LinkTemplate, error)
+	// 查询用户的全部模板，按创建顺序排列
+	FindByUsername(ctx context.Context, username string) ([]*model.LinkTemplate, error)
+	// 统计用户的模板数量
+	CountByUsername(ctx context.Context, username string) (int64, error)
+}
+
+// linkTemplateRepo 短链接模板仓库实现
+type linkTemplateRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkTemplateRepo 创建短链接模板仓库
+func NewLinkTemplateRepo(db *gorm.DB) LinkTemplateRepo {
+	return &linkTemplateRepo{
+		db: db,
+	}
+}
+
+// Create 创建模板
+func (r *linkTemplateRepo) Create(ctx context.Context, template *model.LinkTemplate) error {
+	return r.db.WithContext(ctx).Create(template).Error
+}
+
+// Update 更新模板
+func (r *linkTemplateRepo) Update(ctx context.Context, template *model.LinkTemplate) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkTemplate{}).
+		Where("id = ? AND username = ?", template.ID, template.Username).
+		Updates(map[string]interface{}{
+			"name":               template.Name,
+			"gid":                template.Gid,
+			"domain":             template.Domain,
+			"valid_days":         template.ValidDays,
+			"describe":           template.Describe,
+			"query_param_policy": template.QueryParamPolicy,
+			"utm_source":         template.UtmSource,
+			"utm_medium":         template.UtmMedium,
+			"utm_campaign":       template.UtmCampaign,
+			"redirect_type":      template.RedirectType,
+			"expired_url":        template.ExpiredUrl,
+			"expired_message":    template.ExpiredMessage,
+			"grace_days":         template.GraceDays,
+			"tags":               template.Tags,
+			"update_time":        template.UpdateTime,
+		}).Error
+}
+
+// Delete 删除用户的模板，模板名称唯一，直接删除以便重新使用名称
+func (r *linkTemplateRepo) Delete(ctx context.Context, id int64, username string) error {
+	return r.db.WithContext(ctx).
+		Where("id = ? AND username = ?", id, username).
+		Delete(&model.LinkTemplate{}).Error
+}
+
+// FindByIDAndUsername 根据ID查询用户的模板
+func (r *linkTemplateRepo) FindByIDAndUsername(ctx context.Context, id int64, username string) (*model.LinkTemplate, error) {
+	var template model.LinkTemplate
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND username = ?", id, username).
+		First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByUsernameAndName 根据名称查询用户的模板
+func (r *linkTemplateRepo) FindByUsernameAndName(ctx context.Context, username, name string) (*model.LinkTemplate, error) {
+	var template model.LinkTemplate
+	err := r.db.WithContext(ctx).
+		Where("username = ? AND name = ?", username, name).
+		First(&template).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByUsername 查询用户的全部模板，按创建顺序排列
+func (r *linkTemplateRepo) FindByUsername(ctx context.Context, username string) ([]*model.LinkTemplate, error) {
+	var templates []*model.LinkTemplate
+	err := r.db.WithContext(ctx).
+		Where("username = ?", username).
+		Order("id ASC").
+		Find(&templates).Error
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// CountByUsername 统计用户的模板数量
+func (r *linkTemplateRepo) CountByUsername(ctx context.Context, username string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.LinkTemplate{}).
+		Where("username = ?", username).
+		Count(&count).Error
+	return count, err
+}
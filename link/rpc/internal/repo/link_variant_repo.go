@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// LinkVariantRepo 短链接A/B分流目标链接仓库接口
+type LinkVariantRepo interface {
+	// 查询短链接的所有分流版本
+	FindByFullShortUrl(ctx context.Context, fullShortUrl string) ([]*model.LinkVariant, error)
+	// 替换短链接的所有分流版本，variants为空时仅删除
+	ReplaceByFullShortUrl(ctx context.Context, fullShortUrl string, variants []*model.LinkVariant) error
+	// 更新短链接分流版本所属分组
+	UpdateGid(ctx context.Context, fullShortUrl, gid string) error
+}
+
+// linkVariantRepo 短链接A/B分流目标链接仓库实现
+type linkVariantRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkVariantRepo 创建短链接A/B分流目标链接仓库
+func NewLinkVariantRepo(db *gorm.DB) LinkVariantRepo {
+	return &linkVariantRepo{
+		db: db,
+	}
+}
+
+// FindByFullShortUrl 查询短链接的所有分流版本
+func (r *linkVariantRepo) FindByFullShortUrl(ctx context.Context, fullShortUrl string) ([]*model.LinkVariant, error) {
+	var variants []*model.LinkVariant
+	err := r.db.WithContext(ctx).
+		Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+		Order("id ASC").
+		Find(&variants).Error
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// ReplaceByFullShortUrl 替换短链接的所有分流版本，variants为空时仅删除
+func (r *linkVariantRepo) ReplaceByFullShortUrl(ctx context.Context, fullShortUrl string, variants []*model.LinkVariant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.LinkVariant{}).
+			Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+			Updates(map[string]interface{}{
+				"del_flag":    1,
+				"update_time": gorm.Expr("NOW()"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(variants) == 0 {
+			return nil
+		}
+		return tx.Create(&variants).Error
+	})
+}
+
+// UpdateGid 更新短链接分流版本所属分组
+func (r *linkVariantRepo) UpdateGid(ctx context.Context, fullShortUrl, gid string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.LinkVariant{}).
+		Where("full_short_url = ? AND del_flag = 0", fullShortUrl).
+		Updates(map[string]interface{}{
+			"gid":         gid,
+			"update_time": gorm.Expr("NOW()"),
+		}).Error
+}
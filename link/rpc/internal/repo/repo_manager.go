@@ -38,6 +38,17 @@ type RepoManager struct {
 	LinkOsStats      LinkOsStatsRepo
 	LinkDeviceStats  LinkDeviceStatsRepo
 	LinkNetworkStats LinkNetworkStatsRepo
+	UserDomain       UserDomainRepo
+	RedirectRule     LinkRedirectRuleRepo
+	Variant          LinkVariantRepo
+	Tag              LinkTagRepo
+	GroupExpiry      GroupExpiryPolicyRepo
+	GroupTransfer    GroupTransferRepo
+	Moderation       LinkModerationRepo
+	StatsToday       LinkStatsTodayRepo
+	History          LinkHistoryRepo
+	Template         LinkTemplateRepo
+	Retention        LinkRetentionRepo
 
 	// 添加对 LinkDB 的引用，以便传递给需要的 Repo
 	linkDB *gorm.DB
@@ -70,6 +81,17 @@ func NewRepoManager(common, linkDB, gotoLinkDB, groupDB, userDB *gorm.DB) *RepoM
 		LinkOsStats:      NewLinkOsStatsRepo(dbs.Common, dbs.LinkDB),      // 传递 LinkDB
 		LinkDeviceStats:  NewLinkDeviceStatsRepo(dbs.Common, dbs.LinkDB),  // 传递 LinkDB
 		LinkNetworkStats: NewLinkNetworkStatsRepo(dbs.Common, dbs.LinkDB), // 传递 LinkDB
+		UserDomain:       NewUserDomainRepo(dbs.Common),
+		RedirectRule:     NewLinkRedirectRuleRepo(dbs.Common),
+		Variant:          NewLinkVariantRepo(dbs.Common),
+		Tag:              NewLinkTagRepo(dbs.Common),
+		GroupExpiry:      NewGroupExpiryPolicyRepo(dbs.Common),
+		GroupTransfer:    NewGroupTransferRepo(dbs.Common),
+		Moderation:       NewLinkModerationRepo(dbs.Common),
+		StatsToday:       NewLinkStatsTodayRepo(dbs.Common),
+		History:          NewLinkHistoryRepo(dbs.Common),
+		Template:         NewLinkTemplateRepo(dbs.Common),
+		Retention:        NewLinkRetentionRepo(dbs.Common, dbs.LinkDB, dbs.GotoLinkDB),
 	}
 }
 
@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"shorterurl/link/rpc/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// 域名验证状态
+const (
+	// 未验证
+	DomainVerifyStatusPending = 0
+	// 已验证
+	DomainVerifyStatusVerified = 1
+)
+
+// UserDomainRepo 用户自定义域名仓库接口
+type UserDomainRepo interface {
+	// 查询用户名下已验证的域名
+	FindVerifiedByUsernameAndDomain(ctx context.Context, username, domain string) (*model.UserDomain, error)
+	// 查询用户的所有域名
+	FindByUsername(ctx context.Context, username string) ([]*model.UserDomain, error)
+	// 创建域名记录
+	Create(ctx context.Context, userDomain *model.UserDomain) error
+	// 更新域名验证状态
+	UpdateVerifyStatus(ctx context.Context, id int64, verifyStatus int) error
+	// 根据域名查询域名记录（包括未验证的）
+	FindByDomain(ctx context.Context, domain string) (*model.UserDomain, error)
+	// 将未验证的域名记录转给新的申请用户，并重新生成验证令牌
+	UpdateClaim(ctx context.Context, id int64, username, verifyToken string) error
+	// 根据域名查询已验证的域名
+	FindVerifiedByDomain(ctx context.Context, domain string) (*model.UserDomain, error)
+	// 更新域名关联的应用信息
+	UpdateAppLinks(ctx context.Context, id int64, appleAppIds, androidPackage, androidCertFingerprints string) error
+}
+
+// userDomainRepo 用户自定义域名仓库实现
+type userDomainRepo struct {
+	db *gorm.DB
+}
+
+// NewUserDomainRepo 创建用户自定义域名仓库
+func NewUserDomainRepo(db *gorm.DB) UserDomainRepo {
+	return &userDomainRepo{
+		db: db,
+	}
+}
+
+// FindVerifiedByUsernameAndDomain 查询用户名下已验证的域名
+func (r *userDomainRepo) FindVerifiedByUsernameAndDomain(ctx context.Context, username, domain string) (*model.UserDomain, error) {
+	var userDomain model.UserDomain
+	err := r.db.WithContext(ctx).
+		Where("username = ? AND domain = ? AND verify_status = ? AND del_flag = 0", username, domain, DomainVerifyStatusVerified).
+		First(&userDomain).Error
+	if err != nil {
+		return nil, err
+	}
+	return &userDomain, nil
+}
+
+// FindByUsername 查询用户的所有域名
+func (r *userDomainRepo) FindByUsername(ctx context.Context, username string) ([]*model.UserDomain, error) {
+	var userDomains []*model.UserDomain
+	err := r.db.WithContext(ctx).
+		Where("username = ? AND del_flag = 0", username).
+		Order("id ASC").
+		Find(&userDomains).Error
+	if err != nil {
+		return nil, err
+	}
+	return userDomains, nil
+}
+
+// Create 创建域名记录
+func (r *userDomainRepo) Create(ctx context.Context, userDomain *model.UserDomain) error {
+	return r.db.WithContext(ctx).Create(userDomain).Error
+}
+
+// UpdateVerifyStatus 更新域名验证状态
+func (r *userDomainRepo) UpdateVerifyStatus(ctx context.Context, id int64, verifyStatus int) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserDomain{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"verify_status": verifyStatus,
+			"update_time":   gorm.Expr("NOW()"),
+		}).Error
+}
+
+// FindByDomain 根据域名查询域名记录（包括未验证的）
+func (r *userDomainRepo) FindByDomain(ctx context.Context, domain string) (*model.UserDomain, error) {
+	var userDomain model.UserDomain
+	err := r.db.WithContext(ctx).
+		Where("domain = ? AND del_flag = 0", domain).
+		First(&userDomain).Error
+	if err != nil {
+		return nil, err
+	}
+	return &userDomain, nil
+}
+
+// UpdateClaim 将未验证的域名记录转给新的申请用户，并重新生成验证令牌
+// 只更新未验证的记录，避免覆盖已验证的域名
+func (r *userDomainRepo) UpdateClaim(ctx context.Context, id int64, username, verifyToken string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserDomain{}).
+		Where("id = ? AND verify_status = ?", id, DomainVerifyStatusPending).
+		Updates(map[string]interface{}{
+			"username":     username,
+			"verify_token": verifyToken,
+			"update_time":  gorm.Expr("NOW()"),
+		}).Error
+}
+
+// FindVerifiedByDomain 根据域名查询已验证的域名
+func (r *userDomainRepo) FindVerifiedByDomain(ctx context.Context, domain string) (*model.UserDomain, error) {
+	var userDomain model.UserDomain
+	err := r.db.WithContext(ctx).
+		Where("domain = ? AND verify_status = ? AND del_flag = 0", domain, DomainVerifyStatusVerified).
+		First(&userDomain).Error
+	if err != nil {
+		return nil, err
+	}
+	return &userDomain, nil
+}
+
+// UpdateAppLinks 更新域名关联的应用信息
+func (r *userDomainRepo) UpdateAppLinks(ctx context.Context, id int64, appleAppIds, androidPackage, androidCertFingerprints string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserDomain{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"apple_app_ids":             appleAppIds,
+			"android_package":           androidPackage,
+			"android_cert_fingerprints": androidCertFingerprints,
+			"update_time":               gorm.Expr("NOW()"),
+		}).Error
+}
@@ -44,18 +44,96 @@ func (s *ShortLinkServiceServer) ShortLinkPage(ctx context.Context, in *pb.PageS
 	return l.ShortLinkPage(in)
 }
 
+// 跨分组搜索短链接
+func (s *ShortLinkServiceServer) ShortLinkSearch(ctx context.Context, in *pb.SearchShortLinkRequest) (*pb.SearchShortLinkResponse, error) {
+	l := logic.NewShortLinkSearchLogic(ctx, s.svcCtx)
+	return l.ShortLinkSearch(in)
+}
+
+// 生成短链接二维码
+func (s *ShortLinkServiceServer) ShortLinkQrCode(ctx context.Context, in *pb.ShortLinkQrCodeRequest) (*pb.ShortLinkQrCodeResponse, error) {
+	l := logic.NewShortLinkQrCodeLogic(ctx, s.svcCtx)
+	return l.ShortLinkQrCode(in)
+}
+
 // 查询短链接分组内数量
 func (s *ShortLinkServiceServer) ShortLinkListGroupCount(ctx context.Context, in *pb.GroupShortLinkCountRequest) (*pb.GroupShortLinkCountResponse, error) {
 	l := logic.NewShortLinkListGroupCountLogic(ctx, s.svcCtx)
 	return l.ShortLinkListGroupCount(in)
 }
 
+// 移动短链接到其他分组
+func (s *ShortLinkServiceServer) ShortLinkMove(ctx context.Context, in *pb.MoveShortLinkRequest) (*pb.MoveShortLinkResponse, error) {
+	l := logic.NewShortLinkMoveLogic(ctx, s.svcCtx)
+	return l.ShortLinkMove(in)
+}
+
+// 复制短链接的配置到新的短链接
+func (s *ShortLinkServiceServer) ShortLinkClone(ctx context.Context, in *pb.CloneShortLinkRequest) (*pb.CloneShortLinkResponse, error) {
+	l := logic.NewShortLinkCloneLogic(ctx, s.svcCtx)
+	return l.ShortLinkClone(in)
+}
+
+// 批量操作短链接
+func (s *ShortLinkServiceServer) ShortLinkBulkAction(ctx context.Context, in *pb.BulkActionRequest) (*pb.BulkActionResponse, error) {
+	l := logic.NewShortLinkBulkActionLogic(ctx, s.svcCtx)
+	return l.ShortLinkBulkAction(in)
+}
+
+// 查询短链接变更记录
+func (s *ShortLinkServiceServer) ShortLinkHistoryList(ctx context.Context, in *pb.ListLinkHistoryRequest) (*pb.ListLinkHistoryResponse, error) {
+	l := logic.NewShortLinkHistoryListLogic(ctx, s.svcCtx)
+	return l.ShortLinkHistoryList(in)
+}
+
+// 回滚短链接到指定变更记录
+func (s *ShortLinkServiceServer) ShortLinkRollback(ctx context.Context, in *pb.RollbackShortLinkRequest) (*pb.RollbackShortLinkResponse, error) {
+	l := logic.NewShortLinkRollbackLogic(ctx, s.svcCtx)
+	return l.ShortLinkRollback(in)
+}
+
+// 批量导入短链接
+func (s *ShortLinkServiceServer) ShortLinkImport(ctx context.Context, in *pb.ImportShortLinkRequest) (*pb.ImportShortLinkResponse, error) {
+	l := logic.NewShortLinkImportLogic(ctx, s.svcCtx)
+	return l.ShortLinkImport(in)
+}
+
+// 查询短链接导入任务
+func (s *ShortLinkServiceServer) ShortLinkImportJobGet(ctx context.Context, in *pb.GetImportJobRequest) (*pb.GetImportJobResponse, error) {
+	l := logic.NewShortLinkImportJobGetLogic(ctx, s.svcCtx)
+	return l.ShortLinkImportJobGet(in)
+}
+
+// 导出短链接及访问数据
+func (s *ShortLinkServiceServer) ShortLinkExport(ctx context.Context, in *pb.ExportShortLinkRequest) (*pb.ExportShortLinkResponse, error) {
+	l := logic.NewShortLinkExportLogic(ctx, s.svcCtx)
+	return l.ShortLinkExport(in)
+}
+
+// 查询短链接导出任务
+func (s *ShortLinkServiceServer) ShortLinkExportJobGet(ctx context.Context, in *pb.GetExportJobRequest) (*pb.GetExportJobResponse, error) {
+	l := logic.NewShortLinkExportJobGetLogic(ctx, s.svcCtx)
+	return l.ShortLinkExportJobGet(in)
+}
+
+// 下载短链接导出文件
+func (s *ShortLinkServiceServer) ShortLinkExportDownload(ctx context.Context, in *pb.DownloadExportRequest) (*pb.DownloadExportResponse, error) {
+	l := logic.NewShortLinkExportDownloadLogic(ctx, s.svcCtx)
+	return l.ShortLinkExportDownload(in)
+}
+
 // 短链接跳转
 func (s *ShortLinkServiceServer) RestoreUrl(ctx context.Context, in *pb.RestoreUrlRequest) (*pb.RestoreUrlResponse, error) {
 	l := logic.NewRestoreUrlLogic(ctx, s.svcCtx)
 	return l.RestoreUrl(in)
 }
 
+// 验证短链接访问密码
+func (s *ShortLinkServiceServer) VerifyLinkPassword(ctx context.Context, in *pb.VerifyLinkPasswordRequest) (*pb.VerifyLinkPasswordResponse, error) {
+	l := logic.NewVerifyLinkPasswordLogic(ctx, s.svcCtx)
+	return l.VerifyLinkPassword(in)
+}
+
 // 短链接统计
 func (s *ShortLinkServiceServer) ShortLinkStats(ctx context.Context, in *pb.ShortLinkStatsRequest) (*pb.EmptyResponse, error) {
 	l := logic.NewShortLinkStatsLogic(ctx, s.svcCtx)
@@ -104,12 +182,134 @@ func (s *ShortLinkServiceServer) StatsGroupAccessRecordQuery(ctx context.Context
 	return l.StatsGroupAccessRecordQuery(in)
 }
 
+// --------------------- 自定义域名接口 ---------------------
+func (s *ShortLinkServiceServer) UserDomainRegister(ctx context.Context, in *pb.RegisterUserDomainRequest) (*pb.RegisterUserDomainResponse, error) {
+	l := logic.NewUserDomainRegisterLogic(ctx, s.svcCtx)
+	return l.UserDomainRegister(in)
+}
+
+func (s *ShortLinkServiceServer) UserDomainVerify(ctx context.Context, in *pb.VerifyUserDomainRequest) (*pb.VerifyUserDomainResponse, error) {
+	l := logic.NewUserDomainVerifyLogic(ctx, s.svcCtx)
+	return l.UserDomainVerify(in)
+}
+
+func (s *ShortLinkServiceServer) UserDomainList(ctx context.Context, in *pb.ListUserDomainRequest) (*pb.ListUserDomainResponse, error) {
+	l := logic.NewUserDomainListLogic(ctx, s.svcCtx)
+	return l.UserDomainList(in)
+}
+
+// --------------------- 跳转规则管理接口 ---------------------
+func (s *ShortLinkServiceServer) RedirectRuleCreate(ctx context.Context, in *pb.CreateRedirectRuleRequest) (*pb.CreateRedirectRuleResponse, error) {
+	l := logic.NewRedirectRuleCreateLogic(ctx, s.svcCtx)
+	return l.RedirectRuleCreate(in)
+}
+
+func (s *ShortLinkServiceServer) RedirectRuleUpdate(ctx context.Context, in *pb.UpdateRedirectRuleRequest) (*pb.UpdateRedirectRuleResponse, error) {
+	l := logic.NewRedirectRuleUpdateLogic(ctx, s.svcCtx)
+	return l.RedirectRuleUpdate(in)
+}
+
+func (s *ShortLinkServiceServer) RedirectRuleDelete(ctx context.Context, in *pb.DeleteRedirectRuleRequest) (*pb.DeleteRedirectRuleResponse, error) {
+	l := logic.NewRedirectRuleDeleteLogic(ctx, s.svcCtx)
+	return l.RedirectRuleDelete(in)
+}
+
+func (s *ShortLinkServiceServer) RedirectRuleList(ctx context.Context, in *pb.ListRedirectRuleRequest) (*pb.ListRedirectRuleResponse, error) {
+	l := logic.NewRedirectRuleListLogic(ctx, s.svcCtx)
+	return l.RedirectRuleList(in)
+}
+
+// --------------------- 短链接模板接口 ---------------------
+func (s *ShortLinkServiceServer) LinkTemplateCreate(ctx context.Context, in *pb.CreateLinkTemplateRequest) (*pb.CreateLinkTemplateResponse, error) {
+	l := logic.NewLinkTemplateCreateLogic(ctx, s.svcCtx)
+	return l.LinkTemplateCreate(in)
+}
+
+func (s *ShortLinkServiceServer) LinkTemplateUpdate(ctx context.Context, in *pb.UpdateLinkTemplateRequest) (*pb.UpdateLinkTemplateResponse, error) {
+	l := logic.NewLinkTemplateUpdateLogic(ctx, s.svcCtx)
+	return l.LinkTemplateUpdate(in)
+}
+
+func (s *ShortLinkServiceServer) LinkTemplateDelete(ctx context.Context, in *pb.DeleteLinkTemplateRequest) (*pb.DeleteLinkTemplateResponse, error) {
+	l := logic.NewLinkTemplateDeleteLogic(ctx, s.svcCtx)
+	return l.LinkTemplateDelete(in)
+}
+
+func (s *ShortLinkServiceServer) LinkTemplateList(ctx context.Context, in *pb.ListLinkTemplateRequest) (*pb.ListLinkTemplateResponse, error) {
+	l := logic.NewLinkTemplateListLogic(ctx, s.svcCtx)
+	return l.LinkTemplateList(in)
+}
+
+// --------------------- 分组过期策略接口 ---------------------
+func (s *ShortLinkServiceServer) GroupExpiryPolicySave(ctx context.Context, in *pb.SaveGroupExpiryPolicyRequest) (*pb.SaveGroupExpiryPolicyResponse, error) {
+	l := logic.NewGroupExpiryPolicySaveLogic(ctx, s.svcCtx)
+	return l.GroupExpiryPolicySave(in)
+}
+
+func (s *ShortLinkServiceServer) GroupExpiryPolicyGet(ctx context.Context, in *pb.GetGroupExpiryPolicyRequest) (*pb.GetGroupExpiryPolicyResponse, error) {
+	l := logic.NewGroupExpiryPolicyGetLogic(ctx, s.svcCtx)
+	return l.GroupExpiryPolicyGet(in)
+}
+
+// --------------------- 分组转让接口 ---------------------
+func (s *ShortLinkServiceServer) GroupTransferCreate(ctx context.Context, in *pb.CreateGroupTransferRequest) (*pb.CreateGroupTransferResponse, error) {
+	l := logic.NewGroupTransferCreateLogic(ctx, s.svcCtx)
+	return l.GroupTransferCreate(in)
+}
+
+func (s *ShortLinkServiceServer) GroupTransferList(ctx context.Context, in *pb.ListGroupTransferRequest) (*pb.ListGroupTransferResponse, error) {
+	l := logic.NewGroupTransferListLogic(ctx, s.svcCtx)
+	return l.GroupTransferList(in)
+}
+
+func (s *ShortLinkServiceServer) GroupTransferRespond(ctx context.Context, in *pb.RespondGroupTransferRequest) (*pb.RespondGroupTransferResponse, error) {
+	l := logic.NewGroupTransferRespondLogic(ctx, s.svcCtx)
+	return l.GroupTransferRespond(in)
+}
+
+func (s *ShortLinkServiceServer) GroupTransferCancel(ctx context.Context, in *pb.CancelGroupTransferRequest) (*pb.CancelGroupTransferResponse, error) {
+	l := logic.NewGroupTransferCancelLogic(ctx, s.svcCtx)
+	return l.GroupTransferCancel(in)
+}
+
+// --------------------- 链接安全审核接口 ---------------------
+func (s *ShortLinkServiceServer) ModerationPage(ctx context.Context, in *pb.PageModerationRequest) (*pb.PageModerationResponse, error) {
+	l := logic.NewModerationPageLogic(ctx, s.svcCtx)
+	return l.ModerationPage(in)
+}
+
+func (s *ShortLinkServiceServer) ModerationReview(ctx context.Context, in *pb.ReviewModerationRequest) (*pb.ReviewModerationResponse, error) {
+	l := logic.NewModerationReviewLogic(ctx, s.svcCtx)
+	return l.ModerationReview(in)
+}
+
+func (s *ShortLinkServiceServer) ModerationFlag(ctx context.Context, in *pb.FlagModerationRequest) (*pb.FlagModerationResponse, error) {
+	l := logic.NewModerationFlagLogic(ctx, s.svcCtx)
+	return l.ModerationFlag(in)
+}
+
+// --------------------- 域名应用关联接口 ---------------------
+func (s *ShortLinkServiceServer) DomainAppLinksSave(ctx context.Context, in *pb.SaveDomainAppLinksRequest) (*pb.SaveDomainAppLinksResponse, error) {
+	l := logic.NewDomainAppLinksSaveLogic(ctx, s.svcCtx)
+	return l.DomainAppLinksSave(in)
+}
+
+func (s *ShortLinkServiceServer) DomainAppLinksGet(ctx context.Context, in *pb.GetDomainAppLinksRequest) (*pb.GetDomainAppLinksResponse, error) {
+	l := logic.NewDomainAppLinksGetLogic(ctx, s.svcCtx)
+	return l.DomainAppLinksGet(in)
+}
+
 // --------------------- URL标题功能接口 ---------------------
 func (s *ShortLinkServiceServer) UrlTitleGet(ctx context.Context, in *pb.GetUrlTitleRequest) (*pb.GetUrlTitleResponse, error) {
 	l := logic.NewUrlTitleGetLogic(ctx, s.svcCtx)
 	return l.UrlTitleGet(in)
 }
 
+func (s *ShortLinkServiceServer) ShortLinkPreview(ctx context.Context, in *pb.ShortLinkPreviewRequest) (*pb.ShortLinkPreviewResponse, error) {
+	l := logic.NewShortLinkPreviewLogic(ctx, s.svcCtx)
+	return l.ShortLinkPreview(in)
+}
+
 // --------------------- IP位置查询接口 ---------------------
 func (s *ShortLinkServiceServer) GetIpLocation(ctx context.Context, in *pb.GetIPLocationRequest) (*pb.GetIPLocationResponse, error) {
 	l := logic.NewGetIpLocationLogic(ctx, s.svcCtx)
@@ -80,6 +80,19 @@ func (d *DBs) GetLinkDB() *gorm.DB {
 	return d.LinkDB
 }
 
+// GetGotoLinkDB 获取短链接跳转数据库连接
+func (d *DBs) GetGotoLinkDB() *gorm.DB {
+	return d.GotoLinkDB
+}
+
+// JoinTx 让db复用tx的事务连接
+// 各数据库对象连接同一个库，仅分片规则不同，复用连接后跨分片表的写入可在同一事务中提交或回滚
+func JoinTx(db, tx *gorm.DB) *gorm.DB {
+	joined := db.Session(&gorm.Session{NewDB: true, Context: tx.Statement.Context})
+	joined.Statement.ConnPool = tx.Statement.ConnPool
+	return joined
+}
+
 // InitDBs 初始化所有数据库连接
 func InitDBs(c config.Config, idGen func() int64) (*DBs, error) {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
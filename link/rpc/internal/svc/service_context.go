@@ -5,19 +5,28 @@ import (
 	"shorterurl/link/rpc/internal/config"
 	"shorterurl/link/rpc/internal/consumer"
 	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/internal/worker"
+	"shorterurl/link/rpc/pkg/linkhealth"
+	"shorterurl/link/rpc/pkg/shortcode"
 	"shorterurl/link/rpc/pkg/snowflake"
+	"shorterurl/link/rpc/pkg/urlsafety"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 )
 
 // ServiceContext 服务上下文
 type ServiceContext struct {
-	Config         config.Config
-	DBs            *DBs
-	BizRedis       *redis.Redis
-	BloomFilterMgr *BloomFilterManager
-	RepoManager    *repo.RepoManager
-	StatsConsumer  *consumer.ShortLinkStatsConsumer
+	Config          config.Config
+	DBs             *DBs
+	BizRedis        *redis.Redis
+	BloomFilterMgr  *BloomFilterManager
+	RepoManager     *repo.RepoManager
+	StatsConsumer   *consumer.ShortLinkStatsConsumer
+	HealthWorker    *worker.LinkHealthWorker
+	RetentionWorker *worker.LinkRetentionWorker
+	ShortCodeGen    shortcode.ShortCodeGenerator
+	UrlSafety       urlsafety.UrlSafetyChecker
 }
 
 // 实现消费者所需的接口
@@ -57,6 +66,34 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(fmt.Errorf("init bloom filter failed: %v", err))
 	}
 
+	// 初始化短链接后缀生成器
+	shortCodeGen, err := shortcode.NewGenerator(shortcode.Options{
+		Strategy:     c.ShortCode.Strategy,
+		MinLength:    c.ShortCode.MinLength,
+		MaxLength:    c.ShortCode.MaxLength,
+		MaxFillRatio: c.ShortCode.MaxFillRatio,
+		CounterStart: c.ShortCode.CounterStart,
+		Redis:        bizRedis,
+		IdGen:        idGen,
+	})
+	if err != nil {
+		panic(fmt.Errorf("init short code generator failed: %v", err))
+	}
+
+	// 初始化目标链接安全检测器
+	urlSafety, err := urlsafety.NewChecker(urlsafety.Options{
+		BlocklistFile:   c.UrlSafety.BlocklistFile,
+		BlocklistReload: time.Duration(c.UrlSafety.BlocklistReloadSeconds) * time.Second,
+		Redis:           bizRedis,
+		RedisKey:        c.UrlSafety.RedisDenyKey,
+		HttpEndpoint:    c.UrlSafety.HttpEndpoint,
+		HttpToken:       c.UrlSafety.HttpToken,
+		HttpTimeout:     time.Duration(c.UrlSafety.HttpTimeoutMs) * time.Millisecond,
+	})
+	if err != nil {
+		panic(fmt.Errorf("init url safety checker failed: %v", err))
+	}
+
 	// 初始化仓库管理器
 	repoManager := repo.NewRepoManager(
 		dbs.Common,
@@ -72,6 +109,8 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		BizRedis:       bizRedis,
 		BloomFilterMgr: bloomFilterMgr,
 		RepoManager:    repoManager,
+		ShortCodeGen:   shortCodeGen,
+		UrlSafety:      urlSafety,
 	}
 
 	// 创建并启动统计消费者
@@ -79,5 +118,41 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	statsConsumer.Start()
 	svcCtx.StatsConsumer = statsConsumer
 
+	// 创建并启动目标链接健康检测任务
+	if c.LinkHealth.Enable {
+		healthWorker := worker.NewLinkHealthWorker(worker.LinkHealthOptions{
+			NumberOfShards: c.DB.Sharding.NumberOfShards,
+			Interval:       time.Duration(c.LinkHealth.IntervalSeconds) * time.Second,
+			Recheck:        time.Duration(c.LinkHealth.RecheckSeconds) * time.Second,
+			BatchSize:      c.LinkHealth.BatchSize,
+			Concurrency:    c.LinkHealth.Concurrency,
+			FailThreshold:  c.LinkHealth.FailThreshold,
+			Prober: linkhealth.Options{
+				Timeout:      time.Duration(c.LinkHealth.TimeoutMs) * time.Millisecond,
+				MaxRedirects: c.LinkHealth.MaxRedirects,
+				UserAgent:    c.LinkHealth.UserAgent,
+				HostInterval: time.Duration(c.LinkHealth.HostIntervalMs) * time.Millisecond,
+			},
+		}, repoManager.Link, bizRedis)
+		healthWorker.Start()
+		svcCtx.HealthWorker = healthWorker
+	}
+
+	// 创建并启动短链接数据清理任务
+	if c.Retention.Enable {
+		retentionWorker := worker.NewLinkRetentionWorker(worker.LinkRetentionOptions{
+			NumberOfShards: c.DB.Sharding.NumberOfShards,
+			Interval:       time.Duration(c.Retention.IntervalSeconds) * time.Second,
+			BatchSize:      c.Retention.BatchSize,
+			DryRun:         c.Retention.DryRun,
+			ExpiredDays:    c.Retention.ExpiredDays,
+			RecycleBinDays: c.Retention.RecycleBinDays,
+			RemovedDays:    c.Retention.RemovedDays,
+			ReportSamples:  c.Retention.ReportSamples,
+		}, repoManager, bizRedis)
+		retentionWorker.Start()
+		svcCtx.RetentionWorker = retentionWorker
+	}
+
 	return svcCtx
 }
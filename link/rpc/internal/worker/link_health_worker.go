@@ -0,0 +1,240 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/pkg/linkhealth"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// 多实例部署时保证同一时间只有一个实例执行检测的分布式锁
+	LinkHealthLockKey = "short-link:health-check:lock"
+	// 检测失败原因最大长度
+	healthErrorMaxLength = 256
+	// 重定向链路JSON最大长度
+	healthRedirectsMaxLength = 2048
+)
+
+// LinkHealthOptions 目标链接健康检测参数
+type LinkHealthOptions struct {
+	NumberOfShards int           // t_link分片数量
+	Interval       time.Duration // 每轮检测间隔
+	Recheck        time.Duration // 同一短链接的最短复检间隔
+	BatchSize      int           // 每批从分片中读取的短链接数量
+	Concurrency    int           // 同时检测的短链接数量
+	FailThreshold  int           // 连续失败达到该次数后标记为失效
+	Prober         linkhealth.Options
+}
+
+// LinkHealthWorker 目标链接健康检测后台任务
+// 按分片、按ID顺序分批遍历正常状态的短链接，检测目标链接并记录状态码、耗时和重定向链路
+type LinkHealthWorker struct {
+	opts     LinkHealthOptions
+	linkRepo repo.LinkRepo
+	lock     *redis.RedisLock
+	prober   *linkhealth.Prober
+	running  bool
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewLinkHealthWorker 创建目标链接健康检测后台任务
+func NewLinkHealthWorker(opts LinkHealthOptions, linkRepo repo.LinkRepo, rds *redis.Redis) *LinkHealthWorker {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	if opts.FailThreshold <= 0 {
+		opts.FailThreshold = 1
+	}
+
+	return &LinkHealthWorker{
+		opts:     opts,
+		linkRepo: linkRepo,
+		lock:     newWorkerLock(rds, LinkHealthLockKey),
+		prober:   linkhealth.NewProber(opts.Prober),
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Start 启动后台任务，立即执行一轮检测，之后按间隔执行
+func (w *LinkHealthWorker) Start() {
+	if w.running {
+		return
+	}
+	w.running = true
+	w.wg.Add(1)
+	go w.loop()
+	logx.Infof("[健康检测] 后台任务已启动, 检测间隔: %s", w.opts.Interval)
+}
+
+// Stop 停止后台任务，等待当前批次检测完成
+func (w *LinkHealthWorker) Stop() {
+	if !w.running {
+		return
+	}
+	w.running = false
+	close(w.stopChan)
+	w.wg.Wait()
+	logx.Infof("[健康检测] 后台任务已停止")
+}
+
+func (w *LinkHealthWorker) loop() {
+	defer w.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-w.stopChan
+		cancel()
+	}()
+
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	for {
+		w.runRound(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runRound 执行一轮检测，未获取到分布式锁时说明其他实例正在检测，跳过本轮
+func (w *LinkHealthWorker) runRound(ctx context.Context) {
+	ok, err := w.lock.AcquireCtx(ctx)
+	if err != nil {
+		logx.Errorf("[健康检测] 获取分布式锁失败: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		if _, err := w.lock.ReleaseCtx(context.Background()); err != nil {
+			logx.Errorf("[健康检测] 释放分布式锁失败: %v", err)
+		}
+	}()
+	ctx, stop := keepLock(ctx, w.lock, "健康检测")
+	defer stop()
+
+	start := time.Now()
+	checkedBefore := start.Add(-w.opts.Recheck)
+	total := 0
+	for shard := 0; shard < w.opts.NumberOfShards; shard++ {
+		var afterID int64
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			links, err := w.linkRepo.FindHealthCheckBatch(ctx, shard, afterID, checkedBefore, w.opts.BatchSize)
+			if err != nil {
+				logx.Errorf("[健康检测] 查询分片 %d 失败: %v", shard, err)
+				break
+			}
+			if len(links) == 0 {
+				break
+			}
+			w.checkBatch(ctx, links)
+			total += len(links)
+			afterID = links[len(links)-1].ID
+			if len(links) < w.opts.BatchSize {
+				break
+			}
+		}
+	}
+	logx.Infof("[健康检测] 本轮检测完成, 检测数量: %d, 耗时: %s", total, time.Since(start))
+}
+
+// checkBatch 并发检测一批短链接，并发数量不超过Concurrency
+func (w *LinkHealthWorker) checkBatch(ctx context.Context, links []*model.Link) {
+	sem := make(chan struct{}, w.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, link := range links {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(link *model.Link) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			w.check(ctx, link)
+		}(link)
+	}
+	wg.Wait()
+}
+
+// check 检测单个短链接的目标链接并保存结果，任务停止导致的中断不保存
+func (w *LinkHealthWorker) check(ctx context.Context, link *model.Link) {
+	result := w.prober.Probe(ctx, link.OriginUrl)
+	if ctx.Err() != nil {
+		return
+	}
+
+	applyHealthResult(link, result, w.opts.FailThreshold)
+	if err := w.linkRepo.UpdateHealth(ctx, link); err != nil {
+		logx.Errorf("[健康检测] 保存检测结果失败: %s, %v", link.FullShortUrl, err)
+	}
+}
+
+// applyHealthResult 将检测结果写入短链接
+// 检测正常时清零失败次数，连续失败达到阈值后标记为失效，未达到阈值时保持原状态
+func applyHealthResult(link *model.Link, result *linkhealth.Result, failThreshold int) {
+	now := time.Now()
+	link.HealthCheckTime = &now
+	link.HealthCode = result.StatusCode
+	link.HealthLatency = int(result.Latency.Milliseconds())
+	link.HealthRedirects = encodeRedirects(result.Redirects)
+	link.HealthError = ""
+
+	if result.Healthy() {
+		link.HealthStatus = linkhealth.HealthStatusHealthy
+		link.HealthFailCount = 0
+		return
+	}
+
+	if result.Err != nil {
+		link.HealthError = truncateRunes(result.Err.Error(), healthErrorMaxLength)
+	} else {
+		link.HealthError = fmt.Sprintf("目标链接返回状态码%d", result.StatusCode)
+	}
+	link.HealthFailCount++
+	if link.HealthFailCount >= failThreshold {
+		link.HealthStatus = linkhealth.HealthStatusBroken
+	}
+}
+
+// encodeRedirects 将重定向链路编码为JSON数组，超出字段长度时丢弃末尾的链接
+func encodeRedirects(redirects []string) string {
+	for len(redirects) > 0 {
+		data, err := json.Marshal(redirects)
+		if err != nil {
+			return ""
+		}
+		if len(data) <= healthRedirectsMaxLength {
+			return string(data)
+		}
+		redirects = redirects[:len(redirects)-1]
+	}
+	return ""
+}
+
+// truncateRunes 按字符截断字符串
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
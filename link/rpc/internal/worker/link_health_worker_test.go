@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/pkg/linkhealth"
+)
+
+func TestApplyHealthResult(t *testing.T) {
+	link := &model.Link{HealthStatus: linkhealth.HealthStatusHealthy}
+
+	// 未达到连续失败阈值时保持原状态
+	applyHealthResult(link, &linkhealth.Result{StatusCode: http.StatusNotFound}, 2)
+	if link.HealthStatus != linkhealth.HealthStatusHealthy || link.HealthFailCount != 1 || link.HealthCode != http.StatusNotFound {
+		t.Errorf("第一次失败后: status %d, fail %d, code %d", link.HealthStatus, link.HealthFailCount, link.HealthCode)
+	}
+	if link.HealthError != "目标链接返回状态码404" {
+		t.Errorf("HealthError = %q", link.HealthError)
+	}
+	if link.HealthCheckTime == nil {
+		t.Error("未记录检测时间")
+	}
+
+	// 连续失败达到阈值后标记为失效，记录失败原因
+	applyHealthResult(link, &linkhealth.Result{Err: errors.New("连接超时")}, 2)
+	if link.HealthStatus != linkhealth.HealthStatusBroken || link.HealthFailCount != 2 || link.HealthError != "连接超时" {
+		t.Errorf("第二次失败后: status %d, fail %d, error %q", link.HealthStatus, link.HealthFailCount, link.HealthError)
+	}
+
+	// 检测正常时清零失败次数并记录重定向链路
+	applyHealthResult(link, &linkhealth.Result{
+		StatusCode: http.StatusOK,
+		Latency:    120 * time.Millisecond,
+		Redirects:  []string{"https://github.com/"},
+	}, 2)
+	if link.HealthStatus != linkhealth.HealthStatusHealthy || link.HealthFailCount != 0 || link.HealthError != "" {
+		t.Errorf("恢复后: status %d, fail %d, error %q", link.HealthStatus, link.HealthFailCount, link.HealthError)
+	}
+	if link.HealthLatency != 120 || link.HealthRedirects != `["https://github.com/"]` {
+		t.Errorf("恢复后: latency %d, redirects %s", link.HealthLatency, link.HealthRedirects)
+	}
+}
+
+func TestApplyHealthResult_Truncate(t *testing.T) {
+	link := &model.Link{}
+	redirects := make([]string, 100)
+	for i := range redirects {
+		redirects[i] = "https://github.com/" + strings.Repeat("a", 50)
+	}
+	applyHealthResult(link, &linkhealth.Result{Err: errors.New(strings.Repeat("错", 300)), Redirects: redirects}, 1)
+
+	if n := len([]rune(link.HealthError)); n != healthErrorMaxLength {
+		t.Errorf("失败原因长度 = %d, want %d", n, healthErrorMaxLength)
+	}
+	if len(link.HealthRedirects) == 0 || len(link.HealthRedirects) > healthRedirectsMaxLength {
+		t.Errorf("重定向链路长度 = %d, 期望不超过 %d", len(link.HealthRedirects), healthRedirectsMaxLength)
+	}
+	if link.HealthStatus != linkhealth.HealthStatusBroken {
+		t.Errorf("阈值为1时首次失败应标记为失效, status %d", link.HealthStatus)
+	}
+}
@@ -0,0 +1,376 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"shorterurl/link/rpc/internal/cachekey"
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+	"shorterurl/link/rpc/pkg/util"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+)
+
+const (
+	// 多实例部署时保证同一时间只有一个实例执行清理的分布式锁
+	LinkRetentionLockKey = "short-link:retention:lock"
+	// 最近一轮清理报告
+	LinkRetentionReportKey = "short-link:retention:report"
+	// 清理报告保留时间（秒）
+	linkRetentionReportExpire = 7 * 24 * 3600
+
+	// 清理规则
+	RetentionRuleExpired    = "expired"     // 过期的短链接移入回收站
+	RetentionRuleRecycleBin = "recycle-bin" // 回收站中的短链接永久清除
+	RetentionRuleRemoved    = "removed"     // 已永久删除的短链接清除数据
+)
+
+// LinkRetentionOptions 短链接数据保留参数，天数小于等于0时不执行对应规则
+type LinkRetentionOptions struct {
+	NumberOfShards int           // t_link分片数量
+	Interval       time.Duration // 每轮清理间隔
+	BatchSize      int           // 每批从分片中读取的短链接数量
+	DryRun         bool          // 只生成报告，不修改数据
+	ExpiredDays    int           // 过期超过该天数的短链接移入回收站
+	RecycleBinDays int           // 在回收站中超过该天数的短链接永久清除
+	RemovedDays    int           // 永久删除超过该天数的短链接清除数据
+	ReportSamples  int           // 报告中每条规则最多列出的短链接数量
+}
+
+// LinkRetentionRuleReport 单条清理规则的执行结果
+type LinkRetentionRuleReport struct {
+	Rule    string   `json:"rule"`    // 清理规则
+	Before  string   `json:"before"`  // 处理早于该时间的短链接
+	Matched int      `json:"matched"` // 命中的短链接数量
+	Handled int      `json:"handled"` // 处理成功的短链接数量，预演时为0
+	Failed  int      `json:"failed"`  // 处理失败的短链接数量
+	Samples []string `json:"samples"` // 命中的短链接示例
+}
+
+// LinkRetentionReport 一轮清理的执行报告
+type LinkRetentionReport struct {
+	DryRun    bool                       `json:"dryRun"`    // 是否为预演
+	StartTime string                     `json:"startTime"` // 开始时间
+	EndTime   string                     `json:"endTime"`   // 结束时间
+	Rules     []*LinkRetentionRuleReport `json:"rules"`     // 各规则的执行结果
+}
+
+// LinkRetentionWorker 短链接数据保留后台任务
+// 按分片、按ID顺序分批遍历短链接，将过期较久的短链接移入回收站，并清除回收站和已永久删除短链接的数据
+type LinkRetentionWorker struct {
+	opts            LinkRetentionOptions
+	linkRepo        repo.LinkRepo
+	gotoRepo        repo.LinkGotoRepo
+	groupExpiryRepo repo.GroupExpiryPolicyRepo
+	historyRepo     repo.LinkHistoryRepo
+	retentionRepo   repo.LinkRetentionRepo
+	rds             *redis.Redis
+	lock            *redis.RedisLock
+	running         bool
+	stopChan        chan struct{}
+	wg              sync.WaitGroup
+}
+
+// NewLinkRetentionWorker 创建短链接数据保留后台任务
+func NewLinkRetentionWorker(opts LinkRetentionOptions, repoManager *repo.RepoManager, rds *redis.Redis) *LinkRetentionWorker {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 200
+	}
+	if opts.ReportSamples < 0 {
+		opts.ReportSamples = 0
+	}
+
+	return &LinkRetentionWorker{
+		opts:            opts,
+		linkRepo:        repoManager.Link,
+		gotoRepo:        repoManager.LinkGoto,
+		groupExpiryRepo: repoManager.GroupExpiry,
+		historyRepo:     repoManager.History,
+		retentionRepo:   repoManager.Retention,
+		rds:             rds,
+		lock:            newWorkerLock(rds, LinkRetentionLockKey),
+		stopChan:        make(chan struct{}),
+	}
+}
+
+// Start 启动后台任务，立即执行一轮清理，之后按间隔执行
+func (w *LinkRetentionWorker) Start() {
+	if w.running {
+		return
+	}
+	w.running = true
+	w.wg.Add(1)
+	go w.loop()
+	logx.Infof("[数据清理] 后台任务已启动, 清理间隔: %s, 预演: %t", w.opts.Interval, w.opts.DryRun)
+}
+
+// Stop 停止后台任务，等待当前批次处理完成
+func (w *LinkRetentionWorker) Stop() {
+	if !w.running {
+		return
+	}
+	w.running = false
+	close(w.stopChan)
+	w.wg.Wait()
+	logx.Infof("[数据清理] 后台任务已停止")
+}
+
+func (w *LinkRetentionWorker) loop() {
+	defer w.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-w.stopChan
+		cancel()
+	}()
+
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	for {
+		w.runRound(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runRound 执行一轮清理，未获取到分布式锁时说明其他实例正在清理，跳过本轮
+// 先将过期的短链接移入回收站，本轮移入的短链接移入回收站时间为当前时间，不会在同一轮中被清除
+func (w *LinkRetentionWorker) runRound(ctx context.Context) {
+	ok, err := w.lock.AcquireCtx(ctx)
+	if err != nil {
+		logx.Errorf("[数据清理] 获取分布式锁失败: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		if _, err := w.lock.ReleaseCtx(context.Background()); err != nil {
+			logx.Errorf("[数据清理] 释放分布式锁失败: %v", err)
+		}
+	}()
+	ctx, stop := keepLock(ctx, w.lock, "数据清理")
+	defer stop()
+
+	start := time.Now()
+	report := &LinkRetentionReport{
+		DryRun:    w.opts.DryRun,
+		StartTime: start.Format(time.RFC3339),
+	}
+
+	if w.opts.ExpiredDays > 0 {
+		before := start.AddDate(0, 0, -w.opts.ExpiredDays)
+		groups := make(map[string]int)
+		report.Rules = append(report.Rules, w.runRule(ctx, RetentionRuleExpired, before,
+			func(shard int, afterID int64) ([]*model.Link, error) {
+				return w.retentionRepo.FindExpiredBatch(ctx, shard, afterID, before, w.opts.BatchSize)
+			},
+			func(link *model.Link) bool {
+				// 宽限期内的短链接仍可跳转，宽限期结束后才开始计算
+				graceDays, err := w.graceDays(ctx, link, groups)
+				if err != nil {
+					logx.Errorf("[数据清理] 查询分组过期策略失败, 分组: %s, %v", link.Gid, err)
+					return true
+				}
+				return util.GetLinkExpireTime(link.ValidDate, graceDays).After(before)
+			}, w.recycle))
+	}
+	if w.opts.RecycleBinDays > 0 {
+		before := start.AddDate(0, 0, -w.opts.RecycleBinDays)
+		report.Rules = append(report.Rules, w.runRule(ctx, RetentionRuleRecycleBin, before,
+			func(shard int, afterID int64) ([]*model.Link, error) {
+				return w.retentionRepo.FindRecycleBinBatch(ctx, shard, afterID, before, w.opts.BatchSize)
+			}, nil, w.purge))
+	}
+	if w.opts.RemovedDays > 0 {
+		before := start.AddDate(0, 0, -w.opts.RemovedDays)
+		report.Rules = append(report.Rules, w.runRule(ctx, RetentionRuleRemoved, before,
+			func(shard int, afterID int64) ([]*model.Link, error) {
+				return w.retentionRepo.FindRemovedBatch(ctx, shard, afterID, before, w.opts.BatchSize)
+			}, nil, w.purge))
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	report.EndTime = time.Now().Format(time.RFC3339)
+	w.saveReport(ctx, report)
+}
+
+// runRule 遍历所有分片执行一条清理规则，skip不为空时跳过其返回true的短链接，预演时只统计命中的短链接
+func (w *LinkRetentionWorker) runRule(ctx context.Context, rule string, before time.Time,
+	find func(shard int, afterID int64) ([]*model.Link, error),
+	skip func(link *model.Link) bool,
+	handle func(ctx context.Context, link *model.Link) error) *LinkRetentionRuleReport {
+	result := &LinkRetentionRuleReport{
+		Rule:    rule,
+		Before:  before.Format(time.RFC3339),
+		Samples: []string{},
+	}
+	for shard := 0; shard < w.opts.NumberOfShards; shard++ {
+		var afterID int64
+		for {
+			if ctx.Err() != nil {
+				return result
+			}
+			links, err := find(shard, afterID)
+			if err != nil {
+				logx.Errorf("[数据清理] 查询分片 %d 失败, 规则: %s, %v", shard, rule, err)
+				break
+			}
+			for _, link := range links {
+				if skip != nil && skip(link) {
+					continue
+				}
+				result.Matched++
+				if len(result.Samples) < w.opts.ReportSamples {
+					result.Samples = append(result.Samples, link.FullShortUrl)
+				}
+				if w.opts.DryRun {
+					continue
+				}
+				if err := handle(ctx, link); err != nil {
+					result.Failed++
+					logx.Errorf("[数据清理] 处理短链接失败, 规则: %s, 短链接: %s, %v", rule, link.FullShortUrl, err)
+					continue
+				}
+				result.Handled++
+			}
+			if len(links) < w.opts.BatchSize {
+				break
+			}
+			afterID = links[len(links)-1].ID
+		}
+	}
+	logx.Infof("[数据清理] 规则: %s, 命中: %d, 处理: %d, 失败: %d, 预演: %t",
+		rule, result.Matched, result.Handled, result.Failed, w.opts.DryRun)
+	return result
+}
+
+// graceDays 返回短链接的过期宽限天数，短链接未设置时使用分组过期策略，与跳转时的规则一致
+// 分组过期策略按分组缓存在groups中，同一轮清理中每个分组只查询一次
+func (w *LinkRetentionWorker) graceDays(ctx context.Context, link *model.Link, groups map[string]int) (int, error) {
+	if link.GraceDays > 0 {
+		return link.GraceDays, nil
+	}
+	if days, ok := groups[link.Gid]; ok {
+		return days, nil
+	}
+	days := 0
+	policy, err := w.groupExpiryRepo.FindByGid(ctx, link.Gid)
+	if err == nil {
+		days = policy.GraceDays
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+	groups[link.Gid] = days
+	return days, nil
+}
+
+// recycle 将过期的短链接移入回收站，删除跳转缓存并记录变更
+func (w *LinkRetentionWorker) recycle(ctx context.Context, link *model.Link) error {
+	before := linkhistory.NewSnapshot(link)
+	now := time.Now()
+	if err := w.linkRepo.BatchUpdateFields(ctx, link.Gid, []int64{link.ID}, map[string]interface{}{
+		"enable_status": 1,
+		"recycle_time":  now,
+		"update_time":   now,
+	}); err != nil {
+		return err
+	}
+	link.EnableStatus = 1
+	link.RecycleTime = &now
+	link.UpdateTime = now
+	w.deleteCache(ctx, link.FullShortUrl, cachekey.GotoKeys(link.FullShortUrl))
+
+	history, err := linkhistory.New(linkhistory.ActionRecycle, "", before, link)
+	w.recordHistory(ctx, link, history, err)
+	return nil
+}
+
+// purge 永久清除短链接的统计、关联数据、变更记录、跳转记录、缓存和短链接记录
+// 短链接记录最后删除，中途失败时下一轮可以继续清理
+// 已永久删除的短链接后缀可能被新的短链接重新使用，此时只删除短链接记录，保留新短链接的数据
+func (w *LinkRetentionWorker) purge(ctx context.Context, link *model.Link) error {
+	inUse, err := w.inUseByOther(ctx, link)
+	if err != nil {
+		return err
+	}
+	if !inUse {
+		if err := w.retentionRepo.PurgeRelated(ctx, link.FullShortUrl); err != nil {
+			return fmt.Errorf("清除关联数据失败: %w", err)
+		}
+		if err := w.retentionRepo.PurgeGoto(ctx, link.FullShortUrl); err != nil {
+			return fmt.Errorf("删除跳转记录失败: %w", err)
+		}
+		w.deleteCache(ctx, link.FullShortUrl, append(cachekey.GotoKeys(link.FullShortUrl), cachekey.StatsKeys(link.FullShortUrl)...))
+	}
+	if err := w.retentionRepo.PurgeLink(ctx, link); err != nil {
+		return fmt.Errorf("删除短链接失败: %w", err)
+	}
+	return nil
+}
+
+// inUseByOther 通过跳转记录判断完整短链接是否属于其他未永久删除的短链接
+func (w *LinkRetentionWorker) inUseByOther(ctx context.Context, link *model.Link) (bool, error) {
+	linkGoto, err := w.gotoRepo.FindByFullShortUrl(ctx, link.FullShortUrl)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("查询跳转记录失败: %w", err)
+	}
+	current, err := w.linkRepo.FindByFullShortUrlAndGid(ctx, link.FullShortUrl, linkGoto.Gid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("查询短链接失败: %w", err)
+	}
+	return current.ID != link.ID || current.Gid != link.Gid, nil
+}
+
+// recordHistory 保存后台任务生成的变更记录，后台任务没有操作人，记录失败不影响清理
+func (w *LinkRetentionWorker) recordHistory(ctx context.Context, link *model.Link, history *model.LinkHistory, err error) {
+	if err != nil {
+		logx.Errorf("[数据清理] 生成变更记录失败: %s, %v", link.FullShortUrl, err)
+		return
+	}
+	if history == nil {
+		return
+	}
+	if err := w.historyRepo.Create(ctx, history); err != nil {
+		logx.Errorf("[数据清理] 记录变更失败: %s, %v", link.FullShortUrl, err)
+	}
+}
+
+// deleteCache 删除短链接的缓存
+func (w *LinkRetentionWorker) deleteCache(ctx context.Context, fullShortUrl string, keys []string) {
+	if _, err := w.rds.DelCtx(ctx, keys...); err != nil {
+		logx.Errorf("[数据清理] 删除缓存失败: %s, %v", fullShortUrl, err)
+	}
+}
+
+// saveReport 保存最近一轮的清理报告，便于预演后确认清理范围
+func (w *LinkRetentionWorker) saveReport(ctx context.Context, report *LinkRetentionReport) {
+	data, err := json.Marshal(report)
+	if err != nil {
+		logx.Errorf("[数据清理] 序列化清理报告失败: %v", err)
+		return
+	}
+	if err := w.rds.SetexCtx(ctx, LinkRetentionReportKey, string(data), linkRetentionReportExpire); err != nil {
+		logx.Errorf("[数据清理] 保存清理报告失败: %v", err)
+	}
+	logx.Infof("[数据清理] 本轮清理完成, 报告: %s", data)
+}
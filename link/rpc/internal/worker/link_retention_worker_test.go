@@ -0,0 +1,240 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"shorterurl/link/rpc/internal/linkhistory"
+	"shorterurl/link/rpc/internal/model"
+	"shorterurl/link/rpc/internal/repo"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+)
+
+// fakeLinkRepo 只实现数据清理用到的短链接仓库方法
+type fakeLinkRepo struct {
+	repo.LinkRepo
+	updates map[int64]map[string]interface{}
+}
+
+func (r *fakeLinkRepo) BatchUpdateFields(_ context.Context, _ string, ids []int64, fields map[string]interface{}) error {
+	for _, id := range ids {
+		r.updates[id] = fields
+	}
+	return nil
+}
+
+func (r *fakeLinkRepo) FindByFullShortUrlAndGid(context.Context, string, string) (*model.Link, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+// fakeGotoRepo 没有跳转记录，清除的短链接后缀都未被重新使用
+type fakeGotoRepo struct {
+	repo.LinkGotoRepo
+}
+
+func (r *fakeGotoRepo) FindByFullShortUrl(context.Context, string) (*model.LinkGoto, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+type fakeGroupExpiryRepo struct {
+	repo.GroupExpiryPolicyRepo
+	policies map[string]*model.GroupExpiryPolicy
+	queries  int
+}
+
+func (r *fakeGroupExpiryRepo) FindByGid(_ context.Context, gid string) (*model.GroupExpiryPolicy, error) {
+	r.queries++
+	if policy, ok := r.policies[gid]; ok {
+		return policy, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type fakeHistoryRepo struct {
+	repo.LinkHistoryRepo
+	histories []*model.LinkHistory
+}
+
+func (r *fakeHistoryRepo) Create(_ context.Context, history *model.LinkHistory) error {
+	r.histories = append(r.histories, history)
+	return nil
+}
+
+// fakeRetentionRepo 每条规则返回固定的短链接，记录被清除的短链接
+type fakeRetentionRepo struct {
+	expired, recycleBin, removed []*model.Link
+	purged                       []string
+}
+
+func (r *fakeRetentionRepo) FindExpiredBatch(_ context.Context, _ int, afterID int64, _ time.Time, _ int) ([]*model.Link, error) {
+	return linksAfter(r.expired, afterID), nil
+}
+
+func (r *fakeRetentionRepo) FindRecycleBinBatch(_ context.Context, _ int, afterID int64, _ time.Time, _ int) ([]*model.Link, error) {
+	return linksAfter(r.recycleBin, afterID), nil
+}
+
+func (r *fakeRetentionRepo) FindRemovedBatch(_ context.Context, _ int, afterID int64, _ time.Time, _ int) ([]*model.Link, error) {
+	return linksAfter(r.removed, afterID), nil
+}
+
+func (r *fakeRetentionRepo) PurgeRelated(context.Context, string) error { return nil }
+
+func (r *fakeRetentionRepo) PurgeGoto(context.Context, string) error { return nil }
+
+func (r *fakeRetentionRepo) PurgeLink(_ context.Context, link *model.Link) error {
+	r.purged = append(r.purged, link.FullShortUrl)
+	return nil
+}
+
+func linksAfter(links []*model.Link, afterID int64) []*model.Link {
+	var result []*model.Link
+	for _, link := range links {
+		if link.ID > afterID {
+			result = append(result, link)
+		}
+	}
+	return result
+}
+
+func newTestRetentionWorker(t *testing.T, retentionRepo *fakeRetentionRepo, groups map[string]*model.GroupExpiryPolicy) (*LinkRetentionWorker, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	w := NewLinkRetentionWorker(LinkRetentionOptions{
+		NumberOfShards: 1,
+		Interval:       time.Hour,
+		BatchSize:      10,
+		ExpiredDays:    90,
+		RecycleBinDays: 30,
+		RemovedDays:    7,
+		ReportSamples:  10,
+	}, &repo.RepoManager{
+		Link:        &fakeLinkRepo{updates: map[int64]map[string]interface{}{}},
+		LinkGoto:    &fakeGotoRepo{},
+		GroupExpiry: &fakeGroupExpiryRepo{policies: groups},
+		History:     &fakeHistoryRepo{},
+		Retention:   retentionRepo,
+	}, redis.New(mr.Addr()))
+	return w, mr
+}
+
+func TestLinkRetentionWorker_RunRound(t *testing.T) {
+	validDate := time.Now().AddDate(0, 0, -100)
+	retentionRepo := &fakeRetentionRepo{
+		expired: []*model.Link{
+			{ID: 1, Gid: "g1", FullShortUrl: "s.cn/expired", OriginUrl: "https://github.com", ValidDateType: 1, ValidDate: validDate},
+			// 短链接自己的宽限期未结束
+			{ID: 2, Gid: "g1", FullShortUrl: "s.cn/link-grace", ValidDateType: 1, ValidDate: validDate, GraceDays: 30},
+			// 分组过期策略的宽限期未结束
+			{ID: 3, Gid: "g2", FullShortUrl: "s.cn/group-grace", ValidDateType: 1, ValidDate: validDate},
+			{ID: 4, Gid: "g2", FullShortUrl: "s.cn/group-grace-2", ValidDateType: 1, ValidDate: validDate},
+		},
+		recycleBin: []*model.Link{
+			{ID: 5, Gid: "g1", FullShortUrl: "s.cn/recycled", OriginUrl: "https://github.com", EnableStatus: 1},
+		},
+	}
+	w, mr := newTestRetentionWorker(t, retentionRepo, map[string]*model.GroupExpiryPolicy{
+		"g2": {Gid: "g2", GraceDays: 20},
+	})
+	mr.Set("short-link:goto:s.cn/expired", "https://github.com")
+	mr.Set("short-link:goto:s.cn/recycled", "https://github.com")
+	mr.Set("short-link:clicks:remaining:s.cn/recycled", "10")
+
+	w.runRound(context.Background())
+
+	// 过期的短链接移入回收站并记录移入时间，宽限期内的短链接不处理
+	updates := w.linkRepo.(*fakeLinkRepo).updates
+	if len(updates) != 1 || updates[1]["enable_status"] != 1 || updates[1]["recycle_time"] == nil {
+		t.Errorf("移入回收站的短链接不符合预期: %v", updates)
+	}
+	if groups := w.groupExpiryRepo.(*fakeGroupExpiryRepo); groups.queries != 2 {
+		t.Errorf("每个分组的过期策略只应查询一次, 实际查询: %d", groups.queries)
+	}
+
+	// 回收站中的短链接被清除
+	if len(retentionRepo.purged) != 1 || retentionRepo.purged[0] != "s.cn/recycled" {
+		t.Errorf("清除的短链接不符合预期: %v", retentionRepo.purged)
+	}
+	for _, key := range []string{"short-link:goto:s.cn/expired", "short-link:goto:s.cn/recycled", "short-link:clicks:remaining:s.cn/recycled"} {
+		if mr.Exists(key) {
+			t.Errorf("缓存未删除: %s", key)
+		}
+	}
+
+	// 移入回收站有变更记录，清除时变更记录随关联数据一起删除
+	histories := w.historyRepo.(*fakeHistoryRepo).histories
+	if len(histories) != 1 {
+		t.Fatalf("期望1条变更记录, 实际: %d", len(histories))
+	}
+	if histories[0].Action != linkhistory.ActionRecycle || histories[0].FullShortUrl != "s.cn/expired" || histories[0].Operator != "" {
+		t.Errorf("移入回收站的变更记录不符合预期: %+v", histories[0])
+	}
+	var snapshot linkhistory.Snapshot
+	if err := json.Unmarshal([]byte(histories[0].Snapshot), &snapshot); err != nil || snapshot.EnableStatus != 1 || snapshot.OriginUrl != "https://github.com" {
+		t.Errorf("移入回收站的变更记录快照不符合预期: %s, %v", histories[0].Snapshot, err)
+	}
+
+	// 保存报告并释放分布式锁
+	data, err := mr.Get(LinkRetentionReportKey)
+	if err != nil {
+		t.Fatalf("未保存清理报告: %v", err)
+	}
+	var report LinkRetentionReport
+	if err := json.Unmarshal([]byte(data), &report); err != nil {
+		t.Fatalf("解析清理报告失败: %v", err)
+	}
+	if len(report.Rules) != 3 || report.Rules[0].Matched != 1 || report.Rules[0].Handled != 1 || report.Rules[1].Handled != 1 {
+		t.Errorf("清理报告不符合预期: %s", data)
+	}
+	if mr.Exists(LinkRetentionLockKey) {
+		t.Error("本轮结束后应释放分布式锁")
+	}
+}
+
+func TestLinkRetentionWorker_DryRun(t *testing.T) {
+	retentionRepo := &fakeRetentionRepo{
+		removed: []*model.Link{{ID: 1, Gid: "g1", FullShortUrl: "s.cn/removed", DelFlag: 1}},
+	}
+	w, mr := newTestRetentionWorker(t, retentionRepo, nil)
+	w.opts.DryRun = true
+
+	w.runRound(context.Background())
+
+	if len(retentionRepo.purged) != 0 || len(w.historyRepo.(*fakeHistoryRepo).histories) != 0 {
+		t.Errorf("预演时不应修改数据: %v", retentionRepo.purged)
+	}
+	data, err := mr.Get(LinkRetentionReportKey)
+	if err != nil {
+		t.Fatalf("未保存清理报告: %v", err)
+	}
+	var report LinkRetentionReport
+	if err := json.Unmarshal([]byte(data), &report); err != nil {
+		t.Fatalf("解析清理报告失败: %v", err)
+	}
+	if !report.DryRun || report.Rules[2].Matched != 1 || report.Rules[2].Handled != 0 {
+		t.Errorf("预演报告不符合预期: %s", data)
+	}
+}
+
+func TestLinkRetentionWorker_Locked(t *testing.T) {
+	retentionRepo := &fakeRetentionRepo{
+		recycleBin: []*model.Link{{ID: 1, Gid: "g1", FullShortUrl: "s.cn/recycled", EnableStatus: 1}},
+	}
+	w, mr := newTestRetentionWorker(t, retentionRepo, nil)
+
+	// 其他实例持有锁时跳过本轮
+	mr.Set(LinkRetentionLockKey, "other")
+	w.runRound(context.Background())
+
+	if len(retentionRepo.purged) != 0 || mr.Exists(LinkRetentionReportKey) {
+		t.Errorf("未获取到锁时不应执行清理: %v", retentionRepo.purged)
+	}
+	if ttl := mr.TTL(LinkRetentionLockKey); ttl != 0 {
+		t.Errorf("不应修改其他实例的锁, TTL: %s", ttl)
+	}
+}
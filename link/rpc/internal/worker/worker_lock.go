@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 后台任务分布式锁的租约时间（秒），执行期间定期续期，实例异常退出时锁在租约到期后自动释放
+const workerLockLeaseSeconds = 60
+
+// newWorkerLock 创建后台任务使用的分布式锁
+func newWorkerLock(rds *redis.Redis, key string) *redis.RedisLock {
+	lock := redis.NewRedisLock(rds, key)
+	lock.SetExpire(workerLockLeaseSeconds)
+	return lock
+}
+
+// keepLock 在本轮任务执行期间定期续期分布式锁，避免耗时较长的任务执行过程中锁过期
+// 续期失败时锁可能已被其他实例获取，取消返回的context以结束本轮任务，调用返回的stop函数停止续期
+func keepLock(ctx context.Context, lock *redis.RedisLock, name string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(workerLockLeaseSeconds * time.Second / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ok, err := lock.AcquireCtx(ctx)
+				if err != nil || !ok {
+					logx.Errorf("[%s] 续期分布式锁失败, 结束本轮任务: %v", name, err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return ctx, cancel
+}
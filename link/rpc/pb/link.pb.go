@@ -21,23 +21,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// 创建短链接请求
-type CreateShortLinkRequest struct {
+// A/B分流目标链接
+type LinkVariant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                                       // 域名
-	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                // 原始链接
-	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                             // 分组标识
-	ValidDateType int32                  `protobuf:"varint,4,opt,name=valid_date_type,json=validDateType,proto3" json:"valid_date_type,omitempty"` // 有效期类型
-	ValidDate     string                 `protobuf:"bytes,5,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                // 有效期（ISO-8601格式）
-	Describe      string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                   // 描述
-	CreatedType   int32                  `protobuf:"varint,7,opt,name=created_type,json=createdType,proto3" json:"created_type,omitempty"`         // 创建类型
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // 版本名称，如 A、B
+	TargetUrl     string                 `protobuf:"bytes,2,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"` // 目标链接
+	Weight        int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`                       // 流量权重（百分比），所有版本之和为100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LinkVariant) Reset() {
+	*x = LinkVariant{}
+	mi := &file_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkVariant) ProtoMessage() {}
+
+func (x *LinkVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkVariant.ProtoReflect.Descriptor instead.
+func (*LinkVariant) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinkVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkVariant) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *LinkVariant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// 创建短链接请求
+type CreateShortLinkRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Domain              string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                                                           // 域名
+	OriginUrl           string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                                    // 原始链接
+	Gid                 string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                                                 // 分组标识
+	ValidDateType       int32                  `protobuf:"varint,4,opt,name=valid_date_type,json=validDateType,proto3" json:"valid_date_type,omitempty"`                     // 有效期类型
+	ValidDate           string                 `protobuf:"bytes,5,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                                    // 有效期（ISO-8601格式）
+	Describe            string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                                       // 描述
+	CreatedType         int32                  `protobuf:"varint,7,opt,name=created_type,json=createdType,proto3" json:"created_type,omitempty"`                             // 创建类型
+	CustomUri           string                 `protobuf:"bytes,8,opt,name=custom_uri,json=customUri,proto3" json:"custom_uri,omitempty"`                                    // 自定义短链接后缀（可选）
+	Password            string                 `protobuf:"bytes,9,opt,name=password,proto3" json:"password,omitempty"`                                                       // 访问密码（可选）
+	MaxClicks           int32                  `protobuf:"varint,10,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`                                  // 最大访问次数，0表示不限制
+	Variants            []*LinkVariant         `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`                                                      // A/B分流目标链接（可选）
+	QueryParamPolicy    int32                  `protobuf:"varint,12,opt,name=query_param_policy,json=queryParamPolicy,proto3" json:"query_param_policy,omitempty"`           // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource           string                 `protobuf:"bytes,13,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`                                   // utm_source模板（可选）
+	UtmMedium           string                 `protobuf:"bytes,14,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`                                   // utm_medium模板（可选）
+	UtmCampaign         string                 `protobuf:"bytes,15,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`                             // utm_campaign模板（可选）
+	ValidFrom           string                 `protobuf:"bytes,16,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                                   // 生效时间（ISO-8601格式，可选），为空表示立即生效
+	ExpiredUrl          string                 `protobuf:"bytes,17,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                                // 过期后跳转链接（可选），为空时使用分组配置
+	ExpiredMessage      string                 `protobuf:"bytes,18,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`                    // 过期后提示信息（可选），为空时使用分组配置
+	GraceDays           int32                  `protobuf:"varint,19,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                                  // 过期宽限天数，宽限期内继续跳转，0表示使用分组配置
+	RedirectType        int32                  `protobuf:"varint,20,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`                         // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string                 `protobuf:"bytes,21,opt,name=ios_deep_link,json=iosDeepLink,proto3" json:"ios_deep_link,omitempty"`                           // iOS深度链接（可选），自定义Scheme或通用链接
+	AndroidPackage      string                 `protobuf:"bytes,22,opt,name=android_package,json=androidPackage,proto3" json:"android_package,omitempty"`                    // Android应用包名（可选）
+	AndroidDeepLink     string                 `protobuf:"bytes,23,opt,name=android_deep_link,json=androidDeepLink,proto3" json:"android_deep_link,omitempty"`               // Android深度链接（可选），自定义Scheme或App Links链接
+	DeepLinkFallbackUrl string                 `protobuf:"bytes,24,opt,name=deep_link_fallback_url,json=deepLinkFallbackUrl,proto3" json:"deep_link_fallback_url,omitempty"` // 未安装应用时的兜底链接（可选），如应用商店或网页，为空时使用原始链接
+	OgTitle             string                 `protobuf:"bytes,25,opt,name=og_title,json=ogTitle,proto3" json:"og_title,omitempty"`                                         // 社交分享预览标题（可选），为空时使用目标页面标题
+	OgDescription       string                 `protobuf:"bytes,26,opt,name=og_description,json=ogDescription,proto3" json:"og_description,omitempty"`                       // 社交分享预览描述（可选），为空时使用目标页面描述
+	OgImage             string                 `protobuf:"bytes,27,opt,name=og_image,json=ogImage,proto3" json:"og_image,omitempty"`                                         // 社交分享预览图片（可选），为空时使用目标页面图片
+	Tags                []string               `protobuf:"bytes,28,rep,name=tags,proto3" json:"tags,omitempty"`                                                              // 标签（可选）
+	TemplateId          int64                  `protobuf:"varint,29,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`                               // 短链接模板ID（可选），模板为未填写的字段提供默认值
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *CreateShortLinkRequest) Reset() {
 	*x = CreateShortLinkRequest{}
-	mi := &file_link_proto_msgTypes[0]
+	mi := &file_link_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49,7 +132,7 @@ func (x *CreateShortLinkRequest) String() string {
 func (*CreateShortLinkRequest) ProtoMessage() {}
 
 func (x *CreateShortLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[0]
+	mi := &file_link_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +145,7 @@ func (x *CreateShortLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShortLinkRequest.ProtoReflect.Descriptor instead.
 func (*CreateShortLinkRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{0}
+	return file_link_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateShortLinkRequest) GetDomain() string {
@@ -114,19 +197,174 @@ func (x *CreateShortLinkRequest) GetCreatedType() int32 {
 	return 0
 }
 
+func (x *CreateShortLinkRequest) GetCustomUri() string {
+	if x != nil {
+		return x.CustomUri
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *CreateShortLinkRequest) GetVariants() []*LinkVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *CreateShortLinkRequest) GetQueryParamPolicy() int32 {
+	if x != nil {
+		return x.QueryParamPolicy
+	}
+	return 0
+}
+
+func (x *CreateShortLinkRequest) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetExpiredUrl() string {
+	if x != nil {
+		return x.ExpiredUrl
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetExpiredMessage() string {
+	if x != nil {
+		return x.ExpiredMessage
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+func (x *CreateShortLinkRequest) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *CreateShortLinkRequest) GetIosDeepLink() string {
+	if x != nil {
+		return x.IosDeepLink
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetAndroidPackage() string {
+	if x != nil {
+		return x.AndroidPackage
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetAndroidDeepLink() string {
+	if x != nil {
+		return x.AndroidDeepLink
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetDeepLinkFallbackUrl() string {
+	if x != nil {
+		return x.DeepLinkFallbackUrl
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetOgTitle() string {
+	if x != nil {
+		return x.OgTitle
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetOgDescription() string {
+	if x != nil {
+		return x.OgDescription
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetOgImage() string {
+	if x != nil {
+		return x.OgImage
+	}
+	return ""
+}
+
+func (x *CreateShortLinkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateShortLinkRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
 // 创建短链接响应
 type CreateShortLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`            // 原始链接
-	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`   // 完整短链接
+	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`              // 原始链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                           // 分组标识
+	PendingReview bool                   `protobuf:"varint,4,opt,name=pending_review,json=pendingReview,proto3" json:"pending_review,omitempty"` // 目标链接未通过安全检测，短链接已禁用并等待审核
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateShortLinkResponse) Reset() {
 	*x = CreateShortLinkResponse{}
-	mi := &file_link_proto_msgTypes[1]
+	mi := &file_link_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +376,7 @@ func (x *CreateShortLinkResponse) String() string {
 func (*CreateShortLinkResponse) ProtoMessage() {}
 
 func (x *CreateShortLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[1]
+	mi := &file_link_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +389,7 @@ func (x *CreateShortLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShortLinkResponse.ProtoReflect.Descriptor instead.
 func (*CreateShortLinkResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{1}
+	return file_link_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateShortLinkResponse) GetFullShortUrl() string {
@@ -175,6 +413,13 @@ func (x *CreateShortLinkResponse) GetGid() string {
 	return ""
 }
 
+func (x *CreateShortLinkResponse) GetPendingReview() bool {
+	if x != nil {
+		return x.PendingReview
+	}
+	return false
+}
+
 // 批量创建短链接请求
 type BatchCreateShortLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -184,13 +429,15 @@ type BatchCreateShortLinkRequest struct {
 	ValidDateType int32                  `protobuf:"varint,4,opt,name=valid_date_type,json=validDateType,proto3" json:"valid_date_type,omitempty"` // 有效期类型
 	ValidDate     string                 `protobuf:"bytes,5,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                // 有效期（ISO-8601格式）
 	Describe      string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                   // 描述
+	ValidFrom     string                 `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                // 生效时间（ISO-8601格式，可选），为空表示立即生效
+	TemplateId    int64                  `protobuf:"varint,8,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`            // 短链接模板ID（可选），模板为未填写的字段提供默认值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BatchCreateShortLinkRequest) Reset() {
 	*x = BatchCreateShortLinkRequest{}
-	mi := &file_link_proto_msgTypes[2]
+	mi := &file_link_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +449,7 @@ func (x *BatchCreateShortLinkRequest) String() string {
 func (*BatchCreateShortLinkRequest) ProtoMessage() {}
 
 func (x *BatchCreateShortLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[2]
+	mi := &file_link_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +462,7 @@ func (x *BatchCreateShortLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCreateShortLinkRequest.ProtoReflect.Descriptor instead.
 func (*BatchCreateShortLinkRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{2}
+	return file_link_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BatchCreateShortLinkRequest) GetOriginUrls() []string {
@@ -260,19 +507,35 @@ func (x *BatchCreateShortLinkRequest) GetDescribe() string {
 	return ""
 }
 
+func (x *BatchCreateShortLinkRequest) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *BatchCreateShortLinkRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
 // 单个创建结果
 type BatchCreateResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`            // 原始链接
-	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`   // 完整短链接
+	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`              // 原始链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                           // 分组标识
+	PendingReview bool                   `protobuf:"varint,4,opt,name=pending_review,json=pendingReview,proto3" json:"pending_review,omitempty"` // 目标链接未通过安全检测，短链接已禁用并等待审核
+	Describe      string                 `protobuf:"bytes,5,opt,name=describe,proto3" json:"describe,omitempty"`                                 // 描述
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BatchCreateResult) Reset() {
 	*x = BatchCreateResult{}
-	mi := &file_link_proto_msgTypes[3]
+	mi := &file_link_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +547,7 @@ func (x *BatchCreateResult) String() string {
 func (*BatchCreateResult) ProtoMessage() {}
 
 func (x *BatchCreateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[3]
+	mi := &file_link_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +560,7 @@ func (x *BatchCreateResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCreateResult.ProtoReflect.Descriptor instead.
 func (*BatchCreateResult) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{3}
+	return file_link_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BatchCreateResult) GetFullShortUrl() string {
@@ -321,6 +584,20 @@ func (x *BatchCreateResult) GetGid() string {
 	return ""
 }
 
+func (x *BatchCreateResult) GetPendingReview() bool {
+	if x != nil {
+		return x.PendingReview
+	}
+	return false
+}
+
+func (x *BatchCreateResult) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
 // 批量创建短链接响应
 type BatchCreateShortLinkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -331,7 +608,7 @@ type BatchCreateShortLinkResponse struct {
 
 func (x *BatchCreateShortLinkResponse) Reset() {
 	*x = BatchCreateShortLinkResponse{}
-	mi := &file_link_proto_msgTypes[4]
+	mi := &file_link_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +620,7 @@ func (x *BatchCreateShortLinkResponse) String() string {
 func (*BatchCreateShortLinkResponse) ProtoMessage() {}
 
 func (x *BatchCreateShortLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[4]
+	mi := &file_link_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +633,7 @@ func (x *BatchCreateShortLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchCreateShortLinkResponse.ProtoReflect.Descriptor instead.
 func (*BatchCreateShortLinkResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{4}
+	return file_link_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BatchCreateShortLinkResponse) GetResults() []*BatchCreateResult {
@@ -367,21 +644,45 @@ func (x *BatchCreateShortLinkResponse) GetResults() []*BatchCreateResult {
 }
 
 // 修改短链接请求
+// optional字段未传入时保持原值不变，传入空值或0表示清除或恢复默认
 type UpdateShortLinkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`     // 完整短链接
-	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                // 原始链接
-	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                             // 分组标识
-	ValidDateType int32                  `protobuf:"varint,4,opt,name=valid_date_type,json=validDateType,proto3" json:"valid_date_type,omitempty"` // 有效期类型
-	ValidDate     string                 `protobuf:"bytes,5,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                // 有效期（ISO-8601格式）
-	Describe      string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                   // 描述
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl        string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`                               // 完整短链接
+	OriginUrl           string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                                          // 原始链接
+	Gid                 string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                                                       // 分组标识
+	ValidDateType       int32                  `protobuf:"varint,4,opt,name=valid_date_type,json=validDateType,proto3" json:"valid_date_type,omitempty"`                           // 有效期类型
+	ValidDate           string                 `protobuf:"bytes,5,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                                          // 有效期（ISO-8601格式）
+	Describe            string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                                             // 描述
+	Password            string                 `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`                                                             // 访问密码，为空表示不修改
+	ClearPassword       bool                   `protobuf:"varint,8,opt,name=clear_password,json=clearPassword,proto3" json:"clear_password,omitempty"`                             // 是否清除访问密码
+	MaxClicks           *int32                 `protobuf:"varint,9,opt,name=max_clicks,json=maxClicks,proto3,oneof" json:"max_clicks,omitempty"`                                   // 最大访问次数，0表示不限制
+	Variants            []*LinkVariant         `protobuf:"bytes,10,rep,name=variants,proto3" json:"variants,omitempty"`                                                            // A/B分流目标链接，为空表示不修改
+	ClearVariants       bool                   `protobuf:"varint,11,opt,name=clear_variants,json=clearVariants,proto3" json:"clear_variants,omitempty"`                            // 是否清除A/B分流
+	QueryParamPolicy    *int32                 `protobuf:"varint,12,opt,name=query_param_policy,json=queryParamPolicy,proto3,oneof" json:"query_param_policy,omitempty"`           // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource           *string                `protobuf:"bytes,13,opt,name=utm_source,json=utmSource,proto3,oneof" json:"utm_source,omitempty"`                                   // utm_source模板，为空表示不追加
+	UtmMedium           *string                `protobuf:"bytes,14,opt,name=utm_medium,json=utmMedium,proto3,oneof" json:"utm_medium,omitempty"`                                   // utm_medium模板，为空表示不追加
+	UtmCampaign         *string                `protobuf:"bytes,15,opt,name=utm_campaign,json=utmCampaign,proto3,oneof" json:"utm_campaign,omitempty"`                             // utm_campaign模板，为空表示不追加
+	ValidFrom           *string                `protobuf:"bytes,16,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`                                   // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          *string                `protobuf:"bytes,17,opt,name=expired_url,json=expiredUrl,proto3,oneof" json:"expired_url,omitempty"`                                // 过期后跳转链接，为空时使用分组配置
+	ExpiredMessage      *string                `protobuf:"bytes,18,opt,name=expired_message,json=expiredMessage,proto3,oneof" json:"expired_message,omitempty"`                    // 过期后提示信息，为空时使用分组配置
+	GraceDays           *int32                 `protobuf:"varint,19,opt,name=grace_days,json=graceDays,proto3,oneof" json:"grace_days,omitempty"`                                  // 过期宽限天数，宽限期内继续跳转，0表示使用分组配置
+	RedirectType        *int32                 `protobuf:"varint,20,opt,name=redirect_type,json=redirectType,proto3,oneof" json:"redirect_type,omitempty"`                         // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         *string                `protobuf:"bytes,21,opt,name=ios_deep_link,json=iosDeepLink,proto3,oneof" json:"ios_deep_link,omitempty"`                           // iOS深度链接（可选），自定义Scheme或通用链接
+	AndroidPackage      *string                `protobuf:"bytes,22,opt,name=android_package,json=androidPackage,proto3,oneof" json:"android_package,omitempty"`                    // Android应用包名（可选）
+	AndroidDeepLink     *string                `protobuf:"bytes,23,opt,name=android_deep_link,json=androidDeepLink,proto3,oneof" json:"android_deep_link,omitempty"`               // Android深度链接（可选），自定义Scheme或App Links链接
+	DeepLinkFallbackUrl *string                `protobuf:"bytes,24,opt,name=deep_link_fallback_url,json=deepLinkFallbackUrl,proto3,oneof" json:"deep_link_fallback_url,omitempty"` // 未安装应用时的兜底链接（可选），如应用商店或网页，为空时使用原始链接
+	OgTitle             *string                `protobuf:"bytes,25,opt,name=og_title,json=ogTitle,proto3,oneof" json:"og_title,omitempty"`                                         // 社交分享预览标题（可选），为空时使用目标页面标题
+	OgDescription       *string                `protobuf:"bytes,26,opt,name=og_description,json=ogDescription,proto3,oneof" json:"og_description,omitempty"`                       // 社交分享预览描述（可选），为空时使用目标页面描述
+	OgImage             *string                `protobuf:"bytes,27,opt,name=og_image,json=ogImage,proto3,oneof" json:"og_image,omitempty"`                                         // 社交分享预览图片（可选），为空时使用目标页面图片
+	Tags                []string               `protobuf:"bytes,28,rep,name=tags,proto3" json:"tags,omitempty"`                                                                    // 标签，为空表示不修改
+	ClearTags           bool                   `protobuf:"varint,29,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`                                        // 是否清除标签
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UpdateShortLinkRequest) Reset() {
 	*x = UpdateShortLinkRequest{}
-	mi := &file_link_proto_msgTypes[5]
+	mi := &file_link_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -393,7 +694,7 @@ func (x *UpdateShortLinkRequest) String() string {
 func (*UpdateShortLinkRequest) ProtoMessage() {}
 
 func (x *UpdateShortLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[5]
+	mi := &file_link_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +707,7 @@ func (x *UpdateShortLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateShortLinkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateShortLinkRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{5}
+	return file_link_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateShortLinkRequest) GetFullShortUrl() string {
@@ -451,30 +752,191 @@ func (x *UpdateShortLinkRequest) GetDescribe() string {
 	return ""
 }
 
-// 修改短链接响应（空结构体）
-type UpdateShortLinkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateShortLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *UpdateShortLinkResponse) Reset() {
-	*x = UpdateShortLinkResponse{}
-	mi := &file_link_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateShortLinkRequest) GetClearPassword() bool {
+	if x != nil {
+		return x.ClearPassword
+	}
+	return false
 }
 
-func (x *UpdateShortLinkResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateShortLinkRequest) GetMaxClicks() int32 {
+	if x != nil && x.MaxClicks != nil {
+		return *x.MaxClicks
+	}
+	return 0
 }
 
-func (*UpdateShortLinkResponse) ProtoMessage() {}
-
-func (x *UpdateShortLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[6]
+func (x *UpdateShortLinkRequest) GetVariants() []*LinkVariant {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *UpdateShortLinkRequest) GetClearVariants() bool {
+	if x != nil {
+		return x.ClearVariants
+	}
+	return false
+}
+
+func (x *UpdateShortLinkRequest) GetQueryParamPolicy() int32 {
+	if x != nil && x.QueryParamPolicy != nil {
+		return *x.QueryParamPolicy
+	}
+	return 0
+}
+
+func (x *UpdateShortLinkRequest) GetUtmSource() string {
+	if x != nil && x.UtmSource != nil {
+		return *x.UtmSource
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetUtmMedium() string {
+	if x != nil && x.UtmMedium != nil {
+		return *x.UtmMedium
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetUtmCampaign() string {
+	if x != nil && x.UtmCampaign != nil {
+		return *x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetValidFrom() string {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetExpiredUrl() string {
+	if x != nil && x.ExpiredUrl != nil {
+		return *x.ExpiredUrl
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetExpiredMessage() string {
+	if x != nil && x.ExpiredMessage != nil {
+		return *x.ExpiredMessage
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetGraceDays() int32 {
+	if x != nil && x.GraceDays != nil {
+		return *x.GraceDays
+	}
+	return 0
+}
+
+func (x *UpdateShortLinkRequest) GetRedirectType() int32 {
+	if x != nil && x.RedirectType != nil {
+		return *x.RedirectType
+	}
+	return 0
+}
+
+func (x *UpdateShortLinkRequest) GetIosDeepLink() string {
+	if x != nil && x.IosDeepLink != nil {
+		return *x.IosDeepLink
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetAndroidPackage() string {
+	if x != nil && x.AndroidPackage != nil {
+		return *x.AndroidPackage
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetAndroidDeepLink() string {
+	if x != nil && x.AndroidDeepLink != nil {
+		return *x.AndroidDeepLink
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetDeepLinkFallbackUrl() string {
+	if x != nil && x.DeepLinkFallbackUrl != nil {
+		return *x.DeepLinkFallbackUrl
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetOgTitle() string {
+	if x != nil && x.OgTitle != nil {
+		return *x.OgTitle
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetOgDescription() string {
+	if x != nil && x.OgDescription != nil {
+		return *x.OgDescription
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetOgImage() string {
+	if x != nil && x.OgImage != nil {
+		return *x.OgImage
+	}
+	return ""
+}
+
+func (x *UpdateShortLinkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateShortLinkRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+// 修改短链接响应（空结构体）
+type UpdateShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShortLinkResponse) Reset() {
+	*x = UpdateShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortLinkResponse) ProtoMessage() {}
+
+func (x *UpdateShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -485,22 +947,24 @@ func (x *UpdateShortLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateShortLinkResponse.ProtoReflect.Descriptor instead.
 func (*UpdateShortLinkResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{6}
+	return file_link_proto_rawDescGZIP(), []int{7}
 }
 
 // 分页查询短链接请求
 type PageShortLinkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`          // 分组标识
-	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"` // 当前页
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                  // 分组标识
+	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`                         // 当前页
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                               // 每页大小
+	BrokenOnly    bool                   `protobuf:"varint,4,opt,name=broken_only,json=brokenOnly,proto3" json:"broken_only,omitempty"` // 只查询目标链接已失效的短链接
+	OrderTag      string                 `protobuf:"bytes,5,opt,name=order_tag,json=orderTag,proto3" json:"order_tag,omitempty"`        // 排序字段 todayPv/todayUv/todayUip/totalPv/totalUv，均按降序排列，为空时按创建顺序倒序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PageShortLinkRequest) Reset() {
 	*x = PageShortLinkRequest{}
-	mi := &file_link_proto_msgTypes[7]
+	mi := &file_link_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +976,7 @@ func (x *PageShortLinkRequest) String() string {
 func (*PageShortLinkRequest) ProtoMessage() {}
 
 func (x *PageShortLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[7]
+	mi := &file_link_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +989,7 @@ func (x *PageShortLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageShortLinkRequest.ProtoReflect.Descriptor instead.
 func (*PageShortLinkRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{7}
+	return file_link_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PageShortLinkRequest) GetGid() string {
@@ -549,26 +1013,71 @@ func (x *PageShortLinkRequest) GetSize() int32 {
 	return 0
 }
 
+func (x *PageShortLinkRequest) GetBrokenOnly() bool {
+	if x != nil {
+		return x.BrokenOnly
+	}
+	return false
+}
+
+func (x *PageShortLinkRequest) GetOrderTag() string {
+	if x != nil {
+		return x.OrderTag
+	}
+	return ""
+}
+
 // 短链接记录
 type ShortLinkRecord struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`            // 原始链接
-	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                                   // 域名
-	Gid           string                 `protobuf:"bytes,4,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	CreateTime    string                 `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 创建时间（ISO-8601格式）
-	ValidDate     string                 `protobuf:"bytes,6,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`            // 有效期（ISO-8601格式）
-	Describe      string                 `protobuf:"bytes,7,opt,name=describe,proto3" json:"describe,omitempty"`                               // 描述
-	TotalPv       int32                  `protobuf:"varint,8,opt,name=total_pv,json=totalPv,proto3" json:"total_pv,omitempty"`                 // 总访问量
-	TotalUv       int32                  `protobuf:"varint,9,opt,name=total_uv,json=totalUv,proto3" json:"total_uv,omitempty"`                 // 总独立访问量
-	TotalUip      int32                  `protobuf:"varint,10,opt,name=total_uip,json=totalUip,proto3" json:"total_uip,omitempty"`             // 总IP数
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl        string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`                         // 完整短链接
+	OriginUrl           string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                                    // 原始链接
+	Domain              string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                                                           // 域名
+	Gid                 string                 `protobuf:"bytes,4,opt,name=gid,proto3" json:"gid,omitempty"`                                                                 // 分组标识
+	CreateTime          string                 `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                 // 创建时间（ISO-8601格式）
+	ValidDate           string                 `protobuf:"bytes,6,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`                                    // 有效期（ISO-8601格式）
+	Describe            string                 `protobuf:"bytes,7,opt,name=describe,proto3" json:"describe,omitempty"`                                                       // 描述
+	TotalPv             int32                  `protobuf:"varint,8,opt,name=total_pv,json=totalPv,proto3" json:"total_pv,omitempty"`                                         // 总访问量
+	TotalUv             int32                  `protobuf:"varint,9,opt,name=total_uv,json=totalUv,proto3" json:"total_uv,omitempty"`                                         // 总独立访问量
+	TotalUip            int32                  `protobuf:"varint,10,opt,name=total_uip,json=totalUip,proto3" json:"total_uip,omitempty"`                                     // 总IP数
+	EnableStatus        int32                  `protobuf:"varint,11,opt,name=enable_status,json=enableStatus,proto3" json:"enable_status,omitempty"`                         // 启用状态 0：启用 1：未启用
+	MaxClicks           int32                  `protobuf:"varint,12,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`                                  // 最大访问次数，0表示不限制
+	ClickNum            int32                  `protobuf:"varint,13,opt,name=click_num,json=clickNum,proto3" json:"click_num,omitempty"`                                     // 已访问次数
+	QueryParamPolicy    int32                  `protobuf:"varint,14,opt,name=query_param_policy,json=queryParamPolicy,proto3" json:"query_param_policy,omitempty"`           // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource           string                 `protobuf:"bytes,15,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`                                   // utm_source模板
+	UtmMedium           string                 `protobuf:"bytes,16,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`                                   // utm_medium模板
+	UtmCampaign         string                 `protobuf:"bytes,17,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`                             // utm_campaign模板
+	ValidFrom           string                 `protobuf:"bytes,18,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                                   // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          string                 `protobuf:"bytes,19,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                                // 过期后跳转链接
+	ExpiredMessage      string                 `protobuf:"bytes,20,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`                    // 过期后提示信息
+	GraceDays           int32                  `protobuf:"varint,21,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                                  // 过期宽限天数
+	InGracePeriod       bool                   `protobuf:"varint,22,opt,name=in_grace_period,json=inGracePeriod,proto3" json:"in_grace_period,omitempty"`                    // 是否已过期但处于宽限期内
+	RedirectType        int32                  `protobuf:"varint,23,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`                         // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string                 `protobuf:"bytes,24,opt,name=ios_deep_link,json=iosDeepLink,proto3" json:"ios_deep_link,omitempty"`                           // iOS深度链接
+	AndroidPackage      string                 `protobuf:"bytes,25,opt,name=android_package,json=androidPackage,proto3" json:"android_package,omitempty"`                    // Android应用包名
+	AndroidDeepLink     string                 `protobuf:"bytes,26,opt,name=android_deep_link,json=androidDeepLink,proto3" json:"android_deep_link,omitempty"`               // Android深度链接
+	DeepLinkFallbackUrl string                 `protobuf:"bytes,27,opt,name=deep_link_fallback_url,json=deepLinkFallbackUrl,proto3" json:"deep_link_fallback_url,omitempty"` // 未安装应用时的兜底链接
+	OgTitle             string                 `protobuf:"bytes,28,opt,name=og_title,json=ogTitle,proto3" json:"og_title,omitempty"`                                         // 社交分享预览标题
+	OgDescription       string                 `protobuf:"bytes,29,opt,name=og_description,json=ogDescription,proto3" json:"og_description,omitempty"`                       // 社交分享预览描述
+	OgImage             string                 `protobuf:"bytes,30,opt,name=og_image,json=ogImage,proto3" json:"og_image,omitempty"`                                         // 社交分享预览图片
+	SafetyStatus        int32                  `protobuf:"varint,31,opt,name=safety_status,json=safetyStatus,proto3" json:"safety_status,omitempty"`                         // 安全状态 0：正常 1：待审核（已禁用） 2：风险提示 3：审核未通过（已禁用）
+	HealthStatus        int32                  `protobuf:"varint,32,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`                         // 目标链接健康状态 0：未检测 1：正常 2：失效
+	HealthCode          int32                  `protobuf:"varint,33,opt,name=health_code,json=healthCode,proto3" json:"health_code,omitempty"`                               // 最近一次检测的HTTP状态码，请求失败时为0
+	HealthLatency       int32                  `protobuf:"varint,34,opt,name=health_latency,json=healthLatency,proto3" json:"health_latency,omitempty"`                      // 最近一次检测的响应耗时（毫秒）
+	HealthRedirects     []string               `protobuf:"bytes,35,rep,name=health_redirects,json=healthRedirects,proto3" json:"health_redirects,omitempty"`                 // 最近一次检测的重定向链路
+	HealthError         string                 `protobuf:"bytes,36,opt,name=health_error,json=healthError,proto3" json:"health_error,omitempty"`                             // 最近一次检测的失败原因
+	HealthCheckTime     string                 `protobuf:"bytes,37,opt,name=health_check_time,json=healthCheckTime,proto3" json:"health_check_time,omitempty"`               // 最近一次检测时间（ISO-8601格式），为空表示未检测
+	Tags                []string               `protobuf:"bytes,38,rep,name=tags,proto3" json:"tags,omitempty"`                                                              // 标签
+	TodayPv             int32                  `protobuf:"varint,39,opt,name=today_pv,json=todayPv,proto3" json:"today_pv,omitempty"`                                        // 今日访问量
+	TodayUv             int32                  `protobuf:"varint,40,opt,name=today_uv,json=todayUv,proto3" json:"today_uv,omitempty"`                                        // 今日独立访问量
+	TodayUip            int32                  `protobuf:"varint,41,opt,name=today_uip,json=todayUip,proto3" json:"today_uip,omitempty"`                                     // 今日IP数
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ShortLinkRecord) Reset() {
 	*x = ShortLinkRecord{}
-	mi := &file_link_proto_msgTypes[8]
+	mi := &file_link_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +1089,7 @@ func (x *ShortLinkRecord) String() string {
 func (*ShortLinkRecord) ProtoMessage() {}
 
 func (x *ShortLinkRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[8]
+	mi := &file_link_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +1102,7 @@ func (x *ShortLinkRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortLinkRecord.ProtoReflect.Descriptor instead.
 func (*ShortLinkRecord) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{8}
+	return file_link_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ShortLinkRecord) GetFullShortUrl() string {
@@ -666,150 +1175,324 @@ func (x *ShortLinkRecord) GetTotalUip() int32 {
 	return 0
 }
 
-// 分页响应
-type PageShortLinkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*ShortLinkRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 短链接记录列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
-	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShortLinkRecord) GetEnableStatus() int32 {
+	if x != nil {
+		return x.EnableStatus
+	}
+	return 0
 }
 
-func (x *PageShortLinkResponse) Reset() {
-	*x = PageShortLinkResponse{}
-	mi := &file_link_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ShortLinkRecord) GetMaxClicks() int32 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
 }
 
-func (x *PageShortLinkResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ShortLinkRecord) GetClickNum() int32 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
 }
 
-func (*PageShortLinkResponse) ProtoMessage() {}
+func (x *ShortLinkRecord) GetQueryParamPolicy() int32 {
+	if x != nil {
+		return x.QueryParamPolicy
+	}
+	return 0
+}
 
-func (x *PageShortLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[9]
+func (x *ShortLinkRecord) GetUtmSource() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UtmSource
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PageShortLinkResponse.ProtoReflect.Descriptor instead.
-func (*PageShortLinkResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{9}
+func (x *ShortLinkRecord) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
 }
 
-func (x *PageShortLinkResponse) GetRecords() []*ShortLinkRecord {
+func (x *ShortLinkRecord) GetUtmCampaign() string {
 	if x != nil {
-		return x.Records
+		return x.UtmCampaign
 	}
-	return nil
+	return ""
 }
 
-func (x *PageShortLinkResponse) GetTotal() int32 {
+func (x *ShortLinkRecord) GetValidFrom() string {
 	if x != nil {
-		return x.Total
+		return x.ValidFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *PageShortLinkResponse) GetSize() int32 {
+func (x *ShortLinkRecord) GetExpiredUrl() string {
 	if x != nil {
-		return x.Size
+		return x.ExpiredUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *PageShortLinkResponse) GetCurrent() int32 {
+func (x *ShortLinkRecord) GetExpiredMessage() string {
 	if x != nil {
-		return x.Current
+		return x.ExpiredMessage
 	}
-	return 0
+	return ""
 }
 
-// 保存到回收站请求
-type SaveToRecycleBinRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShortLinkRecord) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
 }
 
-func (x *SaveToRecycleBinRequest) Reset() {
-	*x = SaveToRecycleBinRequest{}
-	mi := &file_link_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ShortLinkRecord) GetInGracePeriod() bool {
+	if x != nil {
+		return x.InGracePeriod
+	}
+	return false
 }
 
-func (x *SaveToRecycleBinRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ShortLinkRecord) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
 }
 
-func (*SaveToRecycleBinRequest) ProtoMessage() {}
+func (x *ShortLinkRecord) GetIosDeepLink() string {
+	if x != nil {
+		return x.IosDeepLink
+	}
+	return ""
+}
 
-func (x *SaveToRecycleBinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[10]
+func (x *ShortLinkRecord) GetAndroidPackage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AndroidPackage
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SaveToRecycleBinRequest.ProtoReflect.Descriptor instead.
-func (*SaveToRecycleBinRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{10}
+func (x *ShortLinkRecord) GetAndroidDeepLink() string {
+	if x != nil {
+		return x.AndroidDeepLink
+	}
+	return ""
 }
 
-func (x *SaveToRecycleBinRequest) GetGid() string {
+func (x *ShortLinkRecord) GetDeepLinkFallbackUrl() string {
 	if x != nil {
-		return x.Gid
+		return x.DeepLinkFallbackUrl
 	}
 	return ""
 }
 
-func (x *SaveToRecycleBinRequest) GetFullShortUrl() string {
+func (x *ShortLinkRecord) GetOgTitle() string {
 	if x != nil {
-		return x.FullShortUrl
+		return x.OgTitle
 	}
 	return ""
 }
 
-// 保存到回收站响应
-type SaveToRecycleBinResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShortLinkRecord) GetOgDescription() string {
+	if x != nil {
+		return x.OgDescription
+	}
+	return ""
 }
 
-func (x *SaveToRecycleBinResponse) Reset() {
-	*x = SaveToRecycleBinResponse{}
+func (x *ShortLinkRecord) GetOgImage() string {
+	if x != nil {
+		return x.OgImage
+	}
+	return ""
+}
+
+func (x *ShortLinkRecord) GetSafetyStatus() int32 {
+	if x != nil {
+		return x.SafetyStatus
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetHealthStatus() int32 {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetHealthCode() int32 {
+	if x != nil {
+		return x.HealthCode
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetHealthLatency() int32 {
+	if x != nil {
+		return x.HealthLatency
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetHealthRedirects() []string {
+	if x != nil {
+		return x.HealthRedirects
+	}
+	return nil
+}
+
+func (x *ShortLinkRecord) GetHealthError() string {
+	if x != nil {
+		return x.HealthError
+	}
+	return ""
+}
+
+func (x *ShortLinkRecord) GetHealthCheckTime() string {
+	if x != nil {
+		return x.HealthCheckTime
+	}
+	return ""
+}
+
+func (x *ShortLinkRecord) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ShortLinkRecord) GetTodayPv() int32 {
+	if x != nil {
+		return x.TodayPv
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetTodayUv() int32 {
+	if x != nil {
+		return x.TodayUv
+	}
+	return 0
+}
+
+func (x *ShortLinkRecord) GetTodayUip() int32 {
+	if x != nil {
+		return x.TodayUip
+	}
+	return 0
+}
+
+// 分页响应
+type PageShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*ShortLinkRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 短链接记录列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageShortLinkResponse) Reset() {
+	*x = PageShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageShortLinkResponse) ProtoMessage() {}
+
+func (x *PageShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*PageShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PageShortLinkResponse) GetRecords() []*ShortLinkRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *PageShortLinkResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PageShortLinkResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PageShortLinkResponse) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 搜索短链接请求，在当前用户的分组中按关键字匹配描述、原始链接和短链接后缀
+type SearchShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                            // 关键字，为空表示不按关键字筛选
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`                                    // 标签
+	Gids          []string               `protobuf:"bytes,3,rep,name=gids,proto3" json:"gids,omitempty"`                                  // 分组标识列表，为空表示当前用户的全部分组
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                              // 启用状态 enabled：已启用 disabled：未启用，为空表示全部
+	Expiry        string                 `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty"`                              // 有效期状态 valid：未过期 expired：已过期，为空表示全部
+	CreateStart   string                 `protobuf:"bytes,6,opt,name=create_start,json=createStart,proto3" json:"create_start,omitempty"` // 创建时间起始（ISO-8601格式）
+	CreateEnd     string                 `protobuf:"bytes,7,opt,name=create_end,json=createEnd,proto3" json:"create_end,omitempty"`       // 创建时间截止（ISO-8601格式）
+	OrderBy       string                 `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`             // 排序字段 create_time：创建时间 total_pv：总访问量 today_pv：今日访问量，默认create_time
+	Asc           bool                   `protobuf:"varint,9,opt,name=asc,proto3" json:"asc,omitempty"`                                   // 是否升序，默认降序
+	Current       int32                  `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`                          // 当前页
+	Size          int32                  `protobuf:"varint,11,opt,name=size,proto3" json:"size,omitempty"`                                // 每页大小
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchShortLinkRequest) Reset() {
+	*x = SearchShortLinkRequest{}
 	mi := &file_link_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveToRecycleBinResponse) String() string {
+func (x *SearchShortLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveToRecycleBinResponse) ProtoMessage() {}
+func (*SearchShortLinkRequest) ProtoMessage() {}
 
-func (x *SaveToRecycleBinResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchShortLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_link_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,42 +1504,5716 @@ func (x *SaveToRecycleBinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveToRecycleBinResponse.ProtoReflect.Descriptor instead.
-func (*SaveToRecycleBinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*SearchShortLinkRequest) Descriptor() ([]byte, []int) {
 	return file_link_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SaveToRecycleBinResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+func (x *SearchShortLinkRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetGids() []string {
+	if x != nil {
+		return x.Gids
+	}
+	return nil
+}
+
+func (x *SearchShortLinkRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetExpiry() string {
+	if x != nil {
+		return x.Expiry
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetCreateStart() string {
+	if x != nil {
+		return x.CreateStart
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetCreateEnd() string {
+	if x != nil {
+		return x.CreateEnd
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchShortLinkRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+func (x *SearchShortLinkRequest) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *SearchShortLinkRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 搜索短链接响应
+type SearchShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*ShortLinkRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 短链接记录列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchShortLinkResponse) Reset() {
+	*x = SearchShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShortLinkResponse) ProtoMessage() {}
+
+func (x *SearchShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*SearchShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchShortLinkResponse) GetRecords() []*ShortLinkRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *SearchShortLinkResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchShortLinkResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchShortLinkResponse) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 保存到回收站请求
+type SaveToRecycleBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveToRecycleBinRequest) Reset() {
+	*x = SaveToRecycleBinRequest{}
+	mi := &file_link_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveToRecycleBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveToRecycleBinRequest) ProtoMessage() {}
+
+func (x *SaveToRecycleBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveToRecycleBinRequest.ProtoReflect.Descriptor instead.
+func (*SaveToRecycleBinRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveToRecycleBinRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *SaveToRecycleBinRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+// 保存到回收站响应
+type SaveToRecycleBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveToRecycleBinResponse) Reset() {
+	*x = SaveToRecycleBinResponse{}
+	mi := &file_link_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveToRecycleBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveToRecycleBinResponse) ProtoMessage() {}
+
+func (x *SaveToRecycleBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveToRecycleBinResponse.ProtoReflect.Descriptor instead.
+func (*SaveToRecycleBinResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SaveToRecycleBinResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 从回收站恢复请求
+type RecoverFromRecycleBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoverFromRecycleBinRequest) Reset() {
+	*x = RecoverFromRecycleBinRequest{}
+	mi := &file_link_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverFromRecycleBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverFromRecycleBinRequest) ProtoMessage() {}
+
+func (x *RecoverFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverFromRecycleBinRequest.ProtoReflect.Descriptor instead.
+func (*RecoverFromRecycleBinRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecoverFromRecycleBinRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *RecoverFromRecycleBinRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+// 从回收站恢复响应
+type RecoverFromRecycleBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoverFromRecycleBinResponse) Reset() {
+	*x = RecoverFromRecycleBinResponse{}
+	mi := &file_link_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverFromRecycleBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverFromRecycleBinResponse) ProtoMessage() {}
+
+func (x *RecoverFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverFromRecycleBinResponse.ProtoReflect.Descriptor instead.
+func (*RecoverFromRecycleBinResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RecoverFromRecycleBinResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 从回收站删除请求
+type RemoveFromRecycleBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromRecycleBinRequest) Reset() {
+	*x = RemoveFromRecycleBinRequest{}
+	mi := &file_link_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromRecycleBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromRecycleBinRequest) ProtoMessage() {}
+
+func (x *RemoveFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromRecycleBinRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromRecycleBinRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveFromRecycleBinRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *RemoveFromRecycleBinRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+// 从回收站删除响应
+type RemoveFromRecycleBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromRecycleBinResponse) Reset() {
+	*x = RemoveFromRecycleBinResponse{}
+	mi := &file_link_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromRecycleBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromRecycleBinResponse) ProtoMessage() {}
+
+func (x *RemoveFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromRecycleBinResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromRecycleBinResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveFromRecycleBinResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 分页查询回收站短链接请求
+type PageRecycleBinShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`          // 分组标识
+	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRecycleBinShortLinkRequest) Reset() {
+	*x = PageRecycleBinShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRecycleBinShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRecycleBinShortLinkRequest) ProtoMessage() {}
+
+func (x *PageRecycleBinShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRecycleBinShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*PageRecycleBinShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PageRecycleBinShortLinkRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *PageRecycleBinShortLinkRequest) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *PageRecycleBinShortLinkRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 分页查询回收站短链接响应
+type PageRecycleBinShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*ShortLinkRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 回收站短链接记录列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRecycleBinShortLinkResponse) Reset() {
+	*x = PageRecycleBinShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRecycleBinShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRecycleBinShortLinkResponse) ProtoMessage() {}
+
+func (x *PageRecycleBinShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRecycleBinShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*PageRecycleBinShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PageRecycleBinShortLinkResponse) GetRecords() []*ShortLinkRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *PageRecycleBinShortLinkResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PageRecycleBinShortLinkResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PageRecycleBinShortLinkResponse) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 获取单个短链接统计数据请求
+type GetSingleStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // 开始日期（ISO-8601格式）
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                  // 结束日期（ISO-8601格式）
+	EnableStatus  int32                  `protobuf:"varint,5,opt,name=enable_status,json=enableStatus,proto3" json:"enable_status,omitempty"`  // 启用状态 0：启用 1：未启用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSingleStatsRequest) Reset() {
+	*x = GetSingleStatsRequest{}
+	mi := &file_link_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSingleStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSingleStatsRequest) ProtoMessage() {}
+
+func (x *GetSingleStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSingleStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSingleStatsRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSingleStatsRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *GetSingleStatsRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *GetSingleStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSingleStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetSingleStatsRequest) GetEnableStatus() int32 {
+	if x != nil {
+		return x.EnableStatus
+	}
+	return 0
+}
+
+// 每日统计
+type DailyStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 日期
+	Pv            int32                  `protobuf:"varint,2,opt,name=pv,proto3" json:"pv,omitempty"`    // 访问量
+	Uv            int32                  `protobuf:"varint,3,opt,name=uv,proto3" json:"uv,omitempty"`    // 独立访问量
+	Uip           int32                  `protobuf:"varint,4,opt,name=uip,proto3" json:"uip,omitempty"`  // IP数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyStat) Reset() {
+	*x = DailyStat{}
+	mi := &file_link_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStat) ProtoMessage() {}
+
+func (x *DailyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
+func (*DailyStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DailyStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStat) GetPv() int32 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *DailyStat) GetUv() int32 {
+	if x != nil {
+		return x.Uv
+	}
+	return 0
+}
+
+func (x *DailyStat) GetUip() int32 {
+	if x != nil {
+		return x.Uip
+	}
+	return 0
+}
+
+// 地域统计
+type LocaleCnStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // 地区
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocaleCnStat) Reset() {
+	*x = LocaleCnStat{}
+	mi := &file_link_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocaleCnStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocaleCnStat) ProtoMessage() {}
+
+func (x *LocaleCnStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocaleCnStat.ProtoReflect.Descriptor instead.
+func (*LocaleCnStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LocaleCnStat) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocaleCnStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *LocaleCnStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 浏览器统计
+type BrowserStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Browser       string                 `protobuf:"bytes,1,opt,name=browser,proto3" json:"browser,omitempty"` // 浏览器
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`        // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`   // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowserStat) Reset() {
+	*x = BrowserStat{}
+	mi := &file_link_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowserStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowserStat) ProtoMessage() {}
+
+func (x *BrowserStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowserStat.ProtoReflect.Descriptor instead.
+func (*BrowserStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BrowserStat) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *BrowserStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *BrowserStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 操作系统统计
+type OSStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Os            string                 `protobuf:"bytes,1,opt,name=os,proto3" json:"os,omitempty"`         // 操作系统
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OSStat) Reset() {
+	*x = OSStat{}
+	mi := &file_link_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OSStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSStat) ProtoMessage() {}
+
+func (x *OSStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSStat.ProtoReflect.Descriptor instead.
+func (*OSStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OSStat) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *OSStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *OSStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 设备统计
+type DeviceStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"` // 设备
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceStat) Reset() {
+	*x = DeviceStat{}
+	mi := &file_link_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceStat) ProtoMessage() {}
+
+func (x *DeviceStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceStat.ProtoReflect.Descriptor instead.
+func (*DeviceStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeviceStat) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DeviceStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *DeviceStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 网络统计
+type NetworkStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // 网络类型
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`        // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`   // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkStat) Reset() {
+	*x = NetworkStat{}
+	mi := &file_link_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStat) ProtoMessage() {}
+
+func (x *NetworkStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStat.ProtoReflect.Descriptor instead.
+func (*NetworkStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NetworkStat) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NetworkStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *NetworkStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 高频访问IP统计
+type TopIpStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`         // IP地址
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 访问次数
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopIpStat) Reset() {
+	*x = TopIpStat{}
+	mi := &file_link_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopIpStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopIpStat) ProtoMessage() {}
+
+func (x *TopIpStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopIpStat.ProtoReflect.Descriptor instead.
+func (*TopIpStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TopIpStat) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *TopIpStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *TopIpStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// 访客类型统计
+type UvTypeStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UvType        string                 `protobuf:"bytes,1,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"` // 访客类型
+	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`                    // 数量
+	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`               // 比例
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UvTypeStat) Reset() {
+	*x = UvTypeStat{}
+	mi := &file_link_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UvTypeStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UvTypeStat) ProtoMessage() {}
+
+func (x *UvTypeStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UvTypeStat.ProtoReflect.Descriptor instead.
+func (*UvTypeStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UvTypeStat) GetUvType() string {
+	if x != nil {
+		return x.UvType
+	}
+	return ""
+}
+
+func (x *UvTypeStat) GetCnt() int32 {
+	if x != nil {
+		return x.Cnt
+	}
+	return 0
+}
+
+func (x *UvTypeStat) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+// A/B分流版本统计
+type VariantStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       string                 `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`                      // 版本名称
+	TargetUrl     string                 `protobuf:"bytes,2,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"` // 目标链接
+	Weight        int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`                       // 流量权重
+	Pv            int32                  `protobuf:"varint,4,opt,name=pv,proto3" json:"pv,omitempty"`                               // 访问量
+	Uv            int32                  `protobuf:"varint,5,opt,name=uv,proto3" json:"uv,omitempty"`                               // 独立访客数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantStat) Reset() {
+	*x = VariantStat{}
+	mi := &file_link_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantStat) ProtoMessage() {}
+
+func (x *VariantStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantStat.ProtoReflect.Descriptor instead.
+func (*VariantStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VariantStat) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *VariantStat) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *VariantStat) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *VariantStat) GetPv() int32 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *VariantStat) GetUv() int32 {
+	if x != nil {
+		return x.Uv
+	}
+	return 0
+}
+
+// 访问渠道统计
+type ChannelStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // 访问渠道，direct表示直接访问，qr表示扫描二维码
+	Pv            int32                  `protobuf:"varint,2,opt,name=pv,proto3" json:"pv,omitempty"`          // 访问量
+	Uv            int32                  `protobuf:"varint,3,opt,name=uv,proto3" json:"uv,omitempty"`          // 独立访客数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelStat) Reset() {
+	*x = ChannelStat{}
+	mi := &file_link_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelStat) ProtoMessage() {}
+
+func (x *ChannelStat) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelStat.ProtoReflect.Descriptor instead.
+func (*ChannelStat) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChannelStat) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelStat) GetPv() int32 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *ChannelStat) GetUv() int32 {
+	if x != nil {
+		return x.Uv
+	}
+	return 0
+}
+
+// 获取单个短链接统计数据响应
+type GetSingleStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pv            int32                  `protobuf:"varint,1,opt,name=pv,proto3" json:"pv,omitempty"`                                                // 访问量
+	Uv            int32                  `protobuf:"varint,2,opt,name=uv,proto3" json:"uv,omitempty"`                                                // 独立访问量
+	Uip           int32                  `protobuf:"varint,3,opt,name=uip,proto3" json:"uip,omitempty"`                                              // IP数
+	Daily         []*DailyStat           `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`                                           // 每日统计
+	LocaleCnStats []*LocaleCnStat        `protobuf:"bytes,5,rep,name=locale_cn_stats,json=localeCnStats,proto3" json:"locale_cn_stats,omitempty"`    // 地域统计
+	HourStats     []int32                `protobuf:"varint,6,rep,packed,name=hour_stats,json=hourStats,proto3" json:"hour_stats,omitempty"`          // 小时访问详情（24小时）
+	TopIpStats    []*TopIpStat           `protobuf:"bytes,7,rep,name=top_ip_stats,json=topIpStats,proto3" json:"top_ip_stats,omitempty"`             // 高频访问IP详情
+	WeekdayStats  []int32                `protobuf:"varint,8,rep,packed,name=weekday_stats,json=weekdayStats,proto3" json:"weekday_stats,omitempty"` // 一周访问详情（7天）
+	BrowserStats  []*BrowserStat         `protobuf:"bytes,9,rep,name=browser_stats,json=browserStats,proto3" json:"browser_stats,omitempty"`         // 浏览器统计
+	OsStats       []*OSStat              `protobuf:"bytes,10,rep,name=os_stats,json=osStats,proto3" json:"os_stats,omitempty"`                       // 操作系统统计
+	UvTypeStats   []*UvTypeStat          `protobuf:"bytes,11,rep,name=uv_type_stats,json=uvTypeStats,proto3" json:"uv_type_stats,omitempty"`         // 访客类型统计
+	DeviceStats   []*DeviceStat          `protobuf:"bytes,12,rep,name=device_stats,json=deviceStats,proto3" json:"device_stats,omitempty"`           // 设备统计
+	NetworkStats  []*NetworkStat         `protobuf:"bytes,13,rep,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"`        // 网络统计
+	VariantStats  []*VariantStat         `protobuf:"bytes,14,rep,name=variant_stats,json=variantStats,proto3" json:"variant_stats,omitempty"`        // A/B分流版本统计
+	ChannelStats  []*ChannelStat         `protobuf:"bytes,15,rep,name=channel_stats,json=channelStats,proto3" json:"channel_stats,omitempty"`        // 访问渠道统计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSingleStatsResponse) Reset() {
+	*x = GetSingleStatsResponse{}
+	mi := &file_link_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSingleStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSingleStatsResponse) ProtoMessage() {}
+
+func (x *GetSingleStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSingleStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSingleStatsResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSingleStatsResponse) GetPv() int32 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *GetSingleStatsResponse) GetUv() int32 {
+	if x != nil {
+		return x.Uv
+	}
+	return 0
+}
+
+func (x *GetSingleStatsResponse) GetUip() int32 {
+	if x != nil {
+		return x.Uip
+	}
+	return 0
+}
+
+func (x *GetSingleStatsResponse) GetDaily() []*DailyStat {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetLocaleCnStats() []*LocaleCnStat {
+	if x != nil {
+		return x.LocaleCnStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetHourStats() []int32 {
+	if x != nil {
+		return x.HourStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetTopIpStats() []*TopIpStat {
+	if x != nil {
+		return x.TopIpStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetWeekdayStats() []int32 {
+	if x != nil {
+		return x.WeekdayStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetBrowserStats() []*BrowserStat {
+	if x != nil {
+		return x.BrowserStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetOsStats() []*OSStat {
+	if x != nil {
+		return x.OsStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetUvTypeStats() []*UvTypeStat {
+	if x != nil {
+		return x.UvTypeStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetDeviceStats() []*DeviceStat {
+	if x != nil {
+		return x.DeviceStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetNetworkStats() []*NetworkStat {
+	if x != nil {
+		return x.NetworkStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetVariantStats() []*VariantStat {
+	if x != nil {
+		return x.VariantStats
+	}
+	return nil
+}
+
+func (x *GetSingleStatsResponse) GetChannelStats() []*ChannelStat {
+	if x != nil {
+		return x.ChannelStats
+	}
+	return nil
+}
+
+// 获取分组短链接统计数据请求
+type GetGroupStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                              // 分组标识
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（ISO-8601格式）
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（ISO-8601格式）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupStatsRequest) Reset() {
+	*x = GetGroupStatsRequest{}
+	mi := &file_link_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupStatsRequest) ProtoMessage() {}
+
+func (x *GetGroupStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupStatsRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetGroupStatsRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *GetGroupStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 获取分组短链接统计数据响应
+type GetGroupStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pv            int32                  `protobuf:"varint,1,opt,name=pv,proto3" json:"pv,omitempty"`                                                // 访问量
+	Uv            int32                  `protobuf:"varint,2,opt,name=uv,proto3" json:"uv,omitempty"`                                                // 独立访问量
+	Uip           int32                  `protobuf:"varint,3,opt,name=uip,proto3" json:"uip,omitempty"`                                              // IP数
+	Daily         []*DailyStat           `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`                                           // 每日统计
+	LocaleCnStats []*LocaleCnStat        `protobuf:"bytes,5,rep,name=locale_cn_stats,json=localeCnStats,proto3" json:"locale_cn_stats,omitempty"`    // 地域统计
+	HourStats     []int32                `protobuf:"varint,6,rep,packed,name=hour_stats,json=hourStats,proto3" json:"hour_stats,omitempty"`          // 小时访问详情（24小时）
+	TopIpStats    []*TopIpStat           `protobuf:"bytes,7,rep,name=top_ip_stats,json=topIpStats,proto3" json:"top_ip_stats,omitempty"`             // 高频访问IP详情
+	WeekdayStats  []int32                `protobuf:"varint,8,rep,packed,name=weekday_stats,json=weekdayStats,proto3" json:"weekday_stats,omitempty"` // 一周访问详情（7天）
+	BrowserStats  []*BrowserStat         `protobuf:"bytes,9,rep,name=browser_stats,json=browserStats,proto3" json:"browser_stats,omitempty"`         // 浏览器统计
+	OsStats       []*OSStat              `protobuf:"bytes,10,rep,name=os_stats,json=osStats,proto3" json:"os_stats,omitempty"`                       // 操作系统统计
+	UvTypeStats   []*UvTypeStat          `protobuf:"bytes,11,rep,name=uv_type_stats,json=uvTypeStats,proto3" json:"uv_type_stats,omitempty"`         // 访客类型统计
+	DeviceStats   []*DeviceStat          `protobuf:"bytes,12,rep,name=device_stats,json=deviceStats,proto3" json:"device_stats,omitempty"`           // 设备统计
+	NetworkStats  []*NetworkStat         `protobuf:"bytes,13,rep,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"`        // 网络统计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupStatsResponse) Reset() {
+	*x = GetGroupStatsResponse{}
+	mi := &file_link_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupStatsResponse) ProtoMessage() {}
+
+func (x *GetGroupStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupStatsResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetGroupStatsResponse) GetPv() int32 {
+	if x != nil {
+		return x.Pv
+	}
+	return 0
+}
+
+func (x *GetGroupStatsResponse) GetUv() int32 {
+	if x != nil {
+		return x.Uv
+	}
+	return 0
+}
+
+func (x *GetGroupStatsResponse) GetUip() int32 {
+	if x != nil {
+		return x.Uip
+	}
+	return 0
+}
+
+func (x *GetGroupStatsResponse) GetDaily() []*DailyStat {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetLocaleCnStats() []*LocaleCnStat {
+	if x != nil {
+		return x.LocaleCnStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetHourStats() []int32 {
+	if x != nil {
+		return x.HourStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetTopIpStats() []*TopIpStat {
+	if x != nil {
+		return x.TopIpStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetWeekdayStats() []int32 {
+	if x != nil {
+		return x.WeekdayStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetBrowserStats() []*BrowserStat {
+	if x != nil {
+		return x.BrowserStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetOsStats() []*OSStat {
+	if x != nil {
+		return x.OsStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetUvTypeStats() []*UvTypeStat {
+	if x != nil {
+		return x.UvTypeStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetDeviceStats() []*DeviceStat {
+	if x != nil {
+		return x.DeviceStats
+	}
+	return nil
+}
+
+func (x *GetGroupStatsResponse) GetNetworkStats() []*NetworkStat {
+	if x != nil {
+		return x.NetworkStats
+	}
+	return nil
+}
+
+// 单个分组数量结果
+type GroupCount struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                                // 分组标识
+	ShortLinkCount int32                  `protobuf:"varint,2,opt,name=short_link_count,json=shortLinkCount,proto3" json:"short_link_count,omitempty"` // 短链接数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GroupCount) Reset() {
+	*x = GroupCount{}
+	mi := &file_link_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupCount) ProtoMessage() {}
+
+func (x *GroupCount) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupCount.ProtoReflect.Descriptor instead.
+func (*GroupCount) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GroupCount) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *GroupCount) GetShortLinkCount() int32 {
+	if x != nil {
+		return x.ShortLinkCount
+	}
+	return 0
+}
+
+// 访问记录
+type AccessRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UvType        string                 `protobuf:"bytes,1,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"`             // 访客类型
+	Browser       string                 `protobuf:"bytes,2,opt,name=browser,proto3" json:"browser,omitempty"`                         // 浏览器
+	Os            string                 `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`                                   // 操作系统
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                                   // 访问IP
+	Network       string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`                         // 网络环境
+	Device        string                 `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`                           // 访问设备
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`                           // 地区
+	User          string                 `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`                               // 用户标识
+	CreateTime    string                 `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 访问时间（ISO-8601格式）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRecord) Reset() {
+	*x = AccessRecord{}
+	mi := &file_link_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRecord) ProtoMessage() {}
+
+func (x *AccessRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRecord.ProtoReflect.Descriptor instead.
+func (*AccessRecord) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AccessRecord) GetUvType() string {
+	if x != nil {
+		return x.UvType
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+// 访问记录查询请求
+type AccessRecordQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // 开始日期（ISO-8601格式）
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                  // 结束日期（ISO-8601格式）
+	EnableStatus  int32                  `protobuf:"varint,5,opt,name=enable_status,json=enableStatus,proto3" json:"enable_status,omitempty"`  // 启用状态 0：启用 1：未启用
+	Current       int64                  `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`                                // 当前页
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`                                      // 每页大小
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRecordQueryRequest) Reset() {
+	*x = AccessRecordQueryRequest{}
+	mi := &file_link_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRecordQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRecordQueryRequest) ProtoMessage() {}
+
+func (x *AccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRecordQueryRequest.ProtoReflect.Descriptor instead.
+func (*AccessRecordQueryRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccessRecordQueryRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *AccessRecordQueryRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *AccessRecordQueryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AccessRecordQueryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AccessRecordQueryRequest) GetEnableStatus() int32 {
+	if x != nil {
+		return x.EnableStatus
+	}
+	return 0
+}
+
+func (x *AccessRecordQueryRequest) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *AccessRecordQueryRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 访问记录查询响应
+type AccessRecordQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AccessRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 访问记录列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRecordQueryResponse) Reset() {
+	*x = AccessRecordQueryResponse{}
+	mi := &file_link_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRecordQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRecordQueryResponse) ProtoMessage() {}
+
+func (x *AccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRecordQueryResponse.ProtoReflect.Descriptor instead.
+func (*AccessRecordQueryResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AccessRecordQueryResponse) GetRecords() []*AccessRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *AccessRecordQueryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AccessRecordQueryResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AccessRecordQueryResponse) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 分组访问记录查询请求
+type GroupAccessRecordQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                              // 分组标识
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（ISO-8601格式）
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（ISO-8601格式）
+	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`                     // 当前页
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                           // 每页大小
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAccessRecordQueryRequest) Reset() {
+	*x = GroupAccessRecordQueryRequest{}
+	mi := &file_link_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAccessRecordQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAccessRecordQueryRequest) ProtoMessage() {}
+
+func (x *GroupAccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAccessRecordQueryRequest.ProtoReflect.Descriptor instead.
+func (*GroupAccessRecordQueryRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GroupAccessRecordQueryRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *GroupAccessRecordQueryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GroupAccessRecordQueryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GroupAccessRecordQueryRequest) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *GroupAccessRecordQueryRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 分组访问记录查询响应
+type GroupAccessRecordQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AccessRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 访问记录列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAccessRecordQueryResponse) Reset() {
+	*x = GroupAccessRecordQueryResponse{}
+	mi := &file_link_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAccessRecordQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAccessRecordQueryResponse) ProtoMessage() {}
+
+func (x *GroupAccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAccessRecordQueryResponse.ProtoReflect.Descriptor instead.
+func (*GroupAccessRecordQueryResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GroupAccessRecordQueryResponse) GetRecords() []*AccessRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GroupAccessRecordQueryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GroupAccessRecordQueryResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GroupAccessRecordQueryResponse) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 获取URL标题请求
+type GetUrlTitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // 目标URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUrlTitleRequest) Reset() {
+	*x = GetUrlTitleRequest{}
+	mi := &file_link_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUrlTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUrlTitleRequest) ProtoMessage() {}
+
+func (x *GetUrlTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUrlTitleRequest.ProtoReflect.Descriptor instead.
+func (*GetUrlTitleRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUrlTitleRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// 获取URL标题响应
+type GetUrlTitleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // URL标题
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUrlTitleResponse) Reset() {
+	*x = GetUrlTitleResponse{}
+	mi := &file_link_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUrlTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUrlTitleResponse) ProtoMessage() {}
+
+func (x *GetUrlTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUrlTitleResponse.ProtoReflect.Descriptor instead.
+func (*GetUrlTitleResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetUrlTitleResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// 短链接社交分享预览请求
+type ShortLinkPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortUri      string                 `protobuf:"bytes,1,opt,name=short_uri,json=shortUri,proto3" json:"short_uri,omitempty"` // 短链接后缀
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`                         // 请求域名（Host），为空时使用默认域名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkPreviewRequest) Reset() {
+	*x = ShortLinkPreviewRequest{}
+	mi := &file_link_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkPreviewRequest) ProtoMessage() {}
+
+func (x *ShortLinkPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkPreviewRequest.ProtoReflect.Descriptor instead.
+func (*ShortLinkPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ShortLinkPreviewRequest) GetShortUri() string {
+	if x != nil {
+		return x.ShortUri
+	}
+	return ""
+}
+
+func (x *ShortLinkPreviewRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// 短链接社交分享预览响应
+type ShortLinkPreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                          // 预览标题
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`              // 预览描述
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                          // 预览图片
+	OriginUrl     string                 `protobuf:"bytes,4,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"` // 原始链接，需要访问密码时不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkPreviewResponse) Reset() {
+	*x = ShortLinkPreviewResponse{}
+	mi := &file_link_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkPreviewResponse) ProtoMessage() {}
+
+func (x *ShortLinkPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkPreviewResponse.ProtoReflect.Descriptor instead.
+func (*ShortLinkPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ShortLinkPreviewResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortLinkPreviewResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShortLinkPreviewResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ShortLinkPreviewResponse) GetOriginUrl() string {
+	if x != nil {
+		return x.OriginUrl
+	}
+	return ""
+}
+
+// 短链接二维码请求
+type ShortLinkQrCodeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl    string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`        // 完整短链接
+	Size            int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                             // 图片边长（像素），0表示默认尺寸
+	Format          string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                          // 图片格式 png/svg，为空时使用png
+	Ecc             string                 `protobuf:"bytes,4,opt,name=ecc,proto3" json:"ecc,omitempty"`                                                // 纠错等级 L/M/Q/H，为空时使用M，设置中心图标时使用H
+	Margin          int32                  `protobuf:"varint,5,opt,name=margin,proto3" json:"margin,omitempty"`                                         // 静默区宽度（模块数）
+	ForegroundColor string                 `protobuf:"bytes,6,opt,name=foreground_color,json=foregroundColor,proto3" json:"foreground_color,omitempty"` // 前景色，如 #000000，为空时使用黑色
+	BackgroundColor string                 `protobuf:"bytes,7,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"` // 背景色，如 #FFFFFF，为空时使用白色
+	LogoUrl         string                 `protobuf:"bytes,8,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                         // 中心图标链接（可选）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShortLinkQrCodeRequest) Reset() {
+	*x = ShortLinkQrCodeRequest{}
+	mi := &file_link_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkQrCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkQrCodeRequest) ProtoMessage() {}
+
+func (x *ShortLinkQrCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkQrCodeRequest.ProtoReflect.Descriptor instead.
+func (*ShortLinkQrCodeRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ShortLinkQrCodeRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *ShortLinkQrCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ShortLinkQrCodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ShortLinkQrCodeRequest) GetEcc() string {
+	if x != nil {
+		return x.Ecc
+	}
+	return ""
+}
+
+func (x *ShortLinkQrCodeRequest) GetMargin() int32 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+func (x *ShortLinkQrCodeRequest) GetForegroundColor() string {
+	if x != nil {
+		return x.ForegroundColor
+	}
+	return ""
+}
+
+func (x *ShortLinkQrCodeRequest) GetBackgroundColor() string {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return ""
+}
+
+func (x *ShortLinkQrCodeRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+// 短链接二维码响应
+type ShortLinkQrCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`                            // 图片内容
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 图片类型，如 image/png
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkQrCodeResponse) Reset() {
+	*x = ShortLinkQrCodeResponse{}
+	mi := &file_link_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkQrCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkQrCodeResponse) ProtoMessage() {}
+
+func (x *ShortLinkQrCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkQrCodeResponse.ProtoReflect.Descriptor instead.
+func (*ShortLinkQrCodeResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ShortLinkQrCodeResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ShortLinkQrCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// 短链接分组内数量查询请求
+type GroupShortLinkCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gids          []string               `protobuf:"bytes,1,rep,name=gids,proto3" json:"gids,omitempty"` // 分组标识列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupShortLinkCountRequest) Reset() {
+	*x = GroupShortLinkCountRequest{}
+	mi := &file_link_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupShortLinkCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupShortLinkCountRequest) ProtoMessage() {}
+
+func (x *GroupShortLinkCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupShortLinkCountRequest.ProtoReflect.Descriptor instead.
+func (*GroupShortLinkCountRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GroupShortLinkCountRequest) GetGids() []string {
+	if x != nil {
+		return x.Gids
+	}
+	return nil
+}
+
+// 短链接分组计数项
+type ShortLinkGroupCountItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                                // 分组ID
+	ShortLinkCount int64                  `protobuf:"varint,2,opt,name=short_link_count,json=shortLinkCount,proto3" json:"short_link_count,omitempty"` // 短链接数量
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShortLinkGroupCountItem) Reset() {
+	*x = ShortLinkGroupCountItem{}
+	mi := &file_link_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkGroupCountItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkGroupCountItem) ProtoMessage() {}
+
+func (x *ShortLinkGroupCountItem) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkGroupCountItem.ProtoReflect.Descriptor instead.
+func (*ShortLinkGroupCountItem) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ShortLinkGroupCountItem) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *ShortLinkGroupCountItem) GetShortLinkCount() int64 {
+	if x != nil {
+		return x.ShortLinkCount
+	}
+	return 0
+}
+
+// 查询短链接分组内数量响应
+type GroupShortLinkCountResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	GroupCounts   []*ShortLinkGroupCountItem `protobuf:"bytes,1,rep,name=group_counts,json=groupCounts,proto3" json:"group_counts,omitempty"` // 分组计数列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupShortLinkCountResponse) Reset() {
+	*x = GroupShortLinkCountResponse{}
+	mi := &file_link_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupShortLinkCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupShortLinkCountResponse) ProtoMessage() {}
+
+func (x *GroupShortLinkCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupShortLinkCountResponse.ProtoReflect.Descriptor instead.
+func (*GroupShortLinkCountResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GroupShortLinkCountResponse) GetGroupCounts() []*ShortLinkGroupCountItem {
+	if x != nil {
+		return x.GroupCounts
+	}
+	return nil
+}
+
+// 移动短链接请求，将短链接连同跳转记录、分流版本、跳转规则移动到当前用户的其他分组
+type MoveShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrls []string               `protobuf:"bytes,1,rep,name=full_short_urls,json=fullShortUrls,proto3" json:"full_short_urls,omitempty"` // 待移动的完整短链接列表
+	OriginGid     string                 `protobuf:"bytes,2,opt,name=origin_gid,json=originGid,proto3" json:"origin_gid,omitempty"`               // 原分组标识
+	TargetGid     string                 `protobuf:"bytes,3,opt,name=target_gid,json=targetGid,proto3" json:"target_gid,omitempty"`               // 目标分组标识
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveShortLinkRequest) Reset() {
+	*x = MoveShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveShortLinkRequest) ProtoMessage() {}
+
+func (x *MoveShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*MoveShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MoveShortLinkRequest) GetFullShortUrls() []string {
+	if x != nil {
+		return x.FullShortUrls
+	}
+	return nil
+}
+
+func (x *MoveShortLinkRequest) GetOriginGid() string {
+	if x != nil {
+		return x.OriginGid
+	}
+	return ""
+}
+
+func (x *MoveShortLinkRequest) GetTargetGid() string {
+	if x != nil {
+		return x.TargetGid
+	}
+	return ""
+}
+
+// 移动短链接响应
+type MoveShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         int32                  `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"` // 移动的短链接数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveShortLinkResponse) Reset() {
+	*x = MoveShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveShortLinkResponse) ProtoMessage() {}
+
+func (x *MoveShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*MoveShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MoveShortLinkResponse) GetMoved() int32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+// 复制短链接请求，复制目标链接和各项配置到新的短链接，不复制访问统计
+type CloneShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 源短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 源短链接分组标识
+	TargetGid     string                 `protobuf:"bytes,3,opt,name=target_gid,json=targetGid,proto3" json:"target_gid,omitempty"`            // 新短链接的分组标识（可选），为空时与源短链接相同
+	CustomUri     string                 `protobuf:"bytes,4,opt,name=custom_uri,json=customUri,proto3" json:"custom_uri,omitempty"`            // 新短链接的自定义后缀（可选），为空时自动生成
+	Describe      string                 `protobuf:"bytes,5,opt,name=describe,proto3" json:"describe,omitempty"`                               // 新短链接的描述（可选），为空时使用源短链接的描述
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneShortLinkRequest) Reset() {
+	*x = CloneShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneShortLinkRequest) ProtoMessage() {}
+
+func (x *CloneShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*CloneShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CloneShortLinkRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *CloneShortLinkRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *CloneShortLinkRequest) GetTargetGid() string {
+	if x != nil {
+		return x.TargetGid
+	}
+	return ""
+}
+
+func (x *CloneShortLinkRequest) GetCustomUri() string {
+	if x != nil {
+		return x.CustomUri
+	}
+	return ""
+}
+
+func (x *CloneShortLinkRequest) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
+// 复制短链接响应
+type CloneShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"`   // 新的完整短链接
+	OriginUrl     string                 `protobuf:"bytes,2,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`              // 原始链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                           // 分组标识
+	PendingReview bool                   `protobuf:"varint,4,opt,name=pending_review,json=pendingReview,proto3" json:"pending_review,omitempty"` // 目标链接未通过安全检测，短链接已禁用并等待审核
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneShortLinkResponse) Reset() {
+	*x = CloneShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneShortLinkResponse) ProtoMessage() {}
+
+func (x *CloneShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*CloneShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CloneShortLinkResponse) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *CloneShortLinkResponse) GetOriginUrl() string {
+	if x != nil {
+		return x.OriginUrl
+	}
+	return ""
+}
+
+func (x *CloneShortLinkResponse) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *CloneShortLinkResponse) GetPendingReview() bool {
+	if x != nil {
+		return x.PendingReview
+	}
+	return false
+}
+
+// 导入短链接请求，文件每行包含目标链接、自定义后缀、分组、有效期、描述和标签
+// CSV首行为表头，列名：origin_url、alias、gid、valid_date、describe、tags（多个标签以|分隔）
+// NDJSON每行一个JSON对象，字段名同CSV列名，tags为字符串数组
+type ImportShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`                           // 文件格式 csv/ndjson
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                         // 文件内容
+	DefaultGid    string                 `protobuf:"bytes,3,opt,name=default_gid,json=defaultGid,proto3" json:"default_gid,omitempty"` // 未指定分组的行使用的分组标识
+	Domain        string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`                           // 短链接域名，为空时使用默认域名
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`            // 是否试运行，试运行仅校验不写入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportShortLinkRequest) Reset() {
+	*x = ImportShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShortLinkRequest) ProtoMessage() {}
+
+func (x *ImportShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*ImportShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportShortLinkRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportShortLinkRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportShortLinkRequest) GetDefaultGid() string {
+	if x != nil {
+		return x.DefaultGid
+	}
+	return ""
+}
+
+func (x *ImportShortLinkRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ImportShortLinkRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 导入短链接响应
+type ImportShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ShortLinkImportJob    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // 导入任务，行数较少时同步处理完成并包含行级结果，否则后台处理，通过任务ID查询进度
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportShortLinkResponse) Reset() {
+	*x = ImportShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShortLinkResponse) ProtoMessage() {}
+
+func (x *ImportShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*ImportShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportShortLinkResponse) GetJob() *ShortLinkImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 导入行处理结果
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                                      // 文件中的行号
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                   // 处理结果 created/skipped/failed，试运行时通过校验的行为created
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 跳过或失败原因
+	OriginUrl     string                 `protobuf:"bytes,4,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`            // 目标链接
+	FullShortUrl  string                 `protobuf:"bytes,5,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 创建的短链接，试运行且未指定自定义后缀时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_link_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetOriginUrl() string {
+	if x != nil {
+		return x.OriginUrl
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+// 短链接导入任务
+type ShortLinkImportJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                 // 任务ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                            // 任务状态 running/finished/failed
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`             // 是否试运行
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                             // 总行数
+	Processed     int32                  `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`                     // 已处理行数
+	Created       int32                  `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`                         // 创建成功行数，试运行时为通过校验的行数
+	Skipped       int32                  `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`                         // 跳过行数
+	Failed        int32                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`                           // 失败行数
+	Rows          []*ImportRowResult     `protobuf:"bytes,9,rep,name=rows,proto3" json:"rows,omitempty"`                                // 行级结果
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                             // 任务失败原因
+	CreateTime    string                 `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+	UpdateTime    string                 `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"` // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkImportJob) Reset() {
+	*x = ShortLinkImportJob{}
+	mi := &file_link_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkImportJob) ProtoMessage() {}
+
+func (x *ShortLinkImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkImportJob.ProtoReflect.Descriptor instead.
+func (*ShortLinkImportJob) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ShortLinkImportJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ShortLinkImportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShortLinkImportJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ShortLinkImportJob) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ShortLinkImportJob) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ShortLinkImportJob) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ShortLinkImportJob) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ShortLinkImportJob) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ShortLinkImportJob) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ShortLinkImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ShortLinkImportJob) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *ShortLinkImportJob) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+// 查询导入任务请求
+type GetImportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
+	mi := &file_link_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportJobRequest) ProtoMessage() {}
+
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetImportJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// 查询导入任务响应
+type GetImportJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ShortLinkImportJob    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // 导入任务
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportJobResponse) Reset() {
+	*x = GetImportJobResponse{}
+	mi := &file_link_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportJobResponse) ProtoMessage() {}
+
+func (x *GetImportJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportJobResponse.ProtoReflect.Descriptor instead.
+func (*GetImportJobResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetImportJobResponse) GetJob() *ShortLinkImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 批量操作短链接选择条件，指定短链接列表时忽略筛选条件，否则按分组筛选
+type BulkActionSelector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrls []string               `protobuf:"bytes,1,rep,name=full_short_urls,json=fullShortUrls,proto3" json:"full_short_urls,omitempty"` // 短链接列表，可跨分组
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                            // 分组标识，按条件筛选时必填
+	CreateStart   string                 `protobuf:"bytes,3,opt,name=create_start,json=createStart,proto3" json:"create_start,omitempty"`         // 创建时间起始（ISO-8601格式）
+	CreateEnd     string                 `protobuf:"bytes,4,opt,name=create_end,json=createEnd,proto3" json:"create_end,omitempty"`               // 创建时间截止（ISO-8601格式）
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`                                            // 标签
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkActionSelector) Reset() {
+	*x = BulkActionSelector{}
+	mi := &file_link_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkActionSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionSelector) ProtoMessage() {}
+
+func (x *BulkActionSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionSelector.ProtoReflect.Descriptor instead.
+func (*BulkActionSelector) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BulkActionSelector) GetFullShortUrls() []string {
+	if x != nil {
+		return x.FullShortUrls
+	}
+	return nil
+}
+
+func (x *BulkActionSelector) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *BulkActionSelector) GetCreateStart() string {
+	if x != nil {
+		return x.CreateStart
+	}
+	return ""
+}
+
+func (x *BulkActionSelector) GetCreateEnd() string {
+	if x != nil {
+		return x.CreateEnd
+	}
+	return ""
+}
+
+func (x *BulkActionSelector) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// 批量操作短链接请求
+type BulkActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      *BulkActionSelector    `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`                    // 选择条件
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                        // 操作类型 enable：启用 disable：停用 recycle：移至回收站 set_expiry：设置有效期 set_describe：设置描述
+	ValidDate     string                 `protobuf:"bytes,3,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"` // 有效期（ISO-8601格式），set_expiry时使用，为空表示永久有效
+	Describe      string                 `protobuf:"bytes,4,opt,name=describe,proto3" json:"describe,omitempty"`                    // 描述，set_describe时使用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkActionRequest) Reset() {
+	*x = BulkActionRequest{}
+	mi := &file_link_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionRequest) ProtoMessage() {}
+
+func (x *BulkActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionRequest.ProtoReflect.Descriptor instead.
+func (*BulkActionRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BulkActionRequest) GetSelector() *BulkActionSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *BulkActionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BulkActionRequest) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+func (x *BulkActionRequest) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
+}
+
+// 批量操作单个短链接的结果
+type BulkActionItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`                                // 是否成功
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 失败原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkActionItemResult) Reset() {
+	*x = BulkActionItemResult{}
+	mi := &file_link_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkActionItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionItemResult) ProtoMessage() {}
+
+func (x *BulkActionItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionItemResult.ProtoReflect.Descriptor instead.
+func (*BulkActionItemResult) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BulkActionItemResult) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *BulkActionItemResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkActionItemResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 批量操作短链接响应
+type BulkActionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`         // 选中的短链接数
+	Succeeded     int32                   `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"` // 成功数
+	Failed        int32                   `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`       // 失败数
+	Results       []*BulkActionItemResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`      // 每个短链接的结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkActionResponse) Reset() {
+	*x = BulkActionResponse{}
+	mi := &file_link_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkActionResponse) ProtoMessage() {}
+
+func (x *BulkActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkActionResponse.ProtoReflect.Descriptor instead.
+func (*BulkActionResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BulkActionResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkActionResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkActionResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkActionResponse) GetResults() []*BulkActionItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 短链接字段变更
+type LinkHistoryChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // 字段名
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // 变更前的值，访问密码不返回明文
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // 变更后的值，访问密码不返回明文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkHistoryChange) Reset() {
+	*x = LinkHistoryChange{}
+	mi := &file_link_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHistoryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHistoryChange) ProtoMessage() {}
+
+func (x *LinkHistoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHistoryChange.ProtoReflect.Descriptor instead.
+func (*LinkHistoryChange) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LinkHistoryChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *LinkHistoryChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *LinkHistoryChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// 短链接变更记录
+type LinkHistoryRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 变更记录ID
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 变更时所属分组标识
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`                               // 操作人
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                                   // 操作类型 create：创建 update：修改 enable：启用 disable：停用 recycle：移至回收站 recover：从回收站恢复 rollback：回滚 purge：数据清理任务永久清除
+	Changes       []*LinkHistoryChange   `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`                                 // 字段变更
+	CreateTime    string                 `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 变更时间（ISO-8601格式）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkHistoryRecord) Reset() {
+	*x = LinkHistoryRecord{}
+	mi := &file_link_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkHistoryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHistoryRecord) ProtoMessage() {}
+
+func (x *LinkHistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHistoryRecord.ProtoReflect.Descriptor instead.
+func (*LinkHistoryRecord) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LinkHistoryRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LinkHistoryRecord) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *LinkHistoryRecord) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *LinkHistoryRecord) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LinkHistoryRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LinkHistoryRecord) GetChanges() []*LinkHistoryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *LinkHistoryRecord) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+// 查询短链接变更记录请求
+type ListLinkHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	Current       int32                  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`                                // 当前页
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                                      // 每页大小
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkHistoryRequest) Reset() {
+	*x = ListLinkHistoryRequest{}
+	mi := &file_link_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkHistoryRequest) ProtoMessage() {}
+
+func (x *ListLinkHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListLinkHistoryRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *ListLinkHistoryRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *ListLinkHistoryRequest) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ListLinkHistoryRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 查询短链接变更记录响应
+type ListLinkHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*LinkHistoryRecord   `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 变更记录列表，按时间倒序
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkHistoryResponse) Reset() {
+	*x = ListLinkHistoryResponse{}
+	mi := &file_link_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkHistoryResponse) ProtoMessage() {}
+
+func (x *ListLinkHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLinkHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListLinkHistoryResponse) GetRecords() []*LinkHistoryRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListLinkHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListLinkHistoryResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListLinkHistoryResponse) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+// 回滚短链接请求，将短链接属性恢复为指定变更记录完成后的状态，启用状态不回滚
+type RollbackShortLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	HistoryId     int64                  `protobuf:"varint,3,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`           // 变更记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackShortLinkRequest) Reset() {
+	*x = RollbackShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackShortLinkRequest) ProtoMessage() {}
+
+func (x *RollbackShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*RollbackShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RollbackShortLinkRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *RollbackShortLinkRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *RollbackShortLinkRequest) GetHistoryId() int64 {
+	if x != nil {
+		return x.HistoryId
+	}
+	return 0
+}
+
+// 回滚短链接响应
+type RollbackShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       *LinkHistoryRecord     `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"` // 回滚产生的变更记录，属性无变化时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackShortLinkResponse) Reset() {
+	*x = RollbackShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackShortLinkResponse) ProtoMessage() {}
+
+func (x *RollbackShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*RollbackShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RollbackShortLinkResponse) GetHistory() *LinkHistoryRecord {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// 导出短链接请求，导出分组内全部短链接及累计访问数据，可附带每日访问数据
+type ExportShortLinkRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Gids              []string               `protobuf:"bytes,1,rep,name=gids,proto3" json:"gids,omitempty"`                                                       // 分组标识列表
+	Format            string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                                                   // 文件格式 csv/xlsx
+	IncludeDailyStats bool                   `protobuf:"varint,3,opt,name=include_daily_stats,json=includeDailyStats,proto3" json:"include_daily_stats,omitempty"` // 是否导出每日访问数据，导出时每个短链接按日期展开为多行
+	StartDate         string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                            // 每日访问数据开始日期（yyyy-MM-dd），为空时默认最近30天
+	EndDate           string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                  // 每日访问数据结束日期（yyyy-MM-dd）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExportShortLinkRequest) Reset() {
+	*x = ExportShortLinkRequest{}
+	mi := &file_link_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportShortLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportShortLinkRequest) ProtoMessage() {}
+
+func (x *ExportShortLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportShortLinkRequest.ProtoReflect.Descriptor instead.
+func (*ExportShortLinkRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExportShortLinkRequest) GetGids() []string {
+	if x != nil {
+		return x.Gids
+	}
+	return nil
+}
+
+func (x *ExportShortLinkRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportShortLinkRequest) GetIncludeDailyStats() bool {
+	if x != nil {
+		return x.IncludeDailyStats
+	}
+	return false
+}
+
+func (x *ExportShortLinkRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportShortLinkRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 导出短链接响应
+type ExportShortLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ShortLinkExportJob    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // 导出任务，短链接较少时同步生成文件，否则后台处理，通过任务ID查询进度
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportShortLinkResponse) Reset() {
+	*x = ExportShortLinkResponse{}
+	mi := &file_link_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportShortLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportShortLinkResponse) ProtoMessage() {}
+
+func (x *ExportShortLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportShortLinkResponse.ProtoReflect.Descriptor instead.
+func (*ExportShortLinkResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ExportShortLinkResponse) GetJob() *ShortLinkExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 短链接导出任务
+type ShortLinkExportJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                         // 任务ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                    // 任务状态 running/finished/failed
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                                    // 文件格式
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`                                     // 短链接总数
+	Processed     int32                  `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`                             // 已导出短链接数
+	FileName      string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`                // 文件名
+	FileSize      int64                  `protobuf:"varint,7,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`               // 文件大小（字节）
+	DownloadToken string                 `protobuf:"bytes,8,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"` // 下载令牌，任务完成后生成
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                      // 任务失败原因
+	CreateTime    string                 `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 创建时间
+	UpdateTime    string                 `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`         // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkExportJob) Reset() {
+	*x = ShortLinkExportJob{}
+	mi := &file_link_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkExportJob) ProtoMessage() {}
+
+func (x *ShortLinkExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkExportJob.ProtoReflect.Descriptor instead.
+func (*ShortLinkExportJob) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ShortLinkExportJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ShortLinkExportJob) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ShortLinkExportJob) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ShortLinkExportJob) GetDownloadToken() string {
+	if x != nil {
+		return x.DownloadToken
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *ShortLinkExportJob) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+// 查询导出任务请求
+type GetExportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // 任务ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportJobRequest) Reset() {
+	*x = GetExportJobRequest{}
+	mi := &file_link_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobRequest) ProtoMessage() {}
+
+func (x *GetExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetExportJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// 查询导出任务响应
+type GetExportJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ShortLinkExportJob    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // 导出任务
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportJobResponse) Reset() {
+	*x = GetExportJobResponse{}
+	mi := &file_link_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobResponse) ProtoMessage() {}
+
+func (x *GetExportJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobResponse.ProtoReflect.Descriptor instead.
+func (*GetExportJobResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetExportJobResponse) GetJob() *ShortLinkExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 下载导出文件请求，按偏移量分块读取
+type DownloadExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`    // 下载令牌
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // 读取偏移量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExportRequest) Reset() {
+	*x = DownloadExportRequest{}
+	mi := &file_link_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExportRequest) ProtoMessage() {}
+
+func (x *DownloadExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadExportRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DownloadExportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DownloadExportRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// 下载导出文件响应
+type DownloadExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // 文件内容分块
+	Eof           bool                   `protobuf:"varint,2,opt,name=eof,proto3" json:"eof,omitempty"`                                   // 是否已读取到文件末尾
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // 文件名
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 文件类型
+	FileSize      int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`         // 文件大小（字节）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadExportResponse) Reset() {
+	*x = DownloadExportResponse{}
+	mi := &file_link_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExportResponse) ProtoMessage() {}
+
+func (x *DownloadExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadExportResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DownloadExportResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadExportResponse) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+func (x *DownloadExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadExportResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+// 短链接跳转请求
+type RestoreUrlRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShortUri       string                 `protobuf:"bytes,1,opt,name=short_uri,json=shortUri,proto3" json:"short_uri,omitempty"`                   // 短链接后缀
+	Host           string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`                                           // 请求域名（Host），为空时使用默认域名
+	Os             string                 `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`                                               // 访问者操作系统，用于匹配跳转规则
+	Province       string                 `protobuf:"bytes,5,opt,name=province,proto3" json:"province,omitempty"`                                   // 访问者所在省份，用于匹配跳转规则
+	AcceptLanguage string                 `protobuf:"bytes,6,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"` // 访问者Accept-Language请求头，用于匹配跳转规则
+	Query          string                 `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`                                         // 访问时携带的查询参数（原始查询字符串）
+	Crawler        bool                   `protobuf:"varint,8,opt,name=crawler,proto3" json:"crawler,omitempty"`                                    // 是否为爬虫访问（如社交平台预览抓取），爬虫访问不计入访问统计
+	UnlockToken    string                 `protobuf:"bytes,9,opt,name=unlock_token,json=unlockToken,proto3" json:"unlock_token,omitempty"`          // 密码解锁令牌，由验证密码接口签发
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RestoreUrlRequest) Reset() {
+	*x = RestoreUrlRequest{}
+	mi := &file_link_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUrlRequest) ProtoMessage() {}
+
+func (x *RestoreUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUrlRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUrlRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RestoreUrlRequest) GetShortUri() string {
+	if x != nil {
+		return x.ShortUri
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetAcceptLanguage() string {
+	if x != nil {
+		return x.AcceptLanguage
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RestoreUrlRequest) GetCrawler() bool {
+	if x != nil {
+		return x.Crawler
+	}
+	return false
+}
+
+func (x *RestoreUrlRequest) GetUnlockToken() string {
+	if x != nil {
+		return x.UnlockToken
+	}
+	return ""
+}
+
+// 短链接跳转响应
+type RestoreUrlResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OriginUrl           string                 `protobuf:"bytes,1,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`                                    // 原始链接URL
+	PasswordRequired    bool                   `protobuf:"varint,2,opt,name=password_required,json=passwordRequired,proto3" json:"password_required,omitempty"`              // 是否需要输入访问密码（为true时不返回原始链接）
+	NotYetActive        bool                   `protobuf:"varint,3,opt,name=not_yet_active,json=notYetActive,proto3" json:"not_yet_active,omitempty"`                        // 是否尚未到生效时间（为true时不返回原始链接）
+	ValidFrom           string                 `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                                    // 生效时间（ISO-8601格式），尚未生效时返回
+	Expired             bool                   `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`                                                        // 是否已过期（为true时不返回原始链接）
+	ExpiredUrl          string                 `protobuf:"bytes,6,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                                 // 过期后跳转链接，已过期时返回
+	ExpiredMessage      string                 `protobuf:"bytes,7,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`                     // 过期后提示信息，已过期时返回
+	RedirectType        int32                  `protobuf:"varint,8,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`                          // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string                 `protobuf:"bytes,9,opt,name=ios_deep_link,json=iosDeepLink,proto3" json:"ios_deep_link,omitempty"`                            // iOS深度链接，由网关根据访问设备选择
+	AndroidPackage      string                 `protobuf:"bytes,10,opt,name=android_package,json=androidPackage,proto3" json:"android_package,omitempty"`                    // Android应用包名
+	AndroidDeepLink     string                 `protobuf:"bytes,11,opt,name=android_deep_link,json=androidDeepLink,proto3" json:"android_deep_link,omitempty"`               // Android深度链接
+	DeepLinkFallbackUrl string                 `protobuf:"bytes,12,opt,name=deep_link_fallback_url,json=deepLinkFallbackUrl,proto3" json:"deep_link_fallback_url,omitempty"` // 未安装应用时的兜底链接
+	SafetyWarning       bool                   `protobuf:"varint,13,opt,name=safety_warning,json=safetyWarning,proto3" json:"safety_warning,omitempty"`                      // 目标链接被标记为可疑，跳转前需要展示风险提示
+	SafetyReason        string                 `protobuf:"bytes,14,opt,name=safety_reason,json=safetyReason,proto3" json:"safety_reason,omitempty"`                          // 风险提示原因
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RestoreUrlResponse) Reset() {
+	*x = RestoreUrlResponse{}
+	mi := &file_link_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUrlResponse) ProtoMessage() {}
+
+func (x *RestoreUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUrlResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUrlResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RestoreUrlResponse) GetOriginUrl() string {
+	if x != nil {
+		return x.OriginUrl
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetPasswordRequired() bool {
+	if x != nil {
+		return x.PasswordRequired
+	}
+	return false
+}
+
+func (x *RestoreUrlResponse) GetNotYetActive() bool {
+	if x != nil {
+		return x.NotYetActive
+	}
+	return false
+}
+
+func (x *RestoreUrlResponse) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *RestoreUrlResponse) GetExpiredUrl() string {
+	if x != nil {
+		return x.ExpiredUrl
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetExpiredMessage() string {
+	if x != nil {
+		return x.ExpiredMessage
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
+}
+
+func (x *RestoreUrlResponse) GetIosDeepLink() string {
+	if x != nil {
+		return x.IosDeepLink
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetAndroidPackage() string {
+	if x != nil {
+		return x.AndroidPackage
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetAndroidDeepLink() string {
+	if x != nil {
+		return x.AndroidDeepLink
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetDeepLinkFallbackUrl() string {
+	if x != nil {
+		return x.DeepLinkFallbackUrl
+	}
+	return ""
+}
+
+func (x *RestoreUrlResponse) GetSafetyWarning() bool {
+	if x != nil {
+		return x.SafetyWarning
+	}
+	return false
+}
+
+func (x *RestoreUrlResponse) GetSafetyReason() string {
+	if x != nil {
+		return x.SafetyReason
+	}
+	return ""
+}
+
+// 验证短链接访问密码请求
+type VerifyLinkPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortUri      string                 `protobuf:"bytes,1,opt,name=short_uri,json=shortUri,proto3" json:"short_uri,omitempty"` // 短链接后缀
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`                         // 请求域名（Host），为空时使用默认域名
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`                 // 访问密码
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                             // 访问者IP，用于错误次数限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLinkPasswordRequest) Reset() {
+	*x = VerifyLinkPasswordRequest{}
+	mi := &file_link_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLinkPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLinkPasswordRequest) ProtoMessage() {}
+
+func (x *VerifyLinkPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLinkPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLinkPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *VerifyLinkPasswordRequest) GetShortUri() string {
+	if x != nil {
+		return x.ShortUri
+	}
+	return ""
+}
+
+func (x *VerifyLinkPasswordRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *VerifyLinkPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VerifyLinkPasswordRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+// 验证短链接访问密码响应
+type VerifyLinkPasswordResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`                                              // 是否验证通过
+	RemainingAttempts int32                  `protobuf:"varint,2,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"` // 剩余可尝试次数
+	UnlockToken       string                 `protobuf:"bytes,3,opt,name=unlock_token,json=unlockToken,proto3" json:"unlock_token,omitempty"`                    // 验证通过时签发的解锁令牌
+	UnlockMaxAge      int32                  `protobuf:"varint,4,opt,name=unlock_max_age,json=unlockMaxAge,proto3" json:"unlock_max_age,omitempty"`              // 解锁令牌有效期（秒）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VerifyLinkPasswordResponse) Reset() {
+	*x = VerifyLinkPasswordResponse{}
+	mi := &file_link_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLinkPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLinkPasswordResponse) ProtoMessage() {}
+
+func (x *VerifyLinkPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLinkPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLinkPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VerifyLinkPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyLinkPasswordResponse) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *VerifyLinkPasswordResponse) GetUnlockToken() string {
+	if x != nil {
+		return x.UnlockToken
+	}
+	return ""
+}
+
+func (x *VerifyLinkPasswordResponse) GetUnlockMaxAge() int32 {
+	if x != nil {
+		return x.UnlockMaxAge
+	}
+	return 0
+}
+
+// 短链接统计请求
+type ShortLinkStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                       // 用户标识
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                                           // 访问IP
+	Browser       string                 `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`                                 // 浏览器
+	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`                                           // 操作系统
+	Device        string                 `protobuf:"bytes,7,opt,name=device,proto3" json:"device,omitempty"`                                   // 访问设备
+	Network       string                 `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`                                 // 访问网络
+	Locale        string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`                                   // 地区
+	UvType        string                 `protobuf:"bytes,10,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"`                    // 访客类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortLinkStatsRequest) Reset() {
+	*x = ShortLinkStatsRequest{}
+	mi := &file_link_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortLinkStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortLinkStatsRequest) ProtoMessage() {}
+
+func (x *ShortLinkStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortLinkStatsRequest.ProtoReflect.Descriptor instead.
+func (*ShortLinkStatsRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ShortLinkStatsRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ShortLinkStatsRequest) GetUvType() string {
+	if x != nil {
+		return x.UvType
+	}
+	return ""
+}
+
+// 空响应
+type EmptyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	mi := &file_link_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{82}
+}
+
+// --------------------- 自定义域名接口 ---------------------
+// 用户自定义域名，添加后需在域名DNS中配置TXT记录完成验证
+type UserDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                                  // 自定义域名
+	VerifyStatus  int32                  `protobuf:"varint,2,opt,name=verify_status,json=verifyStatus,proto3" json:"verify_status,omitempty"` // 验证状态 0：未验证 1：已验证
+	VerifyRecord  string                 `protobuf:"bytes,3,opt,name=verify_record,json=verifyRecord,proto3" json:"verify_record,omitempty"`  // 需要配置的TXT记录名称
+	VerifyValue   string                 `protobuf:"bytes,4,opt,name=verify_value,json=verifyValue,proto3" json:"verify_value,omitempty"`     // 需要配置的TXT记录值
+	CreateTime    string                 `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`        // 添加时间（ISO-8601格式）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDomain) Reset() {
+	*x = UserDomain{}
+	mi := &file_link_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDomain) ProtoMessage() {}
+
+func (x *UserDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDomain.ProtoReflect.Descriptor instead.
+func (*UserDomain) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UserDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *UserDomain) GetVerifyStatus() int32 {
+	if x != nil {
+		return x.VerifyStatus
+	}
+	return 0
+}
+
+func (x *UserDomain) GetVerifyRecord() string {
+	if x != nil {
+		return x.VerifyRecord
+	}
+	return ""
+}
+
+func (x *UserDomain) GetVerifyValue() string {
+	if x != nil {
+		return x.VerifyValue
+	}
+	return ""
+}
+
+func (x *UserDomain) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+// 添加自定义域名请求
+type RegisterUserDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // 自定义域名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserDomainRequest) Reset() {
+	*x = RegisterUserDomainRequest{}
+	mi := &file_link_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserDomainRequest) ProtoMessage() {}
+
+func (x *RegisterUserDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserDomainRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUserDomainRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RegisterUserDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// 添加自定义域名响应
+type RegisterUserDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *UserDomain            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // 域名及验证所需的TXT记录
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserDomainResponse) Reset() {
+	*x = RegisterUserDomainResponse{}
+	mi := &file_link_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserDomainResponse) ProtoMessage() {}
+
+func (x *RegisterUserDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserDomainResponse.ProtoReflect.Descriptor instead.
+func (*RegisterUserDomainResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RegisterUserDomainResponse) GetDomain() *UserDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// 验证自定义域名请求
+type VerifyUserDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // 自定义域名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyUserDomainRequest) Reset() {
+	*x = VerifyUserDomainRequest{}
+	mi := &file_link_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyUserDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUserDomainRequest) ProtoMessage() {}
+
+func (x *VerifyUserDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUserDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyUserDomainRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *VerifyUserDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// 验证自定义域名响应
+type VerifyUserDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *UserDomain            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // 验证后的域名信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyUserDomainResponse) Reset() {
+	*x = VerifyUserDomainResponse{}
+	mi := &file_link_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyUserDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyUserDomainResponse) ProtoMessage() {}
+
+func (x *VerifyUserDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyUserDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyUserDomainResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VerifyUserDomainResponse) GetDomain() *UserDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+// 查询自定义域名请求（空结构体）
+type ListUserDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserDomainRequest) Reset() {
+	*x = ListUserDomainRequest{}
+	mi := &file_link_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserDomainRequest) ProtoMessage() {}
+
+func (x *ListUserDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserDomainRequest.ProtoReflect.Descriptor instead.
+func (*ListUserDomainRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{88}
+}
+
+// 查询自定义域名响应
+type ListUserDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*UserDomain          `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"` // 当前用户的域名列表，按添加顺序排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserDomainResponse) Reset() {
+	*x = ListUserDomainResponse{}
+	mi := &file_link_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserDomainResponse) ProtoMessage() {}
+
+func (x *ListUserDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserDomainResponse.ProtoReflect.Descriptor instead.
+func (*ListUserDomainResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListUserDomainResponse) GetDomains() []*UserDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// --------------------- 跳转规则管理接口 ---------------------
+// 跳转规则
+type RedirectRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 规则ID
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	RuleType      string                 `protobuf:"bytes,4,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`               // 规则类型 os：操作系统 province：省份 language：语言
+	RuleValue     string                 `protobuf:"bytes,5,opt,name=rule_value,json=ruleValue,proto3" json:"rule_value,omitempty"`            // 匹配值
+	TargetUrl     string                 `protobuf:"bytes,6,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`            // 目标链接
+	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`           // 排序，越小越优先
+	CreateTime    string                 `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 创建时间（ISO-8601格式）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedirectRule) Reset() {
+	*x = RedirectRule{}
+	mi := &file_link_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectRule) ProtoMessage() {}
+
+func (x *RedirectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectRule.ProtoReflect.Descriptor instead.
+func (*RedirectRule) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RedirectRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RedirectRule) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetRuleValue() string {
+	if x != nil {
+		return x.RuleValue
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *RedirectRule) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+// 创建跳转规则请求
+type CreateRedirectRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	RuleType      string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`               // 规则类型
+	RuleValue     string                 `protobuf:"bytes,4,opt,name=rule_value,json=ruleValue,proto3" json:"rule_value,omitempty"`            // 匹配值
+	TargetUrl     string                 `protobuf:"bytes,5,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`            // 目标链接
+	SortOrder     int32                  `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`           // 排序，越小越优先
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedirectRuleRequest) Reset() {
+	*x = CreateRedirectRuleRequest{}
+	mi := &file_link_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedirectRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectRuleRequest) ProtoMessage() {}
+
+func (x *CreateRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateRedirectRuleRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetRuleValue() string {
+	if x != nil {
+		return x.RuleValue
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 创建跳转规则响应
+type CreateRedirectRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 规则ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedirectRuleResponse) Reset() {
+	*x = CreateRedirectRuleResponse{}
+	mi := &file_link_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedirectRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectRuleResponse) ProtoMessage() {}
+
+func (x *CreateRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateRedirectRuleResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改跳转规则请求
+type UpdateRedirectRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 规则ID
+	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	RuleType      string                 `protobuf:"bytes,4,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`               // 规则类型
+	RuleValue     string                 `protobuf:"bytes,5,opt,name=rule_value,json=ruleValue,proto3" json:"rule_value,omitempty"`            // 匹配值
+	TargetUrl     string                 `protobuf:"bytes,6,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`            // 目标链接
+	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`           // 排序，越小越优先
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedirectRuleRequest) Reset() {
+	*x = UpdateRedirectRuleRequest{}
+	mi := &file_link_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRedirectRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRedirectRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateRedirectRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRedirectRuleRequest) GetFullShortUrl() string {
+	if x != nil {
+		return x.FullShortUrl
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRuleRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRuleRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRuleRequest) GetRuleValue() string {
+	if x != nil {
+		return x.RuleValue
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRuleRequest) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRuleRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 修改跳转规则响应（空结构体）
+type UpdateRedirectRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedirectRuleResponse) Reset() {
+	*x = UpdateRedirectRuleResponse{}
+	mi := &file_link_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRedirectRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRedirectRuleResponse) ProtoMessage() {}
+
+func (x *UpdateRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{94}
 }
 
-// 从回收站恢复请求
-type RecoverFromRecycleBinRequest struct {
+// 删除跳转规则请求
+type DeleteRedirectRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 规则ID
 	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecoverFromRecycleBinRequest) Reset() {
-	*x = RecoverFromRecycleBinRequest{}
-	mi := &file_link_proto_msgTypes[12]
+func (x *DeleteRedirectRuleRequest) Reset() {
+	*x = DeleteRedirectRuleRequest{}
+	mi := &file_link_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecoverFromRecycleBinRequest) String() string {
+func (x *DeleteRedirectRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverFromRecycleBinRequest) ProtoMessage() {}
+func (*DeleteRedirectRuleRequest) ProtoMessage() {}
 
-func (x *RecoverFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[12]
+func (x *DeleteRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,48 +7224,55 @@ func (x *RecoverFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverFromRecycleBinRequest.ProtoReflect.Descriptor instead.
-func (*RecoverFromRecycleBinRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RecoverFromRecycleBinRequest) GetGid() string {
+func (x *DeleteRedirectRuleRequest) GetId() int64 {
 	if x != nil {
-		return x.Gid
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *RecoverFromRecycleBinRequest) GetFullShortUrl() string {
+func (x *DeleteRedirectRuleRequest) GetFullShortUrl() string {
 	if x != nil {
 		return x.FullShortUrl
 	}
 	return ""
 }
 
-// 从回收站恢复响应
-type RecoverFromRecycleBinResponse struct {
+func (x *DeleteRedirectRuleRequest) GetGid() string {
+	if x != nil {
+		return x.Gid
+	}
+	return ""
+}
+
+// 删除跳转规则响应
+type DeleteRedirectRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecoverFromRecycleBinResponse) Reset() {
-	*x = RecoverFromRecycleBinResponse{}
-	mi := &file_link_proto_msgTypes[13]
+func (x *DeleteRedirectRuleResponse) Reset() {
+	*x = DeleteRedirectRuleResponse{}
+	mi := &file_link_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecoverFromRecycleBinResponse) String() string {
+func (x *DeleteRedirectRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverFromRecycleBinResponse) ProtoMessage() {}
+func (*DeleteRedirectRuleResponse) ProtoMessage() {}
 
-func (x *RecoverFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[13]
+func (x *DeleteRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,42 +7283,42 @@ func (x *RecoverFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverFromRecycleBinResponse.ProtoReflect.Descriptor instead.
-func (*RecoverFromRecycleBinResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeleteRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *RecoverFromRecycleBinResponse) GetSuccess() bool {
+func (x *DeleteRedirectRuleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-// 从回收站删除请求
-type RemoveFromRecycleBinRequest struct {
+// 查询跳转规则请求
+type ListRedirectRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	FullShortUrl  string                 `protobuf:"bytes,2,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFromRecycleBinRequest) Reset() {
-	*x = RemoveFromRecycleBinRequest{}
-	mi := &file_link_proto_msgTypes[14]
+func (x *ListRedirectRuleRequest) Reset() {
+	*x = ListRedirectRuleRequest{}
+	mi := &file_link_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromRecycleBinRequest) String() string {
+func (x *ListRedirectRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromRecycleBinRequest) ProtoMessage() {}
+func (*ListRedirectRuleRequest) ProtoMessage() {}
 
-func (x *RemoveFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[14]
+func (x *ListRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,48 +7329,48 @@ func (x *RemoveFromRecycleBinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromRecycleBinRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFromRecycleBinRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*ListRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RemoveFromRecycleBinRequest) GetGid() string {
+func (x *ListRedirectRuleRequest) GetFullShortUrl() string {
 	if x != nil {
-		return x.Gid
+		return x.FullShortUrl
 	}
 	return ""
 }
 
-func (x *RemoveFromRecycleBinRequest) GetFullShortUrl() string {
+func (x *ListRedirectRuleRequest) GetGid() string {
 	if x != nil {
-		return x.FullShortUrl
+		return x.Gid
 	}
 	return ""
 }
 
-// 从回收站删除响应
-type RemoveFromRecycleBinResponse struct {
+// 查询跳转规则响应
+type ListRedirectRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	Rules         []*RedirectRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // 按优先级排序的规则列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFromRecycleBinResponse) Reset() {
-	*x = RemoveFromRecycleBinResponse{}
-	mi := &file_link_proto_msgTypes[15]
+func (x *ListRedirectRuleResponse) Reset() {
+	*x = ListRedirectRuleResponse{}
+	mi := &file_link_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFromRecycleBinResponse) String() string {
+func (x *ListRedirectRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFromRecycleBinResponse) ProtoMessage() {}
+func (*ListRedirectRuleResponse) ProtoMessage() {}
 
-func (x *RemoveFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[15]
+func (x *ListRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,43 +7381,58 @@ func (x *RemoveFromRecycleBinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFromRecycleBinResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFromRecycleBinResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*ListRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RemoveFromRecycleBinResponse) GetSuccess() bool {
+func (x *ListRedirectRuleResponse) GetRules() []*RedirectRule {
 	if x != nil {
-		return x.Success
+		return x.Rules
 	}
-	return false
-}
-
-// 分页查询回收站短链接请求
-type PageRecycleBinShortLinkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`          // 分组标识
-	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"` // 当前页
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *PageRecycleBinShortLinkRequest) Reset() {
-	*x = PageRecycleBinShortLinkRequest{}
-	mi := &file_link_proto_msgTypes[16]
+// --------------------- 短链接模板接口 ---------------------
+// 短链接模板，创建短链接时为未填写的字段提供默认值
+type LinkTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 模板ID
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                    // 模板名称
+	Gid              string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                                      // 默认分组标识
+	Domain           string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`                                                // 默认域名
+	ValidDays        int32                  `protobuf:"varint,5,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`                        // 有效天数，从创建时开始计算，0表示永久有效
+	Describe         string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                            // 描述模板，{date}替换为创建日期，{week}替换为创建时的ISO周
+	QueryParamPolicy int32                  `protobuf:"varint,7,opt,name=query_param_policy,json=queryParamPolicy,proto3" json:"query_param_policy,omitempty"` // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource        string                 `protobuf:"bytes,8,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`                         // utm_source模板
+	UtmMedium        string                 `protobuf:"bytes,9,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`                         // utm_medium模板
+	UtmCampaign      string                 `protobuf:"bytes,10,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`                  // utm_campaign模板
+	RedirectType     int32                  `protobuf:"varint,11,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`              // 跳转类型
+	ExpiredUrl       string                 `protobuf:"bytes,12,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                     // 过期后跳转链接
+	ExpiredMessage   string                 `protobuf:"bytes,13,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`         // 过期后提示信息
+	GraceDays        int32                  `protobuf:"varint,14,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                       // 过期宽限天数
+	Tags             []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                                   // 标签
+	CreateTime       string                 `protobuf:"bytes,16,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                     // 创建时间（ISO-8601格式）
+	UpdateTime       string                 `protobuf:"bytes,17,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                     // 修改时间（ISO-8601格式）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LinkTemplate) Reset() {
+	*x = LinkTemplate{}
+	mi := &file_link_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageRecycleBinShortLinkRequest) String() string {
+func (x *LinkTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageRecycleBinShortLinkRequest) ProtoMessage() {}
+func (*LinkTemplate) ProtoMessage() {}
 
-func (x *PageRecycleBinShortLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[16]
+func (x *LinkTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,128 +7443,166 @@ func (x *PageRecycleBinShortLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageRecycleBinShortLinkRequest.ProtoReflect.Descriptor instead.
-func (*PageRecycleBinShortLinkRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use LinkTemplate.ProtoReflect.Descriptor instead.
+func (*LinkTemplate) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *PageRecycleBinShortLinkRequest) GetGid() string {
+func (x *LinkTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LinkTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkTemplate) GetGid() string {
 	if x != nil {
 		return x.Gid
 	}
 	return ""
 }
 
-func (x *PageRecycleBinShortLinkRequest) GetCurrent() int32 {
+func (x *LinkTemplate) GetDomain() string {
 	if x != nil {
-		return x.Current
+		return x.Domain
 	}
-	return 0
+	return ""
 }
 
-func (x *PageRecycleBinShortLinkRequest) GetSize() int32 {
+func (x *LinkTemplate) GetValidDays() int32 {
 	if x != nil {
-		return x.Size
+		return x.ValidDays
 	}
 	return 0
 }
 
-// 分页查询回收站短链接响应
-type PageRecycleBinShortLinkResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*ShortLinkRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 回收站短链接记录列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
-	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LinkTemplate) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
 }
 
-func (x *PageRecycleBinShortLinkResponse) Reset() {
-	*x = PageRecycleBinShortLinkResponse{}
-	mi := &file_link_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LinkTemplate) GetQueryParamPolicy() int32 {
+	if x != nil {
+		return x.QueryParamPolicy
+	}
+	return 0
 }
 
-func (x *PageRecycleBinShortLinkResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LinkTemplate) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
 }
 
-func (*PageRecycleBinShortLinkResponse) ProtoMessage() {}
+func (x *LinkTemplate) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
 
-func (x *PageRecycleBinShortLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[17]
+func (x *LinkTemplate) GetUtmCampaign() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UtmCampaign
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PageRecycleBinShortLinkResponse.ProtoReflect.Descriptor instead.
-func (*PageRecycleBinShortLinkResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{17}
+func (x *LinkTemplate) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
 }
 
-func (x *PageRecycleBinShortLinkResponse) GetRecords() []*ShortLinkRecord {
+func (x *LinkTemplate) GetExpiredUrl() string {
 	if x != nil {
-		return x.Records
+		return x.ExpiredUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *PageRecycleBinShortLinkResponse) GetTotal() int32 {
+func (x *LinkTemplate) GetExpiredMessage() string {
 	if x != nil {
-		return x.Total
+		return x.ExpiredMessage
 	}
-	return 0
+	return ""
 }
 
-func (x *PageRecycleBinShortLinkResponse) GetSize() int32 {
+func (x *LinkTemplate) GetGraceDays() int32 {
 	if x != nil {
-		return x.Size
+		return x.GraceDays
 	}
 	return 0
 }
 
-func (x *PageRecycleBinShortLinkResponse) GetCurrent() int32 {
+func (x *LinkTemplate) GetTags() []string {
 	if x != nil {
-		return x.Current
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-// 获取单个短链接统计数据请求
-type GetSingleStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // 开始日期（ISO-8601格式）
-	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                  // 结束日期（ISO-8601格式）
-	EnableStatus  int32                  `protobuf:"varint,5,opt,name=enable_status,json=enableStatus,proto3" json:"enable_status,omitempty"`  // 启用状态 0：启用 1：未启用
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LinkTemplate) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
 }
 
-func (x *GetSingleStatsRequest) Reset() {
-	*x = GetSingleStatsRequest{}
-	mi := &file_link_proto_msgTypes[18]
+func (x *LinkTemplate) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+// 创建短链接模板请求
+type CreateLinkTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                    // 模板名称
+	Gid              string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                                      // 默认分组标识（可选）
+	Domain           string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                                                // 默认域名（可选）
+	ValidDays        int32                  `protobuf:"varint,4,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`                        // 有效天数，0表示永久有效
+	Describe         string                 `protobuf:"bytes,5,opt,name=describe,proto3" json:"describe,omitempty"`                                            // 描述模板（可选）
+	QueryParamPolicy int32                  `protobuf:"varint,6,opt,name=query_param_policy,json=queryParamPolicy,proto3" json:"query_param_policy,omitempty"` // 查询参数策略
+	UtmSource        string                 `protobuf:"bytes,7,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`                         // utm_source模板（可选）
+	UtmMedium        string                 `protobuf:"bytes,8,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`                         // utm_medium模板（可选）
+	UtmCampaign      string                 `protobuf:"bytes,9,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`                   // utm_campaign模板（可选）
+	RedirectType     int32                  `protobuf:"varint,10,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`              // 跳转类型
+	ExpiredUrl       string                 `protobuf:"bytes,11,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                     // 过期后跳转链接（可选）
+	ExpiredMessage   string                 `protobuf:"bytes,12,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`         // 过期后提示信息（可选）
+	GraceDays        int32                  `protobuf:"varint,13,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                       // 过期宽限天数
+	Tags             []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`                                                   // 标签（可选）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateLinkTemplateRequest) Reset() {
+	*x = CreateLinkTemplateRequest{}
+	mi := &file_link_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingleStatsRequest) String() string {
+func (x *CreateLinkTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingleStatsRequest) ProtoMessage() {}
+func (*CreateLinkTemplateRequest) ProtoMessage() {}
 
-func (x *GetSingleStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[18]
+func (x *CreateLinkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,140 +7613,132 @@ func (x *GetSingleStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingleStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetSingleStatsRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateLinkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetSingleStatsRequest) GetFullShortUrl() string {
+func (x *CreateLinkTemplateRequest) GetName() string {
 	if x != nil {
-		return x.FullShortUrl
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetSingleStatsRequest) GetGid() string {
+func (x *CreateLinkTemplateRequest) GetGid() string {
 	if x != nil {
 		return x.Gid
 	}
 	return ""
 }
 
-func (x *GetSingleStatsRequest) GetStartDate() string {
+func (x *CreateLinkTemplateRequest) GetDomain() string {
 	if x != nil {
-		return x.StartDate
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *GetSingleStatsRequest) GetEndDate() string {
+func (x *CreateLinkTemplateRequest) GetValidDays() int32 {
 	if x != nil {
-		return x.EndDate
+		return x.ValidDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSingleStatsRequest) GetEnableStatus() int32 {
+func (x *CreateLinkTemplateRequest) GetDescribe() string {
 	if x != nil {
-		return x.EnableStatus
+		return x.Describe
 	}
-	return 0
+	return ""
 }
 
-// 每日统计
-type DailyStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 日期
-	Pv            int32                  `protobuf:"varint,2,opt,name=pv,proto3" json:"pv,omitempty"`    // 访问量
-	Uv            int32                  `protobuf:"varint,3,opt,name=uv,proto3" json:"uv,omitempty"`    // 独立访问量
-	Uip           int32                  `protobuf:"varint,4,opt,name=uip,proto3" json:"uip,omitempty"`  // IP数
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateLinkTemplateRequest) GetQueryParamPolicy() int32 {
+	if x != nil {
+		return x.QueryParamPolicy
+	}
+	return 0
 }
 
-func (x *DailyStat) Reset() {
-	*x = DailyStat{}
-	mi := &file_link_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateLinkTemplateRequest) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
 }
 
-func (x *DailyStat) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateLinkTemplateRequest) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
 }
 
-func (*DailyStat) ProtoMessage() {}
-
-func (x *DailyStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[19]
+func (x *CreateLinkTemplateRequest) GetUtmCampaign() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UtmCampaign
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
-func (*DailyStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{19}
+func (x *CreateLinkTemplateRequest) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
 }
 
-func (x *DailyStat) GetDate() string {
+func (x *CreateLinkTemplateRequest) GetExpiredUrl() string {
 	if x != nil {
-		return x.Date
+		return x.ExpiredUrl
 	}
 	return ""
 }
 
-func (x *DailyStat) GetPv() int32 {
+func (x *CreateLinkTemplateRequest) GetExpiredMessage() string {
 	if x != nil {
-		return x.Pv
+		return x.ExpiredMessage
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyStat) GetUv() int32 {
+func (x *CreateLinkTemplateRequest) GetGraceDays() int32 {
 	if x != nil {
-		return x.Uv
+		return x.GraceDays
 	}
 	return 0
 }
 
-func (x *DailyStat) GetUip() int32 {
+func (x *CreateLinkTemplateRequest) GetTags() []string {
 	if x != nil {
-		return x.Uip
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-// 地域统计
-type LocaleCnStat struct {
+// 创建短链接模板响应
+type CreateLinkTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // 地区
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 模板ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LocaleCnStat) Reset() {
-	*x = LocaleCnStat{}
-	mi := &file_link_proto_msgTypes[20]
+func (x *CreateLinkTemplateResponse) Reset() {
+	*x = CreateLinkTemplateResponse{}
+	mi := &file_link_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocaleCnStat) String() string {
+func (x *CreateLinkTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocaleCnStat) ProtoMessage() {}
+func (*CreateLinkTemplateResponse) ProtoMessage() {}
 
-func (x *LocaleCnStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[20]
+func (x *CreateLinkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,57 +7749,55 @@ func (x *LocaleCnStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocaleCnStat.ProtoReflect.Descriptor instead.
-func (*LocaleCnStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *LocaleCnStat) GetLocale() string {
-	if x != nil {
-		return x.Locale
-	}
-	return ""
-}
-
-func (x *LocaleCnStat) GetCnt() int32 {
-	if x != nil {
-		return x.Cnt
-	}
-	return 0
+// Deprecated: Use CreateLinkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateLinkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *LocaleCnStat) GetRatio() float64 {
+func (x *CreateLinkTemplateResponse) GetId() int64 {
 	if x != nil {
-		return x.Ratio
+		return x.Id
 	}
 	return 0
 }
 
-// 浏览器统计
-type BrowserStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Browser       string                 `protobuf:"bytes,1,opt,name=browser,proto3" json:"browser,omitempty"` // 浏览器
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`        // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`   // 比例
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BrowserStat) Reset() {
-	*x = BrowserStat{}
-	mi := &file_link_proto_msgTypes[21]
+// 修改短链接模板请求，覆盖模板的全部字段
+type UpdateLinkTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 模板ID
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                    // 模板名称
+	Gid              string                 `protobuf:"bytes,3,opt,name=gid,proto3" json:"gid,omitempty"`                                                      // 默认分组标识（可选）
+	Domain           string                 `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`                                                // 默认域名（可选）
+	ValidDays        int32                  `protobuf:"varint,5,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`                        // 有效天数，0表示永久有效
+	Describe         string                 `protobuf:"bytes,6,opt,name=describe,proto3" json:"describe,omitempty"`                                            // 描述模板（可选）
+	QueryParamPolicy int32                  `protobuf:"varint,7,opt,name=query_param_policy,json=queryParamPolicy,proto3" json:"query_param_policy,omitempty"` // 查询参数策略
+	UtmSource        string                 `protobuf:"bytes,8,opt,name=utm_source,json=utmSource,proto3" json:"utm_source,omitempty"`                         // utm_source模板（可选）
+	UtmMedium        string                 `protobuf:"bytes,9,opt,name=utm_medium,json=utmMedium,proto3" json:"utm_medium,omitempty"`                         // utm_medium模板（可选）
+	UtmCampaign      string                 `protobuf:"bytes,10,opt,name=utm_campaign,json=utmCampaign,proto3" json:"utm_campaign,omitempty"`                  // utm_campaign模板（可选）
+	RedirectType     int32                  `protobuf:"varint,11,opt,name=redirect_type,json=redirectType,proto3" json:"redirect_type,omitempty"`              // 跳转类型
+	ExpiredUrl       string                 `protobuf:"bytes,12,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`                     // 过期后跳转链接（可选）
+	ExpiredMessage   string                 `protobuf:"bytes,13,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`         // 过期后提示信息（可选）
+	GraceDays        int32                  `protobuf:"varint,14,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                       // 过期宽限天数
+	Tags             []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                                   // 标签（可选）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateLinkTemplateRequest) Reset() {
+	*x = UpdateLinkTemplateRequest{}
+	mi := &file_link_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrowserStat) String() string {
+func (x *UpdateLinkTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowserStat) ProtoMessage() {}
+func (*UpdateLinkTemplateRequest) ProtoMessage() {}
 
-func (x *BrowserStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[21]
+func (x *UpdateLinkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,118 +7808,138 @@ func (x *BrowserStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowserStat.ProtoReflect.Descriptor instead.
-func (*BrowserStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateLinkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLinkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *BrowserStat) GetBrowser() string {
+func (x *UpdateLinkTemplateRequest) GetId() int64 {
 	if x != nil {
-		return x.Browser
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLinkTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BrowserStat) GetCnt() int32 {
+func (x *UpdateLinkTemplateRequest) GetGid() string {
 	if x != nil {
-		return x.Cnt
+		return x.Gid
 	}
-	return 0
+	return ""
 }
 
-func (x *BrowserStat) GetRatio() float64 {
+func (x *UpdateLinkTemplateRequest) GetDomain() string {
 	if x != nil {
-		return x.Ratio
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *UpdateLinkTemplateRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
 	}
 	return 0
 }
 
-// 操作系统统计
-type OSStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Os            string                 `protobuf:"bytes,1,opt,name=os,proto3" json:"os,omitempty"`         // 操作系统
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateLinkTemplateRequest) GetDescribe() string {
+	if x != nil {
+		return x.Describe
+	}
+	return ""
 }
 
-func (x *OSStat) Reset() {
-	*x = OSStat{}
-	mi := &file_link_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateLinkTemplateRequest) GetQueryParamPolicy() int32 {
+	if x != nil {
+		return x.QueryParamPolicy
+	}
+	return 0
 }
 
-func (x *OSStat) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateLinkTemplateRequest) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
 }
 
-func (*OSStat) ProtoMessage() {}
+func (x *UpdateLinkTemplateRequest) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
 
-func (x *OSStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[22]
+func (x *UpdateLinkTemplateRequest) GetUtmCampaign() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UtmCampaign
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use OSStat.ProtoReflect.Descriptor instead.
-func (*OSStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{22}
+func (x *UpdateLinkTemplateRequest) GetRedirectType() int32 {
+	if x != nil {
+		return x.RedirectType
+	}
+	return 0
 }
 
-func (x *OSStat) GetOs() string {
+func (x *UpdateLinkTemplateRequest) GetExpiredUrl() string {
 	if x != nil {
-		return x.Os
+		return x.ExpiredUrl
 	}
 	return ""
 }
 
-func (x *OSStat) GetCnt() int32 {
+func (x *UpdateLinkTemplateRequest) GetExpiredMessage() string {
 	if x != nil {
-		return x.Cnt
+		return x.ExpiredMessage
 	}
-	return 0
+	return ""
 }
 
-func (x *OSStat) GetRatio() float64 {
+func (x *UpdateLinkTemplateRequest) GetGraceDays() int32 {
 	if x != nil {
-		return x.Ratio
+		return x.GraceDays
 	}
 	return 0
 }
 
-// 设备统计
-type DeviceStat struct {
+func (x *UpdateLinkTemplateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// 修改短链接模板响应（空结构体）
+type UpdateLinkTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"` // 设备
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeviceStat) Reset() {
-	*x = DeviceStat{}
-	mi := &file_link_proto_msgTypes[23]
+func (x *UpdateLinkTemplateResponse) Reset() {
+	*x = UpdateLinkTemplateResponse{}
+	mi := &file_link_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeviceStat) String() string {
+func (x *UpdateLinkTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeviceStat) ProtoMessage() {}
+func (*UpdateLinkTemplateResponse) ProtoMessage() {}
 
-func (x *DeviceStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[23]
+func (x *UpdateLinkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,57 +7950,34 @@ func (x *DeviceStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeviceStat.ProtoReflect.Descriptor instead.
-func (*DeviceStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *DeviceStat) GetDevice() string {
-	if x != nil {
-		return x.Device
-	}
-	return ""
-}
-
-func (x *DeviceStat) GetCnt() int32 {
-	if x != nil {
-		return x.Cnt
-	}
-	return 0
-}
-
-func (x *DeviceStat) GetRatio() float64 {
-	if x != nil {
-		return x.Ratio
-	}
-	return 0
+// Deprecated: Use UpdateLinkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLinkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{103}
 }
 
-// 网络统计
-type NetworkStat struct {
+// 删除短链接模板请求
+type DeleteLinkTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // 网络类型
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`        // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`   // 比例
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 模板ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkStat) Reset() {
-	*x = NetworkStat{}
-	mi := &file_link_proto_msgTypes[24]
+func (x *DeleteLinkTemplateRequest) Reset() {
+	*x = DeleteLinkTemplateRequest{}
+	mi := &file_link_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkStat) String() string {
+func (x *DeleteLinkTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkStat) ProtoMessage() {}
+func (*DeleteLinkTemplateRequest) ProtoMessage() {}
 
-func (x *NetworkStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[24]
+func (x *DeleteLinkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,57 +7988,41 @@ func (x *NetworkStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkStat.ProtoReflect.Descriptor instead.
-func (*NetworkStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *NetworkStat) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *NetworkStat) GetCnt() int32 {
-	if x != nil {
-		return x.Cnt
-	}
-	return 0
+// Deprecated: Use DeleteLinkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLinkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *NetworkStat) GetRatio() float64 {
+func (x *DeleteLinkTemplateRequest) GetId() int64 {
 	if x != nil {
-		return x.Ratio
+		return x.Id
 	}
 	return 0
 }
 
-// 高频访问IP统计
-type TopIpStat struct {
+// 删除短链接模板响应
+type DeleteLinkTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`         // IP地址
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`      // 访问次数
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"` // 比例
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopIpStat) Reset() {
-	*x = TopIpStat{}
-	mi := &file_link_proto_msgTypes[25]
+func (x *DeleteLinkTemplateResponse) Reset() {
+	*x = DeleteLinkTemplateResponse{}
+	mi := &file_link_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopIpStat) String() string {
+func (x *DeleteLinkTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopIpStat) ProtoMessage() {}
+func (*DeleteLinkTemplateResponse) ProtoMessage() {}
 
-func (x *TopIpStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[25]
+func (x *DeleteLinkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,57 +8033,40 @@ func (x *TopIpStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopIpStat.ProtoReflect.Descriptor instead.
-func (*TopIpStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *TopIpStat) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
-}
-
-func (x *TopIpStat) GetCnt() int32 {
-	if x != nil {
-		return x.Cnt
-	}
-	return 0
+// Deprecated: Use DeleteLinkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLinkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *TopIpStat) GetRatio() float64 {
+func (x *DeleteLinkTemplateResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Ratio
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// 访客类型统计
-type UvTypeStat struct {
+// 查询短链接模板请求（空结构体）
+type ListLinkTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UvType        string                 `protobuf:"bytes,1,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"` // 访客类型
-	Cnt           int32                  `protobuf:"varint,2,opt,name=cnt,proto3" json:"cnt,omitempty"`                    // 数量
-	Ratio         float64                `protobuf:"fixed64,3,opt,name=ratio,proto3" json:"ratio,omitempty"`               // 比例
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UvTypeStat) Reset() {
-	*x = UvTypeStat{}
-	mi := &file_link_proto_msgTypes[26]
+func (x *ListLinkTemplateRequest) Reset() {
+	*x = ListLinkTemplateRequest{}
+	mi := &file_link_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UvTypeStat) String() string {
+func (x *ListLinkTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UvTypeStat) ProtoMessage() {}
+func (*ListLinkTemplateRequest) ProtoMessage() {}
 
-func (x *UvTypeStat) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[26]
+func (x *ListLinkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,67 +8077,83 @@ func (x *UvTypeStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UvTypeStat.ProtoReflect.Descriptor instead.
-func (*UvTypeStat) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListLinkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *UvTypeStat) GetUvType() string {
-	if x != nil {
-		return x.UvType
-	}
-	return ""
+// 查询短链接模板响应
+type ListLinkTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*LinkTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // 当前用户的模板列表，按创建顺序排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UvTypeStat) GetCnt() int32 {
+func (x *ListLinkTemplateResponse) Reset() {
+	*x = ListLinkTemplateResponse{}
+	mi := &file_link_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkTemplateResponse) ProtoMessage() {}
+
+func (x *ListLinkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[107]
 	if x != nil {
-		return x.Cnt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UvTypeStat) GetRatio() float64 {
+// Deprecated: Use ListLinkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ListLinkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListLinkTemplateResponse) GetTemplates() []*LinkTemplate {
 	if x != nil {
-		return x.Ratio
+		return x.Templates
 	}
-	return 0
+	return nil
 }
 
-// 获取单个短链接统计数据响应
-type GetSingleStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pv            int32                  `protobuf:"varint,1,opt,name=pv,proto3" json:"pv,omitempty"`                                                // 访问量
-	Uv            int32                  `protobuf:"varint,2,opt,name=uv,proto3" json:"uv,omitempty"`                                                // 独立访问量
-	Uip           int32                  `protobuf:"varint,3,opt,name=uip,proto3" json:"uip,omitempty"`                                              // IP数
-	Daily         []*DailyStat           `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`                                           // 每日统计
-	LocaleCnStats []*LocaleCnStat        `protobuf:"bytes,5,rep,name=locale_cn_stats,json=localeCnStats,proto3" json:"locale_cn_stats,omitempty"`    // 地域统计
-	HourStats     []int32                `protobuf:"varint,6,rep,packed,name=hour_stats,json=hourStats,proto3" json:"hour_stats,omitempty"`          // 小时访问详情（24小时）
-	TopIpStats    []*TopIpStat           `protobuf:"bytes,7,rep,name=top_ip_stats,json=topIpStats,proto3" json:"top_ip_stats,omitempty"`             // 高频访问IP详情
-	WeekdayStats  []int32                `protobuf:"varint,8,rep,packed,name=weekday_stats,json=weekdayStats,proto3" json:"weekday_stats,omitempty"` // 一周访问详情（7天）
-	BrowserStats  []*BrowserStat         `protobuf:"bytes,9,rep,name=browser_stats,json=browserStats,proto3" json:"browser_stats,omitempty"`         // 浏览器统计
-	OsStats       []*OSStat              `protobuf:"bytes,10,rep,name=os_stats,json=osStats,proto3" json:"os_stats,omitempty"`                       // 操作系统统计
-	UvTypeStats   []*UvTypeStat          `protobuf:"bytes,11,rep,name=uv_type_stats,json=uvTypeStats,proto3" json:"uv_type_stats,omitempty"`         // 访客类型统计
-	DeviceStats   []*DeviceStat          `protobuf:"bytes,12,rep,name=device_stats,json=deviceStats,proto3" json:"device_stats,omitempty"`           // 设备统计
-	NetworkStats  []*NetworkStat         `protobuf:"bytes,13,rep,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"`        // 网络统计
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// --------------------- 分组过期策略接口 ---------------------
+// 分组过期策略，作为分组内短链接未单独配置时的默认值
+type GroupExpiryPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                             // 分组标识
+	ExpiredUrl     string                 `protobuf:"bytes,2,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`             // 过期后跳转链接
+	ExpiredMessage string                 `protobuf:"bytes,3,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"` // 过期后提示信息
+	GraceDays      int32                  `protobuf:"varint,4,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`               // 过期宽限天数，宽限期内继续跳转
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSingleStatsResponse) Reset() {
-	*x = GetSingleStatsResponse{}
-	mi := &file_link_proto_msgTypes[27]
+func (x *GroupExpiryPolicy) Reset() {
+	*x = GroupExpiryPolicy{}
+	mi := &file_link_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingleStatsResponse) String() string {
+func (x *GroupExpiryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingleStatsResponse) ProtoMessage() {}
+func (*GroupExpiryPolicy) ProtoMessage() {}
 
-func (x *GetSingleStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[27]
+func (x *GroupExpiryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,127 +8164,131 @@ func (x *GetSingleStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingleStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetSingleStatsResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GroupExpiryPolicy.ProtoReflect.Descriptor instead.
+func (*GroupExpiryPolicy) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetSingleStatsResponse) GetPv() int32 {
+func (x *GroupExpiryPolicy) GetGid() string {
 	if x != nil {
-		return x.Pv
+		return x.Gid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetUv() int32 {
+func (x *GroupExpiryPolicy) GetExpiredUrl() string {
 	if x != nil {
-		return x.Uv
+		return x.ExpiredUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetUip() int32 {
+func (x *GroupExpiryPolicy) GetExpiredMessage() string {
 	if x != nil {
-		return x.Uip
+		return x.ExpiredMessage
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetDaily() []*DailyStat {
+func (x *GroupExpiryPolicy) GetGraceDays() int32 {
 	if x != nil {
-		return x.Daily
+		return x.GraceDays
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSingleStatsResponse) GetLocaleCnStats() []*LocaleCnStat {
-	if x != nil {
-		return x.LocaleCnStats
-	}
-	return nil
+// 保存分组过期策略请求
+type SaveGroupExpiryPolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                             // 分组标识
+	ExpiredUrl     string                 `protobuf:"bytes,2,opt,name=expired_url,json=expiredUrl,proto3" json:"expired_url,omitempty"`             // 过期后跳转链接，为空表示不跳转
+	ExpiredMessage string                 `protobuf:"bytes,3,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"` // 过期后提示信息，为空表示使用默认提示
+	GraceDays      int32                  `protobuf:"varint,4,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`               // 过期宽限天数，0表示不设宽限期
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSingleStatsResponse) GetHourStats() []int32 {
-	if x != nil {
-		return x.HourStats
-	}
-	return nil
+func (x *SaveGroupExpiryPolicyRequest) Reset() {
+	*x = SaveGroupExpiryPolicyRequest{}
+	mi := &file_link_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingleStatsResponse) GetTopIpStats() []*TopIpStat {
-	if x != nil {
-		return x.TopIpStats
-	}
-	return nil
+func (x *SaveGroupExpiryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetSingleStatsResponse) GetWeekdayStats() []int32 {
+func (*SaveGroupExpiryPolicyRequest) ProtoMessage() {}
+
+func (x *SaveGroupExpiryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[109]
 	if x != nil {
-		return x.WeekdayStats
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetSingleStatsResponse) GetBrowserStats() []*BrowserStat {
-	if x != nil {
-		return x.BrowserStats
-	}
-	return nil
+// Deprecated: Use SaveGroupExpiryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SaveGroupExpiryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetSingleStatsResponse) GetOsStats() []*OSStat {
+func (x *SaveGroupExpiryPolicyRequest) GetGid() string {
 	if x != nil {
-		return x.OsStats
+		return x.Gid
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetUvTypeStats() []*UvTypeStat {
+func (x *SaveGroupExpiryPolicyRequest) GetExpiredUrl() string {
 	if x != nil {
-		return x.UvTypeStats
+		return x.ExpiredUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetDeviceStats() []*DeviceStat {
+func (x *SaveGroupExpiryPolicyRequest) GetExpiredMessage() string {
 	if x != nil {
-		return x.DeviceStats
+		return x.ExpiredMessage
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSingleStatsResponse) GetNetworkStats() []*NetworkStat {
+func (x *SaveGroupExpiryPolicyRequest) GetGraceDays() int32 {
 	if x != nil {
-		return x.NetworkStats
+		return x.GraceDays
 	}
-	return nil
+	return 0
 }
 
-// 获取分组短链接统计数据请求
-type GetGroupStatsRequest struct {
+// 保存分组过期策略响应
+type SaveGroupExpiryPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                              // 分组标识
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（ISO-8601格式）
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（ISO-8601格式）
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupStatsRequest) Reset() {
-	*x = GetGroupStatsRequest{}
-	mi := &file_link_proto_msgTypes[28]
+func (x *SaveGroupExpiryPolicyResponse) Reset() {
+	*x = SaveGroupExpiryPolicyResponse{}
+	mi := &file_link_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupStatsRequest) String() string {
+func (x *SaveGroupExpiryPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupStatsRequest) ProtoMessage() {}
+func (*SaveGroupExpiryPolicyResponse) ProtoMessage() {}
 
-func (x *GetGroupStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[28]
+func (x *SaveGroupExpiryPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,67 +8299,86 @@ func (x *GetGroupStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupStatsRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SaveGroupExpiryPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SaveGroupExpiryPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetGroupStatsRequest) GetGid() string {
+func (x *SaveGroupExpiryPolicyResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Gid
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GetGroupStatsRequest) GetStartDate() string {
+// 查询分组过期策略请求
+type GetGroupExpiryPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"` // 分组标识
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupExpiryPolicyRequest) Reset() {
+	*x = GetGroupExpiryPolicyRequest{}
+	mi := &file_link_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupExpiryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupExpiryPolicyRequest) ProtoMessage() {}
+
+func (x *GetGroupExpiryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[111]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupStatsRequest) GetEndDate() string {
+// Deprecated: Use GetGroupExpiryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupExpiryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetGroupExpiryPolicyRequest) GetGid() string {
 	if x != nil {
-		return x.EndDate
+		return x.Gid
 	}
 	return ""
 }
 
-// 获取分组短链接统计数据响应
-type GetGroupStatsResponse struct {
+// 查询分组过期策略响应
+type GetGroupExpiryPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pv            int32                  `protobuf:"varint,1,opt,name=pv,proto3" json:"pv,omitempty"`                                                // 访问量
-	Uv            int32                  `protobuf:"varint,2,opt,name=uv,proto3" json:"uv,omitempty"`                                                // 独立访问量
-	Uip           int32                  `protobuf:"varint,3,opt,name=uip,proto3" json:"uip,omitempty"`                                              // IP数
-	Daily         []*DailyStat           `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`                                           // 每日统计
-	LocaleCnStats []*LocaleCnStat        `protobuf:"bytes,5,rep,name=locale_cn_stats,json=localeCnStats,proto3" json:"locale_cn_stats,omitempty"`    // 地域统计
-	HourStats     []int32                `protobuf:"varint,6,rep,packed,name=hour_stats,json=hourStats,proto3" json:"hour_stats,omitempty"`          // 小时访问详情（24小时）
-	TopIpStats    []*TopIpStat           `protobuf:"bytes,7,rep,name=top_ip_stats,json=topIpStats,proto3" json:"top_ip_stats,omitempty"`             // 高频访问IP详情
-	WeekdayStats  []int32                `protobuf:"varint,8,rep,packed,name=weekday_stats,json=weekdayStats,proto3" json:"weekday_stats,omitempty"` // 一周访问详情（7天）
-	BrowserStats  []*BrowserStat         `protobuf:"bytes,9,rep,name=browser_stats,json=browserStats,proto3" json:"browser_stats,omitempty"`         // 浏览器统计
-	OsStats       []*OSStat              `protobuf:"bytes,10,rep,name=os_stats,json=osStats,proto3" json:"os_stats,omitempty"`                       // 操作系统统计
-	UvTypeStats   []*UvTypeStat          `protobuf:"bytes,11,rep,name=uv_type_stats,json=uvTypeStats,proto3" json:"uv_type_stats,omitempty"`         // 访客类型统计
-	DeviceStats   []*DeviceStat          `protobuf:"bytes,12,rep,name=device_stats,json=deviceStats,proto3" json:"device_stats,omitempty"`           // 设备统计
-	NetworkStats  []*NetworkStat         `protobuf:"bytes,13,rep,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"`        // 网络统计
+	Policy        *GroupExpiryPolicy     `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // 分组过期策略，未配置时各字段为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupStatsResponse) Reset() {
-	*x = GetGroupStatsResponse{}
-	mi := &file_link_proto_msgTypes[29]
+func (x *GetGroupExpiryPolicyResponse) Reset() {
+	*x = GetGroupExpiryPolicyResponse{}
+	mi := &file_link_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupStatsResponse) String() string {
+func (x *GetGroupExpiryPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupStatsResponse) ProtoMessage() {}
+func (*GetGroupExpiryPolicyResponse) ProtoMessage() {}
 
-func (x *GetGroupStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[29]
+func (x *GetGroupExpiryPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,126 +8389,144 @@ func (x *GetGroupStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupStatsResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetGroupExpiryPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupExpiryPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetGroupStatsResponse) GetPv() int32 {
+func (x *GetGroupExpiryPolicyResponse) GetPolicy() *GroupExpiryPolicy {
 	if x != nil {
-		return x.Pv
+		return x.Policy
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGroupStatsResponse) GetUv() int32 {
-	if x != nil {
-		return x.Uv
-	}
-	return 0
+// --------------------- 分组转让接口 ---------------------
+// 分组转让记录
+type GroupTransferRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // 转让记录ID
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                       // 分组标识
+	GroupName     string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`          // 分组名称
+	FromUsername  string                 `protobuf:"bytes,4,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"` // 转出用户名
+	ToUsername    string                 `protobuf:"bytes,5,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`       // 接收用户名
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                                // 转让状态 0：待接受 1：已接受 2：已拒绝 3：已取消
+	CreateTime    string                 `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`       // 创建时间
+	UpdateTime    string                 `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`       // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupStatsResponse) GetUip() int32 {
-	if x != nil {
-		return x.Uip
-	}
-	return 0
+func (x *GroupTransferRecord) Reset() {
+	*x = GroupTransferRecord{}
+	mi := &file_link_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupStatsResponse) GetDaily() []*DailyStat {
-	if x != nil {
-		return x.Daily
-	}
-	return nil
+func (x *GroupTransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupStatsResponse) GetLocaleCnStats() []*LocaleCnStat {
+func (*GroupTransferRecord) ProtoMessage() {}
+
+func (x *GroupTransferRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[113]
 	if x != nil {
-		return x.LocaleCnStats
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTransferRecord.ProtoReflect.Descriptor instead.
+func (*GroupTransferRecord) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetGroupStatsResponse) GetHourStats() []int32 {
+func (x *GroupTransferRecord) GetId() int64 {
 	if x != nil {
-		return x.HourStats
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupStatsResponse) GetTopIpStats() []*TopIpStat {
+func (x *GroupTransferRecord) GetGid() string {
 	if x != nil {
-		return x.TopIpStats
+		return x.Gid
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStatsResponse) GetWeekdayStats() []int32 {
+func (x *GroupTransferRecord) GetGroupName() string {
 	if x != nil {
-		return x.WeekdayStats
+		return x.GroupName
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStatsResponse) GetBrowserStats() []*BrowserStat {
+func (x *GroupTransferRecord) GetFromUsername() string {
 	if x != nil {
-		return x.BrowserStats
+		return x.FromUsername
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStatsResponse) GetOsStats() []*OSStat {
+func (x *GroupTransferRecord) GetToUsername() string {
 	if x != nil {
-		return x.OsStats
+		return x.ToUsername
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStatsResponse) GetUvTypeStats() []*UvTypeStat {
+func (x *GroupTransferRecord) GetStatus() int32 {
 	if x != nil {
-		return x.UvTypeStats
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupStatsResponse) GetDeviceStats() []*DeviceStat {
+func (x *GroupTransferRecord) GetCreateTime() string {
 	if x != nil {
-		return x.DeviceStats
+		return x.CreateTime
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStatsResponse) GetNetworkStats() []*NetworkStat {
+func (x *GroupTransferRecord) GetUpdateTime() string {
 	if x != nil {
-		return x.NetworkStats
+		return x.UpdateTime
 	}
-	return nil
+	return ""
 }
 
-// 单个分组数量结果
-type GroupCount struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                                // 分组标识
-	ShortLinkCount int32                  `protobuf:"varint,2,opt,name=short_link_count,json=shortLinkCount,proto3" json:"short_link_count,omitempty"` // 短链接数量
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 发起分组转让请求，接收用户接受后分组及其中的短链接归属变更
+type CreateGroupTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                 // 分组标识
+	ToUsername    string                 `protobuf:"bytes,2,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"` // 接收用户名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupCount) Reset() {
-	*x = GroupCount{}
-	mi := &file_link_proto_msgTypes[30]
+func (x *CreateGroupTransferRequest) Reset() {
+	*x = CreateGroupTransferRequest{}
+	mi := &file_link_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupCount) String() string {
+func (x *CreateGroupTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupCount) ProtoMessage() {}
+func (*CreateGroupTransferRequest) ProtoMessage() {}
 
-func (x *GroupCount) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[30]
+func (x *CreateGroupTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,56 +8537,48 @@ func (x *GroupCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupCount.ProtoReflect.Descriptor instead.
-func (*GroupCount) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CreateGroupTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupTransferRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GroupCount) GetGid() string {
+func (x *CreateGroupTransferRequest) GetGid() string {
 	if x != nil {
 		return x.Gid
 	}
 	return ""
 }
 
-func (x *GroupCount) GetShortLinkCount() int32 {
+func (x *CreateGroupTransferRequest) GetToUsername() string {
 	if x != nil {
-		return x.ShortLinkCount
+		return x.ToUsername
 	}
-	return 0
+	return ""
 }
 
-// 访问记录
-type AccessRecord struct {
+// 发起分组转让响应
+type CreateGroupTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UvType        string                 `protobuf:"bytes,1,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"`             // 访客类型
-	Browser       string                 `protobuf:"bytes,2,opt,name=browser,proto3" json:"browser,omitempty"`                         // 浏览器
-	Os            string                 `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`                                   // 操作系统
-	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                                   // 访问IP
-	Network       string                 `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`                         // 网络环境
-	Device        string                 `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`                           // 访问设备
-	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`                           // 地区
-	User          string                 `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`                               // 用户标识
-	CreateTime    string                 `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 访问时间（ISO-8601格式）
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 转让记录ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessRecord) Reset() {
-	*x = AccessRecord{}
-	mi := &file_link_proto_msgTypes[31]
+func (x *CreateGroupTransferResponse) Reset() {
+	*x = CreateGroupTransferResponse{}
+	mi := &file_link_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessRecord) String() string {
+func (x *CreateGroupTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessRecord) ProtoMessage() {}
+func (*CreateGroupTransferResponse) ProtoMessage() {}
 
-func (x *AccessRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[31]
+func (x *CreateGroupTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2169,103 +8589,132 @@ func (x *AccessRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessRecord.ProtoReflect.Descriptor instead.
-func (*AccessRecord) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use CreateGroupTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupTransferResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *AccessRecord) GetUvType() string {
+func (x *CreateGroupTransferResponse) GetId() int64 {
 	if x != nil {
-		return x.UvType
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AccessRecord) GetBrowser() string {
-	if x != nil {
-		return x.Browser
-	}
-	return ""
+// 查询待处理分组转让请求
+type ListGroupTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessRecord) GetOs() string {
-	if x != nil {
-		return x.Os
-	}
-	return ""
+func (x *ListGroupTransferRequest) Reset() {
+	*x = ListGroupTransferRequest{}
+	mi := &file_link_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessRecord) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
+func (x *ListGroupTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AccessRecord) GetNetwork() string {
+func (*ListGroupTransferRequest) ProtoMessage() {}
+
+func (x *ListGroupTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[116]
 	if x != nil {
-		return x.Network
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AccessRecord) GetDevice() string {
-	if x != nil {
-		return x.Device
-	}
-	return ""
+// Deprecated: Use ListGroupTransferRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupTransferRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *AccessRecord) GetLocale() string {
+// 查询待处理分组转让响应
+type ListGroupTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incoming      []*GroupTransferRecord `protobuf:"bytes,1,rep,name=incoming,proto3" json:"incoming,omitempty"` // 收到的待接受转让
+	Outgoing      []*GroupTransferRecord `protobuf:"bytes,2,rep,name=outgoing,proto3" json:"outgoing,omitempty"` // 发起的待接受转让
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupTransferResponse) Reset() {
+	*x = ListGroupTransferResponse{}
+	mi := &file_link_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupTransferResponse) ProtoMessage() {}
+
+func (x *ListGroupTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[117]
 	if x != nil {
-		return x.Locale
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AccessRecord) GetUser() string {
+// Deprecated: Use ListGroupTransferResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupTransferResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListGroupTransferResponse) GetIncoming() []*GroupTransferRecord {
 	if x != nil {
-		return x.User
+		return x.Incoming
 	}
-	return ""
+	return nil
 }
 
-func (x *AccessRecord) GetCreateTime() string {
+func (x *ListGroupTransferResponse) GetOutgoing() []*GroupTransferRecord {
 	if x != nil {
-		return x.CreateTime
+		return x.Outgoing
 	}
-	return ""
+	return nil
 }
 
-// 访问记录查询请求
-type AccessRecordQueryRequest struct {
+// 处理分组转让请求，由接收用户接受或拒绝
+type RespondGroupTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // 开始日期（ISO-8601格式）
-	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                  // 结束日期（ISO-8601格式）
-	EnableStatus  int32                  `protobuf:"varint,5,opt,name=enable_status,json=enableStatus,proto3" json:"enable_status,omitempty"`  // 启用状态 0：启用 1：未启用
-	Current       int64                  `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`                                // 当前页
-	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`                                      // 每页大小
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`         // 转让记录ID
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"` // 是否接受
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessRecordQueryRequest) Reset() {
-	*x = AccessRecordQueryRequest{}
-	mi := &file_link_proto_msgTypes[32]
+func (x *RespondGroupTransferRequest) Reset() {
+	*x = RespondGroupTransferRequest{}
+	mi := &file_link_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessRecordQueryRequest) String() string {
+func (x *RespondGroupTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessRecordQueryRequest) ProtoMessage() {}
+func (*RespondGroupTransferRequest) ProtoMessage() {}
 
-func (x *AccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[32]
+func (x *RespondGroupTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,86 +8725,93 @@ func (x *AccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessRecordQueryRequest.ProtoReflect.Descriptor instead.
-func (*AccessRecordQueryRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RespondGroupTransferRequest.ProtoReflect.Descriptor instead.
+func (*RespondGroupTransferRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *AccessRecordQueryRequest) GetFullShortUrl() string {
+func (x *RespondGroupTransferRequest) GetId() int64 {
 	if x != nil {
-		return x.FullShortUrl
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AccessRecordQueryRequest) GetGid() string {
+func (x *RespondGroupTransferRequest) GetAccept() bool {
 	if x != nil {
-		return x.Gid
+		return x.Accept
 	}
-	return ""
+	return false
 }
 
-func (x *AccessRecordQueryRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// 处理分组转让响应
+type RespondGroupTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessRecordQueryRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+func (x *RespondGroupTransferResponse) Reset() {
+	*x = RespondGroupTransferResponse{}
+	mi := &file_link_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessRecordQueryRequest) GetEnableStatus() int32 {
-	if x != nil {
-		return x.EnableStatus
-	}
-	return 0
+func (x *RespondGroupTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AccessRecordQueryRequest) GetCurrent() int64 {
+func (*RespondGroupTransferResponse) ProtoMessage() {}
+
+func (x *RespondGroupTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[119]
 	if x != nil {
-		return x.Current
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AccessRecordQueryRequest) GetSize() int64 {
+// Deprecated: Use RespondGroupTransferResponse.ProtoReflect.Descriptor instead.
+func (*RespondGroupTransferResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RespondGroupTransferResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Size
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// 访问记录查询响应
-type AccessRecordQueryResponse struct {
+// 取消分组转让请求，由转出用户取消
+type CancelGroupTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*AccessRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 访问记录列表
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
-	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 转让记录ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessRecordQueryResponse) Reset() {
-	*x = AccessRecordQueryResponse{}
-	mi := &file_link_proto_msgTypes[33]
+func (x *CancelGroupTransferRequest) Reset() {
+	*x = CancelGroupTransferRequest{}
+	mi := &file_link_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessRecordQueryResponse) String() string {
+func (x *CancelGroupTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessRecordQueryResponse) ProtoMessage() {}
+func (*CancelGroupTransferRequest) ProtoMessage() {}
 
-func (x *AccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[33]
+func (x *CancelGroupTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,66 +8822,96 @@ func (x *AccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessRecordQueryResponse.ProtoReflect.Descriptor instead.
-func (*AccessRecordQueryResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CancelGroupTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelGroupTransferRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *AccessRecordQueryResponse) GetRecords() []*AccessRecord {
+func (x *CancelGroupTransferRequest) GetId() int64 {
 	if x != nil {
-		return x.Records
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *AccessRecordQueryResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// 取消分组转让响应
+type CancelGroupTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGroupTransferResponse) Reset() {
+	*x = CancelGroupTransferResponse{}
+	mi := &file_link_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGroupTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AccessRecordQueryResponse) GetSize() int64 {
+func (*CancelGroupTransferResponse) ProtoMessage() {}
+
+func (x *CancelGroupTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[121]
 	if x != nil {
-		return x.Size
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AccessRecordQueryResponse) GetCurrent() int64 {
+// Deprecated: Use CancelGroupTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelGroupTransferResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CancelGroupTransferResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Current
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// 分组访问记录查询请求
-type GroupAccessRecordQueryRequest struct {
+// --------------------- 链接安全审核接口 ---------------------
+// 安全审核记录
+type ModerationRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gid           string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                              // 分组标识
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（ISO-8601格式）
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（ISO-8601格式）
-	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`                     // 当前页
-	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                           // 每页大小
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 审核记录ID
+	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
+	FullShortUrl  string                 `protobuf:"bytes,3,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	OriginUrl     string                 `protobuf:"bytes,4,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"`            // 被标记的目标链接
+	Provider      string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`                               // 检测来源 blocklist/redis/http/manual
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 标记原因
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                                  // 审核状态 0：待审核 1：已通过 2：已拒绝
+	Reviewer      string                 `protobuf:"bytes,8,opt,name=reviewer,proto3" json:"reviewer,omitempty"`                               // 审核人
+	CreateTime    string                 `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 创建时间（ISO-8601格式）
+	UpdateTime    string                 `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`        // 修改时间（ISO-8601格式）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupAccessRecordQueryRequest) Reset() {
-	*x = GroupAccessRecordQueryRequest{}
-	mi := &file_link_proto_msgTypes[34]
+func (x *ModerationRecord) Reset() {
+	*x = ModerationRecord{}
+	mi := &file_link_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupAccessRecordQueryRequest) String() string {
+func (x *ModerationRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAccessRecordQueryRequest) ProtoMessage() {}
+func (*ModerationRecord) ProtoMessage() {}
 
-func (x *GroupAccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[34]
+func (x *ModerationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,72 +8922,106 @@ func (x *GroupAccessRecordQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAccessRecordQueryRequest.ProtoReflect.Descriptor instead.
-func (*GroupAccessRecordQueryRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ModerationRecord.ProtoReflect.Descriptor instead.
+func (*ModerationRecord) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GroupAccessRecordQueryRequest) GetGid() string {
+func (x *ModerationRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationRecord) GetGid() string {
 	if x != nil {
 		return x.Gid
 	}
 	return ""
 }
 
-func (x *GroupAccessRecordQueryRequest) GetStartDate() string {
+func (x *ModerationRecord) GetFullShortUrl() string {
 	if x != nil {
-		return x.StartDate
+		return x.FullShortUrl
 	}
 	return ""
 }
 
-func (x *GroupAccessRecordQueryRequest) GetEndDate() string {
+func (x *ModerationRecord) GetOriginUrl() string {
 	if x != nil {
-		return x.EndDate
+		return x.OriginUrl
 	}
 	return ""
 }
 
-func (x *GroupAccessRecordQueryRequest) GetCurrent() int64 {
+func (x *ModerationRecord) GetProvider() string {
 	if x != nil {
-		return x.Current
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *GroupAccessRecordQueryRequest) GetSize() int64 {
+func (x *ModerationRecord) GetReason() string {
 	if x != nil {
-		return x.Size
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-// 分组访问记录查询响应
-type GroupAccessRecordQueryResponse struct {
+func (x *ModerationRecord) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *ModerationRecord) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+// 分页查询安全审核队列请求
+type PageModerationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*AccessRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 访问记录列表
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
-	Current       int64                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`   // 审核状态 0：待审核 1：已通过 2：已拒绝
+	Current       int32                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"` // 当前页
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupAccessRecordQueryResponse) Reset() {
-	*x = GroupAccessRecordQueryResponse{}
-	mi := &file_link_proto_msgTypes[35]
+func (x *PageModerationRequest) Reset() {
+	*x = PageModerationRequest{}
+	mi := &file_link_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupAccessRecordQueryResponse) String() string {
+func (x *PageModerationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAccessRecordQueryResponse) ProtoMessage() {}
+func (*PageModerationRequest) ProtoMessage() {}
 
-func (x *GroupAccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[35]
+func (x *PageModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,62 +9032,58 @@ func (x *GroupAccessRecordQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAccessRecordQueryResponse.ProtoReflect.Descriptor instead.
-func (*GroupAccessRecordQueryResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *GroupAccessRecordQueryResponse) GetRecords() []*AccessRecord {
-	if x != nil {
-		return x.Records
-	}
-	return nil
+// Deprecated: Use PageModerationRequest.ProtoReflect.Descriptor instead.
+func (*PageModerationRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GroupAccessRecordQueryResponse) GetTotal() int64 {
+func (x *PageModerationRequest) GetStatus() int32 {
 	if x != nil {
-		return x.Total
+		return x.Status
 	}
 	return 0
 }
 
-func (x *GroupAccessRecordQueryResponse) GetSize() int64 {
+func (x *PageModerationRequest) GetCurrent() int32 {
 	if x != nil {
-		return x.Size
+		return x.Current
 	}
 	return 0
 }
 
-func (x *GroupAccessRecordQueryResponse) GetCurrent() int64 {
+func (x *PageModerationRequest) GetSize() int32 {
 	if x != nil {
-		return x.Current
+		return x.Size
 	}
 	return 0
 }
 
-// 获取URL标题请求
-type GetUrlTitleRequest struct {
+// 分页查询安全审核队列响应
+type PageModerationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // 目标URL
+	Records       []*ModerationRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`  // 审核记录列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`     // 总记录数
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // 每页大小
+	Current       int32                  `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"` // 当前页
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUrlTitleRequest) Reset() {
-	*x = GetUrlTitleRequest{}
-	mi := &file_link_proto_msgTypes[36]
+func (x *PageModerationResponse) Reset() {
+	*x = PageModerationResponse{}
+	mi := &file_link_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUrlTitleRequest) String() string {
+func (x *PageModerationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUrlTitleRequest) ProtoMessage() {}
+func (*PageModerationResponse) ProtoMessage() {}
 
-func (x *GetUrlTitleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[36]
+func (x *PageModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,86 +9094,63 @@ func (x *GetUrlTitleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUrlTitleRequest.ProtoReflect.Descriptor instead.
-func (*GetUrlTitleRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use PageModerationResponse.ProtoReflect.Descriptor instead.
+func (*PageModerationResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetUrlTitleRequest) GetUrl() string {
+func (x *PageModerationResponse) GetRecords() []*ModerationRecord {
 	if x != nil {
-		return x.Url
+		return x.Records
 	}
-	return ""
-}
-
-// 获取URL标题响应
-type GetUrlTitleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // URL标题
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUrlTitleResponse) Reset() {
-	*x = GetUrlTitleResponse{}
-	mi := &file_link_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUrlTitleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetUrlTitleResponse) ProtoMessage() {}
-
-func (x *GetUrlTitleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[37]
+func (x *PageModerationResponse) GetTotal() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetUrlTitleResponse.ProtoReflect.Descriptor instead.
-func (*GetUrlTitleResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{37}
+func (x *PageModerationResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (x *GetUrlTitleResponse) GetTitle() string {
+func (x *PageModerationResponse) GetCurrent() int32 {
 	if x != nil {
-		return x.Title
+		return x.Current
 	}
-	return ""
+	return 0
 }
 
-// 短链接分组内数量查询请求
-type GroupShortLinkCountRequest struct {
+// 审核可疑短链接请求
+type ReviewModerationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gids          []string               `protobuf:"bytes,1,rep,name=gids,proto3" json:"gids,omitempty"` // 分组标识列表
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`           // 审核记录ID
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"` // 是否通过，通过后恢复正常跳转，拒绝后禁用短链接
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupShortLinkCountRequest) Reset() {
-	*x = GroupShortLinkCountRequest{}
-	mi := &file_link_proto_msgTypes[38]
+func (x *ReviewModerationRequest) Reset() {
+	*x = ReviewModerationRequest{}
+	mi := &file_link_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupShortLinkCountRequest) String() string {
+func (x *ReviewModerationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupShortLinkCountRequest) ProtoMessage() {}
+func (*ReviewModerationRequest) ProtoMessage() {}
 
-func (x *GroupShortLinkCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[38]
+func (x *ReviewModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2668,42 +9161,48 @@ func (x *GroupShortLinkCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupShortLinkCountRequest.ProtoReflect.Descriptor instead.
-func (*GroupShortLinkCountRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ReviewModerationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewModerationRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GroupShortLinkCountRequest) GetGids() []string {
+func (x *ReviewModerationRequest) GetId() int64 {
 	if x != nil {
-		return x.Gids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// 短链接分组计数项
-type ShortLinkGroupCountItem struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Gid            string                 `protobuf:"bytes,1,opt,name=gid,proto3" json:"gid,omitempty"`                                                // 分组ID
-	ShortLinkCount int64                  `protobuf:"varint,2,opt,name=short_link_count,json=shortLinkCount,proto3" json:"short_link_count,omitempty"` // 短链接数量
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ReviewModerationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
 }
 
-func (x *ShortLinkGroupCountItem) Reset() {
-	*x = ShortLinkGroupCountItem{}
-	mi := &file_link_proto_msgTypes[39]
+// 审核可疑短链接响应
+type ReviewModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewModerationResponse) Reset() {
+	*x = ReviewModerationResponse{}
+	mi := &file_link_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShortLinkGroupCountItem) String() string {
+func (x *ReviewModerationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortLinkGroupCountItem) ProtoMessage() {}
+func (*ReviewModerationResponse) ProtoMessage() {}
 
-func (x *ShortLinkGroupCountItem) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[39]
+func (x *ReviewModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2714,48 +9213,42 @@ func (x *ShortLinkGroupCountItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortLinkGroupCountItem.ProtoReflect.Descriptor instead.
-func (*ShortLinkGroupCountItem) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *ShortLinkGroupCountItem) GetGid() string {
-	if x != nil {
-		return x.Gid
-	}
-	return ""
+// Deprecated: Use ReviewModerationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewModerationResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *ShortLinkGroupCountItem) GetShortLinkCount() int64 {
+func (x *ReviewModerationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ShortLinkCount
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// 查询短链接分组内数量响应
-type GroupShortLinkCountResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	GroupCounts   []*ShortLinkGroupCountItem `protobuf:"bytes,1,rep,name=group_counts,json=groupCounts,proto3" json:"group_counts,omitempty"` // 分组计数列表
+// 标记可疑短链接请求，已创建的短链接被标记后跳转前展示风险提示
+type FlagModerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 标记原因
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupShortLinkCountResponse) Reset() {
-	*x = GroupShortLinkCountResponse{}
-	mi := &file_link_proto_msgTypes[40]
+func (x *FlagModerationRequest) Reset() {
+	*x = FlagModerationRequest{}
+	mi := &file_link_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupShortLinkCountResponse) String() string {
+func (x *FlagModerationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupShortLinkCountResponse) ProtoMessage() {}
+func (*FlagModerationRequest) ProtoMessage() {}
 
-func (x *GroupShortLinkCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[40]
+func (x *FlagModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,41 +9259,48 @@ func (x *GroupShortLinkCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupShortLinkCountResponse.ProtoReflect.Descriptor instead.
-func (*GroupShortLinkCountResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use FlagModerationRequest.ProtoReflect.Descriptor instead.
+func (*FlagModerationRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GroupShortLinkCountResponse) GetGroupCounts() []*ShortLinkGroupCountItem {
+func (x *FlagModerationRequest) GetFullShortUrl() string {
 	if x != nil {
-		return x.GroupCounts
+		return x.FullShortUrl
 	}
-	return nil
+	return ""
 }
 
-// 短链接跳转请求
-type RestoreUrlRequest struct {
+func (x *FlagModerationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 标记可疑短链接响应
+type FlagModerationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortUri      string                 `protobuf:"bytes,1,opt,name=short_uri,json=shortUri,proto3" json:"short_uri,omitempty"` // 短链接后缀
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreUrlRequest) Reset() {
-	*x = RestoreUrlRequest{}
-	mi := &file_link_proto_msgTypes[41]
+func (x *FlagModerationResponse) Reset() {
+	*x = FlagModerationResponse{}
+	mi := &file_link_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreUrlRequest) String() string {
+func (x *FlagModerationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreUrlRequest) ProtoMessage() {}
+func (*FlagModerationResponse) ProtoMessage() {}
 
-func (x *RestoreUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[41]
+func (x *FlagModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2811,41 +9311,45 @@ func (x *RestoreUrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreUrlRequest.ProtoReflect.Descriptor instead.
-func (*RestoreUrlRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use FlagModerationResponse.ProtoReflect.Descriptor instead.
+func (*FlagModerationResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RestoreUrlRequest) GetShortUri() string {
+func (x *FlagModerationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ShortUri
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-// 短链接跳转响应
-type RestoreUrlResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OriginUrl     string                 `protobuf:"bytes,1,opt,name=origin_url,json=originUrl,proto3" json:"origin_url,omitempty"` // 原始链接URL
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// --------------------- 域名应用关联接口 ---------------------
+// 域名关联的应用信息，用于生成apple-app-site-association和assetlinks.json
+type DomainAppLinks struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Domain                  string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                                                                    // 域名
+	AppleAppIds             []string               `protobuf:"bytes,2,rep,name=apple_app_ids,json=appleAppIds,proto3" json:"apple_app_ids,omitempty"`                                     // iOS应用标识列表（TeamID.BundleID）
+	AndroidPackage          string                 `protobuf:"bytes,3,opt,name=android_package,json=androidPackage,proto3" json:"android_package,omitempty"`                              // Android应用包名
+	AndroidCertFingerprints []string               `protobuf:"bytes,4,rep,name=android_cert_fingerprints,json=androidCertFingerprints,proto3" json:"android_cert_fingerprints,omitempty"` // Android应用签名证书SHA256指纹列表
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *RestoreUrlResponse) Reset() {
-	*x = RestoreUrlResponse{}
-	mi := &file_link_proto_msgTypes[42]
+func (x *DomainAppLinks) Reset() {
+	*x = DomainAppLinks{}
+	mi := &file_link_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreUrlResponse) String() string {
+func (x *DomainAppLinks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreUrlResponse) ProtoMessage() {}
+func (*DomainAppLinks) ProtoMessage() {}
 
-func (x *RestoreUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[42]
+func (x *DomainAppLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2856,50 +9360,65 @@ func (x *RestoreUrlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreUrlResponse.ProtoReflect.Descriptor instead.
-func (*RestoreUrlResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DomainAppLinks.ProtoReflect.Descriptor instead.
+func (*DomainAppLinks) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *RestoreUrlResponse) GetOriginUrl() string {
+func (x *DomainAppLinks) GetDomain() string {
 	if x != nil {
-		return x.OriginUrl
+		return x.Domain
 	}
 	return ""
 }
 
-// 短链接统计请求
-type ShortLinkStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullShortUrl  string                 `protobuf:"bytes,1,opt,name=full_short_url,json=fullShortUrl,proto3" json:"full_short_url,omitempty"` // 完整短链接
-	Gid           string                 `protobuf:"bytes,2,opt,name=gid,proto3" json:"gid,omitempty"`                                         // 分组标识
-	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                       // 用户标识
-	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`                                           // 访问IP
-	Browser       string                 `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`                                 // 浏览器
-	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`                                           // 操作系统
-	Device        string                 `protobuf:"bytes,7,opt,name=device,proto3" json:"device,omitempty"`                                   // 访问设备
-	Network       string                 `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`                                 // 访问网络
-	Locale        string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`                                   // 地区
-	UvType        string                 `protobuf:"bytes,10,opt,name=uv_type,json=uvType,proto3" json:"uv_type,omitempty"`                    // 访客类型
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DomainAppLinks) GetAppleAppIds() []string {
+	if x != nil {
+		return x.AppleAppIds
+	}
+	return nil
 }
 
-func (x *ShortLinkStatsRequest) Reset() {
-	*x = ShortLinkStatsRequest{}
-	mi := &file_link_proto_msgTypes[43]
+func (x *DomainAppLinks) GetAndroidPackage() string {
+	if x != nil {
+		return x.AndroidPackage
+	}
+	return ""
+}
+
+func (x *DomainAppLinks) GetAndroidCertFingerprints() []string {
+	if x != nil {
+		return x.AndroidCertFingerprints
+	}
+	return nil
+}
+
+// 保存域名应用关联请求
+type SaveDomainAppLinksRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Domain                  string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                                                                    // 已验证的自定义域名
+	AppleAppIds             []string               `protobuf:"bytes,2,rep,name=apple_app_ids,json=appleAppIds,proto3" json:"apple_app_ids,omitempty"`                                     // iOS应用标识列表（TeamID.BundleID），为空表示不关联iOS应用
+	AndroidPackage          string                 `protobuf:"bytes,3,opt,name=android_package,json=androidPackage,proto3" json:"android_package,omitempty"`                              // Android应用包名，为空表示不关联Android应用
+	AndroidCertFingerprints []string               `protobuf:"bytes,4,rep,name=android_cert_fingerprints,json=androidCertFingerprints,proto3" json:"android_cert_fingerprints,omitempty"` // Android应用签名证书SHA256指纹列表
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SaveDomainAppLinksRequest) Reset() {
+	*x = SaveDomainAppLinksRequest{}
+	mi := &file_link_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShortLinkStatsRequest) String() string {
+func (x *SaveDomainAppLinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortLinkStatsRequest) ProtoMessage() {}
+func (*SaveDomainAppLinksRequest) ProtoMessage() {}
 
-func (x *ShortLinkStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[43]
+func (x *SaveDomainAppLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2910,103 +9429,152 @@ func (x *ShortLinkStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortLinkStatsRequest.ProtoReflect.Descriptor instead.
-func (*ShortLinkStatsRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SaveDomainAppLinksRequest.ProtoReflect.Descriptor instead.
+func (*SaveDomainAppLinksRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ShortLinkStatsRequest) GetFullShortUrl() string {
+func (x *SaveDomainAppLinksRequest) GetDomain() string {
 	if x != nil {
-		return x.FullShortUrl
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *ShortLinkStatsRequest) GetGid() string {
+func (x *SaveDomainAppLinksRequest) GetAppleAppIds() []string {
 	if x != nil {
-		return x.Gid
+		return x.AppleAppIds
 	}
-	return ""
+	return nil
 }
 
-func (x *ShortLinkStatsRequest) GetUser() string {
+func (x *SaveDomainAppLinksRequest) GetAndroidPackage() string {
 	if x != nil {
-		return x.User
+		return x.AndroidPackage
 	}
 	return ""
 }
 
-func (x *ShortLinkStatsRequest) GetIp() string {
+func (x *SaveDomainAppLinksRequest) GetAndroidCertFingerprints() []string {
 	if x != nil {
-		return x.Ip
+		return x.AndroidCertFingerprints
 	}
-	return ""
+	return nil
 }
 
-func (x *ShortLinkStatsRequest) GetBrowser() string {
-	if x != nil {
-		return x.Browser
-	}
-	return ""
+// 保存域名应用关联响应
+type SaveDomainAppLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // 是否成功
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShortLinkStatsRequest) GetOs() string {
-	if x != nil {
-		return x.Os
-	}
-	return ""
+func (x *SaveDomainAppLinksResponse) Reset() {
+	*x = SaveDomainAppLinksResponse{}
+	mi := &file_link_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShortLinkStatsRequest) GetDevice() string {
+func (x *SaveDomainAppLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDomainAppLinksResponse) ProtoMessage() {}
+
+func (x *SaveDomainAppLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[131]
 	if x != nil {
-		return x.Device
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ShortLinkStatsRequest) GetNetwork() string {
+// Deprecated: Use SaveDomainAppLinksResponse.ProtoReflect.Descriptor instead.
+func (*SaveDomainAppLinksResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SaveDomainAppLinksResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Network
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ShortLinkStatsRequest) GetLocale() string {
+// 查询域名应用关联请求
+type GetDomainAppLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // 域名（请求Host）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainAppLinksRequest) Reset() {
+	*x = GetDomainAppLinksRequest{}
+	mi := &file_link_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainAppLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainAppLinksRequest) ProtoMessage() {}
+
+func (x *GetDomainAppLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[132]
 	if x != nil {
-		return x.Locale
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ShortLinkStatsRequest) GetUvType() string {
+// Deprecated: Use GetDomainAppLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainAppLinksRequest) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetDomainAppLinksRequest) GetDomain() string {
 	if x != nil {
-		return x.UvType
+		return x.Domain
 	}
 	return ""
 }
 
-// 空响应
-type EmptyResponse struct {
+// 查询域名应用关联响应
+type GetDomainAppLinksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppLinks      *DomainAppLinks        `protobuf:"bytes,1,opt,name=app_links,json=appLinks,proto3" json:"app_links,omitempty"` // 域名关联的应用信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
-	mi := &file_link_proto_msgTypes[44]
+func (x *GetDomainAppLinksResponse) Reset() {
+	*x = GetDomainAppLinksResponse{}
+	mi := &file_link_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmptyResponse) String() string {
+func (x *GetDomainAppLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyResponse) ProtoMessage() {}
+func (*GetDomainAppLinksResponse) ProtoMessage() {}
 
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[44]
+func (x *GetDomainAppLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_link_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3017,9 +9585,16 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetDomainAppLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainAppLinksResponse) Descriptor() ([]byte, []int) {
+	return file_link_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetDomainAppLinksResponse) GetAppLinks() *DomainAppLinks {
+	if x != nil {
+		return x.AppLinks
+	}
+	return nil
 }
 
 // --------------------- IP位置查询接口 ---------------------
@@ -3033,7 +9608,7 @@ type GetIPLocationRequest struct {
 
 func (x *GetIPLocationRequest) Reset() {
 	*x = GetIPLocationRequest{}
-	mi := &file_link_proto_msgTypes[45]
+	mi := &file_link_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3045,7 +9620,7 @@ func (x *GetIPLocationRequest) String() string {
 func (*GetIPLocationRequest) ProtoMessage() {}
 
 func (x *GetIPLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[45]
+	mi := &file_link_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +9633,7 @@ func (x *GetIPLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIPLocationRequest.ProtoReflect.Descriptor instead.
 func (*GetIPLocationRequest) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{45}
+	return file_link_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetIPLocationRequest) GetIp() string {
@@ -3084,7 +9659,7 @@ type GetIPLocationResponse struct {
 
 func (x *GetIPLocationResponse) Reset() {
 	*x = GetIPLocationResponse{}
-	mi := &file_link_proto_msgTypes[46]
+	mi := &file_link_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3096,7 +9671,7 @@ func (x *GetIPLocationResponse) String() string {
 func (*GetIPLocationResponse) ProtoMessage() {}
 
 func (x *GetIPLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_link_proto_msgTypes[46]
+	mi := &file_link_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,7 +9684,7 @@ func (x *GetIPLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIPLocationResponse.ProtoReflect.Descriptor instead.
 func (*GetIPLocationResponse) Descriptor() ([]byte, []int) {
-	return file_link_proto_rawDescGZIP(), []int{46}
+	return file_link_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *GetIPLocationResponse) GetStatus() string {
@@ -3166,7 +9741,12 @@ var File_link_proto protoreflect.FileDescriptor
 const file_link_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"link.proto\x12\tshortlink\"\xe7\x01\n" +
+	"link.proto\x12\tshortlink\"X\n" +
+	"\vLinkVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x02 \x01(\tR\ttargetUrl\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\"\xf1\a\n" +
 	"\x16CreateShortLinkRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1d\n" +
 	"\n" +
@@ -3176,12 +9756,44 @@ const file_link_proto_rawDesc = "" +
 	"\n" +
 	"valid_date\x18\x05 \x01(\tR\tvalidDate\x12\x1a\n" +
 	"\bdescribe\x18\x06 \x01(\tR\bdescribe\x12!\n" +
-	"\fcreated_type\x18\a \x01(\x05R\vcreatedType\"p\n" +
+	"\fcreated_type\x18\a \x01(\x05R\vcreatedType\x12\x1d\n" +
+	"\n" +
+	"custom_uri\x18\b \x01(\tR\tcustomUri\x12\x1a\n" +
+	"\bpassword\x18\t \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\n" +
+	" \x01(\x05R\tmaxClicks\x122\n" +
+	"\bvariants\x18\v \x03(\v2\x16.shortlink.LinkVariantR\bvariants\x12,\n" +
+	"\x12query_param_policy\x18\f \x01(\x05R\x10queryParamPolicy\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\r \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\x0e \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\x0f \x01(\tR\vutmCampaign\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x10 \x01(\tR\tvalidFrom\x12\x1f\n" +
+	"\vexpired_url\x18\x11 \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\x12 \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x13 \x01(\x05R\tgraceDays\x12#\n" +
+	"\rredirect_type\x18\x14 \x01(\x05R\fredirectType\x12\"\n" +
+	"\rios_deep_link\x18\x15 \x01(\tR\viosDeepLink\x12'\n" +
+	"\x0fandroid_package\x18\x16 \x01(\tR\x0eandroidPackage\x12*\n" +
+	"\x11android_deep_link\x18\x17 \x01(\tR\x0fandroidDeepLink\x123\n" +
+	"\x16deep_link_fallback_url\x18\x18 \x01(\tR\x13deepLinkFallbackUrl\x12\x19\n" +
+	"\bog_title\x18\x19 \x01(\tR\aogTitle\x12%\n" +
+	"\x0eog_description\x18\x1a \x01(\tR\rogDescription\x12\x19\n" +
+	"\bog_image\x18\x1b \x01(\tR\aogImage\x12\x12\n" +
+	"\x04tags\x18\x1c \x03(\tR\x04tags\x12\x1f\n" +
+	"\vtemplate_id\x18\x1d \x01(\x03R\n" +
+	"templateId\"\x97\x01\n" +
 	"\x17CreateShortLinkResponse\x12$\n" +
 	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x1d\n" +
 	"\n" +
 	"origin_url\x18\x02 \x01(\tR\toriginUrl\x12\x10\n" +
-	"\x03gid\x18\x03 \x01(\tR\x03gid\"\xcb\x01\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12%\n" +
+	"\x0epending_review\x18\x04 \x01(\bR\rpendingReview\"\x8b\x02\n" +
 	"\x1bBatchCreateShortLinkRequest\x12\x1f\n" +
 	"\vorigin_urls\x18\x01 \x03(\tR\n" +
 	"originUrls\x12\x16\n" +
@@ -3190,14 +9802,20 @@ const file_link_proto_rawDesc = "" +
 	"\x0fvalid_date_type\x18\x04 \x01(\x05R\rvalidDateType\x12\x1d\n" +
 	"\n" +
 	"valid_date\x18\x05 \x01(\tR\tvalidDate\x12\x1a\n" +
-	"\bdescribe\x18\x06 \x01(\tR\bdescribe\"j\n" +
+	"\bdescribe\x18\x06 \x01(\tR\bdescribe\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\tR\tvalidFrom\x12\x1f\n" +
+	"\vtemplate_id\x18\b \x01(\x03R\n" +
+	"templateId\"\xad\x01\n" +
 	"\x11BatchCreateResult\x12$\n" +
 	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x1d\n" +
 	"\n" +
 	"origin_url\x18\x02 \x01(\tR\toriginUrl\x12\x10\n" +
-	"\x03gid\x18\x03 \x01(\tR\x03gid\"V\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12%\n" +
+	"\x0epending_review\x18\x04 \x01(\bR\rpendingReview\x12\x1a\n" +
+	"\bdescribe\x18\x05 \x01(\tR\bdescribe\"V\n" +
 	"\x1cBatchCreateShortLinkResponse\x126\n" +
-	"\aresults\x18\x01 \x03(\v2\x1c.shortlink.BatchCreateResultR\aresults\"\xd2\x01\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.shortlink.BatchCreateResultR\aresults\"\x8b\v\n" +
 	"\x16UpdateShortLinkRequest\x12$\n" +
 	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x1d\n" +
 	"\n" +
@@ -3206,12 +9824,65 @@ const file_link_proto_rawDesc = "" +
 	"\x0fvalid_date_type\x18\x04 \x01(\x05R\rvalidDateType\x12\x1d\n" +
 	"\n" +
 	"valid_date\x18\x05 \x01(\tR\tvalidDate\x12\x1a\n" +
-	"\bdescribe\x18\x06 \x01(\tR\bdescribe\"\x19\n" +
-	"\x17UpdateShortLinkResponse\"V\n" +
+	"\bdescribe\x18\x06 \x01(\tR\bdescribe\x12\x1a\n" +
+	"\bpassword\x18\a \x01(\tR\bpassword\x12%\n" +
+	"\x0eclear_password\x18\b \x01(\bR\rclearPassword\x12\"\n" +
+	"\n" +
+	"max_clicks\x18\t \x01(\x05H\x00R\tmaxClicks\x88\x01\x01\x122\n" +
+	"\bvariants\x18\n" +
+	" \x03(\v2\x16.shortlink.LinkVariantR\bvariants\x12%\n" +
+	"\x0eclear_variants\x18\v \x01(\bR\rclearVariants\x121\n" +
+	"\x12query_param_policy\x18\f \x01(\x05H\x01R\x10queryParamPolicy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"utm_source\x18\r \x01(\tH\x02R\tutmSource\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"utm_medium\x18\x0e \x01(\tH\x03R\tutmMedium\x88\x01\x01\x12&\n" +
+	"\futm_campaign\x18\x0f \x01(\tH\x04R\vutmCampaign\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"valid_from\x18\x10 \x01(\tH\x05R\tvalidFrom\x88\x01\x01\x12$\n" +
+	"\vexpired_url\x18\x11 \x01(\tH\x06R\n" +
+	"expiredUrl\x88\x01\x01\x12,\n" +
+	"\x0fexpired_message\x18\x12 \x01(\tH\aR\x0eexpiredMessage\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"grace_days\x18\x13 \x01(\x05H\bR\tgraceDays\x88\x01\x01\x12(\n" +
+	"\rredirect_type\x18\x14 \x01(\x05H\tR\fredirectType\x88\x01\x01\x12'\n" +
+	"\rios_deep_link\x18\x15 \x01(\tH\n" +
+	"R\viosDeepLink\x88\x01\x01\x12,\n" +
+	"\x0fandroid_package\x18\x16 \x01(\tH\vR\x0eandroidPackage\x88\x01\x01\x12/\n" +
+	"\x11android_deep_link\x18\x17 \x01(\tH\fR\x0fandroidDeepLink\x88\x01\x01\x128\n" +
+	"\x16deep_link_fallback_url\x18\x18 \x01(\tH\rR\x13deepLinkFallbackUrl\x88\x01\x01\x12\x1e\n" +
+	"\bog_title\x18\x19 \x01(\tH\x0eR\aogTitle\x88\x01\x01\x12*\n" +
+	"\x0eog_description\x18\x1a \x01(\tH\x0fR\rogDescription\x88\x01\x01\x12\x1e\n" +
+	"\bog_image\x18\x1b \x01(\tH\x10R\aogImage\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x1c \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\x1d \x01(\bR\tclearTagsB\r\n" +
+	"\v_max_clicksB\x15\n" +
+	"\x13_query_param_policyB\r\n" +
+	"\v_utm_sourceB\r\n" +
+	"\v_utm_mediumB\x0f\n" +
+	"\r_utm_campaignB\r\n" +
+	"\v_valid_fromB\x0e\n" +
+	"\f_expired_urlB\x12\n" +
+	"\x10_expired_messageB\r\n" +
+	"\v_grace_daysB\x10\n" +
+	"\x0e_redirect_typeB\x10\n" +
+	"\x0e_ios_deep_linkB\x12\n" +
+	"\x10_android_packageB\x14\n" +
+	"\x12_android_deep_linkB\x19\n" +
+	"\x17_deep_link_fallback_urlB\v\n" +
+	"\t_og_titleB\x11\n" +
+	"\x0f_og_descriptionB\v\n" +
+	"\t_og_image\"\x19\n" +
+	"\x17UpdateShortLinkResponse\"\x94\x01\n" +
 	"\x14PageShortLinkRequest\x12\x10\n" +
 	"\x03gid\x18\x01 \x01(\tR\x03gid\x12\x18\n" +
 	"\acurrent\x18\x02 \x01(\x05R\acurrent\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x05R\x04size\"\xaf\x02\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1f\n" +
+	"\vbroken_only\x18\x04 \x01(\bR\n" +
+	"brokenOnly\x12\x1b\n" +
+	"\torder_tag\x18\x05 \x01(\tR\borderTag\"\xf2\n" +
+	"\n" +
 	"\x0fShortLinkRecord\x12$\n" +
 	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x1d\n" +
 	"\n" +
@@ -3226,11 +9897,68 @@ const file_link_proto_rawDesc = "" +
 	"\btotal_pv\x18\b \x01(\x05R\atotalPv\x12\x19\n" +
 	"\btotal_uv\x18\t \x01(\x05R\atotalUv\x12\x1b\n" +
 	"\ttotal_uip\x18\n" +
-	" \x01(\x05R\btotalUip\"\x91\x01\n" +
+	" \x01(\x05R\btotalUip\x12#\n" +
+	"\renable_status\x18\v \x01(\x05R\fenableStatus\x12\x1d\n" +
+	"\n" +
+	"max_clicks\x18\f \x01(\x05R\tmaxClicks\x12\x1b\n" +
+	"\tclick_num\x18\r \x01(\x05R\bclickNum\x12,\n" +
+	"\x12query_param_policy\x18\x0e \x01(\x05R\x10queryParamPolicy\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\x0f \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\x10 \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\x11 \x01(\tR\vutmCampaign\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x12 \x01(\tR\tvalidFrom\x12\x1f\n" +
+	"\vexpired_url\x18\x13 \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\x14 \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x15 \x01(\x05R\tgraceDays\x12&\n" +
+	"\x0fin_grace_period\x18\x16 \x01(\bR\rinGracePeriod\x12#\n" +
+	"\rredirect_type\x18\x17 \x01(\x05R\fredirectType\x12\"\n" +
+	"\rios_deep_link\x18\x18 \x01(\tR\viosDeepLink\x12'\n" +
+	"\x0fandroid_package\x18\x19 \x01(\tR\x0eandroidPackage\x12*\n" +
+	"\x11android_deep_link\x18\x1a \x01(\tR\x0fandroidDeepLink\x123\n" +
+	"\x16deep_link_fallback_url\x18\x1b \x01(\tR\x13deepLinkFallbackUrl\x12\x19\n" +
+	"\bog_title\x18\x1c \x01(\tR\aogTitle\x12%\n" +
+	"\x0eog_description\x18\x1d \x01(\tR\rogDescription\x12\x19\n" +
+	"\bog_image\x18\x1e \x01(\tR\aogImage\x12#\n" +
+	"\rsafety_status\x18\x1f \x01(\x05R\fsafetyStatus\x12#\n" +
+	"\rhealth_status\x18  \x01(\x05R\fhealthStatus\x12\x1f\n" +
+	"\vhealth_code\x18! \x01(\x05R\n" +
+	"healthCode\x12%\n" +
+	"\x0ehealth_latency\x18\" \x01(\x05R\rhealthLatency\x12)\n" +
+	"\x10health_redirects\x18# \x03(\tR\x0fhealthRedirects\x12!\n" +
+	"\fhealth_error\x18$ \x01(\tR\vhealthError\x12*\n" +
+	"\x11health_check_time\x18% \x01(\tR\x0fhealthCheckTime\x12\x12\n" +
+	"\x04tags\x18& \x03(\tR\x04tags\x12\x19\n" +
+	"\btoday_pv\x18' \x01(\x05R\atodayPv\x12\x19\n" +
+	"\btoday_uv\x18( \x01(\x05R\atodayUv\x12\x1b\n" +
+	"\ttoday_uip\x18) \x01(\x05R\btodayUip\"\x91\x01\n" +
 	"\x15PageShortLinkResponse\x124\n" +
 	"\arecords\x18\x01 \x03(\v2\x1a.shortlink.ShortLinkRecordR\arecords\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x05R\acurrent\"\xa5\x02\n" +
+	"\x16SearchShortLinkRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04gids\x18\x03 \x03(\tR\x04gids\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06expiry\x18\x05 \x01(\tR\x06expiry\x12!\n" +
+	"\fcreate_start\x18\x06 \x01(\tR\vcreateStart\x12\x1d\n" +
+	"\n" +
+	"create_end\x18\a \x01(\tR\tcreateEnd\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\t \x01(\bR\x03asc\x12\x18\n" +
+	"\acurrent\x18\n" +
+	" \x01(\x05R\acurrent\x12\x12\n" +
+	"\x04size\x18\v \x01(\x05R\x04size\"\x93\x01\n" +
+	"\x17SearchShortLinkResponse\x124\n" +
+	"\arecords\x18\x01 \x03(\v2\x1a.shortlink.ShortLinkRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
 	"\acurrent\x18\x04 \x01(\x05R\acurrent\"Q\n" +
 	"\x17SaveToRecycleBinRequest\x12\x10\n" +
 	"\x03gid\x18\x01 \x01(\tR\x03gid\x12$\n" +
@@ -3297,7 +10025,18 @@ const file_link_proto_rawDesc = "" +
 	"UvTypeStat\x12\x17\n" +
 	"\auv_type\x18\x01 \x01(\tR\x06uvType\x12\x10\n" +
 	"\x03cnt\x18\x02 \x01(\x05R\x03cnt\x12\x14\n" +
-	"\x05ratio\x18\x03 \x01(\x01R\x05ratio\"\xd0\x04\n" +
+	"\x05ratio\x18\x03 \x01(\x01R\x05ratio\"~\n" +
+	"\vVariantStat\x12\x18\n" +
+	"\avariant\x18\x01 \x01(\tR\avariant\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x02 \x01(\tR\ttargetUrl\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x12\x0e\n" +
+	"\x02pv\x18\x04 \x01(\x05R\x02pv\x12\x0e\n" +
+	"\x02uv\x18\x05 \x01(\x05R\x02uv\"G\n" +
+	"\vChannelStat\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x0e\n" +
+	"\x02pv\x18\x02 \x01(\x05R\x02pv\x12\x0e\n" +
+	"\x02uv\x18\x03 \x01(\x05R\x02uv\"\xca\x05\n" +
 	"\x16GetSingleStatsResponse\x12\x0e\n" +
 	"\x02pv\x18\x01 \x01(\x05R\x02pv\x12\x0e\n" +
 	"\x02uv\x18\x02 \x01(\x05R\x02uv\x12\x10\n" +
@@ -3314,7 +10053,9 @@ const file_link_proto_rawDesc = "" +
 	" \x03(\v2\x11.shortlink.OSStatR\aosStats\x129\n" +
 	"\ruv_type_stats\x18\v \x03(\v2\x15.shortlink.UvTypeStatR\vuvTypeStats\x128\n" +
 	"\fdevice_stats\x18\f \x03(\v2\x15.shortlink.DeviceStatR\vdeviceStats\x12;\n" +
-	"\rnetwork_stats\x18\r \x03(\v2\x16.shortlink.NetworkStatR\fnetworkStats\"b\n" +
+	"\rnetwork_stats\x18\r \x03(\v2\x16.shortlink.NetworkStatR\fnetworkStats\x12;\n" +
+	"\rvariant_stats\x18\x0e \x03(\v2\x16.shortlink.VariantStatR\fvariantStats\x12;\n" +
+	"\rchannel_stats\x18\x0f \x03(\v2\x16.shortlink.ChannelStatR\fchannelStats\"b\n" +
 	"\x14GetGroupStatsRequest\x12\x10\n" +
 	"\x03gid\x18\x01 \x01(\tR\x03gid\x12\x1d\n" +
 	"\n" +
@@ -3381,19 +10122,220 @@ const file_link_proto_rawDesc = "" +
 	"\x12GetUrlTitleRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"+\n" +
 	"\x13GetUrlTitleResponse\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"0\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"J\n" +
+	"\x17ShortLinkPreviewRequest\x12\x1b\n" +
+	"\tshort_uri\x18\x01 \x01(\tR\bshortUri\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\"\x87\x01\n" +
+	"\x18ShortLinkPreviewResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"origin_url\x18\x04 \x01(\tR\toriginUrl\"\x85\x02\n" +
+	"\x16ShortLinkQrCodeRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x10\n" +
+	"\x03ecc\x18\x04 \x01(\tR\x03ecc\x12\x16\n" +
+	"\x06margin\x18\x05 \x01(\x05R\x06margin\x12)\n" +
+	"\x10foreground_color\x18\x06 \x01(\tR\x0fforegroundColor\x12)\n" +
+	"\x10background_color\x18\a \x01(\tR\x0fbackgroundColor\x12\x19\n" +
+	"\blogo_url\x18\b \x01(\tR\alogoUrl\"V\n" +
+	"\x17ShortLinkQrCodeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"0\n" +
 	"\x1aGroupShortLinkCountRequest\x12\x12\n" +
 	"\x04gids\x18\x01 \x03(\tR\x04gids\"U\n" +
 	"\x17ShortLinkGroupCountItem\x12\x10\n" +
 	"\x03gid\x18\x01 \x01(\tR\x03gid\x12(\n" +
 	"\x10short_link_count\x18\x02 \x01(\x03R\x0eshortLinkCount\"d\n" +
 	"\x1bGroupShortLinkCountResponse\x12E\n" +
-	"\fgroup_counts\x18\x01 \x03(\v2\".shortlink.ShortLinkGroupCountItemR\vgroupCounts\"0\n" +
+	"\fgroup_counts\x18\x01 \x03(\v2\".shortlink.ShortLinkGroupCountItemR\vgroupCounts\"|\n" +
+	"\x14MoveShortLinkRequest\x12&\n" +
+	"\x0ffull_short_urls\x18\x01 \x03(\tR\rfullShortUrls\x12\x1d\n" +
+	"\n" +
+	"origin_gid\x18\x02 \x01(\tR\toriginGid\x12\x1d\n" +
+	"\n" +
+	"target_gid\x18\x03 \x01(\tR\ttargetGid\"-\n" +
+	"\x15MoveShortLinkResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x05R\x05moved\"\xa9\x01\n" +
+	"\x15CloneShortLinkRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x1d\n" +
+	"\n" +
+	"target_gid\x18\x03 \x01(\tR\ttargetGid\x12\x1d\n" +
+	"\n" +
+	"custom_uri\x18\x04 \x01(\tR\tcustomUri\x12\x1a\n" +
+	"\bdescribe\x18\x05 \x01(\tR\bdescribe\"\x96\x01\n" +
+	"\x16CloneShortLinkResponse\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x1d\n" +
+	"\n" +
+	"origin_url\x18\x02 \x01(\tR\toriginUrl\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12%\n" +
+	"\x0epending_review\x18\x04 \x01(\bR\rpendingReview\"\x9c\x01\n" +
+	"\x16ImportShortLinkRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x1f\n" +
+	"\vdefault_gid\x18\x03 \x01(\tR\n" +
+	"defaultGid\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"J\n" +
+	"\x17ImportShortLinkResponse\x12/\n" +
+	"\x03job\x18\x01 \x01(\v2\x1d.shortlink.ShortLinkImportJobR\x03job\"\x9a\x01\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"origin_url\x18\x04 \x01(\tR\toriginUrl\x12$\n" +
+	"\x0efull_short_url\x18\x05 \x01(\tR\ffullShortUrl\"\xe4\x02\n" +
+	"\x12ShortLinkImportJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x05 \x01(\x05R\tprocessed\x12\x18\n" +
+	"\acreated\x18\x06 \x01(\x05R\acreated\x12\x18\n" +
+	"\askipped\x18\a \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x05R\x06failed\x12.\n" +
+	"\x04rows\x18\t \x03(\v2\x1a.shortlink.ImportRowResultR\x04rows\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1f\n" +
+	"\vcreate_time\x18\v \x01(\tR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\f \x01(\tR\n" +
+	"updateTime\",\n" +
+	"\x13GetImportJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"G\n" +
+	"\x14GetImportJobResponse\x12/\n" +
+	"\x03job\x18\x01 \x01(\v2\x1d.shortlink.ShortLinkImportJobR\x03job\"\xa2\x01\n" +
+	"\x12BulkActionSelector\x12&\n" +
+	"\x0ffull_short_urls\x18\x01 \x03(\tR\rfullShortUrls\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12!\n" +
+	"\fcreate_start\x18\x03 \x01(\tR\vcreateStart\x12\x1d\n" +
+	"\n" +
+	"create_end\x18\x04 \x01(\tR\tcreateEnd\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\"\xa1\x01\n" +
+	"\x11BulkActionRequest\x129\n" +
+	"\bselector\x18\x01 \x01(\v2\x1d.shortlink.BulkActionSelectorR\bselector\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"valid_date\x18\x03 \x01(\tR\tvalidDate\x12\x1a\n" +
+	"\bdescribe\x18\x04 \x01(\tR\bdescribe\"n\n" +
+	"\x14BulkActionItemResult\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9b\x01\n" +
+	"\x12BulkActionResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x129\n" +
+	"\aresults\x18\x04 \x03(\v2\x1f.shortlink.BulkActionItemResultR\aresults\"c\n" +
+	"\x11LinkHistoryChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xe8\x01\n" +
+	"\x11LinkHistoryRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efull_short_url\x18\x02 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x126\n" +
+	"\achanges\x18\x06 \x03(\v2\x1c.shortlink.LinkHistoryChangeR\achanges\x12\x1f\n" +
+	"\vcreate_time\x18\a \x01(\tR\n" +
+	"createTime\"~\n" +
+	"\x16ListLinkHistoryRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x18\n" +
+	"\acurrent\x18\x03 \x01(\x05R\acurrent\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\x95\x01\n" +
+	"\x17ListLinkHistoryResponse\x126\n" +
+	"\arecords\x18\x01 \x03(\v2\x1c.shortlink.LinkHistoryRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x05R\acurrent\"q\n" +
+	"\x18RollbackShortLinkRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x03 \x01(\x03R\thistoryId\"S\n" +
+	"\x19RollbackShortLinkResponse\x126\n" +
+	"\ahistory\x18\x01 \x01(\v2\x1c.shortlink.LinkHistoryRecordR\ahistory\"\xae\x01\n" +
+	"\x16ExportShortLinkRequest\x12\x12\n" +
+	"\x04gids\x18\x01 \x03(\tR\x04gids\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12.\n" +
+	"\x13include_daily_stats\x18\x03 \x01(\bR\x11includeDailyStats\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\"J\n" +
+	"\x17ExportShortLinkResponse\x12/\n" +
+	"\x03job\x18\x01 \x01(\v2\x1d.shortlink.ShortLinkExportJobR\x03job\"\xc8\x02\n" +
+	"\x12ShortLinkExportJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x05 \x01(\x05R\tprocessed\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\a \x01(\x03R\bfileSize\x12%\n" +
+	"\x0edownload_token\x18\b \x01(\tR\rdownloadToken\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1f\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\tR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\v \x01(\tR\n" +
+	"updateTime\",\n" +
+	"\x13GetExportJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"G\n" +
+	"\x14GetExportJobResponse\x12/\n" +
+	"\x03job\x18\x01 \x01(\v2\x1d.shortlink.ShortLinkExportJobR\x03job\"E\n" +
+	"\x15DownloadExportRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"\x9b\x01\n" +
+	"\x16DownloadExportResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x10\n" +
+	"\x03eof\x18\x02 \x01(\bR\x03eof\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\"\xf2\x01\n" +
 	"\x11RestoreUrlRequest\x12\x1b\n" +
-	"\tshort_uri\x18\x01 \x01(\tR\bshortUri\"3\n" +
+	"\tshort_uri\x18\x01 \x01(\tR\bshortUri\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x1a\n" +
+	"\bprovince\x18\x05 \x01(\tR\bprovince\x12'\n" +
+	"\x0faccept_language\x18\x06 \x01(\tR\x0eacceptLanguage\x12\x14\n" +
+	"\x05query\x18\a \x01(\tR\x05query\x12\x18\n" +
+	"\acrawler\x18\b \x01(\bR\acrawler\x12!\n" +
+	"\funlock_token\x18\t \x01(\tR\vunlockTokenJ\x04\b\x03\x10\x04\"\xa8\x04\n" +
 	"\x12RestoreUrlResponse\x12\x1d\n" +
 	"\n" +
-	"origin_url\x18\x01 \x01(\tR\toriginUrl\"\x80\x02\n" +
+	"origin_url\x18\x01 \x01(\tR\toriginUrl\x12+\n" +
+	"\x11password_required\x18\x02 \x01(\bR\x10passwordRequired\x12$\n" +
+	"\x0enot_yet_active\x18\x03 \x01(\bR\fnotYetActive\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\tR\tvalidFrom\x12\x18\n" +
+	"\aexpired\x18\x05 \x01(\bR\aexpired\x12\x1f\n" +
+	"\vexpired_url\x18\x06 \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\a \x01(\tR\x0eexpiredMessage\x12#\n" +
+	"\rredirect_type\x18\b \x01(\x05R\fredirectType\x12\"\n" +
+	"\rios_deep_link\x18\t \x01(\tR\viosDeepLink\x12'\n" +
+	"\x0fandroid_package\x18\n" +
+	" \x01(\tR\x0eandroidPackage\x12*\n" +
+	"\x11android_deep_link\x18\v \x01(\tR\x0fandroidDeepLink\x123\n" +
+	"\x16deep_link_fallback_url\x18\f \x01(\tR\x13deepLinkFallbackUrl\x12%\n" +
+	"\x0esafety_warning\x18\r \x01(\bR\rsafetyWarning\x12#\n" +
+	"\rsafety_reason\x18\x0e \x01(\tR\fsafetyReason\"x\n" +
+	"\x19VerifyLinkPasswordRequest\x12\x1b\n" +
+	"\tshort_uri\x18\x01 \x01(\tR\bshortUri\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\"\xae\x01\n" +
+	"\x1aVerifyLinkPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12-\n" +
+	"\x12remaining_attempts\x18\x02 \x01(\x05R\x11remainingAttempts\x12!\n" +
+	"\funlock_token\x18\x03 \x01(\tR\vunlockToken\x12$\n" +
+	"\x0eunlock_max_age\x18\x04 \x01(\x05R\funlockMaxAge\"\x80\x02\n" +
 	"\x15ShortLinkStatsRequest\x12$\n" +
 	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
 	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x12\n" +
@@ -3406,7 +10348,255 @@ const file_link_proto_rawDesc = "" +
 	"\x06locale\x18\t \x01(\tR\x06locale\x12\x17\n" +
 	"\auv_type\x18\n" +
 	" \x01(\tR\x06uvType\"\x0f\n" +
-	"\rEmptyResponse\"&\n" +
+	"\rEmptyResponse\"\xb2\x01\n" +
+	"\n" +
+	"UserDomain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12#\n" +
+	"\rverify_status\x18\x02 \x01(\x05R\fverifyStatus\x12#\n" +
+	"\rverify_record\x18\x03 \x01(\tR\fverifyRecord\x12!\n" +
+	"\fverify_value\x18\x04 \x01(\tR\vverifyValue\x12\x1f\n" +
+	"\vcreate_time\x18\x05 \x01(\tR\n" +
+	"createTime\"3\n" +
+	"\x19RegisterUserDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"K\n" +
+	"\x1aRegisterUserDomainResponse\x12-\n" +
+	"\x06domain\x18\x01 \x01(\v2\x15.shortlink.UserDomainR\x06domain\"1\n" +
+	"\x17VerifyUserDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"I\n" +
+	"\x18VerifyUserDomainResponse\x12-\n" +
+	"\x06domain\x18\x01 \x01(\v2\x15.shortlink.UserDomainR\x06domain\"\x17\n" +
+	"\x15ListUserDomainRequest\"I\n" +
+	"\x16ListUserDomainResponse\x12/\n" +
+	"\adomains\x18\x01 \x03(\v2\x15.shortlink.UserDomainR\adomains\"\xf1\x01\n" +
+	"\fRedirectRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efull_short_url\x18\x02 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12\x1b\n" +
+	"\trule_type\x18\x04 \x01(\tR\bruleType\x12\x1d\n" +
+	"\n" +
+	"rule_value\x18\x05 \x01(\tR\truleValue\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x06 \x01(\tR\ttargetUrl\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\x12\x1f\n" +
+	"\vcreate_time\x18\b \x01(\tR\n" +
+	"createTime\"\xcd\x01\n" +
+	"\x19CreateRedirectRuleRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x1b\n" +
+	"\trule_type\x18\x03 \x01(\tR\bruleType\x12\x1d\n" +
+	"\n" +
+	"rule_value\x18\x04 \x01(\tR\truleValue\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x05 \x01(\tR\ttargetUrl\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\x05R\tsortOrder\",\n" +
+	"\x1aCreateRedirectRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xdd\x01\n" +
+	"\x19UpdateRedirectRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efull_short_url\x18\x02 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12\x1b\n" +
+	"\trule_type\x18\x04 \x01(\tR\bruleType\x12\x1d\n" +
+	"\n" +
+	"rule_value\x18\x05 \x01(\tR\truleValue\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x06 \x01(\tR\ttargetUrl\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\"\x1c\n" +
+	"\x1aUpdateRedirectRuleResponse\"c\n" +
+	"\x19DeleteRedirectRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efull_short_url\x18\x02 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\"6\n" +
+	"\x1aDeleteRedirectRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x17ListRedirectRuleRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\"I\n" +
+	"\x18ListRedirectRuleResponse\x12-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.shortlink.RedirectRuleR\x05rules\"\x8a\x04\n" +
+	"\fLinkTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x05 \x01(\x05R\tvalidDays\x12\x1a\n" +
+	"\bdescribe\x18\x06 \x01(\tR\bdescribe\x12,\n" +
+	"\x12query_param_policy\x18\a \x01(\x05R\x10queryParamPolicy\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\b \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\t \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\n" +
+	" \x01(\tR\vutmCampaign\x12#\n" +
+	"\rredirect_type\x18\v \x01(\x05R\fredirectType\x12\x1f\n" +
+	"\vexpired_url\x18\f \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\r \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x0e \x01(\x05R\tgraceDays\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x1f\n" +
+	"\vcreate_time\x18\x10 \x01(\tR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\x11 \x01(\tR\n" +
+	"updateTime\"\xc5\x03\n" +
+	"\x19CreateLinkTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x04 \x01(\x05R\tvalidDays\x12\x1a\n" +
+	"\bdescribe\x18\x05 \x01(\tR\bdescribe\x12,\n" +
+	"\x12query_param_policy\x18\x06 \x01(\x05R\x10queryParamPolicy\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\a \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\b \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\t \x01(\tR\vutmCampaign\x12#\n" +
+	"\rredirect_type\x18\n" +
+	" \x01(\x05R\fredirectType\x12\x1f\n" +
+	"\vexpired_url\x18\v \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\f \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\r \x01(\x05R\tgraceDays\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\",\n" +
+	"\x1aCreateLinkTemplateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xd5\x03\n" +
+	"\x19UpdateLinkTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03gid\x18\x03 \x01(\tR\x03gid\x12\x16\n" +
+	"\x06domain\x18\x04 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x05 \x01(\x05R\tvalidDays\x12\x1a\n" +
+	"\bdescribe\x18\x06 \x01(\tR\bdescribe\x12,\n" +
+	"\x12query_param_policy\x18\a \x01(\x05R\x10queryParamPolicy\x12\x1d\n" +
+	"\n" +
+	"utm_source\x18\b \x01(\tR\tutmSource\x12\x1d\n" +
+	"\n" +
+	"utm_medium\x18\t \x01(\tR\tutmMedium\x12!\n" +
+	"\futm_campaign\x18\n" +
+	" \x01(\tR\vutmCampaign\x12#\n" +
+	"\rredirect_type\x18\v \x01(\x05R\fredirectType\x12\x1f\n" +
+	"\vexpired_url\x18\f \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\r \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x0e \x01(\x05R\tgraceDays\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\"\x1c\n" +
+	"\x1aUpdateLinkTemplateResponse\"+\n" +
+	"\x19DeleteLinkTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteLinkTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x19\n" +
+	"\x17ListLinkTemplateRequest\"Q\n" +
+	"\x18ListLinkTemplateResponse\x125\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x17.shortlink.LinkTemplateR\ttemplates\"\x8e\x01\n" +
+	"\x11GroupExpiryPolicy\x12\x10\n" +
+	"\x03gid\x18\x01 \x01(\tR\x03gid\x12\x1f\n" +
+	"\vexpired_url\x18\x02 \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\x03 \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x04 \x01(\x05R\tgraceDays\"\x99\x01\n" +
+	"\x1cSaveGroupExpiryPolicyRequest\x12\x10\n" +
+	"\x03gid\x18\x01 \x01(\tR\x03gid\x12\x1f\n" +
+	"\vexpired_url\x18\x02 \x01(\tR\n" +
+	"expiredUrl\x12'\n" +
+	"\x0fexpired_message\x18\x03 \x01(\tR\x0eexpiredMessage\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x04 \x01(\x05R\tgraceDays\"9\n" +
+	"\x1dSaveGroupExpiryPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x1bGetGroupExpiryPolicyRequest\x12\x10\n" +
+	"\x03gid\x18\x01 \x01(\tR\x03gid\"T\n" +
+	"\x1cGetGroupExpiryPolicyResponse\x124\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1c.shortlink.GroupExpiryPolicyR\x06policy\"\xf6\x01\n" +
+	"\x13GroupTransferRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\x12#\n" +
+	"\rfrom_username\x18\x04 \x01(\tR\ffromUsername\x12\x1f\n" +
+	"\vto_username\x18\x05 \x01(\tR\n" +
+	"toUsername\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vcreate_time\x18\a \x01(\tR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\b \x01(\tR\n" +
+	"updateTime\"O\n" +
+	"\x1aCreateGroupTransferRequest\x12\x10\n" +
+	"\x03gid\x18\x01 \x01(\tR\x03gid\x12\x1f\n" +
+	"\vto_username\x18\x02 \x01(\tR\n" +
+	"toUsername\"-\n" +
+	"\x1bCreateGroupTransferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1a\n" +
+	"\x18ListGroupTransferRequest\"\x93\x01\n" +
+	"\x19ListGroupTransferResponse\x12:\n" +
+	"\bincoming\x18\x01 \x03(\v2\x1e.shortlink.GroupTransferRecordR\bincoming\x12:\n" +
+	"\boutgoing\x18\x02 \x03(\v2\x1e.shortlink.GroupTransferRecordR\boutgoing\"E\n" +
+	"\x1bRespondGroupTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"8\n" +
+	"\x1cRespondGroupTransferResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\x1aCancelGroupTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"7\n" +
+	"\x1bCancelGroupTransferResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa3\x02\n" +
+	"\x10ModerationRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03gid\x18\x02 \x01(\tR\x03gid\x12$\n" +
+	"\x0efull_short_url\x18\x03 \x01(\tR\ffullShortUrl\x12\x1d\n" +
+	"\n" +
+	"origin_url\x18\x04 \x01(\tR\toriginUrl\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1a\n" +
+	"\breviewer\x18\b \x01(\tR\breviewer\x12\x1f\n" +
+	"\vcreate_time\x18\t \x01(\tR\n" +
+	"createTime\x12\x1f\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\tR\n" +
+	"updateTime\"]\n" +
+	"\x15PageModerationRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x05R\acurrent\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\x93\x01\n" +
+	"\x16PageModerationResponse\x125\n" +
+	"\arecords\x18\x01 \x03(\v2\x1b.shortlink.ModerationRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x05R\acurrent\"C\n" +
+	"\x17ReviewModerationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"4\n" +
+	"\x18ReviewModerationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x15FlagModerationRequest\x12$\n" +
+	"\x0efull_short_url\x18\x01 \x01(\tR\ffullShortUrl\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"2\n" +
+	"\x16FlagModerationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb1\x01\n" +
+	"\x0eDomainAppLinks\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\"\n" +
+	"\rapple_app_ids\x18\x02 \x03(\tR\vappleAppIds\x12'\n" +
+	"\x0fandroid_package\x18\x03 \x01(\tR\x0eandroidPackage\x12:\n" +
+	"\x19android_cert_fingerprints\x18\x04 \x03(\tR\x17androidCertFingerprints\"\xbc\x01\n" +
+	"\x19SaveDomainAppLinksRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\"\n" +
+	"\rapple_app_ids\x18\x02 \x03(\tR\vappleAppIds\x12'\n" +
+	"\x0fandroid_package\x18\x03 \x01(\tR\x0eandroidPackage\x12:\n" +
+	"\x19android_cert_fingerprints\x18\x04 \x03(\tR\x17androidCertFingerprints\"6\n" +
+	"\x1aSaveDomainAppLinksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x18GetDomainAppLinksRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"S\n" +
+	"\x19GetDomainAppLinksResponse\x126\n" +
+	"\tapp_links\x18\x01 \x01(\v2\x19.shortlink.DomainAppLinksR\bappLinks\"&\n" +
 	"\x14GetIPLocationRequest\x12\x0e\n" +
 	"\x02ip\x18\x01 \x01(\tR\x02ip\"\xc5\x01\n" +
 	"\x15GetIPLocationResponse\x12\x16\n" +
@@ -3416,15 +10606,28 @@ const file_link_proto_rawDesc = "" +
 	"\x04city\x18\x04 \x01(\tR\x04city\x12\x16\n" +
 	"\x06adcode\x18\x05 \x01(\tR\x06adcode\x12\x1c\n" +
 	"\trectangle\x18\x06 \x01(\tR\trectangle\x12\x1a\n" +
-	"\binfocode\x18\a \x01(\tR\binfocode2\xbd\f\n" +
+	"\binfocode\x18\a \x01(\tR\binfocode2\x92'\n" +
 	"\x10ShortLinkService\x12X\n" +
 	"\x0fShortLinkCreate\x12!.shortlink.CreateShortLinkRequest\x1a\".shortlink.CreateShortLinkResponse\x12g\n" +
 	"\x14ShortLinkBatchCreate\x12&.shortlink.BatchCreateShortLinkRequest\x1a'.shortlink.BatchCreateShortLinkResponse\x12X\n" +
 	"\x0fShortLinkUpdate\x12!.shortlink.UpdateShortLinkRequest\x1a\".shortlink.UpdateShortLinkResponse\x12R\n" +
-	"\rShortLinkPage\x12\x1f.shortlink.PageShortLinkRequest\x1a .shortlink.PageShortLinkResponse\x12h\n" +
-	"\x17ShortLinkListGroupCount\x12%.shortlink.GroupShortLinkCountRequest\x1a&.shortlink.GroupShortLinkCountResponse\x12I\n" +
+	"\rShortLinkPage\x12\x1f.shortlink.PageShortLinkRequest\x1a .shortlink.PageShortLinkResponse\x12X\n" +
+	"\x0fShortLinkSearch\x12!.shortlink.SearchShortLinkRequest\x1a\".shortlink.SearchShortLinkResponse\x12X\n" +
+	"\x0fShortLinkQrCode\x12!.shortlink.ShortLinkQrCodeRequest\x1a\".shortlink.ShortLinkQrCodeResponse\x12h\n" +
+	"\x17ShortLinkListGroupCount\x12%.shortlink.GroupShortLinkCountRequest\x1a&.shortlink.GroupShortLinkCountResponse\x12R\n" +
+	"\rShortLinkMove\x12\x1f.shortlink.MoveShortLinkRequest\x1a .shortlink.MoveShortLinkResponse\x12U\n" +
+	"\x0eShortLinkClone\x12 .shortlink.CloneShortLinkRequest\x1a!.shortlink.CloneShortLinkResponse\x12R\n" +
+	"\x13ShortLinkBulkAction\x12\x1c.shortlink.BulkActionRequest\x1a\x1d.shortlink.BulkActionResponse\x12]\n" +
+	"\x14ShortLinkHistoryList\x12!.shortlink.ListLinkHistoryRequest\x1a\".shortlink.ListLinkHistoryResponse\x12^\n" +
+	"\x11ShortLinkRollback\x12#.shortlink.RollbackShortLinkRequest\x1a$.shortlink.RollbackShortLinkResponse\x12X\n" +
+	"\x0fShortLinkImport\x12!.shortlink.ImportShortLinkRequest\x1a\".shortlink.ImportShortLinkResponse\x12X\n" +
+	"\x15ShortLinkImportJobGet\x12\x1e.shortlink.GetImportJobRequest\x1a\x1f.shortlink.GetImportJobResponse\x12X\n" +
+	"\x0fShortLinkExport\x12!.shortlink.ExportShortLinkRequest\x1a\".shortlink.ExportShortLinkResponse\x12X\n" +
+	"\x15ShortLinkExportJobGet\x12\x1e.shortlink.GetExportJobRequest\x1a\x1f.shortlink.GetExportJobResponse\x12^\n" +
+	"\x17ShortLinkExportDownload\x12 .shortlink.DownloadExportRequest\x1a!.shortlink.DownloadExportResponse\x12I\n" +
 	"\n" +
-	"RestoreUrl\x12\x1c.shortlink.RestoreUrlRequest\x1a\x1d.shortlink.RestoreUrlResponse\x12L\n" +
+	"RestoreUrl\x12\x1c.shortlink.RestoreUrlRequest\x1a\x1d.shortlink.RestoreUrlResponse\x12a\n" +
+	"\x12VerifyLinkPassword\x12$.shortlink.VerifyLinkPasswordRequest\x1a%.shortlink.VerifyLinkPasswordResponse\x12L\n" +
 	"\x0eShortLinkStats\x12 .shortlink.ShortLinkStatsRequest\x1a\x18.shortlink.EmptyResponse\x12Y\n" +
 	"\x0eRecycleBinSave\x12\".shortlink.SaveToRecycleBinRequest\x1a#.shortlink.SaveToRecycleBinResponse\x12f\n" +
 	"\x11RecycleBinRecover\x12'.shortlink.RecoverFromRecycleBinRequest\x1a(.shortlink.RecoverFromRecycleBinResponse\x12c\n" +
@@ -3433,8 +10636,31 @@ const file_link_proto_rawDesc = "" +
 	"\x0eStatsGetSingle\x12 .shortlink.GetSingleStatsRequest\x1a!.shortlink.GetSingleStatsResponse\x12R\n" +
 	"\rStatsGetGroup\x12\x1f.shortlink.GetGroupStatsRequest\x1a .shortlink.GetGroupStatsResponse\x12c\n" +
 	"\x16StatsAccessRecordQuery\x12#.shortlink.AccessRecordQueryRequest\x1a$.shortlink.AccessRecordQueryResponse\x12r\n" +
-	"\x1bStatsGroupAccessRecordQuery\x12(.shortlink.GroupAccessRecordQueryRequest\x1a).shortlink.GroupAccessRecordQueryResponse\x12L\n" +
-	"\vUrlTitleGet\x12\x1d.shortlink.GetUrlTitleRequest\x1a\x1e.shortlink.GetUrlTitleResponse\x12R\n" +
+	"\x1bStatsGroupAccessRecordQuery\x12(.shortlink.GroupAccessRecordQueryRequest\x1a).shortlink.GroupAccessRecordQueryResponse\x12a\n" +
+	"\x12UserDomainRegister\x12$.shortlink.RegisterUserDomainRequest\x1a%.shortlink.RegisterUserDomainResponse\x12[\n" +
+	"\x10UserDomainVerify\x12\".shortlink.VerifyUserDomainRequest\x1a#.shortlink.VerifyUserDomainResponse\x12U\n" +
+	"\x0eUserDomainList\x12 .shortlink.ListUserDomainRequest\x1a!.shortlink.ListUserDomainResponse\x12a\n" +
+	"\x12RedirectRuleCreate\x12$.shortlink.CreateRedirectRuleRequest\x1a%.shortlink.CreateRedirectRuleResponse\x12a\n" +
+	"\x12RedirectRuleUpdate\x12$.shortlink.UpdateRedirectRuleRequest\x1a%.shortlink.UpdateRedirectRuleResponse\x12a\n" +
+	"\x12RedirectRuleDelete\x12$.shortlink.DeleteRedirectRuleRequest\x1a%.shortlink.DeleteRedirectRuleResponse\x12[\n" +
+	"\x10RedirectRuleList\x12\".shortlink.ListRedirectRuleRequest\x1a#.shortlink.ListRedirectRuleResponse\x12a\n" +
+	"\x12LinkTemplateCreate\x12$.shortlink.CreateLinkTemplateRequest\x1a%.shortlink.CreateLinkTemplateResponse\x12a\n" +
+	"\x12LinkTemplateUpdate\x12$.shortlink.UpdateLinkTemplateRequest\x1a%.shortlink.UpdateLinkTemplateResponse\x12a\n" +
+	"\x12LinkTemplateDelete\x12$.shortlink.DeleteLinkTemplateRequest\x1a%.shortlink.DeleteLinkTemplateResponse\x12[\n" +
+	"\x10LinkTemplateList\x12\".shortlink.ListLinkTemplateRequest\x1a#.shortlink.ListLinkTemplateResponse\x12j\n" +
+	"\x15GroupExpiryPolicySave\x12'.shortlink.SaveGroupExpiryPolicyRequest\x1a(.shortlink.SaveGroupExpiryPolicyResponse\x12g\n" +
+	"\x14GroupExpiryPolicyGet\x12&.shortlink.GetGroupExpiryPolicyRequest\x1a'.shortlink.GetGroupExpiryPolicyResponse\x12d\n" +
+	"\x13GroupTransferCreate\x12%.shortlink.CreateGroupTransferRequest\x1a&.shortlink.CreateGroupTransferResponse\x12^\n" +
+	"\x11GroupTransferList\x12#.shortlink.ListGroupTransferRequest\x1a$.shortlink.ListGroupTransferResponse\x12g\n" +
+	"\x14GroupTransferRespond\x12&.shortlink.RespondGroupTransferRequest\x1a'.shortlink.RespondGroupTransferResponse\x12d\n" +
+	"\x13GroupTransferCancel\x12%.shortlink.CancelGroupTransferRequest\x1a&.shortlink.CancelGroupTransferResponse\x12U\n" +
+	"\x0eModerationPage\x12 .shortlink.PageModerationRequest\x1a!.shortlink.PageModerationResponse\x12[\n" +
+	"\x10ModerationReview\x12\".shortlink.ReviewModerationRequest\x1a#.shortlink.ReviewModerationResponse\x12U\n" +
+	"\x0eModerationFlag\x12 .shortlink.FlagModerationRequest\x1a!.shortlink.FlagModerationResponse\x12a\n" +
+	"\x12DomainAppLinksSave\x12$.shortlink.SaveDomainAppLinksRequest\x1a%.shortlink.SaveDomainAppLinksResponse\x12^\n" +
+	"\x11DomainAppLinksGet\x12#.shortlink.GetDomainAppLinksRequest\x1a$.shortlink.GetDomainAppLinksResponse\x12L\n" +
+	"\vUrlTitleGet\x12\x1d.shortlink.GetUrlTitleRequest\x1a\x1e.shortlink.GetUrlTitleResponse\x12[\n" +
+	"\x10ShortLinkPreview\x12\".shortlink.ShortLinkPreviewRequest\x1a#.shortlink.ShortLinkPreviewResponse\x12R\n" +
 	"\rGetIpLocation\x12\x1f.shortlink.GetIPLocationRequest\x1a .shortlink.GetIPLocationResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
@@ -3449,118 +10675,304 @@ func file_link_proto_rawDescGZIP() []byte {
 	return file_link_proto_rawDescData
 }
 
-var file_link_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_link_proto_msgTypes = make([]protoimpl.MessageInfo, 136)
 var file_link_proto_goTypes = []any{
-	(*CreateShortLinkRequest)(nil),          // 0: shortlink.CreateShortLinkRequest
-	(*CreateShortLinkResponse)(nil),         // 1: shortlink.CreateShortLinkResponse
-	(*BatchCreateShortLinkRequest)(nil),     // 2: shortlink.BatchCreateShortLinkRequest
-	(*BatchCreateResult)(nil),               // 3: shortlink.BatchCreateResult
-	(*BatchCreateShortLinkResponse)(nil),    // 4: shortlink.BatchCreateShortLinkResponse
-	(*UpdateShortLinkRequest)(nil),          // 5: shortlink.UpdateShortLinkRequest
-	(*UpdateShortLinkResponse)(nil),         // 6: shortlink.UpdateShortLinkResponse
-	(*PageShortLinkRequest)(nil),            // 7: shortlink.PageShortLinkRequest
-	(*ShortLinkRecord)(nil),                 // 8: shortlink.ShortLinkRecord
-	(*PageShortLinkResponse)(nil),           // 9: shortlink.PageShortLinkResponse
-	(*SaveToRecycleBinRequest)(nil),         // 10: shortlink.SaveToRecycleBinRequest
-	(*SaveToRecycleBinResponse)(nil),        // 11: shortlink.SaveToRecycleBinResponse
-	(*RecoverFromRecycleBinRequest)(nil),    // 12: shortlink.RecoverFromRecycleBinRequest
-	(*RecoverFromRecycleBinResponse)(nil),   // 13: shortlink.RecoverFromRecycleBinResponse
-	(*RemoveFromRecycleBinRequest)(nil),     // 14: shortlink.RemoveFromRecycleBinRequest
-	(*RemoveFromRecycleBinResponse)(nil),    // 15: shortlink.RemoveFromRecycleBinResponse
-	(*PageRecycleBinShortLinkRequest)(nil),  // 16: shortlink.PageRecycleBinShortLinkRequest
-	(*PageRecycleBinShortLinkResponse)(nil), // 17: shortlink.PageRecycleBinShortLinkResponse
-	(*GetSingleStatsRequest)(nil),           // 18: shortlink.GetSingleStatsRequest
-	(*DailyStat)(nil),                       // 19: shortlink.DailyStat
-	(*LocaleCnStat)(nil),                    // 20: shortlink.LocaleCnStat
-	(*BrowserStat)(nil),                     // 21: shortlink.BrowserStat
-	(*OSStat)(nil),                          // 22: shortlink.OSStat
-	(*DeviceStat)(nil),                      // 23: shortlink.DeviceStat
-	(*NetworkStat)(nil),                     // 24: shortlink.NetworkStat
-	(*TopIpStat)(nil),                       // 25: shortlink.TopIpStat
-	(*UvTypeStat)(nil),                      // 26: shortlink.UvTypeStat
-	(*GetSingleStatsResponse)(nil),          // 27: shortlink.GetSingleStatsResponse
-	(*GetGroupStatsRequest)(nil),            // 28: shortlink.GetGroupStatsRequest
-	(*GetGroupStatsResponse)(nil),           // 29: shortlink.GetGroupStatsResponse
-	(*GroupCount)(nil),                      // 30: shortlink.GroupCount
-	(*AccessRecord)(nil),                    // 31: shortlink.AccessRecord
-	(*AccessRecordQueryRequest)(nil),        // 32: shortlink.AccessRecordQueryRequest
-	(*AccessRecordQueryResponse)(nil),       // 33: shortlink.AccessRecordQueryResponse
-	(*GroupAccessRecordQueryRequest)(nil),   // 34: shortlink.GroupAccessRecordQueryRequest
-	(*GroupAccessRecordQueryResponse)(nil),  // 35: shortlink.GroupAccessRecordQueryResponse
-	(*GetUrlTitleRequest)(nil),              // 36: shortlink.GetUrlTitleRequest
-	(*GetUrlTitleResponse)(nil),             // 37: shortlink.GetUrlTitleResponse
-	(*GroupShortLinkCountRequest)(nil),      // 38: shortlink.GroupShortLinkCountRequest
-	(*ShortLinkGroupCountItem)(nil),         // 39: shortlink.ShortLinkGroupCountItem
-	(*GroupShortLinkCountResponse)(nil),     // 40: shortlink.GroupShortLinkCountResponse
-	(*RestoreUrlRequest)(nil),               // 41: shortlink.RestoreUrlRequest
-	(*RestoreUrlResponse)(nil),              // 42: shortlink.RestoreUrlResponse
-	(*ShortLinkStatsRequest)(nil),           // 43: shortlink.ShortLinkStatsRequest
-	(*EmptyResponse)(nil),                   // 44: shortlink.EmptyResponse
-	(*GetIPLocationRequest)(nil),            // 45: shortlink.GetIPLocationRequest
-	(*GetIPLocationResponse)(nil),           // 46: shortlink.GetIPLocationResponse
+	(*LinkVariant)(nil),                     // 0: shortlink.LinkVariant
+	(*CreateShortLinkRequest)(nil),          // 1: shortlink.CreateShortLinkRequest
+	(*CreateShortLinkResponse)(nil),         // 2: shortlink.CreateShortLinkResponse
+	(*BatchCreateShortLinkRequest)(nil),     // 3: shortlink.BatchCreateShortLinkRequest
+	(*BatchCreateResult)(nil),               // 4: shortlink.BatchCreateResult
+	(*BatchCreateShortLinkResponse)(nil),    // 5: shortlink.BatchCreateShortLinkResponse
+	(*UpdateShortLinkRequest)(nil),          // 6: shortlink.UpdateShortLinkRequest
+	(*UpdateShortLinkResponse)(nil),         // 7: shortlink.UpdateShortLinkResponse
+	(*PageShortLinkRequest)(nil),            // 8: shortlink.PageShortLinkRequest
+	(*ShortLinkRecord)(nil),                 // 9: shortlink.ShortLinkRecord
+	(*PageShortLinkResponse)(nil),           // 10: shortlink.PageShortLinkResponse
+	(*SearchShortLinkRequest)(nil),          // 11: shortlink.SearchShortLinkRequest
+	(*SearchShortLinkResponse)(nil),         // 12: shortlink.SearchShortLinkResponse
+	(*SaveToRecycleBinRequest)(nil),         // 13: shortlink.SaveToRecycleBinRequest
+	(*SaveToRecycleBinResponse)(nil),        // 14: shortlink.SaveToRecycleBinResponse
+	(*RecoverFromRecycleBinRequest)(nil),    // 15: shortlink.RecoverFromRecycleBinRequest
+	(*RecoverFromRecycleBinResponse)(nil),   // 16: shortlink.RecoverFromRecycleBinResponse
+	(*RemoveFromRecycleBinRequest)(nil),     // 17: shortlink.RemoveFromRecycleBinRequest
+	(*RemoveFromRecycleBinResponse)(nil),    // 18: shortlink.RemoveFromRecycleBinResponse
+	(*PageRecycleBinShortLinkRequest)(nil),  // 19: shortlink.PageRecycleBinShortLinkRequest
+	(*PageRecycleBinShortLinkResponse)(nil), // 20: shortlink.PageRecycleBinShortLinkResponse
+	(*GetSingleStatsRequest)(nil),           // 21: shortlink.GetSingleStatsRequest
+	(*DailyStat)(nil),                       // 22: shortlink.DailyStat
+	(*LocaleCnStat)(nil),                    // 23: shortlink.LocaleCnStat
+	(*BrowserStat)(nil),                     // 24: shortlink.BrowserStat
+	(*OSStat)(nil),                          // 25: shortlink.OSStat
+	(*DeviceStat)(nil),                      // 26: shortlink.DeviceStat
+	(*NetworkStat)(nil),                     // 27: shortlink.NetworkStat
+	(*TopIpStat)(nil),                       // 28: shortlink.TopIpStat
+	(*UvTypeStat)(nil),                      // 29: shortlink.UvTypeStat
+	(*VariantStat)(nil),                     // 30: shortlink.VariantStat
+	(*ChannelStat)(nil),                     // 31: shortlink.ChannelStat
+	(*GetSingleStatsResponse)(nil),          // 32: shortlink.GetSingleStatsResponse
+	(*GetGroupStatsRequest)(nil),            // 33: shortlink.GetGroupStatsRequest
+	(*GetGroupStatsResponse)(nil),           // 34: shortlink.GetGroupStatsResponse
+	(*GroupCount)(nil),                      // 35: shortlink.GroupCount
+	(*AccessRecord)(nil),                    // 36: shortlink.AccessRecord
+	(*AccessRecordQueryRequest)(nil),        // 37: shortlink.AccessRecordQueryRequest
+	(*AccessRecordQueryResponse)(nil),       // 38: shortlink.AccessRecordQueryResponse
+	(*GroupAccessRecordQueryRequest)(nil),   // 39: shortlink.GroupAccessRecordQueryRequest
+	(*GroupAccessRecordQueryResponse)(nil),  // 40: shortlink.GroupAccessRecordQueryResponse
+	(*GetUrlTitleRequest)(nil),              // 41: shortlink.GetUrlTitleRequest
+	(*GetUrlTitleResponse)(nil),             // 42: shortlink.GetUrlTitleResponse
+	(*ShortLinkPreviewRequest)(nil),         // 43: shortlink.ShortLinkPreviewRequest
+	(*ShortLinkPreviewResponse)(nil),        // 44: shortlink.ShortLinkPreviewResponse
+	(*ShortLinkQrCodeRequest)(nil),          // 45: shortlink.ShortLinkQrCodeRequest
+	(*ShortLinkQrCodeResponse)(nil),         // 46: shortlink.ShortLinkQrCodeResponse
+	(*GroupShortLinkCountRequest)(nil),      // 47: shortlink.GroupShortLinkCountRequest
+	(*ShortLinkGroupCountItem)(nil),         // 48: shortlink.ShortLinkGroupCountItem
+	(*GroupShortLinkCountResponse)(nil),     // 49: shortlink.GroupShortLinkCountResponse
+	(*MoveShortLinkRequest)(nil),            // 50: shortlink.MoveShortLinkRequest
+	(*MoveShortLinkResponse)(nil),           // 51: shortlink.MoveShortLinkResponse
+	(*CloneShortLinkRequest)(nil),           // 52: shortlink.CloneShortLinkRequest
+	(*CloneShortLinkResponse)(nil),          // 53: shortlink.CloneShortLinkResponse
+	(*ImportShortLinkRequest)(nil),          // 54: shortlink.ImportShortLinkRequest
+	(*ImportShortLinkResponse)(nil),         // 55: shortlink.ImportShortLinkResponse
+	(*ImportRowResult)(nil),                 // 56: shortlink.ImportRowResult
+	(*ShortLinkImportJob)(nil),              // 57: shortlink.ShortLinkImportJob
+	(*GetImportJobRequest)(nil),             // 58: shortlink.GetImportJobRequest
+	(*GetImportJobResponse)(nil),            // 59: shortlink.GetImportJobResponse
+	(*BulkActionSelector)(nil),              // 60: shortlink.BulkActionSelector
+	(*BulkActionRequest)(nil),               // 61: shortlink.BulkActionRequest
+	(*BulkActionItemResult)(nil),            // 62: shortlink.BulkActionItemResult
+	(*BulkActionResponse)(nil),              // 63: shortlink.BulkActionResponse
+	(*LinkHistoryChange)(nil),               // 64: shortlink.LinkHistoryChange
+	(*LinkHistoryRecord)(nil),               // 65: shortlink.LinkHistoryRecord
+	(*ListLinkHistoryRequest)(nil),          // 66: shortlink.ListLinkHistoryRequest
+	(*ListLinkHistoryResponse)(nil),         // 67: shortlink.ListLinkHistoryResponse
+	(*RollbackShortLinkRequest)(nil),        // 68: shortlink.RollbackShortLinkRequest
+	(*RollbackShortLinkResponse)(nil),       // 69: shortlink.RollbackShortLinkResponse
+	(*ExportShortLinkRequest)(nil),          // 70: shortlink.ExportShortLinkRequest
+	(*ExportShortLinkResponse)(nil),         // 71: shortlink.ExportShortLinkResponse
+	(*ShortLinkExportJob)(nil),              // 72: shortlink.ShortLinkExportJob
+	(*GetExportJobRequest)(nil),             // 73: shortlink.GetExportJobRequest
+	(*GetExportJobResponse)(nil),            // 74: shortlink.GetExportJobResponse
+	(*DownloadExportRequest)(nil),           // 75: shortlink.DownloadExportRequest
+	(*DownloadExportResponse)(nil),          // 76: shortlink.DownloadExportResponse
+	(*RestoreUrlRequest)(nil),               // 77: shortlink.RestoreUrlRequest
+	(*RestoreUrlResponse)(nil),              // 78: shortlink.RestoreUrlResponse
+	(*VerifyLinkPasswordRequest)(nil),       // 79: shortlink.VerifyLinkPasswordRequest
+	(*VerifyLinkPasswordResponse)(nil),      // 80: shortlink.VerifyLinkPasswordResponse
+	(*ShortLinkStatsRequest)(nil),           // 81: shortlink.ShortLinkStatsRequest
+	(*EmptyResponse)(nil),                   // 82: shortlink.EmptyResponse
+	(*UserDomain)(nil),                      // 83: shortlink.UserDomain
+	(*RegisterUserDomainRequest)(nil),       // 84: shortlink.RegisterUserDomainRequest
+	(*RegisterUserDomainResponse)(nil),      // 85: shortlink.RegisterUserDomainResponse
+	(*VerifyUserDomainRequest)(nil),         // 86: shortlink.VerifyUserDomainRequest
+	(*VerifyUserDomainResponse)(nil),        // 87: shortlink.VerifyUserDomainResponse
+	(*ListUserDomainRequest)(nil),           // 88: shortlink.ListUserDomainRequest
+	(*ListUserDomainResponse)(nil),          // 89: shortlink.ListUserDomainResponse
+	(*RedirectRule)(nil),                    // 90: shortlink.RedirectRule
+	(*CreateRedirectRuleRequest)(nil),       // 91: shortlink.CreateRedirectRuleRequest
+	(*CreateRedirectRuleResponse)(nil),      // 92: shortlink.CreateRedirectRuleResponse
+	(*UpdateRedirectRuleRequest)(nil),       // 93: shortlink.UpdateRedirectRuleRequest
+	(*UpdateRedirectRuleResponse)(nil),      // 94: shortlink.UpdateRedirectRuleResponse
+	(*DeleteRedirectRuleRequest)(nil),       // 95: shortlink.DeleteRedirectRuleRequest
+	(*DeleteRedirectRuleResponse)(nil),      // 96: shortlink.DeleteRedirectRuleResponse
+	(*ListRedirectRuleRequest)(nil),         // 97: shortlink.ListRedirectRuleRequest
+	(*ListRedirectRuleResponse)(nil),        // 98: shortlink.ListRedirectRuleResponse
+	(*LinkTemplate)(nil),                    // 99: shortlink.LinkTemplate
+	(*CreateLinkTemplateRequest)(nil),       // 100: shortlink.CreateLinkTemplateRequest
+	(*CreateLinkTemplateResponse)(nil),      // 101: shortlink.CreateLinkTemplateResponse
+	(*UpdateLinkTemplateRequest)(nil),       // 102: shortlink.UpdateLinkTemplateRequest
+	(*UpdateLinkTemplateResponse)(nil),      // 103: shortlink.UpdateLinkTemplateResponse
+	(*DeleteLinkTemplateRequest)(nil),       // 104: shortlink.DeleteLinkTemplateRequest
+	(*DeleteLinkTemplateResponse)(nil),      // 105: shortlink.DeleteLinkTemplateResponse
+	(*ListLinkTemplateRequest)(nil),         // 106: shortlink.ListLinkTemplateRequest
+	(*ListLinkTemplateResponse)(nil),        // 107: shortlink.ListLinkTemplateResponse
+	(*GroupExpiryPolicy)(nil),               // 108: shortlink.GroupExpiryPolicy
+	(*SaveGroupExpiryPolicyRequest)(nil),    // 109: shortlink.SaveGroupExpiryPolicyRequest
+	(*SaveGroupExpiryPolicyResponse)(nil),   // 110: shortlink.SaveGroupExpiryPolicyResponse
+	(*GetGroupExpiryPolicyRequest)(nil),     // 111: shortlink.GetGroupExpiryPolicyRequest
+	(*GetGroupExpiryPolicyResponse)(nil),    // 112: shortlink.GetGroupExpiryPolicyResponse
+	(*GroupTransferRecord)(nil),             // 113: shortlink.GroupTransferRecord
+	(*CreateGroupTransferRequest)(nil),      // 114: shortlink.CreateGroupTransferRequest
+	(*CreateGroupTransferResponse)(nil),     // 115: shortlink.CreateGroupTransferResponse
+	(*ListGroupTransferRequest)(nil),        // 116: shortlink.ListGroupTransferRequest
+	(*ListGroupTransferResponse)(nil),       // 117: shortlink.ListGroupTransferResponse
+	(*RespondGroupTransferRequest)(nil),     // 118: shortlink.RespondGroupTransferRequest
+	(*RespondGroupTransferResponse)(nil),    // 119: shortlink.RespondGroupTransferResponse
+	(*CancelGroupTransferRequest)(nil),      // 120: shortlink.CancelGroupTransferRequest
+	(*CancelGroupTransferResponse)(nil),     // 121: shortlink.CancelGroupTransferResponse
+	(*ModerationRecord)(nil),                // 122: shortlink.ModerationRecord
+	(*PageModerationRequest)(nil),           // 123: shortlink.PageModerationRequest
+	(*PageModerationResponse)(nil),          // 124: shortlink.PageModerationResponse
+	(*ReviewModerationRequest)(nil),         // 125: shortlink.ReviewModerationRequest
+	(*ReviewModerationResponse)(nil),        // 126: shortlink.ReviewModerationResponse
+	(*FlagModerationRequest)(nil),           // 127: shortlink.FlagModerationRequest
+	(*FlagModerationResponse)(nil),          // 128: shortlink.FlagModerationResponse
+	(*DomainAppLinks)(nil),                  // 129: shortlink.DomainAppLinks
+	(*SaveDomainAppLinksRequest)(nil),       // 130: shortlink.SaveDomainAppLinksRequest
+	(*SaveDomainAppLinksResponse)(nil),      // 131: shortlink.SaveDomainAppLinksResponse
+	(*GetDomainAppLinksRequest)(nil),        // 132: shortlink.GetDomainAppLinksRequest
+	(*GetDomainAppLinksResponse)(nil),       // 133: shortlink.GetDomainAppLinksResponse
+	(*GetIPLocationRequest)(nil),            // 134: shortlink.GetIPLocationRequest
+	(*GetIPLocationResponse)(nil),           // 135: shortlink.GetIPLocationResponse
 }
 var file_link_proto_depIdxs = []int32{
-	3,  // 0: shortlink.BatchCreateShortLinkResponse.results:type_name -> shortlink.BatchCreateResult
-	8,  // 1: shortlink.PageShortLinkResponse.records:type_name -> shortlink.ShortLinkRecord
-	8,  // 2: shortlink.PageRecycleBinShortLinkResponse.records:type_name -> shortlink.ShortLinkRecord
-	19, // 3: shortlink.GetSingleStatsResponse.daily:type_name -> shortlink.DailyStat
-	20, // 4: shortlink.GetSingleStatsResponse.locale_cn_stats:type_name -> shortlink.LocaleCnStat
-	25, // 5: shortlink.GetSingleStatsResponse.top_ip_stats:type_name -> shortlink.TopIpStat
-	21, // 6: shortlink.GetSingleStatsResponse.browser_stats:type_name -> shortlink.BrowserStat
-	22, // 7: shortlink.GetSingleStatsResponse.os_stats:type_name -> shortlink.OSStat
-	26, // 8: shortlink.GetSingleStatsResponse.uv_type_stats:type_name -> shortlink.UvTypeStat
-	23, // 9: shortlink.GetSingleStatsResponse.device_stats:type_name -> shortlink.DeviceStat
-	24, // 10: shortlink.GetSingleStatsResponse.network_stats:type_name -> shortlink.NetworkStat
-	19, // 11: shortlink.GetGroupStatsResponse.daily:type_name -> shortlink.DailyStat
-	20, // 12: shortlink.GetGroupStatsResponse.locale_cn_stats:type_name -> shortlink.LocaleCnStat
-	25, // 13: shortlink.GetGroupStatsResponse.top_ip_stats:type_name -> shortlink.TopIpStat
-	21, // 14: shortlink.GetGroupStatsResponse.browser_stats:type_name -> shortlink.BrowserStat
-	22, // 15: shortlink.GetGroupStatsResponse.os_stats:type_name -> shortlink.OSStat
-	26, // 16: shortlink.GetGroupStatsResponse.uv_type_stats:type_name -> shortlink.UvTypeStat
-	23, // 17: shortlink.GetGroupStatsResponse.device_stats:type_name -> shortlink.DeviceStat
-	24, // 18: shortlink.GetGroupStatsResponse.network_stats:type_name -> shortlink.NetworkStat
-	31, // 19: shortlink.AccessRecordQueryResponse.records:type_name -> shortlink.AccessRecord
-	31, // 20: shortlink.GroupAccessRecordQueryResponse.records:type_name -> shortlink.AccessRecord
-	39, // 21: shortlink.GroupShortLinkCountResponse.group_counts:type_name -> shortlink.ShortLinkGroupCountItem
-	0,  // 22: shortlink.ShortLinkService.ShortLinkCreate:input_type -> shortlink.CreateShortLinkRequest
-	2,  // 23: shortlink.ShortLinkService.ShortLinkBatchCreate:input_type -> shortlink.BatchCreateShortLinkRequest
-	5,  // 24: shortlink.ShortLinkService.ShortLinkUpdate:input_type -> shortlink.UpdateShortLinkRequest
-	7,  // 25: shortlink.ShortLinkService.ShortLinkPage:input_type -> shortlink.PageShortLinkRequest
-	38, // 26: shortlink.ShortLinkService.ShortLinkListGroupCount:input_type -> shortlink.GroupShortLinkCountRequest
-	41, // 27: shortlink.ShortLinkService.RestoreUrl:input_type -> shortlink.RestoreUrlRequest
-	43, // 28: shortlink.ShortLinkService.ShortLinkStats:input_type -> shortlink.ShortLinkStatsRequest
-	10, // 29: shortlink.ShortLinkService.RecycleBinSave:input_type -> shortlink.SaveToRecycleBinRequest
-	12, // 30: shortlink.ShortLinkService.RecycleBinRecover:input_type -> shortlink.RecoverFromRecycleBinRequest
-	14, // 31: shortlink.ShortLinkService.RecycleBinRemove:input_type -> shortlink.RemoveFromRecycleBinRequest
-	16, // 32: shortlink.ShortLinkService.RecycleBinPage:input_type -> shortlink.PageRecycleBinShortLinkRequest
-	18, // 33: shortlink.ShortLinkService.StatsGetSingle:input_type -> shortlink.GetSingleStatsRequest
-	28, // 34: shortlink.ShortLinkService.StatsGetGroup:input_type -> shortlink.GetGroupStatsRequest
-	32, // 35: shortlink.ShortLinkService.StatsAccessRecordQuery:input_type -> shortlink.AccessRecordQueryRequest
-	34, // 36: shortlink.ShortLinkService.StatsGroupAccessRecordQuery:input_type -> shortlink.GroupAccessRecordQueryRequest
-	36, // 37: shortlink.ShortLinkService.UrlTitleGet:input_type -> shortlink.GetUrlTitleRequest
-	45, // 38: shortlink.ShortLinkService.GetIpLocation:input_type -> shortlink.GetIPLocationRequest
-	1,  // 39: shortlink.ShortLinkService.ShortLinkCreate:output_type -> shortlink.CreateShortLinkResponse
-	4,  // 40: shortlink.ShortLinkService.ShortLinkBatchCreate:output_type -> shortlink.BatchCreateShortLinkResponse
-	6,  // 41: shortlink.ShortLinkService.ShortLinkUpdate:output_type -> shortlink.UpdateShortLinkResponse
-	9,  // 42: shortlink.ShortLinkService.ShortLinkPage:output_type -> shortlink.PageShortLinkResponse
-	40, // 43: shortlink.ShortLinkService.ShortLinkListGroupCount:output_type -> shortlink.GroupShortLinkCountResponse
-	42, // 44: shortlink.ShortLinkService.RestoreUrl:output_type -> shortlink.RestoreUrlResponse
-	44, // 45: shortlink.ShortLinkService.ShortLinkStats:output_type -> shortlink.EmptyResponse
-	11, // 46: shortlink.ShortLinkService.RecycleBinSave:output_type -> shortlink.SaveToRecycleBinResponse
-	13, // 47: shortlink.ShortLinkService.RecycleBinRecover:output_type -> shortlink.RecoverFromRecycleBinResponse
-	15, // 48: shortlink.ShortLinkService.RecycleBinRemove:output_type -> shortlink.RemoveFromRecycleBinResponse
-	17, // 49: shortlink.ShortLinkService.RecycleBinPage:output_type -> shortlink.PageRecycleBinShortLinkResponse
-	27, // 50: shortlink.ShortLinkService.StatsGetSingle:output_type -> shortlink.GetSingleStatsResponse
-	29, // 51: shortlink.ShortLinkService.StatsGetGroup:output_type -> shortlink.GetGroupStatsResponse
-	33, // 52: shortlink.ShortLinkService.StatsAccessRecordQuery:output_type -> shortlink.AccessRecordQueryResponse
-	35, // 53: shortlink.ShortLinkService.StatsGroupAccessRecordQuery:output_type -> shortlink.GroupAccessRecordQueryResponse
-	37, // 54: shortlink.ShortLinkService.UrlTitleGet:output_type -> shortlink.GetUrlTitleResponse
-	46, // 55: shortlink.ShortLinkService.GetIpLocation:output_type -> shortlink.GetIPLocationResponse
-	39, // [39:56] is the sub-list for method output_type
-	22, // [22:39] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	0,   // 0: shortlink.CreateShortLinkRequest.variants:type_name -> shortlink.LinkVariant
+	4,   // 1: shortlink.BatchCreateShortLinkResponse.results:type_name -> shortlink.BatchCreateResult
+	0,   // 2: shortlink.UpdateShortLinkRequest.variants:type_name -> shortlink.LinkVariant
+	9,   // 3: shortlink.PageShortLinkResponse.records:type_name -> shortlink.ShortLinkRecord
+	9,   // 4: shortlink.SearchShortLinkResponse.records:type_name -> shortlink.ShortLinkRecord
+	9,   // 5: shortlink.PageRecycleBinShortLinkResponse.records:type_name -> shortlink.ShortLinkRecord
+	22,  // 6: shortlink.GetSingleStatsResponse.daily:type_name -> shortlink.DailyStat
+	23,  // 7: shortlink.GetSingleStatsResponse.locale_cn_stats:type_name -> shortlink.LocaleCnStat
+	28,  // 8: shortlink.GetSingleStatsResponse.top_ip_stats:type_name -> shortlink.TopIpStat
+	24,  // 9: shortlink.GetSingleStatsResponse.browser_stats:type_name -> shortlink.BrowserStat
+	25,  // 10: shortlink.GetSingleStatsResponse.os_stats:type_name -> shortlink.OSStat
+	29,  // 11: shortlink.GetSingleStatsResponse.uv_type_stats:type_name -> shortlink.UvTypeStat
+	26,  // 12: shortlink.GetSingleStatsResponse.device_stats:type_name -> shortlink.DeviceStat
+	27,  // 13: shortlink.GetSingleStatsResponse.network_stats:type_name -> shortlink.NetworkStat
+	30,  // 14: shortlink.GetSingleStatsResponse.variant_stats:type_name -> shortlink.VariantStat
+	31,  // 15: shortlink.GetSingleStatsResponse.channel_stats:type_name -> shortlink.ChannelStat
+	22,  // 16: shortlink.GetGroupStatsResponse.daily:type_name -> shortlink.DailyStat
+	23,  // 17: shortlink.GetGroupStatsResponse.locale_cn_stats:type_name -> shortlink.LocaleCnStat
+	28,  // 18: shortlink.GetGroupStatsResponse.top_ip_stats:type_name -> shortlink.TopIpStat
+	24,  // 19: shortlink.GetGroupStatsResponse.browser_stats:type_name -> shortlink.BrowserStat
+	25,  // 20: shortlink.GetGroupStatsResponse.os_stats:type_name -> shortlink.OSStat
+	29,  // 21: shortlink.GetGroupStatsResponse.uv_type_stats:type_name -> shortlink.UvTypeStat
+	26,  // 22: shortlink.GetGroupStatsResponse.device_stats:type_name -> shortlink.DeviceStat
+	27,  // 23: shortlink.GetGroupStatsResponse.network_stats:type_name -> shortlink.NetworkStat
+	36,  // 24: shortlink.AccessRecordQueryResponse.records:type_name -> shortlink.AccessRecord
+	36,  // 25: shortlink.GroupAccessRecordQueryResponse.records:type_name -> shortlink.AccessRecord
+	48,  // 26: shortlink.GroupShortLinkCountResponse.group_counts:type_name -> shortlink.ShortLinkGroupCountItem
+	57,  // 27: shortlink.ImportShortLinkResponse.job:type_name -> shortlink.ShortLinkImportJob
+	56,  // 28: shortlink.ShortLinkImportJob.rows:type_name -> shortlink.ImportRowResult
+	57,  // 29: shortlink.GetImportJobResponse.job:type_name -> shortlink.ShortLinkImportJob
+	60,  // 30: shortlink.BulkActionRequest.selector:type_name -> shortlink.BulkActionSelector
+	62,  // 31: shortlink.BulkActionResponse.results:type_name -> shortlink.BulkActionItemResult
+	64,  // 32: shortlink.LinkHistoryRecord.changes:type_name -> shortlink.LinkHistoryChange
+	65,  // 33: shortlink.ListLinkHistoryResponse.records:type_name -> shortlink.LinkHistoryRecord
+	65,  // 34: shortlink.RollbackShortLinkResponse.history:type_name -> shortlink.LinkHistoryRecord
+	72,  // 35: shortlink.ExportShortLinkResponse.job:type_name -> shortlink.ShortLinkExportJob
+	72,  // 36: shortlink.GetExportJobResponse.job:type_name -> shortlink.ShortLinkExportJob
+	83,  // 37: shortlink.RegisterUserDomainResponse.domain:type_name -> shortlink.UserDomain
+	83,  // 38: shortlink.VerifyUserDomainResponse.domain:type_name -> shortlink.UserDomain
+	83,  // 39: shortlink.ListUserDomainResponse.domains:type_name -> shortlink.UserDomain
+	90,  // 40: shortlink.ListRedirectRuleResponse.rules:type_name -> shortlink.RedirectRule
+	99,  // 41: shortlink.ListLinkTemplateResponse.templates:type_name -> shortlink.LinkTemplate
+	108, // 42: shortlink.GetGroupExpiryPolicyResponse.policy:type_name -> shortlink.GroupExpiryPolicy
+	113, // 43: shortlink.ListGroupTransferResponse.incoming:type_name -> shortlink.GroupTransferRecord
+	113, // 44: shortlink.ListGroupTransferResponse.outgoing:type_name -> shortlink.GroupTransferRecord
+	122, // 45: shortlink.PageModerationResponse.records:type_name -> shortlink.ModerationRecord
+	129, // 46: shortlink.GetDomainAppLinksResponse.app_links:type_name -> shortlink.DomainAppLinks
+	1,   // 47: shortlink.ShortLinkService.ShortLinkCreate:input_type -> shortlink.CreateShortLinkRequest
+	3,   // 48: shortlink.ShortLinkService.ShortLinkBatchCreate:input_type -> shortlink.BatchCreateShortLinkRequest
+	6,   // 49: shortlink.ShortLinkService.ShortLinkUpdate:input_type -> shortlink.UpdateShortLinkRequest
+	8,   // 50: shortlink.ShortLinkService.ShortLinkPage:input_type -> shortlink.PageShortLinkRequest
+	11,  // 51: shortlink.ShortLinkService.ShortLinkSearch:input_type -> shortlink.SearchShortLinkRequest
+	45,  // 52: shortlink.ShortLinkService.ShortLinkQrCode:input_type -> shortlink.ShortLinkQrCodeRequest
+	47,  // 53: shortlink.ShortLinkService.ShortLinkListGroupCount:input_type -> shortlink.GroupShortLinkCountRequest
+	50,  // 54: shortlink.ShortLinkService.ShortLinkMove:input_type -> shortlink.MoveShortLinkRequest
+	52,  // 55: shortlink.ShortLinkService.ShortLinkClone:input_type -> shortlink.CloneShortLinkRequest
+	61,  // 56: shortlink.ShortLinkService.ShortLinkBulkAction:input_type -> shortlink.BulkActionRequest
+	66,  // 57: shortlink.ShortLinkService.ShortLinkHistoryList:input_type -> shortlink.ListLinkHistoryRequest
+	68,  // 58: shortlink.ShortLinkService.ShortLinkRollback:input_type -> shortlink.RollbackShortLinkRequest
+	54,  // 59: shortlink.ShortLinkService.ShortLinkImport:input_type -> shortlink.ImportShortLinkRequest
+	58,  // 60: shortlink.ShortLinkService.ShortLinkImportJobGet:input_type -> shortlink.GetImportJobRequest
+	70,  // 61: shortlink.ShortLinkService.ShortLinkExport:input_type -> shortlink.ExportShortLinkRequest
+	73,  // 62: shortlink.ShortLinkService.ShortLinkExportJobGet:input_type -> shortlink.GetExportJobRequest
+	75,  // 63: shortlink.ShortLinkService.ShortLinkExportDownload:input_type -> shortlink.DownloadExportRequest
+	77,  // 64: shortlink.ShortLinkService.RestoreUrl:input_type -> shortlink.RestoreUrlRequest
+	79,  // 65: shortlink.ShortLinkService.VerifyLinkPassword:input_type -> shortlink.VerifyLinkPasswordRequest
+	81,  // 66: shortlink.ShortLinkService.ShortLinkStats:input_type -> shortlink.ShortLinkStatsRequest
+	13,  // 67: shortlink.ShortLinkService.RecycleBinSave:input_type -> shortlink.SaveToRecycleBinRequest
+	15,  // 68: shortlink.ShortLinkService.RecycleBinRecover:input_type -> shortlink.RecoverFromRecycleBinRequest
+	17,  // 69: shortlink.ShortLinkService.RecycleBinRemove:input_type -> shortlink.RemoveFromRecycleBinRequest
+	19,  // 70: shortlink.ShortLinkService.RecycleBinPage:input_type -> shortlink.PageRecycleBinShortLinkRequest
+	21,  // 71: shortlink.ShortLinkService.StatsGetSingle:input_type -> shortlink.GetSingleStatsRequest
+	33,  // 72: shortlink.ShortLinkService.StatsGetGroup:input_type -> shortlink.GetGroupStatsRequest
+	37,  // 73: shortlink.ShortLinkService.StatsAccessRecordQuery:input_type -> shortlink.AccessRecordQueryRequest
+	39,  // 74: shortlink.ShortLinkService.StatsGroupAccessRecordQuery:input_type -> shortlink.GroupAccessRecordQueryRequest
+	84,  // 75: shortlink.ShortLinkService.UserDomainRegister:input_type -> shortlink.RegisterUserDomainRequest
+	86,  // 76: shortlink.ShortLinkService.UserDomainVerify:input_type -> shortlink.VerifyUserDomainRequest
+	88,  // 77: shortlink.ShortLinkService.UserDomainList:input_type -> shortlink.ListUserDomainRequest
+	91,  // 78: shortlink.ShortLinkService.RedirectRuleCreate:input_type -> shortlink.CreateRedirectRuleRequest
+	93,  // 79: shortlink.ShortLinkService.RedirectRuleUpdate:input_type -> shortlink.UpdateRedirectRuleRequest
+	95,  // 80: shortlink.ShortLinkService.RedirectRuleDelete:input_type -> shortlink.DeleteRedirectRuleRequest
+	97,  // 81: shortlink.ShortLinkService.RedirectRuleList:input_type -> shortlink.ListRedirectRuleRequest
+	100, // 82: shortlink.ShortLinkService.LinkTemplateCreate:input_type -> shortlink.CreateLinkTemplateRequest
+	102, // 83: shortlink.ShortLinkService.LinkTemplateUpdate:input_type -> shortlink.UpdateLinkTemplateRequest
+	104, // 84: shortlink.ShortLinkService.LinkTemplateDelete:input_type -> shortlink.DeleteLinkTemplateRequest
+	106, // 85: shortlink.ShortLinkService.LinkTemplateList:input_type -> shortlink.ListLinkTemplateRequest
+	109, // 86: shortlink.ShortLinkService.GroupExpiryPolicySave:input_type -> shortlink.SaveGroupExpiryPolicyRequest
+	111, // 87: shortlink.ShortLinkService.GroupExpiryPolicyGet:input_type -> shortlink.GetGroupExpiryPolicyRequest
+	114, // 88: shortlink.ShortLinkService.GroupTransferCreate:input_type -> shortlink.CreateGroupTransferRequest
+	116, // 89: shortlink.ShortLinkService.GroupTransferList:input_type -> shortlink.ListGroupTransferRequest
+	118, // 90: shortlink.ShortLinkService.GroupTransferRespond:input_type -> shortlink.RespondGroupTransferRequest
+	120, // 91: shortlink.ShortLinkService.GroupTransferCancel:input_type -> shortlink.CancelGroupTransferRequest
+	123, // 92: shortlink.ShortLinkService.ModerationPage:input_type -> shortlink.PageModerationRequest
+	125, // 93: shortlink.ShortLinkService.ModerationReview:input_type -> shortlink.ReviewModerationRequest
+	127, // 94: shortlink.ShortLinkService.ModerationFlag:input_type -> shortlink.FlagModerationRequest
+	130, // 95: shortlink.ShortLinkService.DomainAppLinksSave:input_type -> shortlink.SaveDomainAppLinksRequest
+	132, // 96: shortlink.ShortLinkService.DomainAppLinksGet:input_type -> shortlink.GetDomainAppLinksRequest
+	41,  // 97: shortlink.ShortLinkService.UrlTitleGet:input_type -> shortlink.GetUrlTitleRequest
+	43,  // 98: shortlink.ShortLinkService.ShortLinkPreview:input_type -> shortlink.ShortLinkPreviewRequest
+	134, // 99: shortlink.ShortLinkService.GetIpLocation:input_type -> shortlink.GetIPLocationRequest
+	2,   // 100: shortlink.ShortLinkService.ShortLinkCreate:output_type -> shortlink.CreateShortLinkResponse
+	5,   // 101: shortlink.ShortLinkService.ShortLinkBatchCreate:output_type -> shortlink.BatchCreateShortLinkResponse
+	7,   // 102: shortlink.ShortLinkService.ShortLinkUpdate:output_type -> shortlink.UpdateShortLinkResponse
+	10,  // 103: shortlink.ShortLinkService.ShortLinkPage:output_type -> shortlink.PageShortLinkResponse
+	12,  // 104: shortlink.ShortLinkService.ShortLinkSearch:output_type -> shortlink.SearchShortLinkResponse
+	46,  // 105: shortlink.ShortLinkService.ShortLinkQrCode:output_type -> shortlink.ShortLinkQrCodeResponse
+	49,  // 106: shortlink.ShortLinkService.ShortLinkListGroupCount:output_type -> shortlink.GroupShortLinkCountResponse
+	51,  // 107: shortlink.ShortLinkService.ShortLinkMove:output_type -> shortlink.MoveShortLinkResponse
+	53,  // 108: shortlink.ShortLinkService.ShortLinkClone:output_type -> shortlink.CloneShortLinkResponse
+	63,  // 109: shortlink.ShortLinkService.ShortLinkBulkAction:output_type -> shortlink.BulkActionResponse
+	67,  // 110: shortlink.ShortLinkService.ShortLinkHistoryList:output_type -> shortlink.ListLinkHistoryResponse
+	69,  // 111: shortlink.ShortLinkService.ShortLinkRollback:output_type -> shortlink.RollbackShortLinkResponse
+	55,  // 112: shortlink.ShortLinkService.ShortLinkImport:output_type -> shortlink.ImportShortLinkResponse
+	59,  // 113: shortlink.ShortLinkService.ShortLinkImportJobGet:output_type -> shortlink.GetImportJobResponse
+	71,  // 114: shortlink.ShortLinkService.ShortLinkExport:output_type -> shortlink.ExportShortLinkResponse
+	74,  // 115: shortlink.ShortLinkService.ShortLinkExportJobGet:output_type -> shortlink.GetExportJobResponse
+	76,  // 116: shortlink.ShortLinkService.ShortLinkExportDownload:output_type -> shortlink.DownloadExportResponse
+	78,  // 117: shortlink.ShortLinkService.RestoreUrl:output_type -> shortlink.RestoreUrlResponse
+	80,  // 118: shortlink.ShortLinkService.VerifyLinkPassword:output_type -> shortlink.VerifyLinkPasswordResponse
+	82,  // 119: shortlink.ShortLinkService.ShortLinkStats:output_type -> shortlink.EmptyResponse
+	14,  // 120: shortlink.ShortLinkService.RecycleBinSave:output_type -> shortlink.SaveToRecycleBinResponse
+	16,  // 121: shortlink.ShortLinkService.RecycleBinRecover:output_type -> shortlink.RecoverFromRecycleBinResponse
+	18,  // 122: shortlink.ShortLinkService.RecycleBinRemove:output_type -> shortlink.RemoveFromRecycleBinResponse
+	20,  // 123: shortlink.ShortLinkService.RecycleBinPage:output_type -> shortlink.PageRecycleBinShortLinkResponse
+	32,  // 124: shortlink.ShortLinkService.StatsGetSingle:output_type -> shortlink.GetSingleStatsResponse
+	34,  // 125: shortlink.ShortLinkService.StatsGetGroup:output_type -> shortlink.GetGroupStatsResponse
+	38,  // 126: shortlink.ShortLinkService.StatsAccessRecordQuery:output_type -> shortlink.AccessRecordQueryResponse
+	40,  // 127: shortlink.ShortLinkService.StatsGroupAccessRecordQuery:output_type -> shortlink.GroupAccessRecordQueryResponse
+	85,  // 128: shortlink.ShortLinkService.UserDomainRegister:output_type -> shortlink.RegisterUserDomainResponse
+	87,  // 129: shortlink.ShortLinkService.UserDomainVerify:output_type -> shortlink.VerifyUserDomainResponse
+	89,  // 130: shortlink.ShortLinkService.UserDomainList:output_type -> shortlink.ListUserDomainResponse
+	92,  // 131: shortlink.ShortLinkService.RedirectRuleCreate:output_type -> shortlink.CreateRedirectRuleResponse
+	94,  // 132: shortlink.ShortLinkService.RedirectRuleUpdate:output_type -> shortlink.UpdateRedirectRuleResponse
+	96,  // 133: shortlink.ShortLinkService.RedirectRuleDelete:output_type -> shortlink.DeleteRedirectRuleResponse
+	98,  // 134: shortlink.ShortLinkService.RedirectRuleList:output_type -> shortlink.ListRedirectRuleResponse
+	101, // 135: shortlink.ShortLinkService.LinkTemplateCreate:output_type -> shortlink.CreateLinkTemplateResponse
+	103, // 136: shortlink.ShortLinkService.LinkTemplateUpdate:output_type -> shortlink.UpdateLinkTemplateResponse
+	105, // 137: shortlink.ShortLinkService.LinkTemplateDelete:output_type -> shortlink.DeleteLinkTemplateResponse
+	107, // 138: shortlink.ShortLinkService.LinkTemplateList:output_type -> shortlink.ListLinkTemplateResponse
+	110, // 139: shortlink.ShortLinkService.GroupExpiryPolicySave:output_type -> shortlink.SaveGroupExpiryPolicyResponse
+	112, // 140: shortlink.ShortLinkService.GroupExpiryPolicyGet:output_type -> shortlink.GetGroupExpiryPolicyResponse
+	115, // 141: shortlink.ShortLinkService.GroupTransferCreate:output_type -> shortlink.CreateGroupTransferResponse
+	117, // 142: shortlink.ShortLinkService.GroupTransferList:output_type -> shortlink.ListGroupTransferResponse
+	119, // 143: shortlink.ShortLinkService.GroupTransferRespond:output_type -> shortlink.RespondGroupTransferResponse
+	121, // 144: shortlink.ShortLinkService.GroupTransferCancel:output_type -> shortlink.CancelGroupTransferResponse
+	124, // 145: shortlink.ShortLinkService.ModerationPage:output_type -> shortlink.PageModerationResponse
+	126, // 146: shortlink.ShortLinkService.ModerationReview:output_type -> shortlink.ReviewModerationResponse
+	128, // 147: shortlink.ShortLinkService.ModerationFlag:output_type -> shortlink.FlagModerationResponse
+	131, // 148: shortlink.ShortLinkService.DomainAppLinksSave:output_type -> shortlink.SaveDomainAppLinksResponse
+	133, // 149: shortlink.ShortLinkService.DomainAppLinksGet:output_type -> shortlink.GetDomainAppLinksResponse
+	42,  // 150: shortlink.ShortLinkService.UrlTitleGet:output_type -> shortlink.GetUrlTitleResponse
+	44,  // 151: shortlink.ShortLinkService.ShortLinkPreview:output_type -> shortlink.ShortLinkPreviewResponse
+	135, // 152: shortlink.ShortLinkService.GetIpLocation:output_type -> shortlink.GetIPLocationResponse
+	100, // [100:153] is the sub-list for method output_type
+	47,  // [47:100] is the sub-list for method input_type
+	47,  // [47:47] is the sub-list for extension type_name
+	47,  // [47:47] is the sub-list for extension extendee
+	0,   // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_link_proto_init() }
@@ -3568,13 +10980,14 @@ func file_link_proto_init() {
 	if File_link_proto != nil {
 		return
 	}
+	file_link_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_link_proto_rawDesc), len(file_link_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   136,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,8 +23,21 @@ const (
 	ShortLinkService_ShortLinkBatchCreate_FullMethodName        = "/shortlink.ShortLinkService/ShortLinkBatchCreate"
 	ShortLinkService_ShortLinkUpdate_FullMethodName             = "/shortlink.ShortLinkService/ShortLinkUpdate"
 	ShortLinkService_ShortLinkPage_FullMethodName               = "/shortlink.ShortLinkService/ShortLinkPage"
+	ShortLinkService_ShortLinkSearch_FullMethodName             = "/shortlink.ShortLinkService/ShortLinkSearch"
+	ShortLinkService_ShortLinkQrCode_FullMethodName             = "/shortlink.ShortLinkService/ShortLinkQrCode"
 	ShortLinkService_ShortLinkListGroupCount_FullMethodName     = "/shortlink.ShortLinkService/ShortLinkListGroupCount"
+	ShortLinkService_ShortLinkMove_FullMethodName               = "/shortlink.ShortLinkService/ShortLinkMove"
+	ShortLinkService_ShortLinkClone_FullMethodName              = "/shortlink.ShortLinkService/ShortLinkClone"
+	ShortLinkService_ShortLinkBulkAction_FullMethodName         = "/shortlink.ShortLinkService/ShortLinkBulkAction"
+	ShortLinkService_ShortLinkHistoryList_FullMethodName        = "/shortlink.ShortLinkService/ShortLinkHistoryList"
+	ShortLinkService_ShortLinkRollback_FullMethodName           = "/shortlink.ShortLinkService/ShortLinkRollback"
+	ShortLinkService_ShortLinkImport_FullMethodName             = "/shortlink.ShortLinkService/ShortLinkImport"
+	ShortLinkService_ShortLinkImportJobGet_FullMethodName       = "/shortlink.ShortLinkService/ShortLinkImportJobGet"
+	ShortLinkService_ShortLinkExport_FullMethodName             = "/shortlink.ShortLinkService/ShortLinkExport"
+	ShortLinkService_ShortLinkExportJobGet_FullMethodName       = "/shortlink.ShortLinkService/ShortLinkExportJobGet"
+	ShortLinkService_ShortLinkExportDownload_FullMethodName     = "/shortlink.ShortLinkService/ShortLinkExportDownload"
 	ShortLinkService_RestoreUrl_FullMethodName                  = "/shortlink.ShortLinkService/RestoreUrl"
+	ShortLinkService_VerifyLinkPassword_FullMethodName          = "/shortlink.ShortLinkService/VerifyLinkPassword"
 	ShortLinkService_ShortLinkStats_FullMethodName              = "/shortlink.ShortLinkService/ShortLinkStats"
 	ShortLinkService_RecycleBinSave_FullMethodName              = "/shortlink.ShortLinkService/RecycleBinSave"
 	ShortLinkService_RecycleBinRecover_FullMethodName           = "/shortlink.ShortLinkService/RecycleBinRecover"
@@ -34,7 +47,30 @@ const (
 	ShortLinkService_StatsGetGroup_FullMethodName               = "/shortlink.ShortLinkService/StatsGetGroup"
 	ShortLinkService_StatsAccessRecordQuery_FullMethodName      = "/shortlink.ShortLinkService/StatsAccessRecordQuery"
 	ShortLinkService_StatsGroupAccessRecordQuery_FullMethodName = "/shortlink.ShortLinkService/StatsGroupAccessRecordQuery"
+	ShortLinkService_UserDomainRegister_FullMethodName          = "/shortlink.ShortLinkService/UserDomainRegister"
+	ShortLinkService_UserDomainVerify_FullMethodName            = "/shortlink.ShortLinkService/UserDomainVerify"
+	ShortLinkService_UserDomainList_FullMethodName              = "/shortlink.ShortLinkService/UserDomainList"
+	ShortLinkService_RedirectRuleCreate_FullMethodName          = "/shortlink.ShortLinkService/RedirectRuleCreate"
+	ShortLinkService_RedirectRuleUpdate_FullMethodName          = "/shortlink.ShortLinkService/RedirectRuleUpdate"
+	ShortLinkService_RedirectRuleDelete_FullMethodName          = "/shortlink.ShortLinkService/RedirectRuleDelete"
+	ShortLinkService_RedirectRuleList_FullMethodName            = "/shortlink.ShortLinkService/RedirectRuleList"
+	ShortLinkService_LinkTemplateCreate_FullMethodName          = "/shortlink.ShortLinkService/LinkTemplateCreate"
+	ShortLinkService_LinkTemplateUpdate_FullMethodName          = "/shortlink.ShortLinkService/LinkTemplateUpdate"
+	ShortLinkService_LinkTemplateDelete_FullMethodName          = "/shortlink.ShortLinkService/LinkTemplateDelete"
+	ShortLinkService_LinkTemplateList_FullMethodName            = "/shortlink.ShortLinkService/LinkTemplateList"
+	ShortLinkService_GroupExpiryPolicySave_FullMethodName       = "/shortlink.ShortLinkService/GroupExpiryPolicySave"
+	ShortLinkService_GroupExpiryPolicyGet_FullMethodName        = "/shortlink.ShortLinkService/GroupExpiryPolicyGet"
+	ShortLinkService_GroupTransferCreate_FullMethodName         = "/shortlink.ShortLinkService/GroupTransferCreate"
+	ShortLinkService_GroupTransferList_FullMethodName           = "/shortlink.ShortLinkService/GroupTransferList"
+	ShortLinkService_GroupTransferRespond_FullMethodName        = "/shortlink.ShortLinkService/GroupTransferRespond"
+	ShortLinkService_GroupTransferCancel_FullMethodName         = "/shortlink.ShortLinkService/GroupTransferCancel"
+	ShortLinkService_ModerationPage_FullMethodName              = "/shortlink.ShortLinkService/ModerationPage"
+	ShortLinkService_ModerationReview_FullMethodName            = "/shortlink.ShortLinkService/ModerationReview"
+	ShortLinkService_ModerationFlag_FullMethodName              = "/shortlink.ShortLinkService/ModerationFlag"
+	ShortLinkService_DomainAppLinksSave_FullMethodName          = "/shortlink.ShortLinkService/DomainAppLinksSave"
+	ShortLinkService_DomainAppLinksGet_FullMethodName           = "/shortlink.ShortLinkService/DomainAppLinksGet"
 	ShortLinkService_UrlTitleGet_FullMethodName                 = "/shortlink.ShortLinkService/UrlTitleGet"
+	ShortLinkService_ShortLinkPreview_FullMethodName            = "/shortlink.ShortLinkService/ShortLinkPreview"
 	ShortLinkService_GetIpLocation_FullMethodName               = "/shortlink.ShortLinkService/GetIpLocation"
 )
 
@@ -49,10 +85,36 @@ type ShortLinkServiceClient interface {
 	ShortLinkBatchCreate(ctx context.Context, in *BatchCreateShortLinkRequest, opts ...grpc.CallOption) (*BatchCreateShortLinkResponse, error)
 	ShortLinkUpdate(ctx context.Context, in *UpdateShortLinkRequest, opts ...grpc.CallOption) (*UpdateShortLinkResponse, error)
 	ShortLinkPage(ctx context.Context, in *PageShortLinkRequest, opts ...grpc.CallOption) (*PageShortLinkResponse, error)
+	// 跨分组搜索短链接
+	ShortLinkSearch(ctx context.Context, in *SearchShortLinkRequest, opts ...grpc.CallOption) (*SearchShortLinkResponse, error)
+	// 生成短链接二维码
+	ShortLinkQrCode(ctx context.Context, in *ShortLinkQrCodeRequest, opts ...grpc.CallOption) (*ShortLinkQrCodeResponse, error)
 	// 查询短链接分组内数量
 	ShortLinkListGroupCount(ctx context.Context, in *GroupShortLinkCountRequest, opts ...grpc.CallOption) (*GroupShortLinkCountResponse, error)
+	// 移动短链接到其他分组
+	ShortLinkMove(ctx context.Context, in *MoveShortLinkRequest, opts ...grpc.CallOption) (*MoveShortLinkResponse, error)
+	// 复制短链接的配置到新的短链接
+	ShortLinkClone(ctx context.Context, in *CloneShortLinkRequest, opts ...grpc.CallOption) (*CloneShortLinkResponse, error)
+	// 批量操作短链接
+	ShortLinkBulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error)
+	// 查询短链接变更记录
+	ShortLinkHistoryList(ctx context.Context, in *ListLinkHistoryRequest, opts ...grpc.CallOption) (*ListLinkHistoryResponse, error)
+	// 回滚短链接到指定变更记录
+	ShortLinkRollback(ctx context.Context, in *RollbackShortLinkRequest, opts ...grpc.CallOption) (*RollbackShortLinkResponse, error)
+	// 批量导入短链接
+	ShortLinkImport(ctx context.Context, in *ImportShortLinkRequest, opts ...grpc.CallOption) (*ImportShortLinkResponse, error)
+	// 查询短链接导入任务
+	ShortLinkImportJobGet(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error)
+	// 导出短链接及访问数据
+	ShortLinkExport(ctx context.Context, in *ExportShortLinkRequest, opts ...grpc.CallOption) (*ExportShortLinkResponse, error)
+	// 查询短链接导出任务
+	ShortLinkExportJobGet(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error)
+	// 下载短链接导出文件
+	ShortLinkExportDownload(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (*DownloadExportResponse, error)
 	// 短链接跳转
 	RestoreUrl(ctx context.Context, in *RestoreUrlRequest, opts ...grpc.CallOption) (*RestoreUrlResponse, error)
+	// 验证短链接访问密码
+	VerifyLinkPassword(ctx context.Context, in *VerifyLinkPasswordRequest, opts ...grpc.CallOption) (*VerifyLinkPasswordResponse, error)
 	// 短链接统计
 	ShortLinkStats(ctx context.Context, in *ShortLinkStatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// --------------------- 回收站管理接口 ---------------------
@@ -65,8 +127,38 @@ type ShortLinkServiceClient interface {
 	StatsGetGroup(ctx context.Context, in *GetGroupStatsRequest, opts ...grpc.CallOption) (*GetGroupStatsResponse, error)
 	StatsAccessRecordQuery(ctx context.Context, in *AccessRecordQueryRequest, opts ...grpc.CallOption) (*AccessRecordQueryResponse, error)
 	StatsGroupAccessRecordQuery(ctx context.Context, in *GroupAccessRecordQueryRequest, opts ...grpc.CallOption) (*GroupAccessRecordQueryResponse, error)
+	// --------------------- 自定义域名接口 ---------------------
+	UserDomainRegister(ctx context.Context, in *RegisterUserDomainRequest, opts ...grpc.CallOption) (*RegisterUserDomainResponse, error)
+	UserDomainVerify(ctx context.Context, in *VerifyUserDomainRequest, opts ...grpc.CallOption) (*VerifyUserDomainResponse, error)
+	UserDomainList(ctx context.Context, in *ListUserDomainRequest, opts ...grpc.CallOption) (*ListUserDomainResponse, error)
+	// --------------------- 跳转规则管理接口 ---------------------
+	RedirectRuleCreate(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error)
+	RedirectRuleUpdate(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error)
+	RedirectRuleDelete(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error)
+	RedirectRuleList(ctx context.Context, in *ListRedirectRuleRequest, opts ...grpc.CallOption) (*ListRedirectRuleResponse, error)
+	// --------------------- 短链接模板接口 ---------------------
+	LinkTemplateCreate(ctx context.Context, in *CreateLinkTemplateRequest, opts ...grpc.CallOption) (*CreateLinkTemplateResponse, error)
+	LinkTemplateUpdate(ctx context.Context, in *UpdateLinkTemplateRequest, opts ...grpc.CallOption) (*UpdateLinkTemplateResponse, error)
+	LinkTemplateDelete(ctx context.Context, in *DeleteLinkTemplateRequest, opts ...grpc.CallOption) (*DeleteLinkTemplateResponse, error)
+	LinkTemplateList(ctx context.Context, in *ListLinkTemplateRequest, opts ...grpc.CallOption) (*ListLinkTemplateResponse, error)
+	// --------------------- 分组过期策略接口 ---------------------
+	GroupExpiryPolicySave(ctx context.Context, in *SaveGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*SaveGroupExpiryPolicyResponse, error)
+	GroupExpiryPolicyGet(ctx context.Context, in *GetGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*GetGroupExpiryPolicyResponse, error)
+	// --------------------- 分组转让接口 ---------------------
+	GroupTransferCreate(ctx context.Context, in *CreateGroupTransferRequest, opts ...grpc.CallOption) (*CreateGroupTransferResponse, error)
+	GroupTransferList(ctx context.Context, in *ListGroupTransferRequest, opts ...grpc.CallOption) (*ListGroupTransferResponse, error)
+	GroupTransferRespond(ctx context.Context, in *RespondGroupTransferRequest, opts ...grpc.CallOption) (*RespondGroupTransferResponse, error)
+	GroupTransferCancel(ctx context.Context, in *CancelGroupTransferRequest, opts ...grpc.CallOption) (*CancelGroupTransferResponse, error)
+	// --------------------- 链接安全审核接口 ---------------------
+	ModerationPage(ctx context.Context, in *PageModerationRequest, opts ...grpc.CallOption) (*PageModerationResponse, error)
+	ModerationReview(ctx context.Context, in *ReviewModerationRequest, opts ...grpc.CallOption) (*ReviewModerationResponse, error)
+	ModerationFlag(ctx context.Context, in *FlagModerationRequest, opts ...grpc.CallOption) (*FlagModerationResponse, error)
+	// --------------------- 域名应用关联接口 ---------------------
+	DomainAppLinksSave(ctx context.Context, in *SaveDomainAppLinksRequest, opts ...grpc.CallOption) (*SaveDomainAppLinksResponse, error)
+	DomainAppLinksGet(ctx context.Context, in *GetDomainAppLinksRequest, opts ...grpc.CallOption) (*GetDomainAppLinksResponse, error)
 	// --------------------- URL标题功能接口 ---------------------
 	UrlTitleGet(ctx context.Context, in *GetUrlTitleRequest, opts ...grpc.CallOption) (*GetUrlTitleResponse, error)
+	ShortLinkPreview(ctx context.Context, in *ShortLinkPreviewRequest, opts ...grpc.CallOption) (*ShortLinkPreviewResponse, error)
 	// --------------------- IP位置查询接口 ---------------------
 	GetIpLocation(ctx context.Context, in *GetIPLocationRequest, opts ...grpc.CallOption) (*GetIPLocationResponse, error)
 }
@@ -119,6 +211,26 @@ func (c *shortLinkServiceClient) ShortLinkPage(ctx context.Context, in *PageShor
 	return out, nil
 }
 
+func (c *shortLinkServiceClient) ShortLinkSearch(ctx context.Context, in *SearchShortLinkRequest, opts ...grpc.CallOption) (*SearchShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkQrCode(ctx context.Context, in *ShortLinkQrCodeRequest, opts ...grpc.CallOption) (*ShortLinkQrCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortLinkQrCodeResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkQrCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortLinkServiceClient) ShortLinkListGroupCount(ctx context.Context, in *GroupShortLinkCountRequest, opts ...grpc.CallOption) (*GroupShortLinkCountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GroupShortLinkCountResponse)
@@ -129,6 +241,106 @@ func (c *shortLinkServiceClient) ShortLinkListGroupCount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *shortLinkServiceClient) ShortLinkMove(ctx context.Context, in *MoveShortLinkRequest, opts ...grpc.CallOption) (*MoveShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkMove_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkClone(ctx context.Context, in *CloneShortLinkRequest, opts ...grpc.CallOption) (*CloneShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkClone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkBulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkActionResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkBulkAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkHistoryList(ctx context.Context, in *ListLinkHistoryRequest, opts ...grpc.CallOption) (*ListLinkHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkHistoryResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkHistoryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkRollback(ctx context.Context, in *RollbackShortLinkRequest, opts ...grpc.CallOption) (*RollbackShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkRollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkImport(ctx context.Context, in *ImportShortLinkRequest, opts ...grpc.CallOption) (*ImportShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkImportJobGet(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportJobResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkImportJobGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkExport(ctx context.Context, in *ExportShortLinkRequest, opts ...grpc.CallOption) (*ExportShortLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportShortLinkResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkExportJobGet(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportJobResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkExportJobGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ShortLinkExportDownload(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (*DownloadExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadExportResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkExportDownload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortLinkServiceClient) RestoreUrl(ctx context.Context, in *RestoreUrlRequest, opts ...grpc.CallOption) (*RestoreUrlResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RestoreUrlResponse)
@@ -139,6 +351,16 @@ func (c *shortLinkServiceClient) RestoreUrl(ctx context.Context, in *RestoreUrlR
 	return out, nil
 }
 
+func (c *shortLinkServiceClient) VerifyLinkPassword(ctx context.Context, in *VerifyLinkPasswordRequest, opts ...grpc.CallOption) (*VerifyLinkPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLinkPasswordResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_VerifyLinkPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortLinkServiceClient) ShortLinkStats(ctx context.Context, in *ShortLinkStatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyResponse)
@@ -229,6 +451,226 @@ func (c *shortLinkServiceClient) StatsGroupAccessRecordQuery(ctx context.Context
 	return out, nil
 }
 
+func (c *shortLinkServiceClient) UserDomainRegister(ctx context.Context, in *RegisterUserDomainRequest, opts ...grpc.CallOption) (*RegisterUserDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterUserDomainResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_UserDomainRegister_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) UserDomainVerify(ctx context.Context, in *VerifyUserDomainRequest, opts ...grpc.CallOption) (*VerifyUserDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyUserDomainResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_UserDomainVerify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) UserDomainList(ctx context.Context, in *ListUserDomainRequest, opts ...grpc.CallOption) (*ListUserDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserDomainResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_UserDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) RedirectRuleCreate(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_RedirectRuleCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) RedirectRuleUpdate(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_RedirectRuleUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) RedirectRuleDelete(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_RedirectRuleDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) RedirectRuleList(ctx context.Context, in *ListRedirectRuleRequest, opts ...grpc.CallOption) (*ListRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_RedirectRuleList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) LinkTemplateCreate(ctx context.Context, in *CreateLinkTemplateRequest, opts ...grpc.CallOption) (*CreateLinkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLinkTemplateResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_LinkTemplateCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) LinkTemplateUpdate(ctx context.Context, in *UpdateLinkTemplateRequest, opts ...grpc.CallOption) (*UpdateLinkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLinkTemplateResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_LinkTemplateUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) LinkTemplateDelete(ctx context.Context, in *DeleteLinkTemplateRequest, opts ...grpc.CallOption) (*DeleteLinkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLinkTemplateResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_LinkTemplateDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) LinkTemplateList(ctx context.Context, in *ListLinkTemplateRequest, opts ...grpc.CallOption) (*ListLinkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkTemplateResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_LinkTemplateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupExpiryPolicySave(ctx context.Context, in *SaveGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*SaveGroupExpiryPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveGroupExpiryPolicyResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupExpiryPolicySave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupExpiryPolicyGet(ctx context.Context, in *GetGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*GetGroupExpiryPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupExpiryPolicyResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupExpiryPolicyGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupTransferCreate(ctx context.Context, in *CreateGroupTransferRequest, opts ...grpc.CallOption) (*CreateGroupTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupTransferResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupTransferCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupTransferList(ctx context.Context, in *ListGroupTransferRequest, opts ...grpc.CallOption) (*ListGroupTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupTransferResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupTransferList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupTransferRespond(ctx context.Context, in *RespondGroupTransferRequest, opts ...grpc.CallOption) (*RespondGroupTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondGroupTransferResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupTransferRespond_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) GroupTransferCancel(ctx context.Context, in *CancelGroupTransferRequest, opts ...grpc.CallOption) (*CancelGroupTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGroupTransferResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_GroupTransferCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ModerationPage(ctx context.Context, in *PageModerationRequest, opts ...grpc.CallOption) (*PageModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageModerationResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ModerationPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ModerationReview(ctx context.Context, in *ReviewModerationRequest, opts ...grpc.CallOption) (*ReviewModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewModerationResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ModerationReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) ModerationFlag(ctx context.Context, in *FlagModerationRequest, opts ...grpc.CallOption) (*FlagModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagModerationResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ModerationFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) DomainAppLinksSave(ctx context.Context, in *SaveDomainAppLinksRequest, opts ...grpc.CallOption) (*SaveDomainAppLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDomainAppLinksResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_DomainAppLinksSave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortLinkServiceClient) DomainAppLinksGet(ctx context.Context, in *GetDomainAppLinksRequest, opts ...grpc.CallOption) (*GetDomainAppLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDomainAppLinksResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_DomainAppLinksGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortLinkServiceClient) UrlTitleGet(ctx context.Context, in *GetUrlTitleRequest, opts ...grpc.CallOption) (*GetUrlTitleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUrlTitleResponse)
@@ -239,6 +681,16 @@ func (c *shortLinkServiceClient) UrlTitleGet(ctx context.Context, in *GetUrlTitl
 	return out, nil
 }
 
+func (c *shortLinkServiceClient) ShortLinkPreview(ctx context.Context, in *ShortLinkPreviewRequest, opts ...grpc.CallOption) (*ShortLinkPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortLinkPreviewResponse)
+	err := c.cc.Invoke(ctx, ShortLinkService_ShortLinkPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortLinkServiceClient) GetIpLocation(ctx context.Context, in *GetIPLocationRequest, opts ...grpc.CallOption) (*GetIPLocationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetIPLocationResponse)
@@ -260,10 +712,36 @@ type ShortLinkServiceServer interface {
 	ShortLinkBatchCreate(context.Context, *BatchCreateShortLinkRequest) (*BatchCreateShortLinkResponse, error)
 	ShortLinkUpdate(context.Context, *UpdateShortLinkRequest) (*UpdateShortLinkResponse, error)
 	ShortLinkPage(context.Context, *PageShortLinkRequest) (*PageShortLinkResponse, error)
+	// 跨分组搜索短链接
+	ShortLinkSearch(context.Context, *SearchShortLinkRequest) (*SearchShortLinkResponse, error)
+	// 生成短链接二维码
+	ShortLinkQrCode(context.Context, *ShortLinkQrCodeRequest) (*ShortLinkQrCodeResponse, error)
 	// 查询短链接分组内数量
 	ShortLinkListGroupCount(context.Context, *GroupShortLinkCountRequest) (*GroupShortLinkCountResponse, error)
+	// 移动短链接到其他分组
+	ShortLinkMove(context.Context, *MoveShortLinkRequest) (*MoveShortLinkResponse, error)
+	// 复制短链接的配置到新的短链接
+	ShortLinkClone(context.Context, *CloneShortLinkRequest) (*CloneShortLinkResponse, error)
+	// 批量操作短链接
+	ShortLinkBulkAction(context.Context, *BulkActionRequest) (*BulkActionResponse, error)
+	// 查询短链接变更记录
+	ShortLinkHistoryList(context.Context, *ListLinkHistoryRequest) (*ListLinkHistoryResponse, error)
+	// 回滚短链接到指定变更记录
+	ShortLinkRollback(context.Context, *RollbackShortLinkRequest) (*RollbackShortLinkResponse, error)
+	// 批量导入短链接
+	ShortLinkImport(context.Context, *ImportShortLinkRequest) (*ImportShortLinkResponse, error)
+	// 查询短链接导入任务
+	ShortLinkImportJobGet(context.Context, *GetImportJobRequest) (*GetImportJobResponse, error)
+	// 导出短链接及访问数据
+	ShortLinkExport(context.Context, *ExportShortLinkRequest) (*ExportShortLinkResponse, error)
+	// 查询短链接导出任务
+	ShortLinkExportJobGet(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error)
+	// 下载短链接导出文件
+	ShortLinkExportDownload(context.Context, *DownloadExportRequest) (*DownloadExportResponse, error)
 	// 短链接跳转
 	RestoreUrl(context.Context, *RestoreUrlRequest) (*RestoreUrlResponse, error)
+	// 验证短链接访问密码
+	VerifyLinkPassword(context.Context, *VerifyLinkPasswordRequest) (*VerifyLinkPasswordResponse, error)
 	// 短链接统计
 	ShortLinkStats(context.Context, *ShortLinkStatsRequest) (*EmptyResponse, error)
 	// --------------------- 回收站管理接口 ---------------------
@@ -276,8 +754,38 @@ type ShortLinkServiceServer interface {
 	StatsGetGroup(context.Context, *GetGroupStatsRequest) (*GetGroupStatsResponse, error)
 	StatsAccessRecordQuery(context.Context, *AccessRecordQueryRequest) (*AccessRecordQueryResponse, error)
 	StatsGroupAccessRecordQuery(context.Context, *GroupAccessRecordQueryRequest) (*GroupAccessRecordQueryResponse, error)
+	// --------------------- 自定义域名接口 ---------------------
+	UserDomainRegister(context.Context, *RegisterUserDomainRequest) (*RegisterUserDomainResponse, error)
+	UserDomainVerify(context.Context, *VerifyUserDomainRequest) (*VerifyUserDomainResponse, error)
+	UserDomainList(context.Context, *ListUserDomainRequest) (*ListUserDomainResponse, error)
+	// --------------------- 跳转规则管理接口 ---------------------
+	RedirectRuleCreate(context.Context, *CreateRedirectRuleRequest) (*CreateRedirectRuleResponse, error)
+	RedirectRuleUpdate(context.Context, *UpdateRedirectRuleRequest) (*UpdateRedirectRuleResponse, error)
+	RedirectRuleDelete(context.Context, *DeleteRedirectRuleRequest) (*DeleteRedirectRuleResponse, error)
+	RedirectRuleList(context.Context, *ListRedirectRuleRequest) (*ListRedirectRuleResponse, error)
+	// --------------------- 短链接模板接口 ---------------------
+	LinkTemplateCreate(context.Context, *CreateLinkTemplateRequest) (*CreateLinkTemplateResponse, error)
+	LinkTemplateUpdate(context.Context, *UpdateLinkTemplateRequest) (*UpdateLinkTemplateResponse, error)
+	LinkTemplateDelete(context.Context, *DeleteLinkTemplateRequest) (*DeleteLinkTemplateResponse, error)
+	LinkTemplateList(context.Context, *ListLinkTemplateRequest) (*ListLinkTemplateResponse, error)
+	// --------------------- 分组过期策略接口 ---------------------
+	GroupExpiryPolicySave(context.Context, *SaveGroupExpiryPolicyRequest) (*SaveGroupExpiryPolicyResponse, error)
+	GroupExpiryPolicyGet(context.Context, *GetGroupExpiryPolicyRequest) (*GetGroupExpiryPolicyResponse, error)
+	// --------------------- 分组转让接口 ---------------------
+	GroupTransferCreate(context.Context, *CreateGroupTransferRequest) (*CreateGroupTransferResponse, error)
+	GroupTransferList(context.Context, *ListGroupTransferRequest) (*ListGroupTransferResponse, error)
+	GroupTransferRespond(context.Context, *RespondGroupTransferRequest) (*RespondGroupTransferResponse, error)
+	GroupTransferCancel(context.Context, *CancelGroupTransferRequest) (*CancelGroupTransferResponse, error)
+	// --------------------- 链接安全审核接口 ---------------------
+	ModerationPage(context.Context, *PageModerationRequest) (*PageModerationResponse, error)
+	ModerationReview(context.Context, *ReviewModerationRequest) (*ReviewModerationResponse, error)
+	ModerationFlag(context.Context, *FlagModerationRequest) (*FlagModerationResponse, error)
+	// --------------------- 域名应用关联接口 ---------------------
+	DomainAppLinksSave(context.Context, *SaveDomainAppLinksRequest) (*SaveDomainAppLinksResponse, error)
+	DomainAppLinksGet(context.Context, *GetDomainAppLinksRequest) (*GetDomainAppLinksResponse, error)
 	// --------------------- URL标题功能接口 ---------------------
 	UrlTitleGet(context.Context, *GetUrlTitleRequest) (*GetUrlTitleResponse, error)
+	ShortLinkPreview(context.Context, *ShortLinkPreviewRequest) (*ShortLinkPreviewResponse, error)
 	// --------------------- IP位置查询接口 ---------------------
 	GetIpLocation(context.Context, *GetIPLocationRequest) (*GetIPLocationResponse, error)
 	mustEmbedUnimplementedShortLinkServiceServer()
@@ -302,11 +810,50 @@ func (UnimplementedShortLinkServiceServer) ShortLinkUpdate(context.Context, *Upd
 func (UnimplementedShortLinkServiceServer) ShortLinkPage(context.Context, *PageShortLinkRequest) (*PageShortLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkPage not implemented")
 }
+func (UnimplementedShortLinkServiceServer) ShortLinkSearch(context.Context, *SearchShortLinkRequest) (*SearchShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkSearch not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkQrCode(context.Context, *ShortLinkQrCodeRequest) (*ShortLinkQrCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkQrCode not implemented")
+}
 func (UnimplementedShortLinkServiceServer) ShortLinkListGroupCount(context.Context, *GroupShortLinkCountRequest) (*GroupShortLinkCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkListGroupCount not implemented")
 }
-func (UnimplementedShortLinkServiceServer) RestoreUrl(context.Context, *RestoreUrlRequest) (*RestoreUrlResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestoreUrl not implemented")
+func (UnimplementedShortLinkServiceServer) ShortLinkMove(context.Context, *MoveShortLinkRequest) (*MoveShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkMove not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkClone(context.Context, *CloneShortLinkRequest) (*CloneShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkClone not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkBulkAction(context.Context, *BulkActionRequest) (*BulkActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkBulkAction not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkHistoryList(context.Context, *ListLinkHistoryRequest) (*ListLinkHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkHistoryList not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkRollback(context.Context, *RollbackShortLinkRequest) (*RollbackShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkRollback not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkImport(context.Context, *ImportShortLinkRequest) (*ImportShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkImport not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkImportJobGet(context.Context, *GetImportJobRequest) (*GetImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkImportJobGet not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkExport(context.Context, *ExportShortLinkRequest) (*ExportShortLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkExport not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkExportJobGet(context.Context, *GetExportJobRequest) (*GetExportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkExportJobGet not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkExportDownload(context.Context, *DownloadExportRequest) (*DownloadExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkExportDownload not implemented")
+}
+func (UnimplementedShortLinkServiceServer) RestoreUrl(context.Context, *RestoreUrlRequest) (*RestoreUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUrl not implemented")
+}
+func (UnimplementedShortLinkServiceServer) VerifyLinkPassword(context.Context, *VerifyLinkPasswordRequest) (*VerifyLinkPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLinkPassword not implemented")
 }
 func (UnimplementedShortLinkServiceServer) ShortLinkStats(context.Context, *ShortLinkStatsRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkStats not implemented")
@@ -329,305 +876,1004 @@ func (UnimplementedShortLinkServiceServer) StatsGetSingle(context.Context, *GetS
 func (UnimplementedShortLinkServiceServer) StatsGetGroup(context.Context, *GetGroupStatsRequest) (*GetGroupStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatsGetGroup not implemented")
 }
-func (UnimplementedShortLinkServiceServer) StatsAccessRecordQuery(context.Context, *AccessRecordQueryRequest) (*AccessRecordQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsAccessRecordQuery not implemented")
+func (UnimplementedShortLinkServiceServer) StatsAccessRecordQuery(context.Context, *AccessRecordQueryRequest) (*AccessRecordQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsAccessRecordQuery not implemented")
+}
+func (UnimplementedShortLinkServiceServer) StatsGroupAccessRecordQuery(context.Context, *GroupAccessRecordQueryRequest) (*GroupAccessRecordQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsGroupAccessRecordQuery not implemented")
+}
+func (UnimplementedShortLinkServiceServer) UserDomainRegister(context.Context, *RegisterUserDomainRequest) (*RegisterUserDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDomainRegister not implemented")
+}
+func (UnimplementedShortLinkServiceServer) UserDomainVerify(context.Context, *VerifyUserDomainRequest) (*VerifyUserDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDomainVerify not implemented")
+}
+func (UnimplementedShortLinkServiceServer) UserDomainList(context.Context, *ListUserDomainRequest) (*ListUserDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDomainList not implemented")
+}
+func (UnimplementedShortLinkServiceServer) RedirectRuleCreate(context.Context, *CreateRedirectRuleRequest) (*CreateRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectRuleCreate not implemented")
+}
+func (UnimplementedShortLinkServiceServer) RedirectRuleUpdate(context.Context, *UpdateRedirectRuleRequest) (*UpdateRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectRuleUpdate not implemented")
+}
+func (UnimplementedShortLinkServiceServer) RedirectRuleDelete(context.Context, *DeleteRedirectRuleRequest) (*DeleteRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectRuleDelete not implemented")
+}
+func (UnimplementedShortLinkServiceServer) RedirectRuleList(context.Context, *ListRedirectRuleRequest) (*ListRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectRuleList not implemented")
+}
+func (UnimplementedShortLinkServiceServer) LinkTemplateCreate(context.Context, *CreateLinkTemplateRequest) (*CreateLinkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTemplateCreate not implemented")
+}
+func (UnimplementedShortLinkServiceServer) LinkTemplateUpdate(context.Context, *UpdateLinkTemplateRequest) (*UpdateLinkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTemplateUpdate not implemented")
+}
+func (UnimplementedShortLinkServiceServer) LinkTemplateDelete(context.Context, *DeleteLinkTemplateRequest) (*DeleteLinkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTemplateDelete not implemented")
+}
+func (UnimplementedShortLinkServiceServer) LinkTemplateList(context.Context, *ListLinkTemplateRequest) (*ListLinkTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTemplateList not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupExpiryPolicySave(context.Context, *SaveGroupExpiryPolicyRequest) (*SaveGroupExpiryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExpiryPolicySave not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupExpiryPolicyGet(context.Context, *GetGroupExpiryPolicyRequest) (*GetGroupExpiryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupExpiryPolicyGet not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupTransferCreate(context.Context, *CreateGroupTransferRequest) (*CreateGroupTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupTransferCreate not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupTransferList(context.Context, *ListGroupTransferRequest) (*ListGroupTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupTransferList not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupTransferRespond(context.Context, *RespondGroupTransferRequest) (*RespondGroupTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupTransferRespond not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GroupTransferCancel(context.Context, *CancelGroupTransferRequest) (*CancelGroupTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupTransferCancel not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ModerationPage(context.Context, *PageModerationRequest) (*PageModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationPage not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ModerationReview(context.Context, *ReviewModerationRequest) (*ReviewModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationReview not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ModerationFlag(context.Context, *FlagModerationRequest) (*FlagModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationFlag not implemented")
+}
+func (UnimplementedShortLinkServiceServer) DomainAppLinksSave(context.Context, *SaveDomainAppLinksRequest) (*SaveDomainAppLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainAppLinksSave not implemented")
+}
+func (UnimplementedShortLinkServiceServer) DomainAppLinksGet(context.Context, *GetDomainAppLinksRequest) (*GetDomainAppLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainAppLinksGet not implemented")
+}
+func (UnimplementedShortLinkServiceServer) UrlTitleGet(context.Context, *GetUrlTitleRequest) (*GetUrlTitleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UrlTitleGet not implemented")
+}
+func (UnimplementedShortLinkServiceServer) ShortLinkPreview(context.Context, *ShortLinkPreviewRequest) (*ShortLinkPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortLinkPreview not implemented")
+}
+func (UnimplementedShortLinkServiceServer) GetIpLocation(context.Context, *GetIPLocationRequest) (*GetIPLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIpLocation not implemented")
+}
+func (UnimplementedShortLinkServiceServer) mustEmbedUnimplementedShortLinkServiceServer() {}
+func (UnimplementedShortLinkServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeShortLinkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShortLinkServiceServer will
+// result in compilation errors.
+type UnsafeShortLinkServiceServer interface {
+	mustEmbedUnimplementedShortLinkServiceServer()
+}
+
+func RegisterShortLinkServiceServer(s grpc.ServiceRegistrar, srv ShortLinkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShortLinkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShortLinkService_ServiceDesc, srv)
+}
+
+func _ShortLinkService_ShortLinkCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkCreate(ctx, req.(*CreateShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkBatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkBatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkBatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkBatchCreate(ctx, req.(*BatchCreateShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkUpdate(ctx, req.(*UpdateShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkPage(ctx, req.(*PageShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkSearch(ctx, req.(*SearchShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkQrCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortLinkQrCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkQrCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkQrCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkQrCode(ctx, req.(*ShortLinkQrCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkListGroupCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupShortLinkCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkListGroupCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkListGroupCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkListGroupCount(ctx, req.(*GroupShortLinkCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkMove(ctx, req.(*MoveShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkClone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkClone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkClone(ctx, req.(*CloneShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkBulkAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkBulkAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkBulkAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkBulkAction(ctx, req.(*BulkActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkHistoryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkHistoryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkHistoryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkHistoryList(ctx, req.(*ListLinkHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkRollback(ctx, req.(*RollbackShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkImport(ctx, req.(*ImportShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkImportJobGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkImportJobGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkImportJobGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkImportJobGet(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkExport(ctx, req.(*ExportShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkExportJobGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkExportJobGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkExportJobGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkExportJobGet(ctx, req.(*GetExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkExportDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkExportDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkExportDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkExportDownload(ctx, req.(*DownloadExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_RestoreUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RestoreUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RestoreUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RestoreUrl(ctx, req.(*RestoreUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_VerifyLinkPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLinkPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).VerifyLinkPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_VerifyLinkPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).VerifyLinkPassword(ctx, req.(*VerifyLinkPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_ShortLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortLinkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkStats(ctx, req.(*ShortLinkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_RecycleBinSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveToRecycleBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RecycleBinSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RecycleBinSave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RecycleBinSave(ctx, req.(*SaveToRecycleBinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_RecycleBinRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverFromRecycleBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RecycleBinRecover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RecycleBinRecover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RecycleBinRecover(ctx, req.(*RecoverFromRecycleBinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_RecycleBinRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromRecycleBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RecycleBinRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RecycleBinRemove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RecycleBinRemove(ctx, req.(*RemoveFromRecycleBinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_RecycleBinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRecycleBinShortLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RecycleBinPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RecycleBinPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RecycleBinPage(ctx, req.(*PageRecycleBinShortLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_StatsGetSingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSingleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).StatsGetSingle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_StatsGetSingle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).StatsGetSingle(ctx, req.(*GetSingleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_StatsGetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).StatsGetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_StatsGetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).StatsGetGroup(ctx, req.(*GetGroupStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_StatsAccessRecordQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessRecordQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).StatsAccessRecordQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_StatsAccessRecordQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).StatsAccessRecordQuery(ctx, req.(*AccessRecordQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_StatsGroupAccessRecordQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupAccessRecordQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).StatsGroupAccessRecordQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_StatsGroupAccessRecordQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).StatsGroupAccessRecordQuery(ctx, req.(*GroupAccessRecordQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_UserDomainRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).UserDomainRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_UserDomainRegister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).UserDomainRegister(ctx, req.(*RegisterUserDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortLinkService_UserDomainVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUserDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).UserDomainVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_UserDomainVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).UserDomainVerify(ctx, req.(*VerifyUserDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedShortLinkServiceServer) StatsGroupAccessRecordQuery(context.Context, *GroupAccessRecordQueryRequest) (*GroupAccessRecordQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsGroupAccessRecordQuery not implemented")
+
+func _ShortLinkService_UserDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).UserDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_UserDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).UserDomainList(ctx, req.(*ListUserDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedShortLinkServiceServer) UrlTitleGet(context.Context, *GetUrlTitleRequest) (*GetUrlTitleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UrlTitleGet not implemented")
+
+func _ShortLinkService_RedirectRuleCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RedirectRuleCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RedirectRuleCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RedirectRuleCreate(ctx, req.(*CreateRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedShortLinkServiceServer) GetIpLocation(context.Context, *GetIPLocationRequest) (*GetIPLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIpLocation not implemented")
+
+func _ShortLinkService_RedirectRuleUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RedirectRuleUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RedirectRuleUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RedirectRuleUpdate(ctx, req.(*UpdateRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedShortLinkServiceServer) mustEmbedUnimplementedShortLinkServiceServer() {}
-func (UnimplementedShortLinkServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeShortLinkServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ShortLinkServiceServer will
-// result in compilation errors.
-type UnsafeShortLinkServiceServer interface {
-	mustEmbedUnimplementedShortLinkServiceServer()
+func _ShortLinkService_RedirectRuleDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RedirectRuleDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RedirectRuleDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RedirectRuleDelete(ctx, req.(*DeleteRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterShortLinkServiceServer(s grpc.ServiceRegistrar, srv ShortLinkServiceServer) {
-	// If the following call pancis, it indicates UnimplementedShortLinkServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ShortLinkService_RedirectRuleList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ShortLinkService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).RedirectRuleList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_RedirectRuleList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).RedirectRuleList(ctx, req.(*ListRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateShortLinkRequest)
+func _ShortLinkService_LinkTemplateCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinkTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkCreate(ctx, in)
+		return srv.(ShortLinkServiceServer).LinkTemplateCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkCreate_FullMethodName,
+		FullMethod: ShortLinkService_LinkTemplateCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkCreate(ctx, req.(*CreateShortLinkRequest))
+		return srv.(ShortLinkServiceServer).LinkTemplateCreate(ctx, req.(*CreateLinkTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkBatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateShortLinkRequest)
+func _ShortLinkService_LinkTemplateUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLinkTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkBatchCreate(ctx, in)
+		return srv.(ShortLinkServiceServer).LinkTemplateUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkBatchCreate_FullMethodName,
+		FullMethod: ShortLinkService_LinkTemplateUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkBatchCreate(ctx, req.(*BatchCreateShortLinkRequest))
+		return srv.(ShortLinkServiceServer).LinkTemplateUpdate(ctx, req.(*UpdateLinkTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateShortLinkRequest)
+func _ShortLinkService_LinkTemplateDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLinkTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkUpdate(ctx, in)
+		return srv.(ShortLinkServiceServer).LinkTemplateDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkUpdate_FullMethodName,
+		FullMethod: ShortLinkService_LinkTemplateDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkUpdate(ctx, req.(*UpdateShortLinkRequest))
+		return srv.(ShortLinkServiceServer).LinkTemplateDelete(ctx, req.(*DeleteLinkTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageShortLinkRequest)
+func _ShortLinkService_LinkTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkPage(ctx, in)
+		return srv.(ShortLinkServiceServer).LinkTemplateList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkPage_FullMethodName,
+		FullMethod: ShortLinkService_LinkTemplateList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkPage(ctx, req.(*PageShortLinkRequest))
+		return srv.(ShortLinkServiceServer).LinkTemplateList(ctx, req.(*ListLinkTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkListGroupCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupShortLinkCountRequest)
+func _ShortLinkService_GroupExpiryPolicySave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveGroupExpiryPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkListGroupCount(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupExpiryPolicySave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkListGroupCount_FullMethodName,
+		FullMethod: ShortLinkService_GroupExpiryPolicySave_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkListGroupCount(ctx, req.(*GroupShortLinkCountRequest))
+		return srv.(ShortLinkServiceServer).GroupExpiryPolicySave(ctx, req.(*SaveGroupExpiryPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_RestoreUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreUrlRequest)
+func _ShortLinkService_GroupExpiryPolicyGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupExpiryPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).RestoreUrl(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupExpiryPolicyGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_RestoreUrl_FullMethodName,
+		FullMethod: ShortLinkService_GroupExpiryPolicyGet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).RestoreUrl(ctx, req.(*RestoreUrlRequest))
+		return srv.(ShortLinkServiceServer).GroupExpiryPolicyGet(ctx, req.(*GetGroupExpiryPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_ShortLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShortLinkStatsRequest)
+func _ShortLinkService_GroupTransferCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).ShortLinkStats(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupTransferCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_ShortLinkStats_FullMethodName,
+		FullMethod: ShortLinkService_GroupTransferCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).ShortLinkStats(ctx, req.(*ShortLinkStatsRequest))
+		return srv.(ShortLinkServiceServer).GroupTransferCreate(ctx, req.(*CreateGroupTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_RecycleBinSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveToRecycleBinRequest)
+func _ShortLinkService_GroupTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).RecycleBinSave(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupTransferList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_RecycleBinSave_FullMethodName,
+		FullMethod: ShortLinkService_GroupTransferList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).RecycleBinSave(ctx, req.(*SaveToRecycleBinRequest))
+		return srv.(ShortLinkServiceServer).GroupTransferList(ctx, req.(*ListGroupTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_RecycleBinRecover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecoverFromRecycleBinRequest)
+func _ShortLinkService_GroupTransferRespond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondGroupTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).RecycleBinRecover(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupTransferRespond(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_RecycleBinRecover_FullMethodName,
+		FullMethod: ShortLinkService_GroupTransferRespond_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).RecycleBinRecover(ctx, req.(*RecoverFromRecycleBinRequest))
+		return srv.(ShortLinkServiceServer).GroupTransferRespond(ctx, req.(*RespondGroupTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_RecycleBinRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveFromRecycleBinRequest)
+func _ShortLinkService_GroupTransferCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGroupTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).RecycleBinRemove(ctx, in)
+		return srv.(ShortLinkServiceServer).GroupTransferCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_RecycleBinRemove_FullMethodName,
+		FullMethod: ShortLinkService_GroupTransferCancel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).RecycleBinRemove(ctx, req.(*RemoveFromRecycleBinRequest))
+		return srv.(ShortLinkServiceServer).GroupTransferCancel(ctx, req.(*CancelGroupTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_RecycleBinPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageRecycleBinShortLinkRequest)
+func _ShortLinkService_ModerationPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageModerationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).RecycleBinPage(ctx, in)
+		return srv.(ShortLinkServiceServer).ModerationPage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_RecycleBinPage_FullMethodName,
+		FullMethod: ShortLinkService_ModerationPage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).RecycleBinPage(ctx, req.(*PageRecycleBinShortLinkRequest))
+		return srv.(ShortLinkServiceServer).ModerationPage(ctx, req.(*PageModerationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_StatsGetSingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSingleStatsRequest)
+func _ShortLinkService_ModerationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewModerationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).StatsGetSingle(ctx, in)
+		return srv.(ShortLinkServiceServer).ModerationReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_StatsGetSingle_FullMethodName,
+		FullMethod: ShortLinkService_ModerationReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).StatsGetSingle(ctx, req.(*GetSingleStatsRequest))
+		return srv.(ShortLinkServiceServer).ModerationReview(ctx, req.(*ReviewModerationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_StatsGetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupStatsRequest)
+func _ShortLinkService_ModerationFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagModerationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).StatsGetGroup(ctx, in)
+		return srv.(ShortLinkServiceServer).ModerationFlag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_StatsGetGroup_FullMethodName,
+		FullMethod: ShortLinkService_ModerationFlag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).StatsGetGroup(ctx, req.(*GetGroupStatsRequest))
+		return srv.(ShortLinkServiceServer).ModerationFlag(ctx, req.(*FlagModerationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_StatsAccessRecordQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccessRecordQueryRequest)
+func _ShortLinkService_DomainAppLinksSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDomainAppLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).StatsAccessRecordQuery(ctx, in)
+		return srv.(ShortLinkServiceServer).DomainAppLinksSave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_StatsAccessRecordQuery_FullMethodName,
+		FullMethod: ShortLinkService_DomainAppLinksSave_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).StatsAccessRecordQuery(ctx, req.(*AccessRecordQueryRequest))
+		return srv.(ShortLinkServiceServer).DomainAppLinksSave(ctx, req.(*SaveDomainAppLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShortLinkService_StatsGroupAccessRecordQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupAccessRecordQueryRequest)
+func _ShortLinkService_DomainAppLinksGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDomainAppLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShortLinkServiceServer).StatsGroupAccessRecordQuery(ctx, in)
+		return srv.(ShortLinkServiceServer).DomainAppLinksGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ShortLinkService_StatsGroupAccessRecordQuery_FullMethodName,
+		FullMethod: ShortLinkService_DomainAppLinksGet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShortLinkServiceServer).StatsGroupAccessRecordQuery(ctx, req.(*GroupAccessRecordQueryRequest))
+		return srv.(ShortLinkServiceServer).DomainAppLinksGet(ctx, req.(*GetDomainAppLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -650,6 +1896,24 @@ func _ShortLinkService_UrlTitleGet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortLinkService_ShortLinkPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortLinkPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortLinkServiceServer).ShortLinkPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortLinkService_ShortLinkPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortLinkServiceServer).ShortLinkPreview(ctx, req.(*ShortLinkPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortLinkService_GetIpLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetIPLocationRequest)
 	if err := dec(in); err != nil {
@@ -691,14 +1955,66 @@ var ShortLinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShortLinkPage",
 			Handler:    _ShortLinkService_ShortLinkPage_Handler,
 		},
+		{
+			MethodName: "ShortLinkSearch",
+			Handler:    _ShortLinkService_ShortLinkSearch_Handler,
+		},
+		{
+			MethodName: "ShortLinkQrCode",
+			Handler:    _ShortLinkService_ShortLinkQrCode_Handler,
+		},
 		{
 			MethodName: "ShortLinkListGroupCount",
 			Handler:    _ShortLinkService_ShortLinkListGroupCount_Handler,
 		},
+		{
+			MethodName: "ShortLinkMove",
+			Handler:    _ShortLinkService_ShortLinkMove_Handler,
+		},
+		{
+			MethodName: "ShortLinkClone",
+			Handler:    _ShortLinkService_ShortLinkClone_Handler,
+		},
+		{
+			MethodName: "ShortLinkBulkAction",
+			Handler:    _ShortLinkService_ShortLinkBulkAction_Handler,
+		},
+		{
+			MethodName: "ShortLinkHistoryList",
+			Handler:    _ShortLinkService_ShortLinkHistoryList_Handler,
+		},
+		{
+			MethodName: "ShortLinkRollback",
+			Handler:    _ShortLinkService_ShortLinkRollback_Handler,
+		},
+		{
+			MethodName: "ShortLinkImport",
+			Handler:    _ShortLinkService_ShortLinkImport_Handler,
+		},
+		{
+			MethodName: "ShortLinkImportJobGet",
+			Handler:    _ShortLinkService_ShortLinkImportJobGet_Handler,
+		},
+		{
+			MethodName: "ShortLinkExport",
+			Handler:    _ShortLinkService_ShortLinkExport_Handler,
+		},
+		{
+			MethodName: "ShortLinkExportJobGet",
+			Handler:    _ShortLinkService_ShortLinkExportJobGet_Handler,
+		},
+		{
+			MethodName: "ShortLinkExportDownload",
+			Handler:    _ShortLinkService_ShortLinkExportDownload_Handler,
+		},
 		{
 			MethodName: "RestoreUrl",
 			Handler:    _ShortLinkService_RestoreUrl_Handler,
 		},
+		{
+			MethodName: "VerifyLinkPassword",
+			Handler:    _ShortLinkService_VerifyLinkPassword_Handler,
+		},
 		{
 			MethodName: "ShortLinkStats",
 			Handler:    _ShortLinkService_ShortLinkStats_Handler,
@@ -735,10 +2051,102 @@ var ShortLinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatsGroupAccessRecordQuery",
 			Handler:    _ShortLinkService_StatsGroupAccessRecordQuery_Handler,
 		},
+		{
+			MethodName: "UserDomainRegister",
+			Handler:    _ShortLinkService_UserDomainRegister_Handler,
+		},
+		{
+			MethodName: "UserDomainVerify",
+			Handler:    _ShortLinkService_UserDomainVerify_Handler,
+		},
+		{
+			MethodName: "UserDomainList",
+			Handler:    _ShortLinkService_UserDomainList_Handler,
+		},
+		{
+			MethodName: "RedirectRuleCreate",
+			Handler:    _ShortLinkService_RedirectRuleCreate_Handler,
+		},
+		{
+			MethodName: "RedirectRuleUpdate",
+			Handler:    _ShortLinkService_RedirectRuleUpdate_Handler,
+		},
+		{
+			MethodName: "RedirectRuleDelete",
+			Handler:    _ShortLinkService_RedirectRuleDelete_Handler,
+		},
+		{
+			MethodName: "RedirectRuleList",
+			Handler:    _ShortLinkService_RedirectRuleList_Handler,
+		},
+		{
+			MethodName: "LinkTemplateCreate",
+			Handler:    _ShortLinkService_LinkTemplateCreate_Handler,
+		},
+		{
+			MethodName: "LinkTemplateUpdate",
+			Handler:    _ShortLinkService_LinkTemplateUpdate_Handler,
+		},
+		{
+			MethodName: "LinkTemplateDelete",
+			Handler:    _ShortLinkService_LinkTemplateDelete_Handler,
+		},
+		{
+			MethodName: "LinkTemplateList",
+			Handler:    _ShortLinkService_LinkTemplateList_Handler,
+		},
+		{
+			MethodName: "GroupExpiryPolicySave",
+			Handler:    _ShortLinkService_GroupExpiryPolicySave_Handler,
+		},
+		{
+			MethodName: "GroupExpiryPolicyGet",
+			Handler:    _ShortLinkService_GroupExpiryPolicyGet_Handler,
+		},
+		{
+			MethodName: "GroupTransferCreate",
+			Handler:    _ShortLinkService_GroupTransferCreate_Handler,
+		},
+		{
+			MethodName: "GroupTransferList",
+			Handler:    _ShortLinkService_GroupTransferList_Handler,
+		},
+		{
+			MethodName: "GroupTransferRespond",
+			Handler:    _ShortLinkService_GroupTransferRespond_Handler,
+		},
+		{
+			MethodName: "GroupTransferCancel",
+			Handler:    _ShortLinkService_GroupTransferCancel_Handler,
+		},
+		{
+			MethodName: "ModerationPage",
+			Handler:    _ShortLinkService_ModerationPage_Handler,
+		},
+		{
+			MethodName: "ModerationReview",
+			Handler:    _ShortLinkService_ModerationReview_Handler,
+		},
+		{
+			MethodName: "ModerationFlag",
+			Handler:    _ShortLinkService_ModerationFlag_Handler,
+		},
+		{
+			MethodName: "DomainAppLinksSave",
+			Handler:    _ShortLinkService_DomainAppLinksSave_Handler,
+		},
+		{
+			MethodName: "DomainAppLinksGet",
+			Handler:    _ShortLinkService_DomainAppLinksGet_Handler,
+		},
 		{
 			MethodName: "UrlTitleGet",
 			Handler:    _ShortLinkService_UrlTitleGet_Handler,
 		},
+		{
+			MethodName: "ShortLinkPreview",
+			Handler:    _ShortLinkService_ShortLinkPreview_Handler,
+		},
 		{
 			MethodName: "GetIpLocation",
 			Handler:    _ShortLinkService_GetIpLocation_Handler,
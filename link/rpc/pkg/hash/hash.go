@@ -8,8 +8,8 @@ import (
 )
 
 const (
-	// Base62字符集
-	base62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// Base62Chars Base62字符集
+	Base62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
 	// 短链接长度
 	shortLinkLength = 6
 )
@@ -34,13 +34,48 @@ func HashToBase62(originUrl string) string {
 
 	for hexInt.Cmp(zero) > 0 && result.Len() < shortLinkLength {
 		hexInt.DivMod(hexInt, base, mod)
-		result.WriteByte(base62Chars[mod.Int64()])
+		result.WriteByte(Base62Chars[mod.Int64()])
 	}
 
 	// 如果长度不足，则用0补齐
 	for result.Len() < shortLinkLength {
-		result.WriteByte(base62Chars[0])
+		result.WriteByte(Base62Chars[0])
 	}
 
 	return result.String()
 }
+
+// HashToBase62N 将原始URL哈希为指定长度的Base62编码
+// 使用完整的128位MD5值，长度最大为22位
+func HashToBase62N(originUrl string, length int) string {
+	hasher := md5.New()
+	hasher.Write([]byte(originUrl))
+	hexInt := new(big.Int).SetBytes(hasher.Sum(nil))
+
+	var result strings.Builder
+	base := big.NewInt(62)
+	mod := new(big.Int)
+	for result.Len() < length {
+		hexInt.DivMod(hexInt, base, mod)
+		result.WriteByte(Base62Chars[mod.Int64()])
+	}
+
+	return result.String()
+}
+
+// EncodeBase62 将非负整数编码为Base62字符串（高位在前）
+func EncodeBase62(n uint64) string {
+	if n == 0 {
+		return string(Base62Chars[0])
+	}
+
+	var buf [11]byte
+	i := len(buf)
+	for n > 0 {
+		i--
+		buf[i] = Base62Chars[n%62]
+		n /= 62
+	}
+
+	return string(buf[i:])
+}
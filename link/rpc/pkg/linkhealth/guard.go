@@ -0,0 +1,89 @@
+package linkhealth
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress 目标地址为内网、回环或链路本地地址
+var ErrForbiddenAddress = errors.New("禁止访问内网地址")
+
+// 除标准库已识别的私有地址外，额外禁止的地址段
+var forbiddenNets = mustParseCIDRs(
+	"0.0.0.0/8",     // 本网络
+	"100.64.0.0/10", // 运营商级NAT共享地址，云厂商常用于内部服务
+	"192.0.0.0/24",  // IETF协议分配
+	"198.18.0.0/15", // 网络性能测试
+	"240.0.0.0/4",   // 保留地址
+	"64:ff9b::/96",  // NAT64，可映射到任意IPv4地址
+)
+
+// IsPublicIP 判断IP是否为可以访问的公网地址
+// 回环、私有、链路本地（包括云服务器元数据地址169.254.169.254）、组播及保留地址均不可访问
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range forbiddenNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewGuardedTransport 创建只允许连接公网地址的HTTP传输层
+// 在建立连接时检查域名解析后的实际IP，重定向的每一跳都会重新检查，也能防止DNS重绑定
+// 不使用环境变量中的代理，避免检查的是代理地址而不是目标地址
+func NewGuardedTransport() *http.Transport {
+	return newGuardedTransport(checkPublicAddress)
+}
+
+// newGuardedTransport 创建建立连接前使用check检查目标地址的HTTP传输层
+func newGuardedTransport(check func(address string) error) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			return check(address)
+		},
+	}
+	return &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// checkPublicAddress 检查连接地址（IP:端口）是否为公网地址
+func checkPublicAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
@@ -0,0 +1,63 @@
+package linkhealth
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 记录的主机数超过该值时清理已过期的记录
+const hostLimiterCleanupSize = 10000
+
+// HostLimiter 按主机限速，同一主机两次请求至少间隔interval
+type HostLimiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     map[string]time.Time // 主机下一次允许请求的时间
+}
+
+// NewHostLimiter 创建主机限速器，interval不大于0时不限速
+func NewHostLimiter(interval time.Duration) *HostLimiter {
+	return &HostLimiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+	}
+}
+
+// Wait 等待到该主机允许请求的时间，ctx结束时返回错误
+func (l *HostLimiter) Wait(ctx context.Context, host string) error {
+	if l.interval <= 0 {
+		return nil
+	}
+	host = strings.ToLower(host)
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	if len(l.next) > hostLimiterCleanupSize {
+		for h, t := range l.next {
+			if t.Before(now) {
+				delete(l.next, h)
+			}
+		}
+	}
+	l.mu.Unlock()
+
+	wait := time.Until(at)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
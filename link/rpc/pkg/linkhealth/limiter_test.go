@@ -0,0 +1,65 @@
+package linkhealth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHostLimiter_Wait(t *testing.T) {
+	interval := 50 * time.Millisecond
+	l := NewHostLimiter(interval)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx, "github.com"); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("同一主机3次请求耗时 %s, 期望至少 %s", elapsed, 2*interval)
+	}
+
+	// 不同主机互不影响，主机名不区分大小写
+	start = time.Now()
+	if err := l.Wait(ctx, "gitee.com"); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= interval {
+		t.Errorf("不同主机的首次请求等待了 %s", elapsed)
+	}
+	start = time.Now()
+	if err := l.Wait(ctx, "GITEE.com"); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < interval/2 {
+		t.Errorf("主机名大小写不同时未限速, 等待 %s", elapsed)
+	}
+}
+
+func TestHostLimiter_NoInterval(t *testing.T) {
+	l := NewHostLimiter(0)
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(context.Background(), "github.com"); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Errorf("未设置间隔时不应等待, 实际耗时 %s", elapsed)
+	}
+}
+
+func TestHostLimiter_ContextCanceled(t *testing.T) {
+	l := NewHostLimiter(time.Hour)
+	if err := l.Wait(context.Background(), "github.com"); err != nil {
+		t.Fatalf("首次请求 Wait() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "github.com"); err == nil {
+		t.Error("期望context结束时返回错误")
+	}
+}
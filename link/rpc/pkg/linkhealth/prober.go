@@ -0,0 +1,136 @@
+package linkhealth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// 目标链接健康状态
+const (
+	// 未检测
+	HealthStatusUnknown = 0
+	// 正常
+	HealthStatusHealthy = 1
+	// 失效
+	HealthStatusBroken = 2
+)
+
+const (
+	// 默认请求超时时间
+	defaultTimeout = 5 * time.Second
+	// 默认最大重定向次数
+	defaultMaxRedirects = 10
+	// 默认请求标识
+	defaultUserAgent = "ShortLinkHealthChecker/1.0"
+	// GET请求最多读取的响应字节数，读取少量内容以便复用连接
+	getResponseMaxBytes = 4 << 10
+)
+
+// Result 目标链接检测结果
+type Result struct {
+	StatusCode int           // 最终响应的HTTP状态码，请求失败时为0
+	Latency    time.Duration // 最终请求的响应耗时
+	Redirects  []string      // 依次重定向到的链接，不含原始链接
+	Err        error         // 请求失败原因
+}
+
+// Healthy 请求成功且最终响应为2xx或3xx时视为正常
+func (r *Result) Healthy() bool {
+	return r.Err == nil && r.StatusCode >= http.StatusOK && r.StatusCode < http.StatusBadRequest
+}
+
+// Options 检测参数
+type Options struct {
+	Timeout      time.Duration // 单次请求超时时间，包含重定向
+	MaxRedirects int           // 最大重定向次数
+	UserAgent    string        // 请求标识
+	HostInterval time.Duration // 同一主机两次请求的最小间隔
+}
+
+// Prober 目标链接检测器，先发送HEAD请求，不可用时再发送GET请求确认
+// 只允许连接公网地址，目标链接或重定向指向内网地址时检测失败
+type Prober struct {
+	timeout      time.Duration
+	maxRedirects int
+	userAgent    string
+	limiter      *HostLimiter
+	transport    http.RoundTripper
+}
+
+// NewProber 创建目标链接检测器
+func NewProber(opts Options) *Prober {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = defaultMaxRedirects
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = defaultUserAgent
+	}
+	return &Prober{
+		timeout:      opts.Timeout,
+		maxRedirects: opts.MaxRedirects,
+		userAgent:    opts.UserAgent,
+		limiter:      NewHostLimiter(opts.HostInterval),
+		transport:    NewGuardedTransport(),
+	}
+}
+
+// Probe 检测目标链接
+// 部分站点不支持HEAD请求或对HEAD请求返回错误状态码，HEAD检测不正常时再使用GET请求确认
+func (p *Prober) Probe(ctx context.Context, rawUrl string) *Result {
+	result := p.do(ctx, http.MethodHead, rawUrl)
+	if result.Healthy() || ctx.Err() != nil {
+		return result
+	}
+	return p.do(ctx, http.MethodGet, rawUrl)
+}
+
+// do 发送请求并记录重定向链路，每次请求（包括重定向）都遵守主机限速
+func (p *Prober) do(ctx context.Context, method, rawUrl string) *Result {
+	result := &Result{}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, rawUrl, nil)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	req.Header.Set("User-Agent", p.userAgent)
+	if err := p.limiter.Wait(ctx, req.URL.Hostname()); err != nil {
+		result.Err = err
+		return result
+	}
+
+	client := &http.Client{
+		Transport: p.transport,
+		CheckRedirect: func(next *http.Request, via []*http.Request) error {
+			if len(via) > p.maxRedirects {
+				return fmt.Errorf("重定向次数超过%d次", p.maxRedirects)
+			}
+			result.Redirects = append(result.Redirects, next.URL.String())
+			return p.limiter.Wait(next.Context(), next.URL.Hostname())
+		},
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer resp.Body.Close()
+	if method == http.MethodGet {
+		_, _ = io.CopyN(io.Discard, resp.Body, getResponseMaxBytes)
+	}
+
+	result.StatusCode = resp.StatusCode
+	return result
+}
@@ -0,0 +1,138 @@
+package linkhealth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestProber 创建允许访问本地测试服务的检测器
+func newTestProber(opts Options) *Prober {
+	p := NewProber(opts)
+	p.transport = http.DefaultTransport
+	return p
+}
+
+func TestProber_Probe(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	// 不支持HEAD请求的站点使用GET请求确认
+	mux.HandleFunc("/no-head", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p := newTestProber(Options{MaxRedirects: 3})
+	tests := []struct {
+		name      string
+		path      string
+		healthy   bool
+		code      int
+		redirects int
+	}{
+		{"正常", "/ok", true, http.StatusOK, 0},
+		{"不支持HEAD", "/no-head", true, http.StatusOK, 0},
+		{"重定向", "/redirect", true, http.StatusOK, 1},
+		{"重定向次数超限", "/loop", false, 0, 3},
+		{"不存在", "/missing", false, http.StatusNotFound, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := p.Probe(context.Background(), server.URL+tt.path)
+			if result.Healthy() != tt.healthy || result.StatusCode != tt.code || len(result.Redirects) != tt.redirects {
+				t.Errorf("Probe(%s) = {healthy: %v, code: %d, redirects: %v, err: %v}, want {%v, %d, %d}",
+					tt.path, result.Healthy(), result.StatusCode, result.Redirects, result.Err, tt.healthy, tt.code, tt.redirects)
+			}
+		})
+	}
+}
+
+func TestProber_RefusePrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// 默认检测器不允许访问回环地址
+	result := NewProber(Options{}).Probe(context.Background(), server.URL)
+	if result.Healthy() || !errors.Is(result.Err, ErrForbiddenAddress) {
+		t.Errorf("期望拒绝访问回环地址, 实际: code %d, err %v", result.StatusCode, result.Err)
+	}
+}
+
+func TestProber_RefusePrivateRedirect(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer internal.Close()
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer public.Close()
+
+	// 只允许访问模拟的公网服务，重定向到其他地址时拒绝连接
+	allowed := public.Listener.Addr().String()
+	p := NewProber(Options{})
+	p.transport = newGuardedTransport(func(address string) error {
+		if address != allowed {
+			return ErrForbiddenAddress
+		}
+		return nil
+	})
+
+	result := p.Probe(context.Background(), public.URL)
+	if result.Healthy() || !errors.Is(result.Err, ErrForbiddenAddress) {
+		t.Errorf("期望拒绝重定向到内网地址, 实际: code %d, err %v", result.StatusCode, result.Err)
+	}
+	if len(result.Redirects) != 1 || result.Redirects[0] != internal.URL {
+		t.Errorf("重定向链路 = %v, want [%s]", result.Redirects, internal.URL)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"140.82.112.3", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+		{"::1", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"64:ff9b::a00:1", false},
+	}
+	for _, tt := range tests {
+		if got := IsPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("IsPublicIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
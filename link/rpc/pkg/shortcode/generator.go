@@ -0,0 +1,151 @@
+package shortcode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 短链接后缀生成策略
+const (
+	// 哈希策略：对原始链接加纳秒时间戳做MD5后Base62编码
+	StrategyHash = "hash"
+	// 随机策略：使用加密安全的随机数生成
+	StrategyRandom = "random"
+	// 雪花策略：对雪花ID做Base62编码，不会冲突
+	StrategySnowflake = "snowflake"
+	// 计数器策略：对Redis自增ID做Base62编码，不会冲突
+	StrategyCounter = "counter"
+)
+
+// Redis键
+const (
+	// 计数器策略的自增序列Key
+	CounterSequenceKey = "short-link:code:sequence"
+	// 已分配后缀数量Key，用于计算键空间占用率
+	AllocatedCountKey = "short-link:code:allocated:%s"
+)
+
+// 后缀长度默认值
+const (
+	DefaultMinLength    = 6
+	DefaultMaxLength    = 12
+	DefaultMaxFillRatio = 0.1
+)
+
+// ShortCodeGenerator 短链接后缀生成器
+type ShortCodeGenerator interface {
+	// Generate 生成一个候选短链接后缀，originUrl 仅哈希策略使用
+	Generate(ctx context.Context, originUrl string) (string, error)
+	// Confirm 确认候选后缀未冲突并被采用，计入已分配数量；冲突重试的候选后缀不计入
+	Confirm(ctx context.Context)
+}
+
+// Options 生成器参数
+type Options struct {
+	Strategy     string
+	MinLength    int
+	MaxLength    int
+	MaxFillRatio float64      // 键空间占用率阈值，超过后自动增加长度
+	CounterStart int64        // 计数器策略的起始偏移
+	Redis        *redis.Redis // 计数器策略及长度自增使用
+	IdGen        func() int64 // 雪花策略使用
+}
+
+// NewGenerator 根据策略创建短链接后缀生成器
+func NewGenerator(opts Options) (ShortCodeGenerator, error) {
+	if opts.MinLength <= 0 {
+		opts.MinLength = DefaultMinLength
+	}
+	if opts.MaxLength < opts.MinLength {
+		opts.MaxLength = opts.MinLength
+	}
+	if opts.MaxFillRatio <= 0 || opts.MaxFillRatio > 1 {
+		opts.MaxFillRatio = DefaultMaxFillRatio
+	}
+
+	switch opts.Strategy {
+	case "", StrategyHash:
+		return &hashGenerator{policy: newLengthPolicy(opts, StrategyHash)}, nil
+	case StrategyRandom:
+		return &randomGenerator{policy: newLengthPolicy(opts, StrategyRandom)}, nil
+	case StrategySnowflake:
+		if opts.IdGen == nil {
+			return nil, fmt.Errorf("snowflake策略需要ID生成器")
+		}
+		return &snowflakeGenerator{idGen: opts.IdGen}, nil
+	case StrategyCounter:
+		if opts.Redis == nil {
+			return nil, fmt.Errorf("counter策略需要Redis客户端")
+		}
+		start := opts.CounterStart
+		if start <= 0 {
+			// 默认从最小长度的第一个编码开始，保证后缀长度不小于MinLength
+			start = int64(math.Pow(62, float64(opts.MinLength-1)))
+		}
+		return &counterGenerator{redis: opts.Redis, start: start}, nil
+	default:
+		return nil, fmt.Errorf("不支持的短链接生成策略: %s", opts.Strategy)
+	}
+}
+
+// lengthPolicy 后缀长度策略，根据已分配数量计算长度，键空间占用率超过阈值时自动增加长度
+type lengthPolicy struct {
+	redis        *redis.Redis
+	key          string
+	minLength    int
+	maxLength    int
+	maxFillRatio float64
+}
+
+func newLengthPolicy(opts Options, strategy string) *lengthPolicy {
+	return &lengthPolicy{
+		redis:        opts.Redis,
+		key:          fmt.Sprintf(AllocatedCountKey, strategy),
+		minLength:    opts.MinLength,
+		maxLength:    opts.MaxLength,
+		maxFillRatio: opts.MaxFillRatio,
+	}
+}
+
+// length 根据已分配数量返回本次应使用的后缀长度
+// 未配置Redis或Redis出错时退化为最小长度，由调用方的重试机制兜底
+func (p *lengthPolicy) length(ctx context.Context) int {
+	if p.redis == nil {
+		return p.minLength
+	}
+
+	value, err := p.redis.GetCtx(ctx, p.key)
+	if err != nil || value == "" {
+		return p.minLength
+	}
+	allocated, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return p.minLength
+	}
+
+	return lengthFor(allocated, p.minLength, p.maxLength, p.maxFillRatio)
+}
+
+// record 记录一次成功分配，计数失败只影响长度自增的时机，不影响生成结果
+func (p *lengthPolicy) record(ctx context.Context) {
+	if p.redis == nil {
+		return
+	}
+	if _, err := p.redis.IncrCtx(ctx, p.key); err != nil {
+		logx.WithContext(ctx).Errorf("记录已分配短链接后缀数量失败: %v", err)
+	}
+}
+
+// lengthFor 计算使已分配数量占键空间比例不超过阈值的最小长度
+func lengthFor(allocated int64, minLength, maxLength int, maxFillRatio float64) int {
+	length := minLength
+	for length < maxLength && float64(allocated) > maxFillRatio*math.Pow(62, float64(length)) {
+		length++
+	}
+	return length
+}
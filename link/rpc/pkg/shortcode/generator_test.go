@@ -0,0 +1,105 @@
+package shortcode
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"shorterurl/link/rpc/pkg/hash"
+)
+
+// TestNewGenerator_InvalidOptions 测试缺少依赖或策略不支持时创建失败
+func TestNewGenerator_InvalidOptions(t *testing.T) {
+	cases := []Options{
+		{Strategy: StrategySnowflake},
+		{Strategy: StrategyCounter},
+		{Strategy: "unknown"},
+	}
+	for _, opts := range cases {
+		if _, err := NewGenerator(opts); err == nil {
+			t.Errorf("策略 %q 期望创建失败，但实际成功", opts.Strategy)
+		}
+	}
+}
+
+// TestGenerate_HashAndRandom 测试哈希和随机策略生成最小长度的Base62后缀，且多次生成不重复
+func TestGenerate_HashAndRandom(t *testing.T) {
+	ctx := context.Background()
+	for _, strategy := range []string{StrategyHash, StrategyRandom} {
+		gen, err := NewGenerator(Options{Strategy: strategy, MinLength: 7, MaxLength: 10})
+		if err != nil {
+			t.Fatalf("创建 %s 生成器失败: %v", strategy, err)
+		}
+
+		seen := make(map[string]bool)
+		for i := 0; i < 100; i++ {
+			code, err := gen.Generate(ctx, "https://github.com/zeromicro/go-zero")
+			if err != nil {
+				t.Fatalf("%s 策略生成失败: %v", strategy, err)
+			}
+			if len(code) != 7 {
+				t.Errorf("%s 策略后缀长度期望 7，实际: %s", strategy, code)
+			}
+			for _, c := range code {
+				if !strings.ContainsRune(hash.Base62Chars, c) {
+					t.Errorf("%s 策略后缀包含非Base62字符: %s", strategy, code)
+				}
+			}
+			if seen[code] {
+				t.Errorf("%s 策略生成了重复后缀: %s", strategy, code)
+			}
+			seen[code] = true
+			gen.Confirm(ctx)
+		}
+	}
+}
+
+// TestGenerate_Snowflake 测试雪花策略对ID做Base62编码
+func TestGenerate_Snowflake(t *testing.T) {
+	id := int64(0)
+	gen, err := NewGenerator(Options{
+		Strategy: StrategySnowflake,
+		IdGen: func() int64 {
+			id++
+			return 1<<40 + id
+		},
+	})
+	if err != nil {
+		t.Fatalf("创建雪花生成器失败: %v", err)
+	}
+
+	first, _ := gen.Generate(context.Background(), "")
+	second, _ := gen.Generate(context.Background(), "")
+	if first != hash.EncodeBase62(1<<40+1) || second != hash.EncodeBase62(1<<40+2) {
+		t.Errorf("雪花策略后缀不符合预期: %s, %s", first, second)
+	}
+}
+
+// TestLengthFor 测试键空间占用率超过阈值时增加后缀长度，且不超过最大长度
+func TestLengthFor(t *testing.T) {
+	cases := []struct {
+		allocated int64
+		want      int
+	}{
+		{0, 1},
+		{6, 1},
+		{7, 2},
+		{384, 2},
+		{385, 3},
+		{1 << 40, 3},
+	}
+	for _, c := range cases {
+		if got := lengthFor(c.allocated, 1, 3, 0.1); got != c.want {
+			t.Errorf("已分配 %d 时期望长度 %d，实际: %d", c.allocated, c.want, got)
+		}
+	}
+}
+
+// TestLengthPolicy_WithoutRedis 测试未配置Redis时使用最小长度，确认分配不报错
+func TestLengthPolicy_WithoutRedis(t *testing.T) {
+	policy := newLengthPolicy(Options{MinLength: 6, MaxLength: 8, MaxFillRatio: 0.1}, StrategyRandom)
+	policy.record(context.Background())
+	if got := policy.length(context.Background()); got != 6 {
+		t.Errorf("未配置Redis时期望长度 6，实际: %d", got)
+	}
+}
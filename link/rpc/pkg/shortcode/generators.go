@@ -0,0 +1,85 @@
+package shortcode
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"shorterurl/link/rpc/pkg/hash"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// hashGenerator 哈希生成器，原始链接加纳秒时间戳作为盐，避免相同链接重复
+type hashGenerator struct {
+	policy *lengthPolicy
+}
+
+// Generate 生成短链接后缀
+func (g *hashGenerator) Generate(ctx context.Context, originUrl string) (string, error) {
+	length := g.policy.length(ctx)
+	return hash.HashToBase62N(originUrl+fmt.Sprintf("%d", time.Now().UnixNano()), length), nil
+}
+
+// Confirm 计入已分配数量
+func (g *hashGenerator) Confirm(ctx context.Context) {
+	g.policy.record(ctx)
+}
+
+// randomGenerator 随机生成器，使用crypto/rand生成不可预测的后缀
+type randomGenerator struct {
+	policy *lengthPolicy
+}
+
+// Generate 生成短链接后缀
+func (g *randomGenerator) Generate(ctx context.Context, _ string) (string, error) {
+	length := g.policy.length(ctx)
+	buf := make([]byte, length)
+	max := big.NewInt(int64(len(hash.Base62Chars)))
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("生成随机数失败: %v", err)
+		}
+		buf[i] = hash.Base62Chars[n.Int64()]
+	}
+	return string(buf), nil
+}
+
+// Confirm 计入已分配数量
+func (g *randomGenerator) Confirm(ctx context.Context) {
+	g.policy.record(ctx)
+}
+
+// snowflakeGenerator 雪花ID生成器，ID全局唯一，长度随ID增长
+type snowflakeGenerator struct {
+	idGen func() int64
+}
+
+// Generate 生成短链接后缀
+func (g *snowflakeGenerator) Generate(_ context.Context, _ string) (string, error) {
+	return hash.EncodeBase62(uint64(g.idGen())), nil
+}
+
+// Confirm 雪花ID不会冲突，长度不依赖已分配数量
+func (g *snowflakeGenerator) Confirm(_ context.Context) {}
+
+// counterGenerator Redis自增计数器生成器，序列唯一，长度随序列增长
+type counterGenerator struct {
+	redis *redis.Redis
+	start int64
+}
+
+// Generate 生成短链接后缀
+func (g *counterGenerator) Generate(ctx context.Context, _ string) (string, error) {
+	seq, err := g.redis.IncrCtx(ctx, CounterSequenceKey)
+	if err != nil {
+		return "", fmt.Errorf("获取自增序列失败: %v", err)
+	}
+	return hash.EncodeBase62(uint64(g.start + seq)), nil
+}
+
+// Confirm 自增序列不会冲突，长度不依赖已分配数量
+func (g *counterGenerator) Confirm(_ context.Context) {}
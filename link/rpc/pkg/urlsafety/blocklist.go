@@ -0,0 +1,146 @@
+package urlsafety
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 黑名单文件规则前缀，未带前缀的规则视为域名
+const (
+	blocklistDomainPrefix = "domain:"
+	blocklistRegexPrefix  = "regex:"
+)
+
+// 黑名单文件默认变更检查间隔
+const defaultBlocklistReload = 30 * time.Second
+
+// BlocklistChecker 本地黑名单检测器，文件变更后自动重新加载
+//
+// 文件每行一条规则，#开头为注释：
+//
+//	example.com              命中该域名及其子域名
+//	domain:example.com       同上
+//	regex:^https?://[^/]+/phishing/   对完整链接做正则匹配
+type BlocklistChecker struct {
+	file   string
+	reload time.Duration
+
+	mu        sync.RWMutex
+	domains   map[string]bool
+	patterns  []*regexp.Regexp
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+// NewBlocklistChecker 创建本地黑名单检测器，文件不存在或格式错误时返回错误
+func NewBlocklistChecker(file string, reload time.Duration) (*BlocklistChecker, error) {
+	if reload <= 0 {
+		reload = defaultBlocklistReload
+	}
+	c := &BlocklistChecker{file: file, reload: reload}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *BlocklistChecker) Check(ctx context.Context, rawUrl string) (*Result, error) {
+	c.reloadIfChanged(ctx)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, domain := range domainCandidates(hostOf(rawUrl)) {
+		if c.domains[domain] {
+			return &Result{Flagged: true, Provider: ProviderBlocklist, Reason: "目标域名在黑名单中: " + domain}, nil
+		}
+	}
+	for _, pattern := range c.patterns {
+		if pattern.MatchString(rawUrl) {
+			return &Result{Flagged: true, Provider: ProviderBlocklist, Reason: "目标链接匹配黑名单规则: " + pattern.String()}, nil
+		}
+	}
+	return &Result{}, nil
+}
+
+// reloadIfChanged 超过检查间隔时检查文件修改时间，变更后重新加载，加载失败时保留原有规则
+func (c *BlocklistChecker) reloadIfChanged(ctx context.Context) {
+	c.mu.RLock()
+	due := time.Since(c.checkedAt) >= c.reload
+	c.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	c.mu.Lock()
+	c.checkedAt = time.Now()
+	modTime := c.modTime
+	c.mu.Unlock()
+
+	info, err := os.Stat(c.file)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("检查黑名单文件失败: %v", err)
+		return
+	}
+	if info.ModTime().Equal(modTime) {
+		return
+	}
+	if err := c.load(); err != nil {
+		logx.WithContext(ctx).Errorf("重新加载黑名单文件失败: %v", err)
+		return
+	}
+	logx.WithContext(ctx).Infof("黑名单文件已重新加载: %s", c.file)
+}
+
+// load 读取并解析黑名单文件
+func (c *BlocklistChecker) load() error {
+	f, err := os.Open(c.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	domains := make(map[string]bool)
+	patterns := make([]*regexp.Regexp, 0)
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, blocklistRegexPrefix) {
+			pattern, err := regexp.Compile(strings.TrimSpace(strings.TrimPrefix(line, blocklistRegexPrefix)))
+			if err != nil {
+				return fmt.Errorf("黑名单文件第%d行正则表达式错误: %v", lineNo, err)
+			}
+			patterns = append(patterns, pattern)
+			continue
+		}
+		domain := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, blocklistDomainPrefix)))
+		domains[strings.TrimSuffix(strings.TrimPrefix(domain, "*."), ".")] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.domains = domains
+	c.patterns = patterns
+	c.modTime = info.ModTime()
+	c.checkedAt = time.Now()
+	c.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,129 @@
+package urlsafety
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 检测来源
+const (
+	ProviderBlocklist = "blocklist"
+	ProviderRedis     = "redis"
+	ProviderHttp      = "http"
+	// 审核人员手动标记
+	ProviderManual = "manual"
+)
+
+// 短链接安全状态
+const (
+	// 正常
+	SafetyStatusNormal = 0
+	// 创建时命中安全检测，已禁用并等待审核
+	SafetyStatusPending = 1
+	// 创建后命中安全检测，跳转前展示风险提示
+	SafetyStatusWarning = 2
+	// 审核未通过，已禁用
+	SafetyStatusBlocked = 3
+)
+
+// Result 链接安全检测结果
+type Result struct {
+	Flagged  bool   // 是否为可疑链接
+	Provider string // 命中的检测来源
+	Reason   string // 命中原因
+}
+
+// UrlSafetyChecker 目标链接安全检测器
+type UrlSafetyChecker interface {
+	// Check 检测目标链接，命中任一检测来源时返回可疑结果
+	Check(ctx context.Context, rawUrl string) (*Result, error)
+}
+
+// Options 检测器参数，未配置的检测来源不启用
+type Options struct {
+	BlocklistFile   string        // 本地黑名单文件路径
+	BlocklistReload time.Duration // 黑名单文件变更检查间隔
+	Redis           *redis.Redis  // Redis黑名单使用
+	RedisKey        string        // Redis黑名单集合Key，为空时不启用
+	HttpEndpoint    string        // 外部检测服务地址
+	HttpToken       string        // 外部检测服务鉴权令牌
+	HttpTimeout     time.Duration // 外部检测服务超时时间
+}
+
+// NewChecker 根据配置创建检测器，多个检测来源按本地黑名单、Redis黑名单、外部检测服务的顺序依次检测
+func NewChecker(opts Options) (UrlSafetyChecker, error) {
+	chain := &chainChecker{}
+
+	if opts.BlocklistFile != "" {
+		checker, err := NewBlocklistChecker(opts.BlocklistFile, opts.BlocklistReload)
+		if err != nil {
+			return nil, err
+		}
+		chain.checkers = append(chain.checkers, checker)
+	}
+	if opts.RedisKey != "" {
+		if opts.Redis == nil {
+			return nil, fmt.Errorf("Redis黑名单需要Redis客户端")
+		}
+		chain.checkers = append(chain.checkers, NewRedisChecker(opts.Redis, opts.RedisKey))
+	}
+	if opts.HttpEndpoint != "" {
+		chain.checkers = append(chain.checkers, NewHttpChecker(opts.HttpEndpoint, opts.HttpToken, opts.HttpTimeout))
+	}
+	return chain, nil
+}
+
+// chainChecker 依次调用多个检测器，某个检测器出错时继续检测，全部未命中时返回第一个错误
+type chainChecker struct {
+	checkers []UrlSafetyChecker
+}
+
+func (c *chainChecker) Check(ctx context.Context, rawUrl string) (*Result, error) {
+	var firstErr error
+	for _, checker := range c.checkers {
+		result, err := checker.Check(ctx, rawUrl)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("链接安全检测失败: %v", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if result.Flagged {
+			return result, nil
+		}
+	}
+	return &Result{}, firstErr
+}
+
+// hostOf 提取链接的小写主机名，不含端口
+func hostOf(rawUrl string) string {
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "http://" + rawUrl
+	}
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// domainCandidates 返回主机名及其所有上级域名，如 a.b.com 返回 a.b.com、b.com、com
+func domainCandidates(host string) []string {
+	candidates := make([]string, 0)
+	for host != "" {
+		candidates = append(candidates, host)
+		i := strings.Index(host, ".")
+		if i < 0 {
+			break
+		}
+		host = host[i+1:]
+	}
+	return candidates
+}
@@ -0,0 +1,85 @@
+package urlsafety
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// 外部检测服务默认超时时间
+const defaultHttpTimeout = 3 * time.Second
+
+// 外部检测服务响应最多读取的字节数
+const httpResponseMaxBytes = 64 << 10
+
+// HttpChecker 外部检测服务适配器
+//
+// 以 POST 方式发送 {"url": "..."}，配置了令牌时携带 Authorization: Bearer <token>，
+// 服务返回 200 及 {"flagged": true, "reason": "..."}，可以指向本地桩服务进行联调
+type HttpChecker struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+type httpCheckRequest struct {
+	Url string `json:"url"`
+}
+
+type httpCheckResponse struct {
+	Flagged bool   `json:"flagged"`
+	Reason  string `json:"reason"`
+}
+
+// NewHttpChecker 创建外部检测服务适配器
+func NewHttpChecker(endpoint, token string, timeout time.Duration) *HttpChecker {
+	if timeout <= 0 {
+		timeout = defaultHttpTimeout
+	}
+	return &HttpChecker{
+		endpoint: endpoint,
+		token:    token,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *HttpChecker) Check(ctx context.Context, rawUrl string) (*Result, error) {
+	body, err := json.Marshal(httpCheckRequest{Url: rawUrl})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("外部检测服务返回状态码: %d", resp.StatusCode)
+	}
+
+	var result httpCheckResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, httpResponseMaxBytes)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("解析外部检测服务响应失败: %v", err)
+	}
+	if !result.Flagged {
+		return &Result{}, nil
+	}
+	reason := result.Reason
+	if reason == "" {
+		reason = "外部检测服务判定为可疑链接"
+	}
+	return &Result{Flagged: true, Provider: ProviderHttp, Reason: reason}, nil
+}
@@ -0,0 +1,32 @@
+package urlsafety
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// RedisChecker Redis黑名单检测器，集合中保存小写域名，命中该域名及其子域名
+// 运营人员可以通过 SADD 实时添加，无需重启服务
+type RedisChecker struct {
+	redis *redis.Redis
+	key   string
+}
+
+// NewRedisChecker 创建Redis黑名单检测器
+func NewRedisChecker(rds *redis.Redis, key string) *RedisChecker {
+	return &RedisChecker{redis: rds, key: key}
+}
+
+func (c *RedisChecker) Check(ctx context.Context, rawUrl string) (*Result, error) {
+	for _, domain := range domainCandidates(hostOf(rawUrl)) {
+		ok, err := c.redis.SismemberCtx(ctx, c.key, domain)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &Result{Flagged: true, Provider: ProviderRedis, Reason: "目标域名在黑名单中: " + domain}, nil
+		}
+	}
+	return &Result{}, nil
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+)
+
+// 访问渠道标记参数名，如 ?src=qr
+const ChannelParam = "src"
+
+// 访问渠道
+const (
+	// 直接访问，未携带渠道标记
+	ChannelDirect = "direct"
+	// 扫描短链接二维码访问
+	ChannelQrCode = "qr"
+)
+
+// 支持的访问渠道，其他取值视为目标链接自身的查询参数
+var knownChannels = map[string]bool{
+	ChannelQrCode: true,
+}
+
+// ExtractChannel 从访问时携带的查询参数中提取访问渠道，返回渠道和去掉渠道标记后的查询参数
+// 未携带渠道标记时渠道为空，其他参数保持原有顺序
+func ExtractChannel(rawQuery string) (string, string) {
+	rawQuery = strings.TrimPrefix(rawQuery, "?")
+	if !strings.Contains(rawQuery, ChannelParam+"=") {
+		return "", rawQuery
+	}
+
+	channel := ""
+	pairs := make([]string, 0)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		kv := strings.SplitN(pair, "=", 2)
+		if channel == "" && len(kv) == 2 && kv[0] == ChannelParam {
+			if value, err := url.QueryUnescape(kv[1]); err == nil && knownChannels[value] {
+				channel = value
+				continue
+			}
+		}
+		pairs = append(pairs, pair)
+	}
+	return channel, strings.Join(pairs, "&")
+}
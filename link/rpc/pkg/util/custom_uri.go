@@ -0,0 +1,63 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 自定义短链接后缀默认长度限制
+const (
+	CustomUriMinLength = 3
+	CustomUriMaxLength = 32
+)
+
+// customUriPattern 自定义后缀只允许字母、数字、下划线和中划线
+var customUriPattern = regexp.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+// DefaultReservedUris 默认保留字，与网关路由或系统路径冲突，不允许作为自定义后缀
+var DefaultReservedUris = []string{
+	"api",
+	"admin",
+	"static",
+	"assets",
+	"login",
+	"logout",
+	"register",
+	"user",
+	"health",
+	"metrics",
+}
+
+// ValidateCustomUri 校验自定义短链接后缀
+// minLength、maxLength 小于等于0时使用默认长度限制，保留字比较不区分大小写
+func ValidateCustomUri(uri string, minLength, maxLength int, reserved []string) error {
+	if minLength <= 0 {
+		minLength = CustomUriMinLength
+	}
+	if maxLength <= 0 {
+		maxLength = CustomUriMaxLength
+	}
+
+	if len(uri) < minLength || len(uri) > maxLength {
+		return fmt.Errorf("自定义短链接长度需在%d到%d个字符之间", minLength, maxLength)
+	}
+
+	if !customUriPattern.MatchString(uri) {
+		return errors.New("自定义短链接只能包含字母、数字、下划线和中划线")
+	}
+
+	if strings.HasPrefix(uri, "-") || strings.HasPrefix(uri, "_") ||
+		strings.HasSuffix(uri, "-") || strings.HasSuffix(uri, "_") {
+		return errors.New("自定义短链接不能以下划线或中划线开头或结尾")
+	}
+
+	for _, word := range reserved {
+		if strings.EqualFold(uri, word) {
+			return fmt.Errorf("自定义短链接 %s 为系统保留字", uri)
+		}
+	}
+
+	return nil
+}
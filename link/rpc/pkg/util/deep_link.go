@@ -0,0 +1,84 @@
+package util
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// 深度链接配置限制
+const (
+	// 深度链接最大长度
+	DeepLinkMaxLength = 1024
+)
+
+var (
+	// Android包名，如 com.example.app
+	androidPackagePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*(\.[a-zA-Z][a-zA-Z0-9_]*)+$`)
+	// iOS应用标识，格式为 TeamID.BundleID，如 ABCDE12345.com.example.app
+	appleAppIdPattern = regexp.MustCompile(`^[A-Z0-9]{10}\.[a-zA-Z0-9\-]+(\.[a-zA-Z0-9\-]+)*$`)
+	// Android签名证书SHA256指纹，32组以冒号分隔的十六进制字节
+	certFingerprintPattern = regexp.MustCompile(`^([0-9A-F]{2}:){31}[0-9A-F]{2}$`)
+)
+
+// DeepLink 短链接的应用深度链接配置
+type DeepLink struct {
+	IosUrl         string `json:"iosUrl,omitempty"`         // iOS自定义Scheme或通用链接
+	AndroidPackage string `json:"androidPackage,omitempty"` // Android应用包名
+	AndroidUrl     string `json:"androidUrl,omitempty"`     // Android自定义Scheme或App Links链接
+	FallbackUrl    string `json:"fallbackUrl,omitempty"`    // 未安装应用时的兜底链接
+}
+
+// IsEmpty 判断是否未配置任何深度链接
+func (d DeepLink) IsEmpty() bool {
+	return d.IosUrl == "" && d.AndroidPackage == "" && d.AndroidUrl == ""
+}
+
+// IsValidAppUrl 判断应用链接是否合法，支持自定义Scheme（如 myapp://path）和http(s)链接
+func IsValidAppUrl(appUrl string) bool {
+	if len(appUrl) > DeepLinkMaxLength {
+		return false
+	}
+	u, err := url.Parse(appUrl)
+	if err != nil || u.Scheme == "" || u.Opaque != "" {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "javascript", "data", "vbscript", "file", "intent":
+		// 禁止可执行脚本或本地文件的Scheme，intent链接由网关根据包名生成
+		return false
+	}
+	return true
+}
+
+// IsValidAndroidPackage 判断Android包名是否合法
+func IsValidAndroidPackage(pkg string) bool {
+	return len(pkg) <= 256 && androidPackagePattern.MatchString(pkg)
+}
+
+// IsValidAppleAppId 判断iOS应用标识是否合法
+func IsValidAppleAppId(appId string) bool {
+	return len(appId) <= 256 && appleAppIdPattern.MatchString(appId)
+}
+
+// NormalizeCertFingerprint 规范化签名证书指纹为大写，格式不合法时返回空字符串
+func NormalizeCertFingerprint(fingerprint string) string {
+	fingerprint = strings.ToUpper(strings.TrimSpace(fingerprint))
+	if !certFingerprintPattern.MatchString(fingerprint) {
+		return ""
+	}
+	return fingerprint
+}
+
+// SplitList 拆分逗号分隔的列表，忽略空项
+func SplitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
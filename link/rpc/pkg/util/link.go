@@ -14,13 +14,40 @@ const (
 	ValidDateTypeCustom = 1
 )
 
+// 跳转类型
+const (
+	// 302临时重定向（默认）
+	RedirectTypeFound = 0
+	// 301永久重定向
+	RedirectTypeMovedPermanently = 1
+	// 307临时重定向，保留请求方法
+	RedirectTypeTemporaryRedirect = 2
+	// 中间页倒计时跳转，展示目标链接后再跳转
+	RedirectTypeInterstitial = 3
+)
+
+// IsValidRedirectType 判断跳转类型是否合法
+func IsValidRedirectType(redirectType int) bool {
+	switch redirectType {
+	case RedirectTypeFound, RedirectTypeMovedPermanently, RedirectTypeTemporaryRedirect, RedirectTypeInterstitial:
+		return true
+	}
+	return false
+}
+
 // GetLinkCacheValidTime 计算链接缓存的有效时间（毫秒）
+// 如果尚未到生效时间，则返回从现在到生效时间的毫秒数，保证链接按时生效
 // 如果是永久有效，则返回30天的毫秒数
 // 如果是自定义有效期，则返回从现在到有效期的毫秒数
-func GetLinkCacheValidTime(validDate time.Time) int64 {
+func GetLinkCacheValidTime(validFrom *time.Time, validDate time.Time) int64 {
 	// 当前时间
 	now := time.Now()
 
+	// 尚未生效的链接只缓存到生效时间
+	if IsLinkNotYetActive(validFrom) {
+		return validFrom.UnixMilli() - now.UnixMilli()
+	}
+
 	// 如果有效期为空或者过去的时间，则返回30天缓存时间
 	if validDate.IsZero() || validDate.Before(now) {
 		return 30 * DayMilliseconds // 30天
@@ -30,6 +57,36 @@ func GetLinkCacheValidTime(validDate time.Time) int64 {
 	return validDate.UnixMilli() - now.UnixMilli()
 }
 
+// GetLinkCacheValidSeconds 计算链接缓存的有效时间（秒），不足1秒时按1秒计算
+// Redis的SETEX不接受0秒，即将生效的链接也需要写入缓存
+func GetLinkCacheValidSeconds(validFrom *time.Time, validDate time.Time) int {
+	seconds := int(GetLinkCacheValidTime(validFrom, validDate) / 1000)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// IsLinkNotYetActive 判断链接是否尚未到生效时间，未设置生效时间时视为立即生效
+func IsLinkNotYetActive(validFrom *time.Time) bool {
+	return validFrom != nil && !validFrom.IsZero() && time.Now().Before(*validFrom)
+}
+
+// GetLinkExpireTime 计算链接实际停止跳转的时间，宽限天数大于0时在有效期基础上顺延
+func GetLinkExpireTime(validDate time.Time, graceDays int) time.Time {
+	if graceDays <= 0 {
+		return validDate
+	}
+	return validDate.AddDate(0, 0, graceDays)
+}
+
+// IsInGracePeriod 判断链接是否已过有效期但仍处于宽限期内
+func IsInGracePeriod(validDate time.Time, graceDays int) bool {
+	now := time.Now()
+	return graceDays > 0 && !validDate.IsZero() && validDate.Before(now) &&
+		GetLinkExpireTime(validDate, graceDays).After(now)
+}
+
 // IsValidLink 判断链接是否有效
 // 根据短链接的有效期类型和有效期判断链接是否过期
 func IsValidLink(validDateType int, validDate time.Time) bool {
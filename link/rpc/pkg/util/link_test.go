@@ -0,0 +1,22 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+// TestGetLinkCacheValidSeconds 测试缓存时间不足1秒时按1秒计算
+func TestGetLinkCacheValidSeconds(t *testing.T) {
+	soon := time.Now().Add(300 * time.Millisecond)
+	if got := GetLinkCacheValidSeconds(&soon, time.Time{}); got != 1 {
+		t.Errorf("即将生效的链接期望缓存1秒，实际: %d", got)
+	}
+
+	if got := GetLinkCacheValidSeconds(nil, time.Now().Add(500*time.Millisecond)); got != 1 {
+		t.Errorf("即将过期的链接期望缓存1秒，实际: %d", got)
+	}
+
+	if got := GetLinkCacheValidSeconds(nil, time.Time{}); got != 30*24*60*60 {
+		t.Errorf("永久有效的链接期望缓存30天，实际: %d", got)
+	}
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PasswordVersion 计算短链接密码哈希的摘要，用于解锁令牌签名
+// 修改或清除密码后摘要变化，已签发的解锁令牌随之失效
+func PasswordVersion(passwordHash string) string {
+	if passwordHash == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(passwordHash))
+	return hex.EncodeToString(sum[:8])
+}
+
+// SignUnlockToken 生成短链接解锁令牌，格式为 过期时间戳.签名
+func SignUnlockToken(secret, fullShortUrl, passwordVersion string, expireAt time.Time) string {
+	expire := strconv.FormatInt(expireAt.Unix(), 10)
+	return expire + "." + unlockSignature(secret, fullShortUrl, passwordVersion, expire)
+}
+
+// VerifyUnlockToken 校验短链接解锁令牌的签名和有效期
+func VerifyUnlockToken(secret, fullShortUrl, passwordVersion, token string) bool {
+	if passwordVersion == "" {
+		return false
+	}
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return false
+	}
+
+	expire, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || time.Now().Unix() > expire {
+		return false
+	}
+
+	expected := unlockSignature(secret, fullShortUrl, passwordVersion, parts[0])
+	return hmac.Equal([]byte(parts[1]), []byte(expected))
+}
+
+// unlockSignature 计算解锁令牌签名
+func unlockSignature(secret, fullShortUrl, passwordVersion, expire string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%s|%s|%s", fullShortUrl, passwordVersion, expire)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+// TestVerifyUnlockToken 测试解锁令牌绑定短链接和密码，过期或修改密码后失效
+func TestVerifyUnlockToken(t *testing.T) {
+	secret, fullShortUrl := "secret", "nurl.ink/abc123"
+	version := PasswordVersion("$2a$10$hash")
+	token := SignUnlockToken(secret, fullShortUrl, version, time.Now().Add(time.Hour))
+
+	if !VerifyUnlockToken(secret, fullShortUrl, version, token) {
+		t.Error("期望解锁令牌有效")
+	}
+	if VerifyUnlockToken(secret, "nurl.ink/other", version, token) {
+		t.Error("期望其他短链接的解锁令牌无效")
+	}
+	if VerifyUnlockToken(secret, fullShortUrl, PasswordVersion("$2a$10$changed"), token) {
+		t.Error("期望修改密码后解锁令牌无效")
+	}
+	if VerifyUnlockToken(secret, fullShortUrl, "", token) {
+		t.Error("期望密码摘要为空时解锁令牌无效")
+	}
+	if VerifyUnlockToken("other", fullShortUrl, version, token) {
+		t.Error("期望密钥不同时解锁令牌无效")
+	}
+
+	expired := SignUnlockToken(secret, fullShortUrl, version, time.Now().Add(-time.Second))
+	if VerifyUnlockToken(secret, fullShortUrl, version, expired) {
+		t.Error("期望过期的解锁令牌无效")
+	}
+}
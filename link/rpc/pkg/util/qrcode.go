@@ -0,0 +1,213 @@
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// 二维码图片格式
+const (
+	QrCodeFormatPng = "png"
+	QrCodeFormatSvg = "svg"
+)
+
+// 二维码中心图标占二维码内容区域边长的比例，需小于纠错等级H可恢复的面积
+const qrCodeLogoRatio = 0.2
+
+// QrCodeOptions 二维码渲染配置
+type QrCodeOptions struct {
+	Size       int         // 图片边长（像素）
+	Margin     int         // 静默区宽度（模块数）
+	Foreground color.RGBA  // 前景色
+	Background color.RGBA  // 背景色
+	Logo       image.Image // 中心图标，为空时不绘制
+}
+
+// ParseQrCodeLevel 解析纠错等级 L/M/Q/H，为空时使用M
+func ParseQrCodeLevel(ecc string) (qrcode.RecoveryLevel, bool) {
+	switch strings.ToUpper(ecc) {
+	case "L":
+		return qrcode.Low, true
+	case "", "M":
+		return qrcode.Medium, true
+	case "Q":
+		return qrcode.High, true
+	case "H":
+		return qrcode.Highest, true
+	}
+	return qrcode.Medium, false
+}
+
+// ParseHexColor 解析十六进制颜色，支持 #RGB 和 #RRGGBB 格式，#号可省略
+func ParseHexColor(value string) (color.RGBA, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(value) == 3 {
+		value = string([]byte{value[0], value[0], value[1], value[1], value[2], value[2]})
+	}
+	if len(value) != 6 {
+		return color.RGBA{}, false
+	}
+	rgb, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xff}, true
+}
+
+// RenderQrCode 生成二维码图片，返回图片内容
+func RenderQrCode(content string, level qrcode.RecoveryLevel, format string, opts QrCodeOptions) ([]byte, error) {
+	q, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, err
+	}
+	// 静默区由渲染时按配置绘制
+	q.DisableBorder = true
+	bitmap := q.Bitmap()
+
+	modules := len(bitmap) + 2*opts.Margin
+	if opts.Size < modules {
+		return nil, fmt.Errorf("二维码尺寸不能小于%d像素", modules)
+	}
+
+	switch format {
+	case QrCodeFormatSvg:
+		return renderQrCodeSvg(bitmap, opts)
+	default:
+		return renderQrCodePng(bitmap, opts)
+	}
+}
+
+// renderQrCodePng 按整数倍放大模块绘制PNG图片，无法整除的部分居中后填充背景色
+func renderQrCodePng(bitmap [][]bool, opts QrCodeOptions) ([]byte, error) {
+	modules := len(bitmap) + 2*opts.Margin
+	scale := opts.Size / modules
+	offset := (opts.Size-scale*modules)/2 + opts.Margin*scale
+
+	img := image.NewRGBA(image.Rect(0, 0, opts.Size, opts.Size))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = opts.Background.R, opts.Background.G, opts.Background.B, opts.Background.A
+	}
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if !dark {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetRGBA(offset+x*scale+dx, offset+y*scale+dy, opts.Foreground)
+				}
+			}
+		}
+	}
+
+	if opts.Logo != nil {
+		drawQrCodeLogo(img, opts.Logo, offset, len(bitmap)*scale, opts.Background)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// drawQrCodeLogo 在二维码中心绘制图标，图标按比例缩放并保留背景色边框
+func drawQrCodeLogo(img *image.RGBA, logo image.Image, offset, codeSize int, background color.RGBA) {
+	box := int(float64(codeSize) * qrCodeLogoRatio)
+	if box <= 0 {
+		return
+	}
+	padding := box / 10
+	start := offset + (codeSize-box)/2
+	for y := start - padding; y < start+box+padding; y++ {
+		for x := start - padding; x < start+box+padding; x++ {
+			img.SetRGBA(x, y, background)
+		}
+	}
+
+	// 保持图标宽高比，按最近邻缩放
+	bounds := logo.Bounds()
+	w, h := box, box
+	if bounds.Dx() > bounds.Dy() {
+		h = box * bounds.Dy() / bounds.Dx()
+	} else if bounds.Dy() > bounds.Dx() {
+		w = box * bounds.Dx() / bounds.Dy()
+	}
+	left, top := start+(box-w)/2, start+(box-h)/2
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			src := logo.At(bounds.Min.X+x*bounds.Dx()/w, bounds.Min.Y+y*bounds.Dy()/h)
+			img.Set(left+x, top+y, blendColor(src, img.RGBAAt(left+x, top+y)))
+		}
+	}
+}
+
+// blendColor 将半透明的图标像素叠加到背景上
+func blendColor(src color.Color, dst color.RGBA) color.RGBA {
+	r, g, b, a := src.RGBA()
+	if a == 0xffff {
+		return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 0xff}
+	}
+	mix := func(s uint32, d uint8) uint8 {
+		return uint8((s + uint32(d)*0x101*(0xffff-a)/0xffff) >> 8)
+	}
+	return color.RGBA{R: mix(r, dst.R), G: mix(g, dst.G), B: mix(b, dst.B), A: 0xff}
+}
+
+// renderQrCodeSvg 生成SVG矢量图，同一行相邻的模块合并为一个矩形路径
+func renderQrCodeSvg(bitmap [][]bool, opts QrCodeOptions) ([]byte, error) {
+	modules := len(bitmap) + 2*opts.Margin
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		opts.Size, opts.Size, modules, modules)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="%s"/>`, modules, modules, hexColor(opts.Background))
+	fmt.Fprintf(&b, `<path fill="%s" d="`, hexColor(opts.Foreground))
+	for y, row := range bitmap {
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			run := 1
+			for x+run < len(row) && row[x+run] {
+				run++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", x+opts.Margin, y+opts.Margin, run, run)
+			x += run
+		}
+	}
+	b.WriteString(`"/>`)
+
+	if opts.Logo != nil {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, opts.Logo); err != nil {
+			return nil, err
+		}
+		codeSize := float64(len(bitmap))
+		box := codeSize * qrCodeLogoRatio
+		padding := box / 10
+		start := float64(opts.Margin) + (codeSize-box)/2
+		fmt.Fprintf(&b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s"/>`,
+			start-padding, start-padding, box+2*padding, box+2*padding, hexColor(opts.Background))
+		fmt.Fprintf(&b, `<image x="%.2f" y="%.2f" width="%.2f" height="%.2f" preserveAspectRatio="xMidYMid meet" href="data:image/png;base64,%s"/>`,
+			start, start, box, box, base64.StdEncoding.EncodeToString(buf.Bytes()))
+	}
+
+	b.WriteString("</svg>")
+	return []byte(b.String()), nil
+}
+
+// hexColor 将颜色格式化为 #RRGGBB
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
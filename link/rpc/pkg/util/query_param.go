@@ -0,0 +1,172 @@
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// 查询参数策略，决定访问短链接时携带的查询参数如何传递给目标链接
+const (
+	// 忽略访问时携带的查询参数
+	QueryParamPolicyIgnore = 0
+	// 将访问时携带的查询参数原样追加到目标链接
+	QueryParamPolicyForward = 1
+	// 合并查询参数，同名参数以访问时携带的值为准
+	QueryParamPolicyMerge = 2
+)
+
+// UTM模板最大长度
+const UtmTemplateMaxLength = 100
+
+// UTM模板支持的占位符
+const (
+	// 短链接后缀
+	UtmPlaceholderShortUri = "{shortUri}"
+	// 访问者操作系统
+	UtmPlaceholderOs = "{os}"
+	// 命中的A/B分流版本
+	UtmPlaceholderVariant = "{variant}"
+)
+
+// UtmTemplate 短链接的UTM参数模板
+type UtmTemplate struct {
+	Source   string `json:"source,omitempty"`   // utm_source
+	Medium   string `json:"medium,omitempty"`   // utm_medium
+	Campaign string `json:"campaign,omitempty"` // utm_campaign
+}
+
+// IsValidQueryParamPolicy 判断查询参数策略是否合法
+func IsValidQueryParamPolicy(policy int) bool {
+	switch policy {
+	case QueryParamPolicyIgnore, QueryParamPolicyForward, QueryParamPolicyMerge:
+		return true
+	}
+	return false
+}
+
+// ValidateUtmTemplate 校验UTM模板，只允许使用支持的占位符
+func ValidateUtmTemplate(tpl string) error {
+	if utf8.RuneCountInString(tpl) > UtmTemplateMaxLength {
+		return fmt.Errorf("UTM模板长度不能超过%d个字符", UtmTemplateMaxLength)
+	}
+
+	rest := tpl
+	for {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			return nil
+		}
+		if rest[start] == '}' {
+			return fmt.Errorf("UTM模板 %s 中的花括号不匹配", tpl)
+		}
+
+		end := strings.IndexAny(rest[start+1:], "{}")
+		if end < 0 || rest[start+1+end] != '}' {
+			return fmt.Errorf("UTM模板 %s 中的花括号不匹配", tpl)
+		}
+
+		placeholder := rest[start : start+end+2]
+		switch placeholder {
+		case UtmPlaceholderShortUri, UtmPlaceholderOs, UtmPlaceholderVariant:
+		default:
+			return fmt.Errorf("UTM模板中不支持的占位符 %s", placeholder)
+		}
+		rest = rest[start+end+2:]
+	}
+}
+
+// RenderUtmTemplate 将UTM模板中的占位符替换为实际值
+func RenderUtmTemplate(tpl, shortUri, os, variant string) string {
+	if !strings.Contains(tpl, "{") {
+		return tpl
+	}
+	return strings.NewReplacer(
+		UtmPlaceholderShortUri, shortUri,
+		UtmPlaceholderOs, os,
+		UtmPlaceholderVariant, variant,
+	).Replace(tpl)
+}
+
+// SetQueryParams 在目标链接上设置查询参数，已存在的同名参数会被替换
+// 目标链接原有的其他参数保持原有顺序，锚点保持不变
+func SetQueryParams(targetUrl string, params url.Values) (string, error) {
+	if len(params) == 0 {
+		return targetUrl, nil
+	}
+
+	u, err := url.Parse(targetUrl)
+	if err != nil {
+		return "", err
+	}
+
+	pairs := make([]string, 0)
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		key := strings.SplitN(pair, "=", 2)[0]
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		if _, ok := params[key]; ok {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+	pairs = append(pairs, params.Encode())
+
+	u.RawQuery = strings.Join(pairs, "&")
+	return u.String(), nil
+}
+
+// MergeQueryParams 按查询参数策略将访问时携带的查询参数合并到目标链接
+func MergeQueryParams(targetUrl, rawQuery string, policy int) (string, error) {
+	rawQuery = strings.TrimPrefix(rawQuery, "?")
+	if rawQuery == "" {
+		return targetUrl, nil
+	}
+
+	switch policy {
+	case QueryParamPolicyForward:
+		u, err := url.Parse(targetUrl)
+		if err != nil {
+			return "", err
+		}
+		if _, err := url.ParseQuery(rawQuery); err != nil {
+			return "", err
+		}
+		if u.RawQuery == "" {
+			u.RawQuery = rawQuery
+		} else {
+			u.RawQuery = u.RawQuery + "&" + rawQuery
+		}
+		return u.String(), nil
+	case QueryParamPolicyMerge:
+		params, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return "", err
+		}
+		return SetQueryParams(targetUrl, params)
+	}
+	return targetUrl, nil
+}
+
+// ApplyUtmTemplate 将UTM模板渲染后追加到目标链接，模板为空的参数不追加
+func ApplyUtmTemplate(targetUrl string, tpl UtmTemplate, shortUri, os, variant string) (string, error) {
+	params := url.Values{}
+	for key, value := range map[string]string{
+		"utm_source":   tpl.Source,
+		"utm_medium":   tpl.Medium,
+		"utm_campaign": tpl.Campaign,
+	} {
+		if value == "" {
+			continue
+		}
+		if rendered := RenderUtmTemplate(value, shortUri, os, variant); rendered != "" {
+			params.Set(key, rendered)
+		}
+	}
+	return SetQueryParams(targetUrl, params)
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 跳转规则类型
+const (
+	// 按操作系统匹配，如 iOS、Android、Windows
+	RedirectRuleTypeOs = "os"
+	// 按省份匹配，如 广东、北京
+	RedirectRuleTypeProvince = "province"
+	// 按Accept-Language匹配，如 en、zh-TW
+	RedirectRuleTypeLanguage = "language"
+)
+
+// RedirectVisitor 访问者信息，用于匹配跳转规则
+type RedirectVisitor struct {
+	Os             string // 操作系统
+	Province       string // 省份
+	AcceptLanguage string // Accept-Language请求头
+}
+
+// IsValidRedirectRuleType 判断跳转规则类型是否合法
+func IsValidRedirectRuleType(ruleType string) bool {
+	switch ruleType {
+	case RedirectRuleTypeOs, RedirectRuleTypeProvince, RedirectRuleTypeLanguage:
+		return true
+	}
+	return false
+}
+
+// MatchRedirectRule 判断访问者是否命中跳转规则
+func MatchRedirectRule(ruleType, ruleValue string, visitor RedirectVisitor) bool {
+	ruleValue = strings.TrimSpace(ruleValue)
+	if ruleValue == "" {
+		return false
+	}
+
+	switch ruleType {
+	case RedirectRuleTypeOs:
+		return strings.EqualFold(visitor.Os, ruleValue)
+	case RedirectRuleTypeProvince:
+		// 规则值可省略"省"、"市"等后缀，如"广东"可匹配"广东省"
+		return visitor.Province != "" && strings.HasPrefix(visitor.Province, ruleValue)
+	case RedirectRuleTypeLanguage:
+		return matchAcceptLanguage(visitor.AcceptLanguage, ruleValue)
+	}
+	return false
+}
+
+// matchAcceptLanguage 判断访问者最优先的语言是否为指定语言，按q值确定优先级，q=0表示不接受
+// 规则值为主语言（如 en）时匹配该语言的所有地区（如 en-US），否则需完全匹配
+func matchAcceptLanguage(header, lang string) bool {
+	bestQ := 0.0
+	var preferred []string
+	for _, part := range strings.Split(header, ",") {
+		tag, q := parseLanguageRange(part)
+		if tag == "" || tag == "*" || q <= 0 {
+			continue
+		}
+		switch {
+		case q > bestQ:
+			bestQ = q
+			preferred = []string{tag}
+		case q == bestQ:
+			preferred = append(preferred, tag)
+		}
+	}
+
+	for _, tag := range preferred {
+		if strings.EqualFold(tag, lang) {
+			return true
+		}
+		if !strings.Contains(lang, "-") {
+			if primary := strings.SplitN(tag, "-", 2)[0]; strings.EqualFold(primary, lang) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseLanguageRange 解析Accept-Language中的一项，返回语言标签和q值，未指定q值时为1
+func parseLanguageRange(part string) (string, float64) {
+	fields := strings.Split(part, ";")
+	tag := strings.TrimSpace(fields[0])
+	q := 1.0
+	for _, param := range fields[1:] {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(key), "q") {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return tag, 0
+		}
+		q = parsed
+	}
+	return tag, q
+}
@@ -0,0 +1,29 @@
+package util
+
+import "testing"
+
+// TestMatchRedirectRule_Language 测试语言规则按q值匹配访问者最优先的语言
+func TestMatchRedirectRule_Language(t *testing.T) {
+	cases := []struct {
+		header string
+		lang   string
+		want   bool
+	}{
+		{"en-US,en;q=0.9", "en", true},
+		{"en-US,en;q=0.9", "en-US", true},
+		{"en-US,en;q=0.9", "en-GB", false},
+		{"zh-CN,zh;q=0.9,en;q=0.8", "en", false},
+		{"zh-CN,zh;q=0.9,en;q=0.8", "zh", true},
+		{"zh-CN;q=0.5,en-GB", "en", true},
+		{"en;q=0,zh-TW", "en", false},
+		{"fr;q=0.8,de;q=0.8", "de", true},
+		{"*", "en", false},
+		{"", "en", false},
+	}
+	for _, c := range cases {
+		got := MatchRedirectRule(RedirectRuleTypeLanguage, c.lang, RedirectVisitor{AcceptLanguage: c.header})
+		if got != c.want {
+			t.Errorf("Accept-Language=%q 规则=%s 期望 %v，实际 %v", c.header, c.lang, c.want, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"net"
 	"net/url"
 	"strings"
 )
@@ -38,3 +39,65 @@ func ExtractDomain(originUrl string) string {
 
 	return host
 }
+
+// IsHttpUrl 判断链接是否为带主机名的http(s)链接
+// 跳转目标只允许http(s)协议，避免javascript:等链接在中间页等页面中执行脚本
+func IsHttpUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	return scheme == "http" || scheme == "https"
+}
+
+// NormalizeHost 规范化请求中的Host，去除端口号和末尾的点并转为小写
+func NormalizeHost(host string) string {
+	host = strings.TrimSpace(strings.ToLower(host))
+	if host == "" {
+		return ""
+	}
+
+	// 移除端口号部分（兼容IPv6格式 [::1]:8080）
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+
+	return strings.TrimSuffix(host, ".")
+}
+
+// 自定义域名验证的TXT记录
+const (
+	// TXT记录名称前缀，记录配置在 _shorterurl-verify.<域名> 上
+	DomainVerifyRecordPrefix = "_shorterurl-verify."
+	// TXT记录值前缀
+	DomainVerifyValuePrefix = "shorterurl-verify="
+)
+
+// DomainVerifyRecord 返回域名验证需要配置的TXT记录名称和记录值
+func DomainVerifyRecord(domain, token string) (string, string) {
+	return DomainVerifyRecordPrefix + domain, DomainVerifyValuePrefix + token
+}
+
+// IsValidCustomDomain 校验自定义域名格式，至少包含两级，每级由字母、数字和连字符组成
+func IsValidCustomDomain(domain string) bool {
+	if len(domain) > 128 || net.ParseIP(domain) != nil {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,22 @@
+package util
+
+import "testing"
+
+// TestIsHttpUrl 测试只有带主机名的http(s)链接才是合法的跳转目标
+func TestIsHttpUrl(t *testing.T) {
+	cases := map[string]bool{
+		"https://github.com/zeromicro/go-zero":     true,
+		"HTTP://Example.com":                       true,
+		"javascript://example.com/%0aalert(1)":     false,
+		"data:text/html,<script>alert(1)</script>": false,
+		"vbscript:msgbox(1)":                       false,
+		"github.com/zeromicro":                     false,
+		"https:///path":                            false,
+		"":                                         false,
+	}
+	for rawUrl, want := range cases {
+		if got := IsHttpUrl(rawUrl); got != want {
+			t.Errorf("IsHttpUrl(%q) 期望 %v，实际 %v", rawUrl, want, got)
+		}
+	}
+}
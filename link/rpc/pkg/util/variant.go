@@ -0,0 +1,33 @@
+package util
+
+import (
+	"hash/crc32"
+)
+
+// VariantTotalWeight A/B分流所有版本的权重之和
+const VariantTotalWeight = 100
+
+// PickVariant 根据访问者标识选择分流版本，返回版本下标
+// 同一访问者对同一短链接总是落在同一个桶中，保证多次访问结果一致
+func PickVariant(fullShortUrl, visitor string, weights []int) int {
+	if len(weights) == 0 {
+		return -1
+	}
+
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return 0
+	}
+
+	bucket := int(crc32.ChecksumIEEE([]byte(fullShortUrl+"|"+visitor)) % uint32(total))
+	for i, w := range weights {
+		if bucket < w {
+			return i
+		}
+		bucket -= w
+	}
+	return len(weights) - 1
+}
@@ -0,0 +1,146 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// 工作簿固定部分，只包含一个工作表
+const (
+	contentTypesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	rootRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	workbookXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	workbookRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	sheetHeaderXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetFooterXml = `</sheetData></worksheet>`
+)
+
+// ContentType XLSX文件类型
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// StreamWriter 流式写入单个工作表的XLSX文件，逐行写入，不在内存中保存已写入的行
+// 字符串使用内联字符串保存，无需共享字符串表
+type StreamWriter struct {
+	zw     *zip.Writer
+	sheet  *bufio.Writer
+	rowNum int
+	closed bool
+}
+
+// NewStreamWriter 创建流式写入器，sheetName为工作表名称
+func NewStreamWriter(w io.Writer, sheetName string) (*StreamWriter, error) {
+	zw := zip.NewWriter(w)
+	var name bytes.Buffer
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXml},
+		{"_rels/.rels", rootRelsXml},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXml, name.String())},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXml},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// 工作表必须最后创建，之后的写入都在该文件中
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(sheetHeaderXml); err != nil {
+		return nil, err
+	}
+	return &StreamWriter{
+		zw:    zw,
+		sheet: sheet,
+	}, nil
+}
+
+// WriteRow 写入一行，整数写为数值单元格，其他值按字符串写入
+func (w *StreamWriter) WriteRow(cells []interface{}) error {
+	if w.closed {
+		return errors.New("xlsx: write after close")
+	}
+	w.rowNum++
+	if _, err := fmt.Fprintf(w.sheet, `<row r="%d">`, w.rowNum); err != nil {
+		return err
+	}
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.rowNum)
+		var err error
+		switch v := cell.(type) {
+		case int:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int32:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int64:
+			_, err = fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		default:
+			text := fmt.Sprint(v)
+			if text == "" {
+				continue
+			}
+			if _, err = fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref); err != nil {
+				return err
+			}
+			// EscapeText会将XML中不允许的控制字符替换为U+FFFD
+			if err = xml.EscapeText(w.sheet, []byte(text)); err != nil {
+				return err
+			}
+			_, err = w.sheet.WriteString(`</t></is></c>`)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Close 写入工作表结尾并完成压缩包，不关闭底层的io.Writer
+func (w *StreamWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if _, err := w.sheet.WriteString(sheetFooterXml); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// columnName 将从0开始的列序号转换为列名，如0为A，26为AA
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
@@ -21,15 +21,49 @@ type (
 	BatchCreateShortLinkRequest     = pb.BatchCreateShortLinkRequest
 	BatchCreateShortLinkResponse    = pb.BatchCreateShortLinkResponse
 	BrowserStat                     = pb.BrowserStat
+	BulkActionItemResult            = pb.BulkActionItemResult
+	BulkActionRequest               = pb.BulkActionRequest
+	BulkActionResponse              = pb.BulkActionResponse
+	BulkActionSelector              = pb.BulkActionSelector
+	CancelGroupTransferRequest      = pb.CancelGroupTransferRequest
+	CancelGroupTransferResponse     = pb.CancelGroupTransferResponse
+	ChannelStat                     = pb.ChannelStat
+	CloneShortLinkRequest           = pb.CloneShortLinkRequest
+	CloneShortLinkResponse          = pb.CloneShortLinkResponse
+	CreateGroupTransferRequest      = pb.CreateGroupTransferRequest
+	CreateGroupTransferResponse     = pb.CreateGroupTransferResponse
+	CreateLinkTemplateRequest       = pb.CreateLinkTemplateRequest
+	CreateLinkTemplateResponse      = pb.CreateLinkTemplateResponse
+	CreateRedirectRuleRequest       = pb.CreateRedirectRuleRequest
+	CreateRedirectRuleResponse      = pb.CreateRedirectRuleResponse
 	CreateShortLinkRequest          = pb.CreateShortLinkRequest
 	CreateShortLinkResponse         = pb.CreateShortLinkResponse
 	DailyStat                       = pb.DailyStat
+	DeleteLinkTemplateRequest       = pb.DeleteLinkTemplateRequest
+	DeleteLinkTemplateResponse      = pb.DeleteLinkTemplateResponse
+	DeleteRedirectRuleRequest       = pb.DeleteRedirectRuleRequest
+	DeleteRedirectRuleResponse      = pb.DeleteRedirectRuleResponse
 	DeviceStat                      = pb.DeviceStat
+	DomainAppLinks                  = pb.DomainAppLinks
+	DownloadExportRequest           = pb.DownloadExportRequest
+	DownloadExportResponse          = pb.DownloadExportResponse
 	EmptyResponse                   = pb.EmptyResponse
+	ExportShortLinkRequest          = pb.ExportShortLinkRequest
+	ExportShortLinkResponse         = pb.ExportShortLinkResponse
+	FlagModerationRequest           = pb.FlagModerationRequest
+	FlagModerationResponse          = pb.FlagModerationResponse
+	GetDomainAppLinksRequest        = pb.GetDomainAppLinksRequest
+	GetDomainAppLinksResponse       = pb.GetDomainAppLinksResponse
+	GetExportJobRequest             = pb.GetExportJobRequest
+	GetExportJobResponse            = pb.GetExportJobResponse
+	GetGroupExpiryPolicyRequest     = pb.GetGroupExpiryPolicyRequest
+	GetGroupExpiryPolicyResponse    = pb.GetGroupExpiryPolicyResponse
 	GetGroupStatsRequest            = pb.GetGroupStatsRequest
 	GetGroupStatsResponse           = pb.GetGroupStatsResponse
 	GetIPLocationRequest            = pb.GetIPLocationRequest
 	GetIPLocationResponse           = pb.GetIPLocationResponse
+	GetImportJobRequest             = pb.GetImportJobRequest
+	GetImportJobResponse            = pb.GetImportJobResponse
 	GetSingleStatsRequest           = pb.GetSingleStatsRequest
 	GetSingleStatsResponse          = pb.GetSingleStatsResponse
 	GetUrlTitleRequest              = pb.GetUrlTitleRequest
@@ -37,30 +71,85 @@ type (
 	GroupAccessRecordQueryRequest   = pb.GroupAccessRecordQueryRequest
 	GroupAccessRecordQueryResponse  = pb.GroupAccessRecordQueryResponse
 	GroupCount                      = pb.GroupCount
+	GroupExpiryPolicy               = pb.GroupExpiryPolicy
 	GroupShortLinkCountRequest      = pb.GroupShortLinkCountRequest
 	GroupShortLinkCountResponse     = pb.GroupShortLinkCountResponse
+	GroupTransferRecord             = pb.GroupTransferRecord
+	ImportRowResult                 = pb.ImportRowResult
+	ImportShortLinkRequest          = pb.ImportShortLinkRequest
+	ImportShortLinkResponse         = pb.ImportShortLinkResponse
+	LinkHistoryChange               = pb.LinkHistoryChange
+	LinkHistoryRecord               = pb.LinkHistoryRecord
+	LinkTemplate                    = pb.LinkTemplate
+	LinkVariant                     = pb.LinkVariant
+	ListGroupTransferRequest        = pb.ListGroupTransferRequest
+	ListGroupTransferResponse       = pb.ListGroupTransferResponse
+	ListLinkHistoryRequest          = pb.ListLinkHistoryRequest
+	ListLinkHistoryResponse         = pb.ListLinkHistoryResponse
+	ListLinkTemplateRequest         = pb.ListLinkTemplateRequest
+	ListLinkTemplateResponse        = pb.ListLinkTemplateResponse
+	ListRedirectRuleRequest         = pb.ListRedirectRuleRequest
+	ListRedirectRuleResponse        = pb.ListRedirectRuleResponse
+	ListUserDomainRequest           = pb.ListUserDomainRequest
+	ListUserDomainResponse          = pb.ListUserDomainResponse
 	LocaleCnStat                    = pb.LocaleCnStat
+	ModerationRecord                = pb.ModerationRecord
+	MoveShortLinkRequest            = pb.MoveShortLinkRequest
+	MoveShortLinkResponse           = pb.MoveShortLinkResponse
 	NetworkStat                     = pb.NetworkStat
 	OSStat                          = pb.OSStat
+	PageModerationRequest           = pb.PageModerationRequest
+	PageModerationResponse          = pb.PageModerationResponse
 	PageRecycleBinShortLinkRequest  = pb.PageRecycleBinShortLinkRequest
 	PageRecycleBinShortLinkResponse = pb.PageRecycleBinShortLinkResponse
 	PageShortLinkRequest            = pb.PageShortLinkRequest
 	PageShortLinkResponse           = pb.PageShortLinkResponse
 	RecoverFromRecycleBinRequest    = pb.RecoverFromRecycleBinRequest
 	RecoverFromRecycleBinResponse   = pb.RecoverFromRecycleBinResponse
+	RedirectRule                    = pb.RedirectRule
+	RegisterUserDomainRequest       = pb.RegisterUserDomainRequest
+	RegisterUserDomainResponse      = pb.RegisterUserDomainResponse
 	RemoveFromRecycleBinRequest     = pb.RemoveFromRecycleBinRequest
 	RemoveFromRecycleBinResponse    = pb.RemoveFromRecycleBinResponse
+	RespondGroupTransferRequest     = pb.RespondGroupTransferRequest
+	RespondGroupTransferResponse    = pb.RespondGroupTransferResponse
 	RestoreUrlRequest               = pb.RestoreUrlRequest
 	RestoreUrlResponse              = pb.RestoreUrlResponse
+	ReviewModerationRequest         = pb.ReviewModerationRequest
+	ReviewModerationResponse        = pb.ReviewModerationResponse
+	RollbackShortLinkRequest        = pb.RollbackShortLinkRequest
+	RollbackShortLinkResponse       = pb.RollbackShortLinkResponse
+	SaveDomainAppLinksRequest       = pb.SaveDomainAppLinksRequest
+	SaveDomainAppLinksResponse      = pb.SaveDomainAppLinksResponse
+	SaveGroupExpiryPolicyRequest    = pb.SaveGroupExpiryPolicyRequest
+	SaveGroupExpiryPolicyResponse   = pb.SaveGroupExpiryPolicyResponse
 	SaveToRecycleBinRequest         = pb.SaveToRecycleBinRequest
 	SaveToRecycleBinResponse        = pb.SaveToRecycleBinResponse
+	SearchShortLinkRequest          = pb.SearchShortLinkRequest
+	SearchShortLinkResponse         = pb.SearchShortLinkResponse
+	ShortLinkExportJob              = pb.ShortLinkExportJob
 	ShortLinkGroupCountItem         = pb.ShortLinkGroupCountItem
+	ShortLinkImportJob              = pb.ShortLinkImportJob
+	ShortLinkPreviewRequest         = pb.ShortLinkPreviewRequest
+	ShortLinkPreviewResponse        = pb.ShortLinkPreviewResponse
+	ShortLinkQrCodeRequest          = pb.ShortLinkQrCodeRequest
+	ShortLinkQrCodeResponse         = pb.ShortLinkQrCodeResponse
 	ShortLinkRecord                 = pb.ShortLinkRecord
 	ShortLinkStatsRequest           = pb.ShortLinkStatsRequest
 	TopIpStat                       = pb.TopIpStat
+	UpdateLinkTemplateRequest       = pb.UpdateLinkTemplateRequest
+	UpdateLinkTemplateResponse      = pb.UpdateLinkTemplateResponse
+	UpdateRedirectRuleRequest       = pb.UpdateRedirectRuleRequest
+	UpdateRedirectRuleResponse      = pb.UpdateRedirectRuleResponse
 	UpdateShortLinkRequest          = pb.UpdateShortLinkRequest
 	UpdateShortLinkResponse         = pb.UpdateShortLinkResponse
+	UserDomain                      = pb.UserDomain
 	UvTypeStat                      = pb.UvTypeStat
+	VariantStat                     = pb.VariantStat
+	VerifyLinkPasswordRequest       = pb.VerifyLinkPasswordRequest
+	VerifyLinkPasswordResponse      = pb.VerifyLinkPasswordResponse
+	VerifyUserDomainRequest         = pb.VerifyUserDomainRequest
+	VerifyUserDomainResponse        = pb.VerifyUserDomainResponse
 
 	ShortLinkService interface {
 		// --------------------- 短链接管理接口 ---------------------
@@ -68,10 +157,36 @@ type (
 		ShortLinkBatchCreate(ctx context.Context, in *BatchCreateShortLinkRequest, opts ...grpc.CallOption) (*BatchCreateShortLinkResponse, error)
 		ShortLinkUpdate(ctx context.Context, in *UpdateShortLinkRequest, opts ...grpc.CallOption) (*UpdateShortLinkResponse, error)
 		ShortLinkPage(ctx context.Context, in *PageShortLinkRequest, opts ...grpc.CallOption) (*PageShortLinkResponse, error)
+		// 跨分组搜索短链接
+		ShortLinkSearch(ctx context.Context, in *SearchShortLinkRequest, opts ...grpc.CallOption) (*SearchShortLinkResponse, error)
+		// 生成短链接二维码
+		ShortLinkQrCode(ctx context.Context, in *ShortLinkQrCodeRequest, opts ...grpc.CallOption) (*ShortLinkQrCodeResponse, error)
 		// 查询短链接分组内数量
 		ShortLinkListGroupCount(ctx context.Context, in *GroupShortLinkCountRequest, opts ...grpc.CallOption) (*GroupShortLinkCountResponse, error)
+		// 移动短链接到其他分组
+		ShortLinkMove(ctx context.Context, in *MoveShortLinkRequest, opts ...grpc.CallOption) (*MoveShortLinkResponse, error)
+		// 复制短链接的配置到新的短链接
+		ShortLinkClone(ctx context.Context, in *CloneShortLinkRequest, opts ...grpc.CallOption) (*CloneShortLinkResponse, error)
+		// 批量操作短链接
+		ShortLinkBulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error)
+		// 查询短链接变更记录
+		ShortLinkHistoryList(ctx context.Context, in *ListLinkHistoryRequest, opts ...grpc.CallOption) (*ListLinkHistoryResponse, error)
+		// 回滚短链接到指定变更记录
+		ShortLinkRollback(ctx context.Context, in *RollbackShortLinkRequest, opts ...grpc.CallOption) (*RollbackShortLinkResponse, error)
+		// 批量导入短链接
+		ShortLinkImport(ctx context.Context, in *ImportShortLinkRequest, opts ...grpc.CallOption) (*ImportShortLinkResponse, error)
+		// 查询短链接导入任务
+		ShortLinkImportJobGet(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error)
+		// 导出短链接及访问数据
+		ShortLinkExport(ctx context.Context, in *ExportShortLinkRequest, opts ...grpc.CallOption) (*ExportShortLinkResponse, error)
+		// 查询短链接导出任务
+		ShortLinkExportJobGet(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error)
+		// 下载短链接导出文件
+		ShortLinkExportDownload(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (*DownloadExportResponse, error)
 		// 短链接跳转
 		RestoreUrl(ctx context.Context, in *RestoreUrlRequest, opts ...grpc.CallOption) (*RestoreUrlResponse, error)
+		// 验证短链接访问密码
+		VerifyLinkPassword(ctx context.Context, in *VerifyLinkPasswordRequest, opts ...grpc.CallOption) (*VerifyLinkPasswordResponse, error)
 		// 短链接统计
 		ShortLinkStats(ctx context.Context, in *ShortLinkStatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 		// --------------------- 回收站管理接口 ---------------------
@@ -84,8 +199,38 @@ type (
 		StatsGetGroup(ctx context.Context, in *GetGroupStatsRequest, opts ...grpc.CallOption) (*GetGroupStatsResponse, error)
 		StatsAccessRecordQuery(ctx context.Context, in *AccessRecordQueryRequest, opts ...grpc.CallOption) (*AccessRecordQueryResponse, error)
 		StatsGroupAccessRecordQuery(ctx context.Context, in *GroupAccessRecordQueryRequest, opts ...grpc.CallOption) (*GroupAccessRecordQueryResponse, error)
+		// --------------------- 自定义域名接口 ---------------------
+		UserDomainRegister(ctx context.Context, in *RegisterUserDomainRequest, opts ...grpc.CallOption) (*RegisterUserDomainResponse, error)
+		UserDomainVerify(ctx context.Context, in *VerifyUserDomainRequest, opts ...grpc.CallOption) (*VerifyUserDomainResponse, error)
+		UserDomainList(ctx context.Context, in *ListUserDomainRequest, opts ...grpc.CallOption) (*ListUserDomainResponse, error)
+		// --------------------- 跳转规则管理接口 ---------------------
+		RedirectRuleCreate(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error)
+		RedirectRuleUpdate(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error)
+		RedirectRuleDelete(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error)
+		RedirectRuleList(ctx context.Context, in *ListRedirectRuleRequest, opts ...grpc.CallOption) (*ListRedirectRuleResponse, error)
+		// --------------------- 短链接模板接口 ---------------------
+		LinkTemplateCreate(ctx context.Context, in *CreateLinkTemplateRequest, opts ...grpc.CallOption) (*CreateLinkTemplateResponse, error)
+		LinkTemplateUpdate(ctx context.Context, in *UpdateLinkTemplateRequest, opts ...grpc.CallOption) (*UpdateLinkTemplateResponse, error)
+		LinkTemplateDelete(ctx context.Context, in *DeleteLinkTemplateRequest, opts ...grpc.CallOption) (*DeleteLinkTemplateResponse, error)
+		LinkTemplateList(ctx context.Context, in *ListLinkTemplateRequest, opts ...grpc.CallOption) (*ListLinkTemplateResponse, error)
+		// --------------------- 分组过期策略接口 ---------------------
+		GroupExpiryPolicySave(ctx context.Context, in *SaveGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*SaveGroupExpiryPolicyResponse, error)
+		GroupExpiryPolicyGet(ctx context.Context, in *GetGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*GetGroupExpiryPolicyResponse, error)
+		// --------------------- 分组转让接口 ---------------------
+		GroupTransferCreate(ctx context.Context, in *CreateGroupTransferRequest, opts ...grpc.CallOption) (*CreateGroupTransferResponse, error)
+		GroupTransferList(ctx context.Context, in *ListGroupTransferRequest, opts ...grpc.CallOption) (*ListGroupTransferResponse, error)
+		GroupTransferRespond(ctx context.Context, in *RespondGroupTransferRequest, opts ...grpc.CallOption) (*RespondGroupTransferResponse, error)
+		GroupTransferCancel(ctx context.Context, in *CancelGroupTransferRequest, opts ...grpc.CallOption) (*CancelGroupTransferResponse, error)
+		// --------------------- 链接安全审核接口 ---------------------
+		ModerationPage(ctx context.Context, in *PageModerationRequest, opts ...grpc.CallOption) (*PageModerationResponse, error)
+		ModerationReview(ctx context.Context, in *ReviewModerationRequest, opts ...grpc.CallOption) (*ReviewModerationResponse, error)
+		ModerationFlag(ctx context.Context, in *FlagModerationRequest, opts ...grpc.CallOption) (*FlagModerationResponse, error)
+		// --------------------- 域名应用关联接口 ---------------------
+		DomainAppLinksSave(ctx context.Context, in *SaveDomainAppLinksRequest, opts ...grpc.CallOption) (*SaveDomainAppLinksResponse, error)
+		DomainAppLinksGet(ctx context.Context, in *GetDomainAppLinksRequest, opts ...grpc.CallOption) (*GetDomainAppLinksResponse, error)
 		// --------------------- URL标题功能接口 ---------------------
 		UrlTitleGet(ctx context.Context, in *GetUrlTitleRequest, opts ...grpc.CallOption) (*GetUrlTitleResponse, error)
+		ShortLinkPreview(ctx context.Context, in *ShortLinkPreviewRequest, opts ...grpc.CallOption) (*ShortLinkPreviewResponse, error)
 		// --------------------- IP位置查询接口 ---------------------
 		GetIpLocation(ctx context.Context, in *GetIPLocationRequest, opts ...grpc.CallOption) (*GetIPLocationResponse, error)
 	}
@@ -122,18 +267,96 @@ func (m *defaultShortLinkService) ShortLinkPage(ctx context.Context, in *PageSho
 	return client.ShortLinkPage(ctx, in, opts...)
 }
 
+// 跨分组搜索短链接
+func (m *defaultShortLinkService) ShortLinkSearch(ctx context.Context, in *SearchShortLinkRequest, opts ...grpc.CallOption) (*SearchShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkSearch(ctx, in, opts...)
+}
+
+// 生成短链接二维码
+func (m *defaultShortLinkService) ShortLinkQrCode(ctx context.Context, in *ShortLinkQrCodeRequest, opts ...grpc.CallOption) (*ShortLinkQrCodeResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkQrCode(ctx, in, opts...)
+}
+
 // 查询短链接分组内数量
 func (m *defaultShortLinkService) ShortLinkListGroupCount(ctx context.Context, in *GroupShortLinkCountRequest, opts ...grpc.CallOption) (*GroupShortLinkCountResponse, error) {
 	client := pb.NewShortLinkServiceClient(m.cli.Conn())
 	return client.ShortLinkListGroupCount(ctx, in, opts...)
 }
 
+// 移动短链接到其他分组
+func (m *defaultShortLinkService) ShortLinkMove(ctx context.Context, in *MoveShortLinkRequest, opts ...grpc.CallOption) (*MoveShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkMove(ctx, in, opts...)
+}
+
+// 复制短链接的配置到新的短链接
+func (m *defaultShortLinkService) ShortLinkClone(ctx context.Context, in *CloneShortLinkRequest, opts ...grpc.CallOption) (*CloneShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkClone(ctx, in, opts...)
+}
+
+// 批量操作短链接
+func (m *defaultShortLinkService) ShortLinkBulkAction(ctx context.Context, in *BulkActionRequest, opts ...grpc.CallOption) (*BulkActionResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkBulkAction(ctx, in, opts...)
+}
+
+// 查询短链接变更记录
+func (m *defaultShortLinkService) ShortLinkHistoryList(ctx context.Context, in *ListLinkHistoryRequest, opts ...grpc.CallOption) (*ListLinkHistoryResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkHistoryList(ctx, in, opts...)
+}
+
+// 回滚短链接到指定变更记录
+func (m *defaultShortLinkService) ShortLinkRollback(ctx context.Context, in *RollbackShortLinkRequest, opts ...grpc.CallOption) (*RollbackShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkRollback(ctx, in, opts...)
+}
+
+// 批量导入短链接
+func (m *defaultShortLinkService) ShortLinkImport(ctx context.Context, in *ImportShortLinkRequest, opts ...grpc.CallOption) (*ImportShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkImport(ctx, in, opts...)
+}
+
+// 查询短链接导入任务
+func (m *defaultShortLinkService) ShortLinkImportJobGet(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*GetImportJobResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkImportJobGet(ctx, in, opts...)
+}
+
+// 导出短链接及访问数据
+func (m *defaultShortLinkService) ShortLinkExport(ctx context.Context, in *ExportShortLinkRequest, opts ...grpc.CallOption) (*ExportShortLinkResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkExport(ctx, in, opts...)
+}
+
+// 查询短链接导出任务
+func (m *defaultShortLinkService) ShortLinkExportJobGet(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*GetExportJobResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkExportJobGet(ctx, in, opts...)
+}
+
+// 下载短链接导出文件
+func (m *defaultShortLinkService) ShortLinkExportDownload(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (*DownloadExportResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkExportDownload(ctx, in, opts...)
+}
+
 // 短链接跳转
 func (m *defaultShortLinkService) RestoreUrl(ctx context.Context, in *RestoreUrlRequest, opts ...grpc.CallOption) (*RestoreUrlResponse, error) {
 	client := pb.NewShortLinkServiceClient(m.cli.Conn())
 	return client.RestoreUrl(ctx, in, opts...)
 }
 
+// 验证短链接访问密码
+func (m *defaultShortLinkService) VerifyLinkPassword(ctx context.Context, in *VerifyLinkPasswordRequest, opts ...grpc.CallOption) (*VerifyLinkPasswordResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.VerifyLinkPassword(ctx, in, opts...)
+}
+
 // 短链接统计
 func (m *defaultShortLinkService) ShortLinkStats(ctx context.Context, in *ShortLinkStatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	client := pb.NewShortLinkServiceClient(m.cli.Conn())
@@ -182,12 +405,134 @@ func (m *defaultShortLinkService) StatsGroupAccessRecordQuery(ctx context.Contex
 	return client.StatsGroupAccessRecordQuery(ctx, in, opts...)
 }
 
+// --------------------- 自定义域名接口 ---------------------
+func (m *defaultShortLinkService) UserDomainRegister(ctx context.Context, in *RegisterUserDomainRequest, opts ...grpc.CallOption) (*RegisterUserDomainResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.UserDomainRegister(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) UserDomainVerify(ctx context.Context, in *VerifyUserDomainRequest, opts ...grpc.CallOption) (*VerifyUserDomainResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.UserDomainVerify(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) UserDomainList(ctx context.Context, in *ListUserDomainRequest, opts ...grpc.CallOption) (*ListUserDomainResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.UserDomainList(ctx, in, opts...)
+}
+
+// --------------------- 跳转规则管理接口 ---------------------
+func (m *defaultShortLinkService) RedirectRuleCreate(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.RedirectRuleCreate(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) RedirectRuleUpdate(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.RedirectRuleUpdate(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) RedirectRuleDelete(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.RedirectRuleDelete(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) RedirectRuleList(ctx context.Context, in *ListRedirectRuleRequest, opts ...grpc.CallOption) (*ListRedirectRuleResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.RedirectRuleList(ctx, in, opts...)
+}
+
+// --------------------- 短链接模板接口 ---------------------
+func (m *defaultShortLinkService) LinkTemplateCreate(ctx context.Context, in *CreateLinkTemplateRequest, opts ...grpc.CallOption) (*CreateLinkTemplateResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.LinkTemplateCreate(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) LinkTemplateUpdate(ctx context.Context, in *UpdateLinkTemplateRequest, opts ...grpc.CallOption) (*UpdateLinkTemplateResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.LinkTemplateUpdate(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) LinkTemplateDelete(ctx context.Context, in *DeleteLinkTemplateRequest, opts ...grpc.CallOption) (*DeleteLinkTemplateResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.LinkTemplateDelete(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) LinkTemplateList(ctx context.Context, in *ListLinkTemplateRequest, opts ...grpc.CallOption) (*ListLinkTemplateResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.LinkTemplateList(ctx, in, opts...)
+}
+
+// --------------------- 分组过期策略接口 ---------------------
+func (m *defaultShortLinkService) GroupExpiryPolicySave(ctx context.Context, in *SaveGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*SaveGroupExpiryPolicyResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupExpiryPolicySave(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) GroupExpiryPolicyGet(ctx context.Context, in *GetGroupExpiryPolicyRequest, opts ...grpc.CallOption) (*GetGroupExpiryPolicyResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupExpiryPolicyGet(ctx, in, opts...)
+}
+
+// --------------------- 分组转让接口 ---------------------
+func (m *defaultShortLinkService) GroupTransferCreate(ctx context.Context, in *CreateGroupTransferRequest, opts ...grpc.CallOption) (*CreateGroupTransferResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupTransferCreate(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) GroupTransferList(ctx context.Context, in *ListGroupTransferRequest, opts ...grpc.CallOption) (*ListGroupTransferResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupTransferList(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) GroupTransferRespond(ctx context.Context, in *RespondGroupTransferRequest, opts ...grpc.CallOption) (*RespondGroupTransferResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupTransferRespond(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) GroupTransferCancel(ctx context.Context, in *CancelGroupTransferRequest, opts ...grpc.CallOption) (*CancelGroupTransferResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.GroupTransferCancel(ctx, in, opts...)
+}
+
+// --------------------- 链接安全审核接口 ---------------------
+func (m *defaultShortLinkService) ModerationPage(ctx context.Context, in *PageModerationRequest, opts ...grpc.CallOption) (*PageModerationResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ModerationPage(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) ModerationReview(ctx context.Context, in *ReviewModerationRequest, opts ...grpc.CallOption) (*ReviewModerationResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ModerationReview(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) ModerationFlag(ctx context.Context, in *FlagModerationRequest, opts ...grpc.CallOption) (*FlagModerationResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ModerationFlag(ctx, in, opts...)
+}
+
+// --------------------- 域名应用关联接口 ---------------------
+func (m *defaultShortLinkService) DomainAppLinksSave(ctx context.Context, in *SaveDomainAppLinksRequest, opts ...grpc.CallOption) (*SaveDomainAppLinksResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.DomainAppLinksSave(ctx, in, opts...)
+}
+
+func (m *defaultShortLinkService) DomainAppLinksGet(ctx context.Context, in *GetDomainAppLinksRequest, opts ...grpc.CallOption) (*GetDomainAppLinksResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.DomainAppLinksGet(ctx, in, opts...)
+}
+
 // --------------------- URL标题功能接口 ---------------------
 func (m *defaultShortLinkService) UrlTitleGet(ctx context.Context, in *GetUrlTitleRequest, opts ...grpc.CallOption) (*GetUrlTitleResponse, error) {
 	client := pb.NewShortLinkServiceClient(m.cli.Conn())
 	return client.UrlTitleGet(ctx, in, opts...)
 }
 
+func (m *defaultShortLinkService) ShortLinkPreview(ctx context.Context, in *ShortLinkPreviewRequest, opts ...grpc.CallOption) (*ShortLinkPreviewResponse, error) {
+	client := pb.NewShortLinkServiceClient(m.cli.Conn())
+	return client.ShortLinkPreview(ctx, in, opts...)
+}
+
 // --------------------- IP位置查询接口 ---------------------
 func (m *defaultShortLinkService) GetIpLocation(ctx context.Context, in *GetIPLocationRequest, opts ...grpc.CallOption) (*GetIPLocationResponse, error) {
 	client := pb.NewShortLinkServiceClient(m.cli.Conn())
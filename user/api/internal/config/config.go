@@ -16,4 +16,12 @@ type Config struct {
 
 	UserRpc zrpc.RpcClientConf
 	LinkRpc zrpc.RpcClientConf
+
+	// 受信任的反向代理地址（IP或CIDR），只有来自这些地址的请求才读取转发头中的客户端IP
+	TrustedProxies []string `json:",optional"`
+
+	// 中间页跳转配置
+	Interstitial struct {
+		CountdownSeconds int `json:",default=5"` // 倒计时秒数
+	}
 }
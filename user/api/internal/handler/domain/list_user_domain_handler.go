@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"shorterurl/user/api/internal/logic/domain"
+	"shorterurl/user/api/internal/svc"
+)
+
+func ListUserDomainHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := domain.NewListUserDomainLogic(r.Context(), svcCtx)
+		resp, err := l.ListUserDomain()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"shorterurl/user/api/internal/logic/domain"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+)
+
+func RegisterUserDomainHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.RegisterUserDomainReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := domain.NewRegisterUserDomainLogic(r.Context(), svcCtx)
+		resp, err := l.RegisterUserDomain(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
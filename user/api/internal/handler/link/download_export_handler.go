@@ -0,0 +1,42 @@
+package link
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"shorterurl/user/api/internal/logic/link"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+)
+
+// 下载短链接导出文件
+func DownloadExportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DownloadExportReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := link.NewDownloadExportLogic(r.Context(), svcCtx)
+		file, err := l.DownloadExport(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 文件分块读取后直接写入响应，不在网关中缓存整个文件
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(file.FileSize, 10))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+		if _, err := file.WriteTo(w); err != nil {
+			// 响应头已发送，只能记录日志
+			logx.WithContext(r.Context()).Errorf("下载导出文件中断: %v", err)
+		}
+	}
+}
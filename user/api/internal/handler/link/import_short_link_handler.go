@@ -0,0 +1,43 @@
+package link
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"shorterurl/user/api/internal/logic/link"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+)
+
+// 批量导入短链接，导入文件通过multipart表单的file字段上传
+func ImportShortLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ImportShortLinkReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.New(errorx.ClientError, "INVALID_PARAMS", "请上传导入文件"))
+			return
+		}
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.New(errorx.ClientError, "INVALID_PARAMS", "读取导入文件失败"))
+			return
+		}
+
+		l := link.NewImportShortLinkLogic(r.Context(), svcCtx)
+		resp, err := l.ImportShortLink(&req, content)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
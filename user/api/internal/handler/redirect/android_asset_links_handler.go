@@ -0,0 +1,31 @@
+package redirect
+
+import (
+	"net/http"
+
+	"shorterurl/user/api/internal/logic/redirect"
+	"shorterurl/user/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// Android App Links关联文件
+func AndroidAssetLinksHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := redirect.NewAndroidAssetLinksLogic(r.Context(), svcCtx)
+		resp, err := l.AndroidAssetLinks(r.Host)
+		if err != nil {
+			http.Error(w, "服务暂不可用", http.StatusServiceUnavailable)
+			return
+		}
+		// 域名未注册或未关联应用
+		if resp == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		// 系统会定期拉取关联文件，允许缓存以减少请求
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		httpx.OkJsonCtx(r.Context(), w, resp)
+	}
+}
@@ -0,0 +1,32 @@
+package redirect
+
+import (
+	"net/http"
+
+	"shorterurl/user/api/internal/logic/redirect"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 短链接密码解锁
+func UnlockShortLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ShortLinkUnlockReq
+		if err := httpx.Parse(r, &req); err != nil {
+			logx.WithContext(r.Context()).Errorf("解析请求参数失败: %v", err)
+			http.Error(w, "无效的请求", http.StatusBadRequest)
+			return
+		}
+
+		l := redirect.NewUnlockShortLinkLogic(r.Context(), svcCtx)
+		err := l.UnlockShortLink(&req, w, r)
+
+		// 错误处理由逻辑层完成，这里只记录日志
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("短链接密码解锁失败: %v", err)
+		}
+	}
+}
@@ -5,9 +5,12 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
+	domain "shorterurl/user/api/internal/handler/domain"
 	group "shorterurl/user/api/internal/handler/group"
 	link "shorterurl/user/api/internal/handler/link"
+	moderation "shorterurl/user/api/internal/handler/moderation"
 	recycle "shorterurl/user/api/internal/handler/recycle"
 	redirect "shorterurl/user/api/internal/handler/redirect"
 	stats "shorterurl/user/api/internal/handler/stats"
@@ -19,6 +22,38 @@ import (
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenValidateMiddleware},
+			[]rest.Route{
+				{
+					// 添加自定义域名
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/domain",
+					Handler: domain.RegisterUserDomainHandler(serverCtx),
+				},
+				{
+					// 验证自定义域名
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/domain/verify",
+					Handler: domain.VerifyUserDomainHandler(serverCtx),
+				},
+				{
+					// 查询自定义域名列表
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/domain",
+					Handler: domain.ListUserDomainHandler(serverCtx),
+				},
+				{
+					// 保存域名应用关联
+					Method:  http.MethodPut,
+					Path:    "/api/short-link/admin/v1/domain/app-links",
+					Handler: domain.SaveDomainAppLinksHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.TokenValidateMiddleware},
@@ -53,6 +88,42 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/short-link/admin/v1/group/sort",
 					Handler: group.SortGroupsHandler(serverCtx),
 				},
+				{
+					// 查询分组过期策略
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/group/expiry-policy",
+					Handler: group.GetGroupExpiryPolicyHandler(serverCtx),
+				},
+				{
+					// 保存分组过期策略
+					Method:  http.MethodPut,
+					Path:    "/api/short-link/admin/v1/group/expiry-policy",
+					Handler: group.SaveGroupExpiryPolicyHandler(serverCtx),
+				},
+				{
+					// 查询待处理分组转让
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/group/transfer",
+					Handler: group.ListGroupTransferHandler(serverCtx),
+				},
+				{
+					// 发起分组转让
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/group/transfer",
+					Handler: group.CreateGroupTransferHandler(serverCtx),
+				},
+				{
+					// 取消分组转让
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/group/transfer/cancel",
+					Handler: group.CancelGroupTransferHandler(serverCtx),
+				},
+				{
+					// 接受或拒绝分组转让
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/group/transfer/respond",
+					Handler: group.RespondGroupTransferHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -85,6 +156,167 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/short-link/admin/v1/link/batch",
 					Handler: link.BatchCreateShortLinkHandler(serverCtx),
 				},
+				{
+					// 批量操作短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/bulk",
+					Handler: link.BulkActionShortLinkHandler(serverCtx),
+				},
+				{
+					// 搜索短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/search",
+					Handler: link.SearchShortLinkHandler(serverCtx),
+				},
+				{
+					// 查询短链接导入任务
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/import/job",
+					Handler: link.GetImportJobHandler(serverCtx),
+				},
+				{
+					// 导出短链接及访问数据
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/export",
+					Handler: link.ExportShortLinkHandler(serverCtx),
+				},
+				{
+					// 查询短链接导出任务
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/export/job",
+					Handler: link.GetExportJobHandler(serverCtx),
+				},
+				{
+					// 移动短链接到其他分组
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/move",
+					Handler: link.MoveShortLinkHandler(serverCtx),
+				},
+				{
+					// 生成短链接二维码
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/qrcode",
+					Handler: link.ShortLinkQrCodeHandler(serverCtx),
+				},
+				{
+					// 查询跳转规则
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/rule",
+					Handler: link.ListRedirectRuleHandler(serverCtx),
+				},
+				{
+					// 创建跳转规则
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/rule",
+					Handler: link.CreateRedirectRuleHandler(serverCtx),
+				},
+				{
+					// 修改跳转规则
+					Method:  http.MethodPut,
+					Path:    "/api/short-link/admin/v1/link/rule",
+					Handler: link.UpdateRedirectRuleHandler(serverCtx),
+				},
+				{
+					// 删除跳转规则
+					Method:  http.MethodDelete,
+					Path:    "/api/short-link/admin/v1/link/rule",
+					Handler: link.DeleteRedirectRuleHandler(serverCtx),
+				},
+				{
+					// 查询短链接变更记录
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/history",
+					Handler: link.ListLinkHistoryHandler(serverCtx),
+				},
+				{
+					// 回滚短链接到指定版本
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/rollback",
+					Handler: link.RollbackShortLinkHandler(serverCtx),
+				},
+				{
+					// 复制短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/clone",
+					Handler: link.CloneShortLinkHandler(serverCtx),
+				},
+				{
+					// 查询短链接模板
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/link/template",
+					Handler: link.ListLinkTemplateHandler(serverCtx),
+				},
+				{
+					// 创建短链接模板
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/template",
+					Handler: link.CreateLinkTemplateHandler(serverCtx),
+				},
+				{
+					// 修改短链接模板
+					Method:  http.MethodPut,
+					Path:    "/api/short-link/admin/v1/link/template",
+					Handler: link.UpdateLinkTemplateHandler(serverCtx),
+				},
+				{
+					// 删除短链接模板
+					Method:  http.MethodDelete,
+					Path:    "/api/short-link/admin/v1/link/template",
+					Handler: link.DeleteLinkTemplateHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenValidateMiddleware},
+			[]rest.Route{
+				{
+					// 批量导入短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/link/import",
+					Handler: link.ImportShortLinkHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithMaxBytes(3145728),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 下载短链接导出文件
+				Method:  http.MethodGet,
+				Path:    "/api/short-link/admin/v1/link/export/download",
+				Handler: link.DownloadExportHandler(serverCtx),
+			},
+		},
+		rest.WithTimeout(600000*time.Millisecond),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.TokenValidateMiddleware},
+			[]rest.Route{
+				{
+					// 分页查询安全审核队列
+					Method:  http.MethodGet,
+					Path:    "/api/short-link/admin/v1/moderation",
+					Handler: moderation.ModerationPageHandler(serverCtx),
+				},
+				{
+					// 手动标记可疑短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/moderation/flag",
+					Handler: moderation.ModerationFlagHandler(serverCtx),
+				},
+				{
+					// 审核可疑短链接
+					Method:  http.MethodPost,
+					Path:    "/api/short-link/admin/v1/moderation/review",
+					Handler: moderation.ModerationReviewHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -121,13 +353,39 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RedirectStatMiddleware},
+			[]rest.Route{
+				{
+					// 短链接跳转
+					Method:  http.MethodGet,
+					Path:    "/:short_uri",
+					Handler: redirect.RedirectShortLinkHandler(serverCtx),
+				},
+				{
+					// 短链接密码解锁
+					Method:  http.MethodPost,
+					Path:    "/:short_uri",
+					Handler: redirect.UnlockShortLinkHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
-				// 短链接跳转
+				// Android App Links关联文件
+				Method:  http.MethodGet,
+				Path:    "/.well-known/assetlinks.json",
+				Handler: redirect.AndroidAssetLinksHandler(serverCtx),
+			},
+			{
+				// iOS通用链接关联文件
 				Method:  http.MethodGet,
-				Path:    "/:short_uri",
-				Handler: redirect.RedirectShortLinkHandler(serverCtx),
+				Path:    "/.well-known/apple-app-site-association",
+				Handler: redirect.AppleAppSiteAssociationHandler(serverCtx),
 			},
 		},
 	)
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ListUserDomainLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询自定义域名列表
+func NewListUserDomainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserDomainLogic {
+	return &ListUserDomainLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListUserDomainLogic) ListUserDomain() (resp *types.ListUserDomainResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	result, err := l.svcCtx.LinkRpc.UserDomainList(ctx, &shortlinkservice.ListUserDomainRequest{})
+	if err != nil {
+		l.Logger.Errorf("查询自定义域名列表失败 username: %s, error: %v", userInfo.Username, err)
+		return nil, err
+	}
+
+	domains := make([]types.UserDomain, 0, len(result.Domains))
+	for _, userDomain := range result.Domains {
+		domains = append(domains, *toUserDomain(userDomain))
+	}
+	return &types.ListUserDomainResp{
+		Domains: domains,
+	}, nil
+}
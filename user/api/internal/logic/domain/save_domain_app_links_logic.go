@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type SaveDomainAppLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 保存域名应用关联
+func NewSaveDomainAppLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveDomainAppLinksLogic {
+	return &SaveDomainAppLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SaveDomainAppLinksLogic) SaveDomainAppLinks(req *types.SaveDomainAppLinksReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	_, err = l.svcCtx.LinkRpc.DomainAppLinksSave(ctx, &shortlinkservice.SaveDomainAppLinksRequest{
+		Domain:                  req.Domain,
+		AppleAppIds:             req.AppleAppIds,
+		AndroidPackage:          req.AndroidPackage,
+		AndroidCertFingerprints: req.AndroidCertFingerprints,
+	})
+	if err != nil {
+		l.Logger.Errorf("保存域名应用关联失败 username: %s, domain: %s, error: %v", userInfo.Username, req.Domain, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: true,
+	}, nil
+}
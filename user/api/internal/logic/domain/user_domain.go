@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/types"
+)
+
+// toUserDomain 转换RPC返回的自定义域名信息
+func toUserDomain(userDomain *shortlinkservice.UserDomain) *types.UserDomain {
+	if userDomain == nil {
+		return &types.UserDomain{}
+	}
+	return &types.UserDomain{
+		Domain:       userDomain.Domain,
+		VerifyStatus: userDomain.VerifyStatus,
+		VerifyRecord: userDomain.VerifyRecord,
+		VerifyValue:  userDomain.VerifyValue,
+		CreateTime:   userDomain.CreateTime,
+	}
+}
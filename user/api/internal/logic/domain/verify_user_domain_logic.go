@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type VerifyUserDomainLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 验证自定义域名
+func NewVerifyUserDomainLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyUserDomainLogic {
+	return &VerifyUserDomainLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyUserDomainLogic) VerifyUserDomain(req *types.VerifyUserDomainReq) (resp *types.UserDomain, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	result, err := l.svcCtx.LinkRpc.UserDomainVerify(ctx, &shortlinkservice.VerifyUserDomainRequest{
+		Domain: req.Domain,
+	})
+	if err != nil {
+		l.Logger.Errorf("验证自定义域名失败 username: %s, domain: %s, error: %v", userInfo.Username, req.Domain, err)
+		return nil, err
+	}
+
+	return toUserDomain(result.Domain), nil
+}
@@ -0,0 +1,50 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type CancelGroupTransferLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 取消分组转让
+func NewCancelGroupTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelGroupTransferLogic {
+	return &CancelGroupTransferLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelGroupTransferLogic) CancelGroupTransfer(req *types.CancelGroupTransferReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.GroupTransferCancel(ctx, &shortlinkservice.CancelGroupTransferRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		l.Logger.Errorf("取消分组转让失败 username: %s, id: %d, error: %v", userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: rpcResp.Success,
+	}, nil
+}
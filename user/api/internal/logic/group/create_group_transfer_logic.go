@@ -0,0 +1,50 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type CreateGroupTransferLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发起分组转让
+func NewCreateGroupTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateGroupTransferLogic {
+	return &CreateGroupTransferLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateGroupTransferLogic) CreateGroupTransfer(req *types.CreateGroupTransferReq) (resp *types.CreateGroupTransferResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.GroupTransferCreate(ctx, &shortlinkservice.CreateGroupTransferRequest{
+		Gid:        req.Gid,
+		ToUsername: req.ToUsername,
+	})
+	if err != nil {
+		l.Logger.Errorf("发起分组转让失败 username: %s, gid: %s, toUsername: %s, error: %v", userInfo.Username, req.Gid, req.ToUsername, err)
+		return nil, err
+	}
+
+	return &types.CreateGroupTransferResp{
+		Id: rpcResp.Id,
+	}, nil
+}
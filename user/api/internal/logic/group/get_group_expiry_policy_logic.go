@@ -0,0 +1,52 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetGroupExpiryPolicyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询分组过期策略
+func NewGetGroupExpiryPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGroupExpiryPolicyLogic {
+	return &GetGroupExpiryPolicyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGroupExpiryPolicyLogic) GetGroupExpiryPolicy(req *types.GroupExpiryPolicyReq) (resp *types.GroupExpiryPolicyResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.GroupExpiryPolicyGet(ctx, &shortlinkservice.GetGroupExpiryPolicyRequest{
+		Gid: req.Gid,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询分组过期策略失败 username: %s, gid: %s, error: %v", userInfo.Username, req.Gid, err)
+		return nil, err
+	}
+
+	return &types.GroupExpiryPolicyResp{
+		Gid:            rpcResp.Policy.GetGid(),
+		ExpiredUrl:     rpcResp.Policy.GetExpiredUrl(),
+		ExpiredMessage: rpcResp.Policy.GetExpiredMessage(),
+		GraceDays:      int(rpcResp.Policy.GetGraceDays()),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ListGroupTransferLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询待处理分组转让
+func NewListGroupTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGroupTransferLogic {
+	return &ListGroupTransferLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListGroupTransferLogic) ListGroupTransfer() (resp *types.ListGroupTransferResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.GroupTransferList(ctx, &shortlinkservice.ListGroupTransferRequest{})
+	if err != nil {
+		l.Logger.Errorf("查询分组转让失败 username: %s, error: %v", userInfo.Username, err)
+		return nil, err
+	}
+
+	return &types.ListGroupTransferResp{
+		Incoming: toGroupTransferRecords(rpcResp.Incoming),
+		Outgoing: toGroupTransferRecords(rpcResp.Outgoing),
+	}, nil
+}
+
+// toGroupTransferRecords 转换分组转让记录
+func toGroupTransferRecords(records []*shortlinkservice.GroupTransferRecord) []types.GroupTransferRecord {
+	result := make([]types.GroupTransferRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, types.GroupTransferRecord{
+			Id:           record.Id,
+			Gid:          record.Gid,
+			GroupName:    record.GroupName,
+			FromUsername: record.FromUsername,
+			ToUsername:   record.ToUsername,
+			Status:       int(record.Status),
+			CreateTime:   record.CreateTime,
+			UpdateTime:   record.UpdateTime,
+		})
+	}
+	return result
+}
@@ -0,0 +1,51 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type RespondGroupTransferLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 接受或拒绝分组转让
+func NewRespondGroupTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RespondGroupTransferLogic {
+	return &RespondGroupTransferLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RespondGroupTransferLogic) RespondGroupTransfer(req *types.RespondGroupTransferReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.GroupTransferRespond(ctx, &shortlinkservice.RespondGroupTransferRequest{
+		Id:     req.Id,
+		Accept: req.Accept,
+	})
+	if err != nil {
+		l.Logger.Errorf("处理分组转让失败 username: %s, id: %d, accept: %v, error: %v", userInfo.Username, req.Id, req.Accept, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: rpcResp.Success,
+	}, nil
+}
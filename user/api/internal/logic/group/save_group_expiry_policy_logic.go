@@ -0,0 +1,53 @@
+package group
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type SaveGroupExpiryPolicyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 保存分组过期策略
+func NewSaveGroupExpiryPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveGroupExpiryPolicyLogic {
+	return &SaveGroupExpiryPolicyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SaveGroupExpiryPolicyLogic) SaveGroupExpiryPolicy(req *types.SaveGroupExpiryPolicyReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	_, err = l.svcCtx.LinkRpc.GroupExpiryPolicySave(ctx, &shortlinkservice.SaveGroupExpiryPolicyRequest{
+		Gid:            req.Gid,
+		ExpiredUrl:     req.ExpiredUrl,
+		ExpiredMessage: req.ExpiredMessage,
+		GraceDays:      int32(req.GraceDays),
+	})
+	if err != nil {
+		l.Logger.Errorf("保存分组过期策略失败 username: %s, gid: %s, error: %v", userInfo.Username, req.Gid, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: true,
+	}, nil
+}
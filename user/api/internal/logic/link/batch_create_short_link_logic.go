@@ -38,13 +38,21 @@ func (l *BatchCreateShortLinkLogic) BatchCreateShortLink(req *types.BatchCreateL
 		return nil, errors.New("原始URL列表不能为空")
 	}
 
+	// 批量创建时使用第一个描述，未填写时使用模板的描述
+	var describe string
+	if len(req.Describes) > 0 {
+		describe = req.Describes[0]
+	}
+
 	// 构建RPC请求
 	rpcReq := &shortlinkservice.BatchCreateShortLinkRequest{
 		OriginUrls:    req.OriginUrls,
 		Gid:           req.Gid,
 		ValidDateType: int32(req.ValidDateType),
 		ValidDate:     req.ValidDate,
-		Describe:      req.Describes[0], // 批量创建时使用第一个描述
+		ValidFrom:     req.ValidFrom,
+		Describe:      describe,
+		TemplateId:    req.TemplateId,
 	}
 
 	// 添加元数据
@@ -59,9 +67,10 @@ func (l *BatchCreateShortLinkLogic) BatchCreateShortLink(req *types.BatchCreateL
 	linkBaseInfos := make([]types.LinkBaseInfo, 0, len(rpcResp.Results))
 	for _, result := range rpcResp.Results {
 		linkBaseInfos = append(linkBaseInfos, types.LinkBaseInfo{
-			FullShortUrl: result.FullShortUrl,
-			OriginUrl:    result.OriginUrl,
-			Describe:     req.Describes[0], // 使用请求中的描述
+			FullShortUrl:  result.FullShortUrl,
+			OriginUrl:     result.OriginUrl,
+			Describe:      result.Describe,
+			PendingReview: result.PendingReview,
 		})
 	}
 
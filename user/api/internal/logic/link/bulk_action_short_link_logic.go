@@ -0,0 +1,71 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type BulkActionShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量操作短链接
+func NewBulkActionShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BulkActionShortLinkLogic {
+	return &BulkActionShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// BulkActionShortLink 批量启用、停用、移至回收站、设置有效期或描述，返回每个短链接的结果
+func (l *BulkActionShortLinkLogic) BulkActionShortLink(req *types.BulkActionReq) (resp *types.BulkActionResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkBulkAction(ctx, &shortlinkservice.BulkActionRequest{
+		Selector: &shortlinkservice.BulkActionSelector{
+			FullShortUrls: req.FullShortUrls,
+			Gid:           req.Gid,
+			CreateStart:   req.CreateStart,
+			CreateEnd:     req.CreateEnd,
+			Tag:           req.Tag,
+		},
+		Action:    req.Action,
+		ValidDate: req.ValidDate,
+		Describe:  req.Describe,
+	})
+	if err != nil {
+		l.Logger.Errorf("批量操作短链接失败 username: %s, action: %s, gid: %s, error: %v", userInfo.Username, req.Action, req.Gid, err)
+		return nil, err
+	}
+
+	results := make([]types.BulkActionItem, 0, len(rpcResp.Results))
+	for _, result := range rpcResp.Results {
+		results = append(results, types.BulkActionItem{
+			FullShortUrl: result.FullShortUrl,
+			Success:      result.Success,
+			Reason:       result.Reason,
+		})
+	}
+
+	return &types.BulkActionResp{
+		Total:     int(rpcResp.Total),
+		Succeeded: int(rpcResp.Succeeded),
+		Failed:    int(rpcResp.Failed),
+		Results:   results,
+	}, nil
+}
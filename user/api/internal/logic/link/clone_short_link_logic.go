@@ -0,0 +1,57 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type CloneShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 复制短链接
+func NewCloneShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CloneShortLinkLogic {
+	return &CloneShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CloneShortLink 复制短链接的配置到新的短链接，访问统计不复制
+func (l *CloneShortLinkLogic) CloneShortLink(req *types.CloneLinkReq) (resp *types.CreateLinkResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkClone(ctx, &shortlinkservice.CloneShortLinkRequest{
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+		TargetGid:    req.TargetGid,
+		CustomUri:    req.CustomUri,
+		Describe:     req.Describe,
+	})
+	if err != nil {
+		l.Logger.Errorf("复制短链接失败 username: %s, fullShortUrl: %s, targetGid: %s, error: %v", userInfo.Username, req.FullShortUrl, req.TargetGid, err)
+		return nil, err
+	}
+
+	return &types.CreateLinkResp{
+		FullShortUrl:  rpcResp.FullShortUrl,
+		OriginUrl:     rpcResp.OriginUrl,
+		Gid:           rpcResp.Gid,
+		PendingReview: rpcResp.PendingReview,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type CreateLinkTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建短链接模板
+func NewCreateLinkTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateLinkTemplateLogic {
+	return &CreateLinkTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CreateLinkTemplate 保存一组创建短链接时的默认配置
+func (l *CreateLinkTemplateLogic) CreateLinkTemplate(req *types.CreateLinkTemplateReq) (resp *types.CreateLinkTemplateResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.LinkTemplateCreate(ctx, &shortlinkservice.CreateLinkTemplateRequest{
+		Name:             req.Name,
+		Gid:              req.Gid,
+		Domain:           req.Domain,
+		ValidDays:        int32(req.ValidDays),
+		Describe:         req.Describe,
+		QueryParamPolicy: int32(req.QueryParamPolicy),
+		UtmSource:        req.UtmSource,
+		UtmMedium:        req.UtmMedium,
+		UtmCampaign:      req.UtmCampaign,
+		RedirectType:     int32(req.RedirectType),
+		ExpiredUrl:       req.ExpiredUrl,
+		ExpiredMessage:   req.ExpiredMessage,
+		GraceDays:        int32(req.GraceDays),
+		Tags:             req.Tags,
+	})
+	if err != nil {
+		l.Logger.Errorf("创建短链接模板失败 username: %s, name: %s, error: %v", userInfo.Username, req.Name, err)
+		return nil, err
+	}
+
+	return &types.CreateLinkTemplateResp{
+		Id: rpcResp.Id,
+	}, nil
+}
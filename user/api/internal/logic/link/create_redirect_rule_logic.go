@@ -0,0 +1,55 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type CreateRedirectRuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建跳转规则
+func NewCreateRedirectRuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateRedirectRuleLogic {
+	return &CreateRedirectRuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateRedirectRuleLogic) CreateRedirectRule(req *types.CreateRedirectRuleReq) (resp *types.CreateRedirectRuleResp, err error) {
+	// 获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errors.New("未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.RedirectRuleCreate(ctx, &shortlinkservice.CreateRedirectRuleRequest{
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+		RuleType:     req.RuleType,
+		RuleValue:    req.RuleValue,
+		TargetUrl:    req.TargetUrl,
+		SortOrder:    int32(req.SortOrder),
+	})
+	if err != nil {
+		l.Logger.Errorf("创建跳转规则失败 username: %s, fullShortUrl: %s, error: %v",
+			userInfo.Username, req.FullShortUrl, err)
+		return nil, err
+	}
+
+	return &types.CreateRedirectRuleResp{
+		Id: rpcResp.Id,
+	}, nil
+}
@@ -35,12 +35,34 @@ func (l *CreateShortLinkLogic) CreateShortLink(req *types.CreateLinkReq) (resp *
 
 	// 构建RPC请求
 	rpcReq := &shortlinkservice.CreateShortLinkRequest{
-		OriginUrl:     req.OriginUrl,
-		Gid:           req.Gid,
-		ValidDateType: int32(req.ValidDateType),
-		ValidDate:     req.ValidDate,
-		Describe:      req.Describe,
-		CreatedType:   int32(req.CreatedType),
+		OriginUrl:           req.OriginUrl,
+		Gid:                 req.Gid,
+		ValidDateType:       int32(req.ValidDateType),
+		ValidDate:           req.ValidDate,
+		ValidFrom:           req.ValidFrom,
+		ExpiredUrl:          req.ExpiredUrl,
+		ExpiredMessage:      req.ExpiredMessage,
+		GraceDays:           int32(req.GraceDays),
+		RedirectType:        int32(req.RedirectType),
+		IosDeepLink:         req.IosDeepLink,
+		AndroidPackage:      req.AndroidPackage,
+		AndroidDeepLink:     req.AndroidDeepLink,
+		DeepLinkFallbackUrl: req.DeepLinkFallbackUrl,
+		OgTitle:             req.OgTitle,
+		OgDescription:       req.OgDescription,
+		OgImage:             req.OgImage,
+		Describe:            req.Describe,
+		CreatedType:         int32(req.CreatedType),
+		CustomUri:           req.CustomUri,
+		Password:            req.Password,
+		MaxClicks:           int32(req.MaxClicks),
+		Variants:            toRpcVariants(req.Variants),
+		QueryParamPolicy:    int32(req.QueryParamPolicy),
+		UtmSource:           req.UtmSource,
+		UtmMedium:           req.UtmMedium,
+		UtmCampaign:         req.UtmCampaign,
+		Tags:                req.Tags,
+		TemplateId:          req.TemplateId,
 	}
 
 	// 添加元数据
@@ -56,8 +78,22 @@ func (l *CreateShortLinkLogic) CreateShortLink(req *types.CreateLinkReq) (resp *
 
 	// 构建响应
 	return &types.CreateLinkResp{
-		FullShortUrl: rpcResp.FullShortUrl,
-		OriginUrl:    rpcResp.OriginUrl,
-		Gid:          rpcResp.Gid,
+		FullShortUrl:  rpcResp.FullShortUrl,
+		OriginUrl:     rpcResp.OriginUrl,
+		Gid:           rpcResp.Gid,
+		PendingReview: rpcResp.PendingReview,
 	}, nil
 }
+
+// toRpcVariants 将A/B分流目标链接转换为RPC请求参数
+func toRpcVariants(variants []types.LinkVariant) []*shortlinkservice.LinkVariant {
+	result := make([]*shortlinkservice.LinkVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, &shortlinkservice.LinkVariant{
+			Name:      v.Name,
+			TargetUrl: v.TargetUrl,
+			Weight:    int32(v.Weight),
+		})
+	}
+	return result
+}
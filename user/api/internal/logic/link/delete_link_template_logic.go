@@ -0,0 +1,51 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type DeleteLinkTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除短链接模板
+func NewDeleteLinkTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteLinkTemplateLogic {
+	return &DeleteLinkTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DeleteLinkTemplate 删除模板，已创建的短链接不受影响
+func (l *DeleteLinkTemplateLogic) DeleteLinkTemplate(req *types.DeleteLinkTemplateReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.LinkTemplateDelete(ctx, &shortlinkservice.DeleteLinkTemplateRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		l.Logger.Errorf("删除短链接模板失败 username: %s, id: %d, error: %v", userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: rpcResp.Success,
+	}, nil
+}
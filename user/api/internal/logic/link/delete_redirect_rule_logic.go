@@ -0,0 +1,53 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type DeleteRedirectRuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除跳转规则
+func NewDeleteRedirectRuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteRedirectRuleLogic {
+	return &DeleteRedirectRuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteRedirectRuleLogic) DeleteRedirectRule(req *types.DeleteRedirectRuleReq) (resp *types.SuccessResp, err error) {
+	// 获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errors.New("未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.RedirectRuleDelete(ctx, &shortlinkservice.DeleteRedirectRuleRequest{
+		Id:           req.Id,
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+	})
+	if err != nil {
+		l.Logger.Errorf("删除跳转规则失败 username: %s, id: %d, error: %v",
+			userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package link
+
+import (
+	"context"
+	"io"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 下载短链接导出文件
+func NewDownloadExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadExportLogic {
+	return &DownloadExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportFile 导出文件，首个分块已读取用于校验令牌和获取文件信息，其余分块在写入时依次读取
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	FileSize    int64
+
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	token  string
+	first  *shortlinkservice.DownloadExportResponse
+}
+
+// DownloadExport 凭下载令牌读取导出文件的首个分块
+func (l *DownloadExportLogic) DownloadExport(req *types.DownloadExportReq) (*ExportFile, error) {
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkExportDownload(l.ctx, &shortlinkservice.DownloadExportRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		l.Logger.Errorf("下载导出文件失败, error: %v", err)
+		return nil, err
+	}
+
+	return &ExportFile{
+		FileName:    rpcResp.FileName,
+		ContentType: rpcResp.ContentType,
+		FileSize:    rpcResp.FileSize,
+		ctx:         l.ctx,
+		svcCtx:      l.svcCtx,
+		token:       req.Token,
+		first:       rpcResp,
+	}, nil
+}
+
+// WriteTo 依次读取文件分块并写入w，直到文件末尾
+func (f *ExportFile) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	chunk := f.first
+	for {
+		n, err := w.Write(chunk.Data)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+		if chunk.Eof || len(chunk.Data) == 0 {
+			return written, nil
+		}
+		chunk, err = f.svcCtx.LinkRpc.ShortLinkExportDownload(f.ctx, &shortlinkservice.DownloadExportRequest{
+			Token:  f.token,
+			Offset: written,
+		})
+		if err != nil {
+			return written, err
+		}
+	}
+}
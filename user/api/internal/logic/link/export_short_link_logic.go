@@ -0,0 +1,77 @@
+package link
+
+import (
+	"context"
+	"net/url"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+// 导出文件下载地址
+const exportDownloadPath = "/api/short-link/admin/v1/link/export/download"
+
+type ExportShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出短链接及访问数据
+func NewExportShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportShortLinkLogic {
+	return &ExportShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportShortLink 导出分组内的短链接，短链接较少时直接返回下载地址，否则返回后台任务ID
+func (l *ExportShortLinkLogic) ExportShortLink(req *types.ExportShortLinkReq) (resp *types.ExportJobResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkExport(ctx, &shortlinkservice.ExportShortLinkRequest{
+		Gids:              req.Gids,
+		Format:            req.Format,
+		IncludeDailyStats: req.IncludeDailyStats,
+		StartDate:         req.StartDate,
+		EndDate:           req.EndDate,
+	})
+	if err != nil {
+		l.Logger.Errorf("导出短链接失败 username: %s, gids: %v, format: %s, error: %v", userInfo.Username, req.Gids, req.Format, err)
+		return nil, err
+	}
+
+	return toExportJobResp(rpcResp.Job), nil
+}
+
+// toExportJobResp 转换导出任务，任务完成后生成下载地址
+func toExportJobResp(job *shortlinkservice.ShortLinkExportJob) *types.ExportJobResp {
+	resp := &types.ExportJobResp{
+		JobId:         job.JobId,
+		Status:        job.Status,
+		Format:        job.Format,
+		Total:         int(job.Total),
+		Processed:     int(job.Processed),
+		FileName:      job.FileName,
+		FileSize:      job.FileSize,
+		DownloadToken: job.DownloadToken,
+		Error:         job.Error,
+		CreateTime:    job.CreateTime,
+		UpdateTime:    job.UpdateTime,
+	}
+	if job.DownloadToken != "" {
+		resp.DownloadUrl = exportDownloadPath + "?token=" + url.QueryEscape(job.DownloadToken)
+	}
+	return resp
+}
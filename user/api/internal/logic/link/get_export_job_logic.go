@@ -0,0 +1,47 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetExportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询短链接导出任务
+func NewGetExportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExportJobLogic {
+	return &GetExportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetExportJobLogic) GetExportJob(req *types.GetExportJobReq) (resp *types.ExportJobResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkExportJobGet(ctx, &shortlinkservice.GetExportJobRequest{
+		JobId: req.JobId,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询导出任务失败 username: %s, jobId: %s, error: %v", userInfo.Username, req.JobId, err)
+		return nil, err
+	}
+
+	return toExportJobResp(rpcResp.Job), nil
+}
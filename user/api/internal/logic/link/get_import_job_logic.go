@@ -0,0 +1,47 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type GetImportJobLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询短链接导入任务
+func NewGetImportJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetImportJobLogic {
+	return &GetImportJobLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetImportJobLogic) GetImportJob(req *types.GetImportJobReq) (resp *types.ImportJobResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkImportJobGet(ctx, &shortlinkservice.GetImportJobRequest{
+		JobId: req.JobId,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询导入任务失败 username: %s, jobId: %s, error: %v", userInfo.Username, req.JobId, err)
+		return nil, err
+	}
+
+	return toImportJobResp(rpcResp.Job), nil
+}
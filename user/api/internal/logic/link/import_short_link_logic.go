@@ -0,0 +1,80 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ImportShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量导入短链接
+func NewImportShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportShortLinkLogic {
+	return &ImportShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ImportShortLink 导入CSV或NDJSON文件，行数较少时直接返回行级结果，否则返回后台任务ID
+func (l *ImportShortLinkLogic) ImportShortLink(req *types.ImportShortLinkReq, content []byte) (resp *types.ImportJobResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkImport(ctx, &shortlinkservice.ImportShortLinkRequest{
+		Format:     req.Format,
+		Content:    content,
+		DefaultGid: req.Gid,
+		Domain:     req.Domain,
+		DryRun:     req.DryRun,
+	})
+	if err != nil {
+		l.Logger.Errorf("导入短链接失败 username: %s, format: %s, dryRun: %v, error: %v", userInfo.Username, req.Format, req.DryRun, err)
+		return nil, err
+	}
+
+	return toImportJobResp(rpcResp.Job), nil
+}
+
+// toImportJobResp 转换导入任务
+func toImportJobResp(job *shortlinkservice.ShortLinkImportJob) *types.ImportJobResp {
+	rows := make([]types.ImportRowResult, 0, len(job.Rows))
+	for _, row := range job.Rows {
+		rows = append(rows, types.ImportRowResult{
+			Line:         int(row.Line),
+			Status:       row.Status,
+			Reason:       row.Reason,
+			OriginUrl:    row.OriginUrl,
+			FullShortUrl: row.FullShortUrl,
+		})
+	}
+	return &types.ImportJobResp{
+		JobId:      job.JobId,
+		Status:     job.Status,
+		DryRun:     job.DryRun,
+		Total:      int(job.Total),
+		Processed:  int(job.Processed),
+		Created:    int(job.Created),
+		Skipped:    int(job.Skipped),
+		Failed:     int(job.Failed),
+		Rows:       rows,
+		Error:      job.Error,
+		CreateTime: job.CreateTime,
+		UpdateTime: job.UpdateTime,
+	}
+}
@@ -0,0 +1,81 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ListLinkHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询短链接变更记录
+func NewListLinkHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLinkHistoryLogic {
+	return &ListLinkHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListLinkHistory 分页查询短链接的修改、启用、停用和回收站变更记录
+func (l *ListLinkHistoryLogic) ListLinkHistory(req *types.ListLinkHistoryReq) (resp *types.ListLinkHistoryResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkHistoryList(ctx, &shortlinkservice.ListLinkHistoryRequest{
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+		Current:      int32(req.Current),
+		Size:         int32(req.Size),
+	})
+	if err != nil {
+		l.Logger.Errorf("查询短链接变更记录失败 username: %s, fullShortUrl: %s, error: %v", userInfo.Username, req.FullShortUrl, err)
+		return nil, err
+	}
+
+	records := make([]types.LinkHistoryRecord, 0, len(rpcResp.Records))
+	for _, record := range rpcResp.Records {
+		records = append(records, toLinkHistoryRecord(record))
+	}
+	return &types.ListLinkHistoryResp{
+		Records: records,
+		Total:   int64(rpcResp.Total),
+		Size:    int(rpcResp.Size),
+		Current: int(rpcResp.Current),
+	}, nil
+}
+
+// toLinkHistoryRecord 将RPC返回的变更记录转换为接口返回的变更记录
+func toLinkHistoryRecord(record *shortlinkservice.LinkHistoryRecord) types.LinkHistoryRecord {
+	changes := make([]types.LinkHistoryChange, 0, len(record.Changes))
+	for _, change := range record.Changes {
+		changes = append(changes, types.LinkHistoryChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return types.LinkHistoryRecord{
+		Id:           record.Id,
+		FullShortUrl: record.FullShortUrl,
+		Gid:          record.Gid,
+		Operator:     record.Operator,
+		Action:       record.Action,
+		Changes:      changes,
+		CreateTime:   record.CreateTime,
+	}
+}
@@ -0,0 +1,71 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ListLinkTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询短链接模板
+func NewListLinkTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLinkTemplateLogic {
+	return &ListLinkTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ListLinkTemplate 查询当前用户的全部短链接模板
+func (l *ListLinkTemplateLogic) ListLinkTemplate() (resp *types.ListLinkTemplateResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.LinkTemplateList(ctx, &shortlinkservice.ListLinkTemplateRequest{})
+	if err != nil {
+		l.Logger.Errorf("查询短链接模板失败 username: %s, error: %v", userInfo.Username, err)
+		return nil, err
+	}
+
+	templates := make([]types.LinkTemplate, 0, len(rpcResp.Templates))
+	for _, template := range rpcResp.Templates {
+		templates = append(templates, types.LinkTemplate{
+			Id:               template.Id,
+			Name:             template.Name,
+			Gid:              template.Gid,
+			Domain:           template.Domain,
+			ValidDays:        int(template.ValidDays),
+			Describe:         template.Describe,
+			QueryParamPolicy: int(template.QueryParamPolicy),
+			UtmSource:        template.UtmSource,
+			UtmMedium:        template.UtmMedium,
+			UtmCampaign:      template.UtmCampaign,
+			RedirectType:     int(template.RedirectType),
+			ExpiredUrl:       template.ExpiredUrl,
+			ExpiredMessage:   template.ExpiredMessage,
+			GraceDays:        int(template.GraceDays),
+			Tags:             template.Tags,
+			CreateTime:       template.CreateTime,
+			UpdateTime:       template.UpdateTime,
+		})
+	}
+
+	return &types.ListLinkTemplateResp{
+		Templates: templates,
+	}, nil
+}
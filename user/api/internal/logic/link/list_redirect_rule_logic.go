@@ -0,0 +1,66 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ListRedirectRuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 查询跳转规则
+func NewListRedirectRuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRedirectRuleLogic {
+	return &ListRedirectRuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListRedirectRuleLogic) ListRedirectRule(req *types.ListRedirectRuleReq) (resp *types.ListRedirectRuleResp, err error) {
+	// 获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errors.New("未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.RedirectRuleList(ctx, &shortlinkservice.ListRedirectRuleRequest{
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+	})
+	if err != nil {
+		l.Logger.Errorf("查询跳转规则失败 username: %s, fullShortUrl: %s, error: %v",
+			userInfo.Username, req.FullShortUrl, err)
+		return nil, err
+	}
+
+	// 构建响应
+	rules := make([]types.RedirectRule, 0, len(rpcResp.Rules))
+	for _, rule := range rpcResp.Rules {
+		rules = append(rules, types.RedirectRule{
+			Id:           rule.Id,
+			FullShortUrl: rule.FullShortUrl,
+			Gid:          rule.Gid,
+			RuleType:     rule.RuleType,
+			RuleValue:    rule.RuleValue,
+			TargetUrl:    rule.TargetUrl,
+			SortOrder:    int(rule.SortOrder),
+			CreateTime:   rule.CreateTime,
+		})
+	}
+
+	return &types.ListRedirectRuleResp{
+		Rules: rules,
+	}, nil
+}
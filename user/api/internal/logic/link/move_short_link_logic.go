@@ -0,0 +1,51 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type MoveShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 移动短链接到其他分组
+func NewMoveShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveShortLinkLogic {
+	return &MoveShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MoveShortLinkLogic) MoveShortLink(req *types.MoveShortLinkReq) (resp *types.MoveShortLinkResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkMove(ctx, &shortlinkservice.MoveShortLinkRequest{
+		FullShortUrls: req.FullShortUrls,
+		OriginGid:     req.OriginGid,
+		TargetGid:     req.TargetGid,
+	})
+	if err != nil {
+		l.Logger.Errorf("移动短链接失败 username: %s, originGid: %s, targetGid: %s, error: %v", userInfo.Username, req.OriginGid, req.TargetGid, err)
+		return nil, err
+	}
+
+	return &types.MoveShortLinkResp{
+		Moved: int(rpcResp.Moved),
+	}, nil
+}
@@ -38,9 +38,11 @@ func (l *PageShortLinkLogic) PageShortLink(req *types.PageLinkReq) (resp *types.
 
 	// 调用 RPC 服务
 	rpcReq := &shortlinkservice.PageShortLinkRequest{
-		Gid:     req.Gid,
-		Current: int32(req.Current),
-		Size:    int32(req.Size),
+		Gid:        req.Gid,
+		Current:    int32(req.Current),
+		Size:       int32(req.Size),
+		BrokenOnly: req.BrokenOnly,
+		OrderTag:   req.OrderTag,
 	}
 
 	// 添加元数据
@@ -54,19 +56,7 @@ func (l *PageShortLinkLogic) PageShortLink(req *types.PageLinkReq) (resp *types.
 	// 构建响应
 	records := make([]types.ShortLinkRecord, 0, len(rpcResp.Records))
 	for _, record := range rpcResp.Records {
-		records = append(records, types.ShortLinkRecord{
-			FullShortUrl: record.FullShortUrl,
-			OriginUrl:    record.OriginUrl,
-			Domain:       record.Domain,
-			Gid:          record.Gid,
-			CreateTime:   record.CreateTime,
-			ValidDate:    record.ValidDate,
-			Describe:     record.Describe,
-			TotalPv:      int64(record.TotalPv),
-			TotalUv:      int64(record.TotalUv),
-			TotalUip:     int64(record.TotalUip),
-			// 其他统计字段暂时不需要填充
-		})
+		records = append(records, toShortLinkRecord(record))
 	}
 
 	return &types.PageLinkResp{
@@ -76,3 +66,50 @@ func (l *PageShortLinkLogic) PageShortLink(req *types.PageLinkReq) (resp *types.
 		Current: int(rpcResp.Current),
 	}, nil
 }
+
+// toShortLinkRecord 将RPC返回的短链接记录转换为接口响应
+func toShortLinkRecord(record *shortlinkservice.ShortLinkRecord) types.ShortLinkRecord {
+	return types.ShortLinkRecord{
+		FullShortUrl:        record.FullShortUrl,
+		OriginUrl:           record.OriginUrl,
+		Domain:              record.Domain,
+		Gid:                 record.Gid,
+		CreateTime:          record.CreateTime,
+		ValidDate:           record.ValidDate,
+		ValidFrom:           record.ValidFrom,
+		ExpiredUrl:          record.ExpiredUrl,
+		ExpiredMessage:      record.ExpiredMessage,
+		GraceDays:           int(record.GraceDays),
+		RedirectType:        int(record.RedirectType),
+		IosDeepLink:         record.IosDeepLink,
+		AndroidPackage:      record.AndroidPackage,
+		AndroidDeepLink:     record.AndroidDeepLink,
+		DeepLinkFallbackUrl: record.DeepLinkFallbackUrl,
+		OgTitle:             record.OgTitle,
+		OgDescription:       record.OgDescription,
+		OgImage:             record.OgImage,
+		InGracePeriod:       record.InGracePeriod,
+		Describe:            record.Describe,
+		TotalPv:             int64(record.TotalPv),
+		TodayPv:             int64(record.TodayPv),
+		TotalUv:             int64(record.TotalUv),
+		TodayUv:             int64(record.TodayUv),
+		TotalUip:            int64(record.TotalUip),
+		TodayUip:            int64(record.TodayUip),
+		EnableStatus:        int(record.EnableStatus),
+		SafetyStatus:        int(record.SafetyStatus),
+		MaxClicks:           int(record.MaxClicks),
+		ClickNum:            int(record.ClickNum),
+		QueryParamPolicy:    int(record.QueryParamPolicy),
+		UtmSource:           record.UtmSource,
+		UtmMedium:           record.UtmMedium,
+		UtmCampaign:         record.UtmCampaign,
+		HealthStatus:        int(record.HealthStatus),
+		HealthCode:          int(record.HealthCode),
+		HealthLatency:       int(record.HealthLatency),
+		HealthRedirects:     record.HealthRedirects,
+		HealthError:         record.HealthError,
+		HealthCheckTime:     record.HealthCheckTime,
+		Tags:                record.Tags,
+	}
+}
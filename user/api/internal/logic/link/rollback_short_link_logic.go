@@ -0,0 +1,55 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type RollbackShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 回滚短链接到指定版本
+func NewRollbackShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RollbackShortLinkLogic {
+	return &RollbackShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RollbackShortLink 将短链接恢复为指定变更记录完成后的属性
+func (l *RollbackShortLinkLogic) RollbackShortLink(req *types.RollbackLinkReq) (resp *types.RollbackLinkResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkRollback(ctx, &shortlinkservice.RollbackShortLinkRequest{
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+		HistoryId:    req.HistoryId,
+	})
+	if err != nil {
+		l.Logger.Errorf("回滚短链接失败 username: %s, fullShortUrl: %s, historyId: %d, error: %v", userInfo.Username, req.FullShortUrl, req.HistoryId, err)
+		return nil, err
+	}
+
+	resp = &types.RollbackLinkResp{}
+	if rpcResp.History != nil {
+		history := toLinkHistoryRecord(rpcResp.History)
+		resp.History = &history
+	}
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type SearchShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 搜索短链接
+func NewSearchShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchShortLinkLogic {
+	return &SearchShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SearchShortLink 在当前用户的分组中按关键字、标签、状态和创建时间搜索短链接
+func (l *SearchShortLinkLogic) SearchShortLink(req *types.SearchLinkReq) (resp *types.PageLinkResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkSearch(ctx, &shortlinkservice.SearchShortLinkRequest{
+		Keyword:     req.Keyword,
+		Tag:         req.Tag,
+		Gids:        req.Gids,
+		Status:      req.Status,
+		Expiry:      req.Expiry,
+		CreateStart: req.CreateStart,
+		CreateEnd:   req.CreateEnd,
+		OrderBy:     req.OrderBy,
+		Asc:         req.Asc,
+		Current:     int32(req.Current),
+		Size:        int32(req.Size),
+	})
+	if err != nil {
+		l.Logger.Errorf("搜索短链接失败 username: %s, keyword: %s, error: %v", userInfo.Username, req.Keyword, err)
+		return nil, err
+	}
+
+	records := make([]types.ShortLinkRecord, 0, len(rpcResp.Records))
+	for _, record := range rpcResp.Records {
+		records = append(records, toShortLinkRecord(record))
+	}
+	return &types.PageLinkResp{
+		Records: records,
+		Total:   int64(rpcResp.Total),
+		Size:    int(rpcResp.Size),
+		Current: int(rpcResp.Current),
+	}, nil
+}
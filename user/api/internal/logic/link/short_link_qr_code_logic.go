@@ -0,0 +1,56 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ShortLinkQrCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成短链接二维码
+func NewShortLinkQrCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShortLinkQrCodeLogic {
+	return &ShortLinkQrCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ShortLinkQrCode 生成短链接二维码，返回图片内容和图片类型
+func (l *ShortLinkQrCodeLogic) ShortLinkQrCode(req *types.ShortLinkQrCodeReq) (content []byte, contentType string, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, "", errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ShortLinkQrCode(ctx, &shortlinkservice.ShortLinkQrCodeRequest{
+		FullShortUrl:    req.FullShortUrl,
+		Size:            int32(req.Size),
+		Format:          req.Format,
+		Ecc:             req.Ecc,
+		Margin:          int32(req.Margin),
+		ForegroundColor: req.ForegroundColor,
+		BackgroundColor: req.BackgroundColor,
+		LogoUrl:         req.LogoUrl,
+	})
+	if err != nil {
+		l.Logger.Errorf("生成短链接二维码失败 username: %s, fullShortUrl: %s, error: %v",
+			userInfo.Username, req.FullShortUrl, err)
+		return nil, "", err
+	}
+
+	return rpcResp.Content, rpcResp.ContentType, nil
+}
@@ -0,0 +1,65 @@
+package link
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type UpdateLinkTemplateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改短链接模板
+func NewUpdateLinkTemplateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateLinkTemplateLogic {
+	return &UpdateLinkTemplateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// UpdateLinkTemplate 修改模板，已创建的短链接不受影响
+func (l *UpdateLinkTemplateLogic) UpdateLinkTemplate(req *types.UpdateLinkTemplateReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	_, err = l.svcCtx.LinkRpc.LinkTemplateUpdate(ctx, &shortlinkservice.UpdateLinkTemplateRequest{
+		Id:               req.Id,
+		Name:             req.Name,
+		Gid:              req.Gid,
+		Domain:           req.Domain,
+		ValidDays:        int32(req.ValidDays),
+		Describe:         req.Describe,
+		QueryParamPolicy: int32(req.QueryParamPolicy),
+		UtmSource:        req.UtmSource,
+		UtmMedium:        req.UtmMedium,
+		UtmCampaign:      req.UtmCampaign,
+		RedirectType:     int32(req.RedirectType),
+		ExpiredUrl:       req.ExpiredUrl,
+		ExpiredMessage:   req.ExpiredMessage,
+		GraceDays:        int32(req.GraceDays),
+		Tags:             req.Tags,
+	})
+	if err != nil {
+		l.Logger.Errorf("修改短链接模板失败 username: %s, id: %d, error: %v", userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package link
+
+import (
+	"context"
+	"errors"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type UpdateRedirectRuleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改跳转规则
+func NewUpdateRedirectRuleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateRedirectRuleLogic {
+	return &UpdateRedirectRuleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateRedirectRuleLogic) UpdateRedirectRule(req *types.UpdateRedirectRuleReq) (resp *types.SuccessResp, err error) {
+	// 获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errors.New("未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	_, err = l.svcCtx.LinkRpc.RedirectRuleUpdate(ctx, &shortlinkservice.UpdateRedirectRuleRequest{
+		Id:           req.Id,
+		FullShortUrl: req.FullShortUrl,
+		Gid:          req.Gid,
+		RuleType:     req.RuleType,
+		RuleValue:    req.RuleValue,
+		TargetUrl:    req.TargetUrl,
+		SortOrder:    int32(req.SortOrder),
+	})
+	if err != nil {
+		l.Logger.Errorf("修改跳转规则失败 username: %s, id: %d, error: %v",
+			userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: true,
+	}, nil
+}
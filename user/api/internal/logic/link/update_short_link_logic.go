@@ -35,12 +35,35 @@ func (l *UpdateShortLinkLogic) UpdateShortLink(req *types.UpdateLinkReq) (resp *
 
 	// 构建RPC请求
 	rpcReq := &shortlinkservice.UpdateShortLinkRequest{
-		OriginUrl:     req.OriginUrl,
-		FullShortUrl:  req.FullShortUrl,
-		Gid:           req.Gid,
-		ValidDateType: int32(req.ValidDateType),
-		ValidDate:     req.ValidDate,
-		Describe:      req.Describe,
+		OriginUrl:           req.OriginUrl,
+		FullShortUrl:        req.FullShortUrl,
+		Gid:                 req.Gid,
+		ValidDateType:       int32(req.ValidDateType),
+		ValidDate:           req.ValidDate,
+		ValidFrom:           req.ValidFrom,
+		ExpiredUrl:          req.ExpiredUrl,
+		ExpiredMessage:      req.ExpiredMessage,
+		GraceDays:           toInt32Ptr(req.GraceDays),
+		RedirectType:        toInt32Ptr(req.RedirectType),
+		IosDeepLink:         req.IosDeepLink,
+		AndroidPackage:      req.AndroidPackage,
+		AndroidDeepLink:     req.AndroidDeepLink,
+		DeepLinkFallbackUrl: req.DeepLinkFallbackUrl,
+		OgTitle:             req.OgTitle,
+		OgDescription:       req.OgDescription,
+		OgImage:             req.OgImage,
+		Describe:            req.Describe,
+		Password:            req.Password,
+		ClearPassword:       req.ClearPassword,
+		MaxClicks:           toInt32Ptr(req.MaxClicks),
+		Variants:            toRpcVariants(req.Variants),
+		ClearVariants:       req.ClearVariants,
+		QueryParamPolicy:    toInt32Ptr(req.QueryParamPolicy),
+		UtmSource:           req.UtmSource,
+		UtmMedium:           req.UtmMedium,
+		UtmCampaign:         req.UtmCampaign,
+		Tags:                req.Tags,
+		ClearTags:           req.ClearTags,
 	}
 
 	// 添加元数据
@@ -58,3 +81,12 @@ func (l *UpdateShortLinkLogic) UpdateShortLink(req *types.UpdateLinkReq) (resp *
 		Success: true,
 	}, nil
 }
+
+// toInt32Ptr 转换可选的整数字段，未传入时保持为空
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
@@ -0,0 +1,51 @@
+package moderation
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ModerationFlagLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 手动标记可疑短链接
+func NewModerationFlagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationFlagLogic {
+	return &ModerationFlagLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ModerationFlagLogic) ModerationFlag(req *types.ModerationFlagReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	result, err := l.svcCtx.LinkRpc.ModerationFlag(ctx, &shortlinkservice.FlagModerationRequest{
+		FullShortUrl: req.FullShortUrl,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		l.Logger.Errorf("标记可疑短链接失败 username: %s, fullShortUrl: %s, error: %v", userInfo.Username, req.FullShortUrl, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: result.Success,
+	}, nil
+}
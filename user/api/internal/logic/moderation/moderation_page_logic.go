@@ -0,0 +1,71 @@
+package moderation
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ModerationPageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 分页查询安全审核队列
+func NewModerationPageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationPageLogic {
+	return &ModerationPageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ModerationPageLogic) ModerationPage(req *types.ModerationPageReq) (resp *types.ModerationPageResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	rpcResp, err := l.svcCtx.LinkRpc.ModerationPage(ctx, &shortlinkservice.PageModerationRequest{
+		Status:  int32(req.Status),
+		Current: int32(req.Current),
+		Size:    int32(req.Size),
+	})
+	if err != nil {
+		l.Logger.Errorf("分页查询安全审核队列失败 username: %s, error: %v", userInfo.Username, err)
+		return nil, err
+	}
+
+	// 构建响应
+	records := make([]types.ModerationRecord, 0, len(rpcResp.Records))
+	for _, record := range rpcResp.Records {
+		records = append(records, types.ModerationRecord{
+			Id:           record.Id,
+			Gid:          record.Gid,
+			FullShortUrl: record.FullShortUrl,
+			OriginUrl:    record.OriginUrl,
+			Provider:     record.Provider,
+			Reason:       record.Reason,
+			Status:       int(record.Status),
+			Reviewer:     record.Reviewer,
+			CreateTime:   record.CreateTime,
+			UpdateTime:   record.UpdateTime,
+		})
+	}
+
+	return &types.ModerationPageResp{
+		Records: records,
+		Total:   int64(rpcResp.Total),
+		Size:    int(rpcResp.Size),
+		Current: int(rpcResp.Current),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package moderation
+
+import (
+	"context"
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/types/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+type ModerationReviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 审核可疑短链接
+func NewModerationReviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationReviewLogic {
+	return &ModerationReviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ModerationReviewLogic) ModerationReview(req *types.ModerationReviewReq) (resp *types.SuccessResp, err error) {
+	// 从上下文中获取用户信息
+	userInfo, ok := types.GetUserFromCtx(l.ctx)
+	if !ok {
+		return nil, errorx.New(errorx.ClientError, errorx.ErrInternalServer, "未找到用户信息")
+	}
+
+	// 添加元数据
+	ctx := metadata.AppendToOutgoingContext(l.ctx, "username", userInfo.Username)
+	result, err := l.svcCtx.LinkRpc.ModerationReview(ctx, &shortlinkservice.ReviewModerationRequest{
+		Id:      req.Id,
+		Approve: req.Approve,
+	})
+	if err != nil {
+		l.Logger.Errorf("审核可疑短链接失败 username: %s, id: %d, error: %v", userInfo.Username, req.Id, err)
+		return nil, err
+	}
+
+	return &types.SuccessResp{
+		Code:    "0",
+		Success: result.Success,
+	}, nil
+}
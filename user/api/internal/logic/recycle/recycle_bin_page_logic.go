@@ -62,18 +62,31 @@ func (l *RecycleBinPageLogic) RecycleBinPage(req *types.RecycleBinPageReq) (resp
 
 		// 构建短链接记录
 		item := types.ShortLinkPageRecordDTO{
-			Domain:        record.Domain,
-			FullShortUrl:  record.FullShortUrl,
-			ShortUri:      shortUri,
-			OriginUrl:     record.OriginUrl,
-			Gid:           record.Gid,
-			CreateTime:    record.CreateTime,
-			Describe:      record.Describe,
-			ValidDate:     record.ValidDate,
-			ValidDateType: validDateType,
-			TotalPv:       int64(record.TotalPv),
-			TotalUv:       int64(record.TotalUv),
-			TotalUip:      int64(record.TotalUip),
+			Domain:              record.Domain,
+			FullShortUrl:        record.FullShortUrl,
+			ShortUri:            shortUri,
+			OriginUrl:           record.OriginUrl,
+			Gid:                 record.Gid,
+			CreateTime:          record.CreateTime,
+			Describe:            record.Describe,
+			ValidDate:           record.ValidDate,
+			ValidFrom:           record.ValidFrom,
+			ExpiredUrl:          record.ExpiredUrl,
+			ExpiredMessage:      record.ExpiredMessage,
+			GraceDays:           int(record.GraceDays),
+			RedirectType:        int(record.RedirectType),
+			IosDeepLink:         record.IosDeepLink,
+			AndroidPackage:      record.AndroidPackage,
+			AndroidDeepLink:     record.AndroidDeepLink,
+			DeepLinkFallbackUrl: record.DeepLinkFallbackUrl,
+			OgTitle:             record.OgTitle,
+			OgDescription:       record.OgDescription,
+			OgImage:             record.OgImage,
+			ValidDateType:       validDateType,
+			TotalPv:             int64(record.TotalPv),
+			TotalUv:             int64(record.TotalUv),
+			TotalUip:            int64(record.TotalUip),
+			SafetyStatus:        int(record.SafetyStatus),
 			// 设置默认值
 			Id:           0,
 			Favicon:      "https://cdn-icons-png.flaticon.com/512/8763/8763935.png", // 默认图标
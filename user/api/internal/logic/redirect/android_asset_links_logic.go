@@ -0,0 +1,44 @@
+package redirect
+
+import (
+	"context"
+
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AndroidAssetLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Android App Links关联文件
+func NewAndroidAssetLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AndroidAssetLinksLogic {
+	return &AndroidAssetLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AndroidAssetLinks 生成请求域名的assetlinks.json，域名未注册或未关联Android应用时返回空
+func (l *AndroidAssetLinksLogic) AndroidAssetLinks(host string) ([]types.AndroidAssetLink, error) {
+	appLinks, err := getDomainAppLinks(l.ctx, l.svcCtx, host)
+	if err != nil || appLinks == nil || appLinks.AndroidPackage == "" {
+		return nil, err
+	}
+
+	return []types.AndroidAssetLink{
+		{
+			Relation: []string{"delegate_permission/common.handle_all_urls"},
+			Target: types.AndroidAssetLinkTarget{
+				Namespace:              "android_app",
+				PackageName:            appLinks.AndroidPackage,
+				Sha256CertFingerprints: appLinks.AndroidCertFingerprints,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,66 @@
+package redirect
+
+import (
+	"context"
+
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AppleAppSiteAssociationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// iOS通用链接关联文件
+func NewAppleAppSiteAssociationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AppleAppSiteAssociationLogic {
+	return &AppleAppSiteAssociationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AppleAppSiteAssociation 生成请求域名的apple-app-site-association，域名未注册或未关联iOS应用时返回空
+func (l *AppleAppSiteAssociationLogic) AppleAppSiteAssociation(host string) (*types.AppleAppSiteAssociationResp, error) {
+	appLinks, err := getDomainAppLinks(l.ctx, l.svcCtx, host)
+	if err != nil || appLinks == nil || len(appLinks.AppleAppIds) == 0 {
+		return nil, err
+	}
+
+	// 短链接均位于域名根路径下，所有路径都交由应用处理
+	details := make([]types.AppleAppLinkDetail, 0, len(appLinks.AppleAppIds))
+	for _, appId := range appLinks.AppleAppIds {
+		details = append(details, types.AppleAppLinkDetail{
+			AppID: appId,
+			Paths: []string{"*"},
+		})
+	}
+	return &types.AppleAppSiteAssociationResp{
+		Applinks: types.AppleAppLinks{
+			Apps:    []string{},
+			Details: details,
+		},
+	}, nil
+}
+
+// getDomainAppLinks 查询请求域名关联的应用信息，域名未注册或未验证时返回空
+func getDomainAppLinks(ctx context.Context, svcCtx *svc.ServiceContext, host string) (*shortlinkservice.DomainAppLinks, error) {
+	resp, err := svcCtx.LinkRpc.DomainAppLinksGet(ctx, &shortlinkservice.GetDomainAppLinksRequest{
+		Domain: host,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && (st.Code() == codes.NotFound || st.Code() == codes.InvalidArgument) {
+			return nil, nil
+		}
+		logx.WithContext(ctx).Errorf("查询域名应用关联失败 host: %s, error: %v", host, err)
+		return nil, err
+	}
+	return resp.AppLinks, nil
+}
@@ -3,13 +3,16 @@ package redirect
 import (
 	"context"
 	"fmt"
+	"html/template"
 	"net/http"
 	"strings"
+	"time"
 
 	"shorterurl/link/rpc/shortlinkservice"
 	"shorterurl/user/api/internal/middleware"
 	"shorterurl/user/api/internal/svc"
 	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/util"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -33,6 +36,14 @@ const (
 	ErrMsgServerError     = "处理您的请求时发生错误，请稍后再试"
 )
 
+// 跳转类型，与短链接服务中的定义保持一致
+const (
+	RedirectTypeFound             = 0 // 302临时重定向
+	RedirectTypeMovedPermanently  = 1 // 301永久重定向
+	RedirectTypeTemporaryRedirect = 2 // 307临时重定向
+	RedirectTypeInterstitial      = 3 // 中间页倒计时跳转
+)
+
 type RedirectShortLinkLogic struct {
 	logx.Logger
 	ctx      context.Context
@@ -115,6 +126,531 @@ func (l *RedirectShortLinkLogic) renderErrorPage(w http.ResponseWriter, statusCo
 	w.Write([]byte(html))
 }
 
+// 返回短链接密码解锁页面，表单提交到当前短链接地址
+func (l *RedirectShortLinkLogic) renderPasswordPage(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+
+	// 简单的HTML密码输入页面模板
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>请输入访问密码</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f5f5f5;
+            color: #333;
+            display: flex;
+            justify-content: center;
+            align-items: center;
+            height: 100vh;
+            margin: 0;
+        }
+        .unlock-container {
+            background-color: white;
+            border-radius: 8px;
+            box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+            padding: 30px;
+            text-align: center;
+            max-width: 400px;
+            width: 100%%;
+        }
+        h1 {
+            color: #3498db;
+            margin-bottom: 20px;
+        }
+        input[type=password] {
+            width: 100%%;
+            padding: 10px;
+            font-size: 16px;
+            border: 1px solid #ddd;
+            border-radius: 4px;
+            box-sizing: border-box;
+        }
+        button {
+            margin-top: 16px;
+            width: 100%%;
+            padding: 10px;
+            font-size: 16px;
+            color: white;
+            background-color: #3498db;
+            border: none;
+            border-radius: 4px;
+            cursor: pointer;
+        }
+        .message {
+            color: #e74c3c;
+            min-height: 24px;
+        }
+    </style>
+</head>
+<body>
+    <div class="unlock-container">
+        <h1>此链接需要密码</h1>
+        <p class="message">%s</p>
+        <form method="post">
+            <input type="password" name="password" placeholder="请输入访问密码" autofocus required>
+            <button type="submit">访问</button>
+        </form>
+    </div>
+</body>
+</html>
+	`
+
+	html := fmt.Sprintf(htmlTemplate, template.HTMLEscapeString(message))
+	w.Write([]byte(html))
+}
+
+// 获取访问者携带的解锁令牌，由链接服务校验签名和有效期
+func unlockToken(r *http.Request) string {
+	cookie, err := r.Cookie(util.LinkUnlockCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// 返回短链接尚未生效页面，展示链接的生效时间
+func (l *RedirectShortLinkLogic) renderNotYetActivePage(w http.ResponseWriter, validFrom string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusForbidden)
+
+	// 生效时间按服务器本地时区展示
+	startTime := validFrom
+	if t, err := time.Parse(time.RFC3339, validFrom); err == nil {
+		startTime = t.Local().Format("2006-01-02 15:04:05")
+	}
+
+	// 简单的HTML尚未生效页面模板
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>链接尚未生效</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f5f5f5;
+            color: #333;
+            display: flex;
+            justify-content: center;
+            align-items: center;
+            height: 100vh;
+            margin: 0;
+        }
+        .pending-container {
+            background-color: white;
+            border-radius: 8px;
+            box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+            padding: 30px;
+            text-align: center;
+            max-width: 500px;
+            width: 100%%;
+        }
+        h1 {
+            color: #f39c12;
+            margin-bottom: 20px;
+        }
+        p {
+            font-size: 18px;
+            line-height: 1.6;
+        }
+        .start-time {
+            font-weight: bold;
+            color: #3498db;
+        }
+    </style>
+</head>
+<body>
+    <div class="pending-container">
+        <h1>链接尚未生效</h1>
+        <p>此短链接将于 <span class="start-time">%s</span> 开放访问，请稍后再来</p>
+    </div>
+</body>
+</html>
+	`
+
+	html := fmt.Sprintf(htmlTemplate, template.HTMLEscapeString(startTime))
+	w.Write([]byte(html))
+}
+
+// 返回跳转中间页，展示目标链接并在倒计时结束后自动跳转，提醒访问者即将离开当前站点
+func (l *RedirectShortLinkLogic) renderInterstitialPage(w http.ResponseWriter, targetUrl string) {
+	// 中间页会把目标链接写入页面，只允许http(s)链接，避免javascript:等链接执行脚本
+	if !util.IsHttpUrl(targetUrl) {
+		l.Logger.Errorf("拒绝渲染非http(s)目标链接的中间页: %s", targetUrl)
+		l.renderErrorPage(w, ErrCodeInvalidShortUri, "无效的链接", "此短链接指向的原始URL不是有效的网页链接")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	seconds := l.svcCtx.Config.Interstitial.CountdownSeconds
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	// 简单的HTML中间页模板，同时使用meta refresh和脚本倒计时，禁用脚本时仍可跳转
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <meta name="referrer" content="no-referrer">
+    <meta http-equiv="refresh" content="%d;url=%s">
+    <title>即将离开本站</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f5f5f5;
+            color: #333;
+            display: flex;
+            justify-content: center;
+            align-items: center;
+            height: 100vh;
+            margin: 0;
+        }
+        .interstitial-container {
+            background-color: white;
+            border-radius: 8px;
+            box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+            padding: 30px;
+            text-align: center;
+            max-width: 500px;
+            width: 100%%;
+        }
+        h1 {
+            color: #3498db;
+            margin-bottom: 20px;
+        }
+        .target-url {
+            word-break: break-all;
+            color: #e67e22;
+        }
+        .go-link {
+            display: inline-block;
+            margin-top: 20px;
+            color: #3498db;
+            text-decoration: none;
+        }
+    </style>
+</head>
+<body>
+    <div class="interstitial-container">
+        <h1>即将离开本站</h1>
+        <p>您将访问以下链接，请注意账号和财产安全：</p>
+        <p class="target-url">%s</p>
+        <p><span id="countdown">%d</span> 秒后自动跳转</p>
+        <a href="%s" class="go-link" rel="noopener noreferrer">立即前往</a>
+    </div>
+    <script>
+        (function () {
+            var remaining = %d;
+            var el = document.getElementById("countdown");
+            var timer = setInterval(function () {
+                remaining--;
+                if (remaining <= 0) {
+                    clearInterval(timer);
+                    window.location.replace("%s");
+                    return;
+                }
+                el.textContent = remaining;
+            }, 1000);
+        })();
+    </script>
+</body>
+</html>
+	`
+
+	escapedUrl := template.HTMLEscapeString(targetUrl)
+	html := fmt.Sprintf(htmlTemplate, seconds, escapedUrl, escapedUrl, seconds, escapedUrl, seconds, template.JSEscapeString(targetUrl))
+	w.Write([]byte(html))
+}
+
+// 根据访问设备选择深度链接，非iOS和Android设备或未配置对应平台的深度链接时返回false
+func (l *RedirectShortLinkLogic) redirectDeepLink(w http.ResponseWriter, r *http.Request, resp *shortlinkservice.RestoreUrlResponse) bool {
+	uaInfo := util.ParseUserAgent(r.UserAgent())
+	switch uaInfo.OS {
+	case "iOS":
+		if resp.IosDeepLink == "" {
+			return false
+		}
+		// 通用链接由系统决定打开应用或网页，直接跳转即可
+		if util.IsHttpUrl(resp.IosDeepLink) {
+			http.Redirect(w, r, resp.IosDeepLink, http.StatusFound)
+			return true
+		}
+		fallbackUrl := resp.DeepLinkFallbackUrl
+		if fallbackUrl == "" {
+			fallbackUrl = resp.OriginUrl
+		}
+		l.renderAppOpenPage(w, resp.IosDeepLink, fallbackUrl)
+		return true
+	case "Android":
+		if resp.AndroidDeepLink == "" && resp.AndroidPackage == "" {
+			return false
+		}
+		if util.IsHttpUrl(resp.AndroidDeepLink) {
+			http.Redirect(w, r, resp.AndroidDeepLink, http.StatusFound)
+			return true
+		}
+		// 未配置兜底链接时，配置了包名跳转到应用商店，否则跳转到原始链接
+		fallbackUrl := resp.DeepLinkFallbackUrl
+		if fallbackUrl == "" && resp.AndroidPackage != "" {
+			fallbackUrl = util.AndroidStoreUrl(resp.AndroidPackage)
+		} else if fallbackUrl == "" {
+			fallbackUrl = resp.OriginUrl
+		}
+		intentUrl := util.BuildAndroidIntentUrl(resp.AndroidDeepLink, resp.AndroidPackage, fallbackUrl)
+		l.renderAppOpenPage(w, intentUrl, fallbackUrl)
+		return true
+	}
+	return false
+}
+
+// 返回打开应用页面，尝试唤起应用，页面仍可见时说明应用未安装，跳转到兜底链接
+func (l *RedirectShortLinkLogic) renderAppOpenPage(w http.ResponseWriter, appUrl, fallbackUrl string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	// 简单的HTML打开应用页面模板
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>正在打开应用</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f5f5f5;
+            color: #333;
+            display: flex;
+            justify-content: center;
+            align-items: center;
+            height: 100vh;
+            margin: 0;
+        }
+        .app-container {
+            background-color: white;
+            border-radius: 8px;
+            box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+            padding: 30px;
+            text-align: center;
+            max-width: 400px;
+            width: 100%%;
+        }
+        h1 {
+            color: #3498db;
+            margin-bottom: 20px;
+        }
+        .open-button {
+            display: block;
+            padding: 10px;
+            font-size: 16px;
+            color: white;
+            background-color: #3498db;
+            border-radius: 4px;
+            text-decoration: none;
+        }
+        .web-link {
+            display: inline-block;
+            margin-top: 16px;
+            color: #3498db;
+            text-decoration: none;
+        }
+    </style>
+</head>
+<body>
+    <div class="app-container">
+        <h1>正在打开应用</h1>
+        <a href="%s" class="open-button">打开应用</a>
+        <a href="%s" class="web-link" rel="noopener noreferrer">未安装应用？继续访问</a>
+    </div>
+    <script>
+        (function () {
+            var fallbackUrl = "%s";
+            window.location.href = "%s";
+            // 唤起应用后页面会进入后台，超时后仍可见则跳转到兜底链接
+            setTimeout(function () {
+                if (!document.hidden) {
+                    window.location.replace(fallbackUrl);
+                }
+            }, 2000);
+        })();
+    </script>
+</body>
+</html>
+	`
+
+	html := fmt.Sprintf(htmlTemplate,
+		template.HTMLEscapeString(appUrl), template.HTMLEscapeString(fallbackUrl),
+		template.JSEscapeString(fallbackUrl), template.JSEscapeString(appUrl))
+	w.Write([]byte(html))
+}
+
+// 返回安全风险提示页面，展示风险原因和目标链接，访问者确认后继续访问
+func (l *RedirectShortLinkLogic) renderSafetyWarningPage(w http.ResponseWriter, targetUrl, reason string) {
+	// 提示页面的继续访问链接与中间页一样只允许http(s)链接
+	if !util.IsHttpUrl(targetUrl) {
+		l.Logger.Errorf("拒绝渲染非http(s)目标链接的风险提示页: %s", targetUrl)
+		l.renderErrorPage(w, ErrCodeInvalidShortUri, "无效的链接", "此短链接指向的原始URL不是有效的网页链接")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	// 简单的HTML风险提示页面模板
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <meta name="robots" content="noindex">
+    <title>安全风险提示</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f5f5f5;
+            color: #333;
+            display: flex;
+            justify-content: center;
+            align-items: center;
+            height: 100vh;
+            margin: 0;
+        }
+        .warning-container {
+            background-color: white;
+            border-radius: 8px;
+            box-shadow: 0 4px 6px rgba(0, 0, 0, 0.1);
+            padding: 30px;
+            text-align: center;
+            max-width: 480px;
+            width: 100%%;
+        }
+        h1 {
+            color: #e67e22;
+            margin-bottom: 20px;
+        }
+        .target-url {
+            word-break: break-all;
+            color: #666;
+            font-size: 14px;
+        }
+        .continue-link {
+            display: inline-block;
+            margin-top: 16px;
+            color: #e74c3c;
+            text-decoration: none;
+        }
+    </style>
+</head>
+<body>
+    <div class="warning-container">
+        <h1>安全风险提示</h1>
+        <p>%s</p>
+        <p>您即将访问的链接可能存在安全风险，请谨慎访问：</p>
+        <p class="target-url">%s</p>
+        <a href="%s" class="continue-link" rel="noopener noreferrer nofollow">我已了解风险，继续访问</a>
+    </div>
+</body>
+</html>
+	`
+
+	html := fmt.Sprintf(htmlTemplate,
+		template.HTMLEscapeString(reason), template.HTMLEscapeString(targetUrl), template.HTMLEscapeString(targetUrl))
+	w.Write([]byte(html))
+}
+
+// 返回社交分享预览页面，包含Open Graph标签，获取预览信息失败时返回false，按正常跳转处理
+func (l *RedirectShortLinkLogic) renderPreviewPage(ctx context.Context, w http.ResponseWriter, r *http.Request, shortUri string) bool {
+	preview, err := l.svcCtx.LinkRpc.ShortLinkPreview(ctx, &shortlinkservice.ShortLinkPreviewRequest{
+		ShortUri: shortUri,
+		Host:     r.Host,
+	})
+	if err != nil {
+		l.Logger.Infof("获取短链接预览信息失败, 按正常跳转处理: %v", err)
+		return false
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Vary", "User-Agent")
+	w.WriteHeader(http.StatusOK)
+
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	pageUrl := fmt.Sprintf("%s://%s/%s", scheme, r.Host, shortUri)
+
+	// 有图片时使用大图卡片
+	card := "summary"
+	var imageTags string
+	if preview.Image != "" {
+		card = "summary_large_image"
+		imageTags = fmt.Sprintf(`
+    <meta property="og:image" content="%s">
+    <meta name="twitter:image" content="%s">`,
+			template.HTMLEscapeString(preview.Image), template.HTMLEscapeString(preview.Image))
+	}
+
+	// 需要访问密码的短链接不展示原始链接
+	link := ""
+	if preview.OriginUrl != "" {
+		link = fmt.Sprintf(`<a href="%s" rel="noopener noreferrer">%s</a>`,
+			template.HTMLEscapeString(preview.OriginUrl), template.HTMLEscapeString(preview.OriginUrl))
+	}
+
+	// 简单的HTML预览页面模板
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>%s</title>
+    <meta name="description" content="%s">
+    <meta property="og:type" content="website">
+    <meta property="og:url" content="%s">
+    <meta property="og:title" content="%s">
+    <meta property="og:description" content="%s">%s
+    <meta name="twitter:card" content="%s">
+    <meta name="twitter:title" content="%s">
+    <meta name="twitter:description" content="%s">
+</head>
+<body>
+    <h1>%s</h1>
+    <p>%s</p>
+    %s
+</body>
+</html>
+	`
+
+	title := template.HTMLEscapeString(preview.Title)
+	description := template.HTMLEscapeString(preview.Description)
+	html := fmt.Sprintf(htmlTemplate,
+		title, description, template.HTMLEscapeString(pageUrl), title, description, imageTags,
+		card, title, description, title, description, link)
+	w.Write([]byte(html))
+	return true
+}
+
 // 处理gRPC错误
 func (l *RedirectShortLinkLogic) handleGrpcError(err error, w http.ResponseWriter) error {
 	grpcStatus, ok := status.FromError(err)
@@ -196,16 +732,29 @@ func (l *RedirectShortLinkLogic) RedirectShortLink(req *types.ShortLinkRedirectR
 	l.Logger.Infof("短链接跳转请求: URI=%s, IP=%s, UA=%s, Browser=%s, OS=%s, Device=%s, Network=%s, Locale=%s",
 		stats.ShortUri, stats.Ip, stats.UserAgent, stats.Browser, stats.Os, stats.Device, stats.Network, stats.Locale)
 
-	// 获取当前用户信息
-	userInfo := l.ctx.Value("userInfo").(*types.UserInfo)
+	// 获取当前用户信息，跳转接口无需登录，已登录时才附带用户名
+	if userInfo, ok := types.GetUserFromCtx(l.ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, "username", userInfo.Username)
+	}
 
-	// 创建新的上下文并添加用户信息
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(
-		"username", userInfo.Username,
-	))
+	// 爬虫访问（如社交平台抓取链接预览）时返回预览页面，不计入访问统计
+	uaInfo := util.ParseUserAgent(stats.UserAgent)
+	if uaInfo.IsCrawler && l.renderPreviewPage(ctx, w, r, req.ShortUri) {
+		return nil
+	}
 
+	// 携带请求的Host，用于解析自定义域名下的短链接
+	// 携带访问者的系统、省份和语言，用于匹配跳转规则
+	// 携带访问时的查询参数，由短链接的查询参数策略决定是否传递给目标链接
 	resp, err := l.svcCtx.LinkRpc.RestoreUrl(ctx, &shortlinkservice.RestoreUrlRequest{
-		ShortUri: req.ShortUri,
+		ShortUri:       req.ShortUri,
+		Host:           r.Host,
+		UnlockToken:    unlockToken(r),
+		Os:             stats.Os,
+		Province:       util.ProvinceFromLocale(stats.Locale),
+		AcceptLanguage: r.Header.Get("Accept-Language"),
+		Query:          r.URL.RawQuery,
+		Crawler:        uaInfo.IsCrawler,
 	})
 
 	// 4. 处理错误情况
@@ -214,6 +763,30 @@ func (l *RedirectShortLinkLogic) RedirectShortLink(req *types.ShortLinkRedirectR
 		return l.handleGrpcError(err, w)
 	}
 
+	// 尚未到生效时间时渲染等待页面
+	if resp.NotYetActive {
+		l.Logger.Infof("短链接 %s 尚未生效, 生效时间: %s", req.ShortUri, resp.ValidFrom)
+		l.renderNotYetActivePage(w, resp.ValidFrom)
+		return nil
+	}
+
+	// 已过期时按配置跳转到指定链接或展示提示信息
+	if resp.Expired {
+		if resp.ExpiredUrl != "" {
+			l.Logger.Infof("短链接 %s 已过期，跳转到 %s", req.ShortUri, resp.ExpiredUrl)
+			http.Redirect(w, r, resp.ExpiredUrl, http.StatusFound)
+			return nil
+		}
+		l.renderErrorPage(w, http.StatusGone, "链接已过期", template.HTMLEscapeString(resp.ExpiredMessage))
+		return nil
+	}
+
+	// 需要访问密码时渲染解锁页面
+	if resp.PasswordRequired {
+		l.renderPasswordPage(w, http.StatusOK, "")
+		return nil
+	}
+
 	// 5. 确保原始URL存在
 	if resp.OriginUrl == "" {
 		l.Logger.Error("获取到的原始URL为空")
@@ -224,7 +797,28 @@ func (l *RedirectShortLinkLogic) RedirectShortLink(req *types.ShortLinkRedirectR
 	// 6. 记录成功重定向信息
 	l.Logger.Infof("短链接 %s 成功重定向到 %s", req.ShortUri, resp.OriginUrl)
 
-	// 7. 执行HTTP重定向
-	http.Redirect(w, r, resp.OriginUrl, http.StatusFound)
+	// 7. 目标链接被标记为存在安全风险时，先展示风险提示页面，由访问者确认后继续访问
+	if resp.SafetyWarning {
+		l.renderSafetyWarningPage(w, resp.OriginUrl, resp.SafetyReason)
+		return nil
+	}
+
+	// 8. 移动端访问且配置了深度链接时优先打开应用
+	if l.redirectDeepLink(w, r, resp) {
+		return nil
+	}
+
+	// 9. 按短链接的跳转类型执行HTTP重定向
+	// 注意：301会被浏览器缓存，重复访问不再经过短链接服务，访问统计会偏少
+	switch resp.RedirectType {
+	case RedirectTypeMovedPermanently:
+		http.Redirect(w, r, resp.OriginUrl, http.StatusMovedPermanently)
+	case RedirectTypeTemporaryRedirect:
+		http.Redirect(w, r, resp.OriginUrl, http.StatusTemporaryRedirect)
+	case RedirectTypeInterstitial:
+		l.renderInterstitialPage(w, resp.OriginUrl)
+	default:
+		http.Redirect(w, r, resp.OriginUrl, http.StatusFound)
+	}
 	return nil
 }
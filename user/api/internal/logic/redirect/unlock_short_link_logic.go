@@ -0,0 +1,87 @@
+package redirect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"shorterurl/link/rpc/shortlinkservice"
+	"shorterurl/user/api/internal/svc"
+	"shorterurl/user/api/internal/types"
+	"shorterurl/user/api/internal/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnlockShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 短链接密码解锁
+func NewUnlockShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlockShortLinkLogic {
+	return &UnlockShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnlockShortLinkLogic) UnlockShortLink(req *types.ShortLinkUnlockReq, w http.ResponseWriter, r *http.Request) error {
+	// 复用跳转逻辑中的页面渲染
+	pages := NewRedirectShortLinkLogic(l.ctx, l.svcCtx)
+
+	// 1. 参数校验
+	if req.ShortUri == "" {
+		pages.renderErrorPage(w, ErrCodeInvalidShortUri, "无效的短链接", ErrMsgInvalidShortUri)
+		return nil
+	}
+	if req.Password == "" {
+		pages.renderPasswordPage(w, http.StatusBadRequest, "请输入访问密码")
+		return nil
+	}
+
+	// 2. 调用RPC验证密码，错误次数按访问IP限制，转发头只信任来自受信任代理的请求
+	ip := util.GetTrustedClientIP(r, l.svcCtx.Config.TrustedProxies)
+	resp, err := l.svcCtx.LinkRpc.VerifyLinkPassword(l.ctx, &shortlinkservice.VerifyLinkPasswordRequest{
+		ShortUri: req.ShortUri,
+		Host:     r.Host,
+		Password: req.Password,
+		Ip:       ip,
+	})
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			pages.renderErrorPage(w, http.StatusTooManyRequests, "尝试次数过多", "密码错误次数过多，请稍后再试")
+			return err
+		}
+		return pages.handleGrpcError(err, w)
+	}
+
+	// 3. 密码错误，重新展示解锁页面
+	if !resp.Success {
+		l.Logger.Infof("短链接 %s 密码错误, IP: %s", req.ShortUri, ip)
+		pages.renderPasswordPage(w, http.StatusUnauthorized, fmt.Sprintf("密码错误，还可尝试%d次", resp.RemainingAttempts))
+		return nil
+	}
+
+	// 4. 验证通过，保存服务端签发的解锁令牌，后续访问无需再次输入密码
+	http.SetCookie(w, &http.Cookie{
+		Name:     util.LinkUnlockCookieName,
+		Value:    resp.UnlockToken,
+		Path:     "/" + req.ShortUri,
+		MaxAge:   int(resp.UnlockMaxAge),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	// 5. 重定向回短链接地址（保留查询参数），由跳转接口完成最终跳转
+	location := "/" + req.ShortUri
+	if r.URL.RawQuery != "" {
+		location += "?" + r.URL.RawQuery
+	}
+	http.Redirect(w, r, location, http.StatusSeeOther)
+	return nil
+}
@@ -162,5 +162,29 @@ func (l *ShortLinkStatsLogic) ShortLinkStats(req *types.ShortLinkStatsReq) (resp
 	}
 	resp.NetworkStats = networkStats
 
+	// 转换A/B分流版本统计
+	variantStats := make([]types.VariantStat, 0)
+	for _, stat := range result.VariantStats {
+		variantStats = append(variantStats, types.VariantStat{
+			Variant:   stat.Variant,
+			TargetUrl: stat.TargetUrl,
+			Weight:    int(stat.Weight),
+			Pv:        int64(stat.Pv),
+			Uv:        int64(stat.Uv),
+		})
+	}
+	resp.VariantStats = variantStats
+
+	// 转换访问渠道统计
+	channelStats := make([]types.ChannelStat, 0)
+	for _, stat := range result.ChannelStats {
+		channelStats = append(channelStats, types.ChannelStat{
+			Channel: stat.Channel,
+			Pv:      int64(stat.Pv),
+			Uv:      int64(stat.Uv),
+		})
+	}
+	resp.ChannelStats = channelStats
+
 	return resp, nil
 }
@@ -20,13 +20,40 @@ type AccessRecordPageResp struct {
 	Current int64          `json:"current"` // 当前页码
 }
 
+type AndroidAssetLink struct {
+	Relation []string               `json:"relation"` // 授权关系
+	Target   AndroidAssetLinkTarget `json:"target"`   // 关联的应用
+}
+
+type AndroidAssetLinkTarget struct {
+	Namespace              string   `json:"namespace"`                // 固定为android_app
+	PackageName            string   `json:"package_name"`             // Android应用包名
+	Sha256CertFingerprints []string `json:"sha256_cert_fingerprints"` // 签名证书SHA256指纹列表
+}
+
+type AppleAppLinkDetail struct {
+	AppID string   `json:"appID"` // iOS应用标识（TeamID.BundleID）
+	Paths []string `json:"paths"` // 由应用处理的路径
+}
+
+type AppleAppLinks struct {
+	Apps    []string             `json:"apps"`    // 固定为空数组
+	Details []AppleAppLinkDetail `json:"details"` // 关联的应用列表
+}
+
+type AppleAppSiteAssociationResp struct {
+	Applinks AppleAppLinks `json:"applinks"` // 通用链接配置
+}
+
 type BatchCreateLinkReq struct {
 	OriginUrls    []string `json:"originUrls" validate:"required,min=1"` // 原始URL列表
-	Describes     []string `json:"describes" validate:"required,min=1"`  // 描述列表
-	Gid           string   `json:"gid" validate:"required"`              // 分组标识
+	Describes     []string `json:"describes,optional"`                   // 描述列表，使用第一个描述，为空时使用模板的描述
+	Gid           string   `json:"gid,optional"`                         // 分组标识，使用模板时为空表示使用模板的分组
 	CreatedType   int      `json:"createdType,default=0"`                // 创建类型
 	ValidDateType int      `json:"validDateType"`                        // 有效期类型
 	ValidDate     string   `json:"validDate,optional"`                   // 有效日期
+	ValidFrom     string   `json:"validFrom,optional"`                   // 生效时间（ISO-8601格式），为空表示立即生效
+	TemplateId    int64    `json:"templateId,optional"`                  // 短链接模板ID，模板为未填写的字段提供默认值
 }
 
 type BatchCreateLinkResp struct {
@@ -40,19 +67,137 @@ type BrowserStat struct {
 	Ratio   float64 `json:"ratio"`   // 比例
 }
 
+type BulkActionItem struct {
+	FullShortUrl string `json:"fullShortUrl"` // 完整短链接
+	Success      bool   `json:"success"`      // 是否成功
+	Reason       string `json:"reason"`       // 失败原因
+}
+
+type BulkActionReq struct {
+	FullShortUrls []string `json:"fullShortUrls,optional"`     // 短链接列表，单次最多1000个，可跨分组
+	Gid           string   `json:"gid,optional"`               // 分组标识，按条件筛选时必填
+	CreateStart   string   `json:"createStart,optional"`       // 创建时间起始（ISO-8601格式）
+	CreateEnd     string   `json:"createEnd,optional"`         // 创建时间截止（ISO-8601格式）
+	Tag           string   `json:"tag,optional"`               // 标签
+	Action        string   `json:"action" validate:"required"` // 操作类型 enable/disable/recycle/set_expiry/set_describe
+	ValidDate     string   `json:"validDate,optional"`         // 有效期（ISO-8601格式），set_expiry时使用，为空表示永久有效
+	Describe      string   `json:"describe,optional"`          // 描述，set_describe时使用
+}
+
+type BulkActionResp struct {
+	Total     int              `json:"total"`     // 选中的短链接数
+	Succeeded int              `json:"succeeded"` // 成功数
+	Failed    int              `json:"failed"`    // 失败数
+	Results   []BulkActionItem `json:"results"`   // 每个短链接的结果
+}
+
+type CancelGroupTransferReq struct {
+	Id int64 `json:"id" validate:"required"` // 转让记录ID
+}
+
+type ChannelStat struct {
+	Channel string `json:"channel"` // 访问渠道 direct：直接访问 qr：扫描二维码
+	Pv      int64  `json:"pv"`      // 访问量
+	Uv      int64  `json:"uv"`      // 独立访客数
+}
+
+type CloneLinkReq struct {
+	FullShortUrl string `json:"fullShortUrl" validate:"required"` // 源短链接
+	Gid          string `json:"gid" validate:"required"`          // 源短链接分组标识
+	TargetGid    string `json:"targetGid,optional"`               // 新短链接的分组标识，为空时与源短链接相同
+	CustomUri    string `json:"customUri,optional"`               // 新短链接的自定义后缀，为空时自动生成
+	Describe     string `json:"describe,optional"`                // 新短链接的描述，为空时使用源短链接的描述
+}
+
+type CreateGroupTransferReq struct {
+	Gid        string `json:"gid" validate:"required"`        // 分组标识
+	ToUsername string `json:"toUsername" validate:"required"` // 接收用户名
+}
+
+type CreateGroupTransferResp struct {
+	Id int64 `json:"id"` // 转让记录ID
+}
+
 type CreateLinkReq struct {
-	OriginUrl     string `json:"originUrl" validate:"required"` // 原始URL
-	Gid           string `json:"gid" validate:"required"`       // 分组标识
-	CreatedType   int    `json:"createdType,default=0"`         // 创建类型 0:接口创建 1:控制台创建
-	ValidDateType int    `json:"validDateType"`                 // 有效期类型 0:永久有效 1:自定义
-	ValidDate     string `json:"validDate,optional"`            // 有效日期
-	Describe      string `json:"describe,optional"`             // 描述
+	OriginUrl           string        `json:"originUrl" validate:"required"` // 原始URL
+	Gid                 string        `json:"gid,optional"`                  // 分组标识，使用模板时为空表示使用模板的分组
+	CreatedType         int           `json:"createdType,default=0"`         // 创建类型 0:接口创建 1:控制台创建
+	ValidDateType       int           `json:"validDateType"`                 // 有效期类型 0:永久有效 1:自定义
+	ValidDate           string        `json:"validDate,optional"`            // 有效日期
+	ValidFrom           string        `json:"validFrom,optional"`            // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          string        `json:"expiredUrl,optional"`           // 过期后跳转链接，为空时使用分组配置
+	ExpiredMessage      string        `json:"expiredMessage,optional"`       // 过期后提示信息，为空时使用分组配置
+	GraceDays           int           `json:"graceDays,optional"`            // 过期宽限天数，宽限期内继续跳转，0表示使用分组配置
+	RedirectType        int           `json:"redirectType,optional"`         // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string        `json:"iosDeepLink,optional"`          // iOS深度链接，自定义Scheme或通用链接
+	AndroidPackage      string        `json:"androidPackage,optional"`       // Android应用包名
+	AndroidDeepLink     string        `json:"androidDeepLink,optional"`      // Android深度链接，自定义Scheme或App Links链接
+	DeepLinkFallbackUrl string        `json:"deepLinkFallbackUrl,optional"`  // 未安装应用时的兜底链接，如应用商店或网页，为空时使用原始链接
+	OgTitle             string        `json:"ogTitle,optional"`              // 社交分享预览标题，为空时使用目标页面标题
+	OgDescription       string        `json:"ogDescription,optional"`        // 社交分享预览描述，为空时使用目标页面描述
+	OgImage             string        `json:"ogImage,optional"`              // 社交分享预览图片，为空时使用目标页面图片
+	Describe            string        `json:"describe,optional"`             // 描述
+	CustomUri           string        `json:"customUri,optional"`            // 自定义短链接后缀
+	Password            string        `json:"password,optional"`             // 访问密码
+	MaxClicks           int           `json:"maxClicks,optional"`            // 最大访问次数，0表示不限制
+	Variants            []LinkVariant `json:"variants,optional"`             // A/B分流目标链接
+	QueryParamPolicy    int           `json:"queryParamPolicy,optional"`     // 查询参数策略 0：忽略 1：透传 2：合并
+	Tags                []string      `json:"tags,optional"`                 // 标签，最多10个
+	UtmSource           string        `json:"utmSource,optional"`            // utm_source模板，支持{shortUri}、{os}、{variant}占位符
+	UtmMedium           string        `json:"utmMedium,optional"`            // utm_medium模板
+	UtmCampaign         string        `json:"utmCampaign,optional"`          // utm_campaign模板
+	TemplateId          int64         `json:"templateId,optional"`           // 短链接模板ID，模板为未填写的字段提供默认值
 }
 
 type CreateLinkResp struct {
-	FullShortUrl string `json:"fullShortUrl"` // 完整短链接
-	OriginUrl    string `json:"originUrl"`    // 原始URL
-	Gid          string `json:"gid"`          // 分组标识
+	FullShortUrl  string `json:"fullShortUrl"`  // 完整短链接
+	OriginUrl     string `json:"originUrl"`     // 原始URL
+	Gid           string `json:"gid"`           // 分组标识
+	PendingReview bool   `json:"pendingReview"` // 目标链接命中安全检测，已禁用并等待审核
+}
+
+type CreateLinkTemplateReq struct {
+	Name             string   `json:"name" validate:"required"`  // 模板名称，用户内唯一
+	Gid              string   `json:"gid,optional"`              // 默认分组标识
+	Domain           string   `json:"domain,optional"`           // 默认域名
+	ValidDays        int      `json:"validDays,optional"`        // 有效天数，从创建时开始计算，0表示永久有效
+	Describe         string   `json:"describe,optional"`         // 描述模板，{date}替换为创建日期，{week}替换为创建时的ISO周
+	QueryParamPolicy int      `json:"queryParamPolicy,optional"` // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource        string   `json:"utmSource,optional"`        // utm_source模板
+	UtmMedium        string   `json:"utmMedium,optional"`        // utm_medium模板
+	UtmCampaign      string   `json:"utmCampaign,optional"`      // utm_campaign模板
+	RedirectType     int      `json:"redirectType,optional"`     // 跳转类型
+	ExpiredUrl       string   `json:"expiredUrl,optional"`       // 过期后跳转链接
+	ExpiredMessage   string   `json:"expiredMessage,optional"`   // 过期后提示信息
+	GraceDays        int      `json:"graceDays,optional"`        // 过期宽限天数
+	Tags             []string `json:"tags,optional"`             // 标签，最多10个
+}
+
+type CreateLinkTemplateResp struct {
+	Id int64 `json:"id"` // 模板ID
+}
+
+type CreateRedirectRuleReq struct {
+	FullShortUrl string `json:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `json:"gid" validate:"required"`          // 分组标识
+	RuleType     string `json:"ruleType" validate:"required"`     // 规则类型 os：操作系统 province：省份 language：语言
+	RuleValue    string `json:"ruleValue" validate:"required"`    // 匹配值
+	TargetUrl    string `json:"targetUrl" validate:"required"`    // 目标链接
+	SortOrder    int    `json:"sortOrder,optional"`               // 排序，越小越优先
+}
+
+type CreateRedirectRuleResp struct {
+	Id int64 `json:"id"` // 规则ID
+}
+
+type DeleteLinkTemplateReq struct {
+	Id int64 `form:"id" validate:"required"` // 模板ID
+}
+
+type DeleteRedirectRuleReq struct {
+	Id           int64  `form:"id" validate:"required"`           // 规则ID
+	FullShortUrl string `form:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `form:"gid" validate:"required"`          // 分组标识
 }
 
 type DeviceStat struct {
@@ -61,14 +206,180 @@ type DeviceStat struct {
 	Ratio  float64 `json:"ratio"`  // 比例
 }
 
+type DownloadExportReq struct {
+	Token string `form:"token" validate:"required"` // 下载令牌
+}
+
+type ExportJobResp struct {
+	JobId         string `json:"jobId"`         // 任务ID
+	Status        string `json:"status"`        // 任务状态 running/finished/failed
+	Format        string `json:"format"`        // 文件格式
+	Total         int    `json:"total"`         // 短链接总数
+	Processed     int    `json:"processed"`     // 已导出短链接数
+	FileName      string `json:"fileName"`      // 文件名
+	FileSize      int64  `json:"fileSize"`      // 文件大小（字节）
+	DownloadToken string `json:"downloadToken"` // 下载令牌，任务完成后生成
+	DownloadUrl   string `json:"downloadUrl"`   // 下载地址，任务完成后生成
+	Error         string `json:"error"`         // 任务失败原因
+	CreateTime    string `json:"createTime"`    // 创建时间
+	UpdateTime    string `json:"updateTime"`    // 更新时间
+}
+
+type ExportShortLinkReq struct {
+	Gids              []string `json:"gids" validate:"required"`   // 分组标识列表
+	Format            string   `json:"format,optional"`            // 文件格式 csv/xlsx，默认csv
+	IncludeDailyStats bool     `json:"includeDailyStats,optional"` // 是否导出每日访问数据
+	StartDate         string   `json:"startDate,optional"`         // 每日访问数据开始日期（yyyy-MM-dd），为空时默认最近30天
+	EndDate           string   `json:"endDate,optional"`           // 每日访问数据结束日期（yyyy-MM-dd）
+}
+
+type GetExportJobReq struct {
+	JobId string `form:"jobId" validate:"required"` // 任务ID
+}
+
+type GetImportJobReq struct {
+	JobId string `form:"jobId" validate:"required"` // 任务ID
+}
+
 type GetUrlTitleReq struct {
 	Url string `form:"url" validate:"required,url"` // 目标网站地址
 }
 
+type GroupExpiryPolicyReq struct {
+	Gid string `form:"gid" validate:"required"` // 分组标识
+}
+
+type GroupExpiryPolicyResp struct {
+	Gid            string `json:"gid"`            // 分组标识
+	ExpiredUrl     string `json:"expiredUrl"`     // 过期后跳转链接
+	ExpiredMessage string `json:"expiredMessage"` // 过期后提示信息
+	GraceDays      int    `json:"graceDays"`      // 过期宽限天数
+}
+
+type GroupTransferRecord struct {
+	Id           int64  `json:"id"`           // 转让记录ID
+	Gid          string `json:"gid"`          // 分组标识
+	GroupName    string `json:"groupName"`    // 分组名称
+	FromUsername string `json:"fromUsername"` // 转出用户名
+	ToUsername   string `json:"toUsername"`   // 接收用户名
+	Status       int    `json:"status"`       // 转让状态：0待接受，1已接受，2已拒绝，3已取消
+	CreateTime   string `json:"createTime"`   // 创建时间
+	UpdateTime   string `json:"updateTime"`   // 更新时间
+}
+
+type ImportJobResp struct {
+	JobId      string            `json:"jobId"`      // 任务ID
+	Status     string            `json:"status"`     // 任务状态 running/finished/failed
+	DryRun     bool              `json:"dryRun"`     // 是否试运行
+	Total      int               `json:"total"`      // 总行数
+	Processed  int               `json:"processed"`  // 已处理行数
+	Created    int               `json:"created"`    // 创建成功行数，试运行时为通过校验的行数
+	Skipped    int               `json:"skipped"`    // 跳过行数
+	Failed     int               `json:"failed"`     // 失败行数
+	Rows       []ImportRowResult `json:"rows"`       // 行级结果
+	Error      string            `json:"error"`      // 任务失败原因
+	CreateTime string            `json:"createTime"` // 创建时间
+	UpdateTime string            `json:"updateTime"` // 更新时间
+}
+
+type ImportRowResult struct {
+	Line         int    `json:"line"`         // 文件中的行号
+	Status       string `json:"status"`       // 处理结果 created/skipped/failed，试运行时通过校验的行为created
+	Reason       string `json:"reason"`       // 跳过或失败原因
+	OriginUrl    string `json:"originUrl"`    // 目标链接
+	FullShortUrl string `json:"fullShortUrl"` // 创建的短链接
+}
+
+type ImportShortLinkReq struct {
+	Format string `form:"format,optional"` // 文件格式 csv/ndjson，默认csv
+	Gid    string `form:"gid,optional"`    // 未指定分组的行使用的分组标识
+	Domain string `form:"domain,optional"` // 短链接域名，为空时使用默认域名
+	DryRun bool   `form:"dryRun,optional"` // 是否试运行，试运行仅校验不写入
+}
+
 type LinkBaseInfo struct {
-	FullShortUrl string `json:"fullShortUrl"` // 完整短链接
-	OriginUrl    string `json:"originUrl"`    // 原始URL
-	Describe     string `json:"describe"`     // 描述
+	FullShortUrl  string `json:"fullShortUrl"`  // 完整短链接
+	OriginUrl     string `json:"originUrl"`     // 原始URL
+	Describe      string `json:"describe"`      // 描述
+	PendingReview bool   `json:"pendingReview"` // 目标链接命中安全检测，已禁用并等待审核
+}
+
+type LinkHistoryChange struct {
+	Field    string `json:"field"`    // 字段名
+	OldValue string `json:"oldValue"` // 变更前的值，访问密码以******表示已设置
+	NewValue string `json:"newValue"` // 变更后的值
+}
+
+type LinkHistoryRecord struct {
+	Id           int64               `json:"id"`           // 变更记录ID
+	FullShortUrl string              `json:"fullShortUrl"` // 完整短链接
+	Gid          string              `json:"gid"`          // 分组标识
+	Operator     string              `json:"operator"`     // 操作人
+	Action       string              `json:"action"`       // 操作类型 create/update/enable/disable/recycle/recover/rollback/purge
+	Changes      []LinkHistoryChange `json:"changes"`      // 字段变更
+	CreateTime   string              `json:"createTime"`   // 变更时间
+}
+
+type LinkTemplate struct {
+	Id               int64    `json:"id"`               // 模板ID
+	Name             string   `json:"name"`             // 模板名称
+	Gid              string   `json:"gid"`              // 默认分组标识
+	Domain           string   `json:"domain"`           // 默认域名
+	ValidDays        int      `json:"validDays"`        // 有效天数，从创建时开始计算，0表示永久有效
+	Describe         string   `json:"describe"`         // 描述模板，{date}替换为创建日期，{week}替换为创建时的ISO周
+	QueryParamPolicy int      `json:"queryParamPolicy"` // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource        string   `json:"utmSource"`        // utm_source模板
+	UtmMedium        string   `json:"utmMedium"`        // utm_medium模板
+	UtmCampaign      string   `json:"utmCampaign"`      // utm_campaign模板
+	RedirectType     int      `json:"redirectType"`     // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	ExpiredUrl       string   `json:"expiredUrl"`       // 过期后跳转链接
+	ExpiredMessage   string   `json:"expiredMessage"`   // 过期后提示信息
+	GraceDays        int      `json:"graceDays"`        // 过期宽限天数
+	Tags             []string `json:"tags"`             // 标签
+	CreateTime       string   `json:"createTime"`       // 创建时间
+	UpdateTime       string   `json:"updateTime"`       // 修改时间
+}
+
+type LinkVariant struct {
+	Name      string `json:"name,optional"` // 版本名称，如 A、B
+	TargetUrl string `json:"targetUrl"`     // 目标链接
+	Weight    int    `json:"weight"`        // 流量权重（百分比），所有版本之和为100
+}
+
+type ListGroupTransferResp struct {
+	Incoming []GroupTransferRecord `json:"incoming"` // 收到的待接受转让
+	Outgoing []GroupTransferRecord `json:"outgoing"` // 发起的待接受转让
+}
+
+type ListLinkHistoryReq struct {
+	FullShortUrl string `form:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `form:"gid" validate:"required"`          // 分组标识
+	Current      int    `form:"current,default=1"`                // 页码
+	Size         int    `form:"size,default=10"`                  // 每页大小
+}
+
+type ListLinkHistoryResp struct {
+	Records []LinkHistoryRecord `json:"records"` // 变更记录，按时间倒序
+	Total   int64               `json:"total"`   // 总数
+	Size    int                 `json:"size"`    // 每页大小
+	Current int                 `json:"current"` // 当前页
+}
+
+type ListLinkTemplateResp struct {
+	Templates []LinkTemplate `json:"templates"` // 当前用户的模板列表，按创建顺序排列
+}
+
+type ListRedirectRuleReq struct {
+	FullShortUrl string `form:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `form:"gid" validate:"required"`          // 分组标识
+}
+
+type ListRedirectRuleResp struct {
+	Rules []RedirectRule `json:"rules"` // 按优先级排序的规则列表
+}
+
+type ListUserDomainResp struct {
+	Domains []UserDomain `json:"domains"` // 域名列表
 }
 
 type LocaleCnStat struct {
@@ -77,6 +388,52 @@ type LocaleCnStat struct {
 	Ratio  float64 `json:"ratio"`  // 比例
 }
 
+type ModerationFlagReq struct {
+	FullShortUrl string `json:"fullShortUrl" validate:"required"` // 完整短链接
+	Reason       string `json:"reason" validate:"required"`       // 标记原因
+}
+
+type ModerationPageReq struct {
+	Status  int `form:"status,default=0"`  // 审核状态：0待审核，1已通过，2已拒绝
+	Current int `form:"current,default=1"` // 当前页码
+	Size    int `form:"size,default=10"`   // 每页大小
+}
+
+type ModerationPageResp struct {
+	Records []ModerationRecord `json:"records"` // 审核记录列表
+	Total   int64              `json:"total"`   // 总记录数
+	Size    int                `json:"size"`    // 每页大小
+	Current int                `json:"current"` // 当前页码
+}
+
+type ModerationRecord struct {
+	Id           int64  `json:"id"`           // 审核记录ID
+	Gid          string `json:"gid"`          // 分组标识
+	FullShortUrl string `json:"fullShortUrl"` // 完整短链接
+	OriginUrl    string `json:"originUrl"`    // 原始链接
+	Provider     string `json:"provider"`     // 检测来源 blocklist/redis/http/manual
+	Reason       string `json:"reason"`       // 命中原因
+	Status       int    `json:"status"`       // 审核状态：0待审核，1已通过，2已拒绝
+	Reviewer     string `json:"reviewer"`     // 审核人
+	CreateTime   string `json:"createTime"`   // 创建时间
+	UpdateTime   string `json:"updateTime"`   // 更新时间
+}
+
+type ModerationReviewReq struct {
+	Id      int64 `json:"id" validate:"required"` // 审核记录ID
+	Approve bool  `json:"approve"`                // 是否通过：true通过并恢复短链接，false拒绝并禁用短链接
+}
+
+type MoveShortLinkReq struct {
+	FullShortUrls []string `json:"fullShortUrls" validate:"required"` // 待移动的完整短链接列表，单次最多100个
+	OriginGid     string   `json:"originGid" validate:"required"`     // 原分组标识
+	TargetGid     string   `json:"targetGid" validate:"required"`     // 目标分组标识
+}
+
+type MoveShortLinkResp struct {
+	Moved int `json:"moved"` // 移动的短链接数量
+}
+
 type NetworkStat struct {
 	Network string  `json:"network"` // 网络类型
 	Cnt     int64   `json:"cnt"`     // 数量
@@ -90,9 +447,11 @@ type OsStat struct {
 }
 
 type PageLinkReq struct {
-	Current int    `form:"current,default=1"`       // 页码
-	Size    int    `form:"size,default=10"`         // 每页大小
-	Gid     string `form:"gid" validate:"required"` // 分组标识
+	Current    int    `form:"current,default=1"`       // 页码
+	Size       int    `form:"size,default=10"`         // 每页大小
+	Gid        string `form:"gid" validate:"required"` // 分组标识
+	BrokenOnly bool   `form:"brokenOnly,optional"`     // 只查询目标链接已失效的短链接
+	OrderTag   string `form:"orderTag,optional"`       // 排序字段 todayPv/todayUv/todayUip/totalPv/totalUv，均按降序排列
 }
 
 type PageLinkResp struct {
@@ -127,6 +486,64 @@ type RecycleBinPageResp struct {
 	Current int                      `json:"current"` // 当前页码
 }
 
+type RedirectRule struct {
+	Id           int64  `json:"id"`           // 规则ID
+	FullShortUrl string `json:"fullShortUrl"` // 完整短链接
+	Gid          string `json:"gid"`          // 分组标识
+	RuleType     string `json:"ruleType"`     // 规则类型 os：操作系统 province：省份 language：语言
+	RuleValue    string `json:"ruleValue"`    // 匹配值
+	TargetUrl    string `json:"targetUrl"`    // 目标链接
+	SortOrder    int    `json:"sortOrder"`    // 排序，越小越优先
+	CreateTime   string `json:"createTime"`   // 创建时间
+}
+
+type RegisterUserDomainReq struct {
+	Domain string `json:"domain" validate:"required"` // 自定义域名
+}
+
+type RespondGroupTransferReq struct {
+	Id     int64 `json:"id" validate:"required"` // 转让记录ID
+	Accept bool  `json:"accept"`                 // 是否接受：true接受后分组归属变更，false拒绝
+}
+
+type RollbackLinkReq struct {
+	FullShortUrl string `json:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `json:"gid" validate:"required"`          // 分组标识
+	HistoryId    int64  `json:"historyId" validate:"required"`    // 变更记录ID
+}
+
+type RollbackLinkResp struct {
+	History *LinkHistoryRecord `json:"history"` // 回滚产生的变更记录，属性没有变化时为空
+}
+
+type SaveDomainAppLinksReq struct {
+	Domain                  string   `json:"domain" validate:"required"`       // 已验证的自定义域名
+	AppleAppIds             []string `json:"appleAppIds,optional"`             // iOS应用标识列表（TeamID.BundleID）
+	AndroidPackage          string   `json:"androidPackage,optional"`          // Android应用包名
+	AndroidCertFingerprints []string `json:"androidCertFingerprints,optional"` // Android应用签名证书SHA256指纹列表
+}
+
+type SaveGroupExpiryPolicyReq struct {
+	Gid            string `json:"gid" validate:"required"` // 分组标识
+	ExpiredUrl     string `json:"expiredUrl,optional"`     // 过期后跳转链接，为空表示不跳转
+	ExpiredMessage string `json:"expiredMessage,optional"` // 过期后提示信息，为空表示使用默认提示
+	GraceDays      int    `json:"graceDays,optional"`      // 过期宽限天数，0表示不设宽限期
+}
+
+type SearchLinkReq struct {
+	Keyword     string   `json:"keyword,optional"`     // 关键字
+	Tag         string   `json:"tag,optional"`         // 标签
+	Gids        []string `json:"gids,optional"`        // 分组标识列表，为空表示全部分组
+	Status      string   `json:"status,optional"`      // 启用状态 enabled/disabled，为空表示全部
+	Expiry      string   `json:"expiry,optional"`      // 有效期状态 valid/expired，为空表示全部
+	CreateStart string   `json:"createStart,optional"` // 创建时间起始（ISO-8601格式）
+	CreateEnd   string   `json:"createEnd,optional"`   // 创建时间截止（ISO-8601格式）
+	OrderBy     string   `json:"orderBy,optional"`     // 排序字段 create_time/total_pv/today_pv，默认create_time
+	Asc         bool     `json:"asc,optional"`         // 是否升序，默认降序
+	Current     int      `json:"current,default=1"`    // 页码，最多查看前1000条
+	Size        int      `json:"size,default=10"`      // 每页大小
+}
+
 type ShortLinkAccessRecordReq struct {
 	FullShortUrl string `form:"fullShortUrl" validate:"required"` // 完整短链接
 	Gid          string `form:"gid" validate:"required"`          // 分组标识
@@ -176,45 +593,96 @@ type ShortLinkGroupUpdateReq struct {
 }
 
 type ShortLinkPageRecordDTO struct {
-	Id            int64  `json:"id"`            // 短链ID
-	Domain        string `json:"domain"`        // 域名
-	ShortUri      string `json:"shortUri"`      // 短链接URI
-	FullShortUrl  string `json:"fullShortUrl"`  // 完整短链接
-	OriginUrl     string `json:"originUrl"`     // 原始链接
-	Gid           string `json:"gid"`           // 分组标识
-	ValidDateType int    `json:"validDateType"` // 有效期类型：0永久有效，1自定义
-	ValidDate     string `json:"validDate"`     // 有效期
-	CreateTime    string `json:"createTime"`    // 创建时间
-	Describe      string `json:"describe"`      // 描述
-	Favicon       string `json:"favicon"`       // 网站图标
-	EnableStatus  int    `json:"enableStatus"`  // 启用状态：0启用，1未启用
-	TotalPv       int64  `json:"totalPv"`       // 总访问量
-	TodayPv       int64  `json:"todayPv"`       // 今日访问量
-	TotalUv       int64  `json:"totalUv"`       // 总独立访客数
-	TodayUv       int64  `json:"todayUv"`       // 今日独立访客数
-	TotalUip      int64  `json:"totalUip"`      // 总IP数
-	TodayUip      int64  `json:"todayUip"`      // 今日IP数
+	Id                  int64  `json:"id"`                  // 短链ID
+	Domain              string `json:"domain"`              // 域名
+	ShortUri            string `json:"shortUri"`            // 短链接URI
+	FullShortUrl        string `json:"fullShortUrl"`        // 完整短链接
+	OriginUrl           string `json:"originUrl"`           // 原始链接
+	Gid                 string `json:"gid"`                 // 分组标识
+	ValidDateType       int    `json:"validDateType"`       // 有效期类型：0永久有效，1自定义
+	ValidDate           string `json:"validDate"`           // 有效期
+	ValidFrom           string `json:"validFrom"`           // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          string `json:"expiredUrl"`          // 过期后跳转链接
+	ExpiredMessage      string `json:"expiredMessage"`      // 过期后提示信息
+	GraceDays           int    `json:"graceDays"`           // 过期宽限天数
+	RedirectType        int    `json:"redirectType"`        // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string `json:"iosDeepLink"`         // iOS深度链接
+	AndroidPackage      string `json:"androidPackage"`      // Android应用包名
+	AndroidDeepLink     string `json:"androidDeepLink"`     // Android深度链接
+	DeepLinkFallbackUrl string `json:"deepLinkFallbackUrl"` // 未安装应用时的兜底链接
+	OgTitle             string `json:"ogTitle"`             // 社交分享预览标题
+	OgDescription       string `json:"ogDescription"`       // 社交分享预览描述
+	OgImage             string `json:"ogImage"`             // 社交分享预览图片
+	CreateTime          string `json:"createTime"`          // 创建时间
+	Describe            string `json:"describe"`            // 描述
+	Favicon             string `json:"favicon"`             // 网站图标
+	EnableStatus        int    `json:"enableStatus"`        // 启用状态：0启用，1未启用
+	SafetyStatus        int    `json:"safetyStatus"`        // 安全状态：0正常，1待审核，2风险提示，3审核未通过
+	TotalPv             int64  `json:"totalPv"`             // 总访问量
+	TodayPv             int64  `json:"todayPv"`             // 今日访问量
+	TotalUv             int64  `json:"totalUv"`             // 总独立访客数
+	TodayUv             int64  `json:"todayUv"`             // 今日独立访客数
+	TotalUip            int64  `json:"totalUip"`            // 总IP数
+	TodayUip            int64  `json:"todayUip"`            // 今日IP数
+}
+
+type ShortLinkQrCodeReq struct {
+	FullShortUrl    string `form:"fullShortUrl" validate:"required"` // 完整短链接
+	Size            int    `form:"size,optional"`                    // 图片边长（像素），默认256
+	Format          string `form:"format,optional"`                  // 图片格式 png/svg，默认png
+	Ecc             string `form:"ecc,optional"`                     // 纠错等级 L/M/Q/H，默认M，设置中心图标时使用H
+	Margin          int    `form:"margin,default=4"`                 // 静默区宽度（模块数）
+	ForegroundColor string `form:"foregroundColor,optional"`         // 前景色，如 #000000
+	BackgroundColor string `form:"backgroundColor,optional"`         // 背景色，如 #FFFFFF
+	LogoUrl         string `form:"logoUrl,optional"`                 // 中心图标链接
 }
 
 type ShortLinkRecord struct {
-	Id            int64  `json:"id"`            // 短链ID
-	Domain        string `json:"domain"`        // 域名
-	ShortUri      string `json:"shortUri"`      // 短链接URI
-	FullShortUrl  string `json:"fullShortUrl"`  // 完整短链接
-	OriginUrl     string `json:"originUrl"`     // 原始链接
-	Gid           string `json:"gid"`           // 分组标识
-	ValidDateType int    `json:"validDateType"` // 有效期类型：0永久有效，1自定义
-	ValidDate     string `json:"validDate"`     // 有效期
-	CreateTime    string `json:"createTime"`    // 创建时间
-	Describe      string `json:"describe"`      // 描述
-	Favicon       string `json:"favicon"`       // 网站图标
-	EnableStatus  int    `json:"enableStatus"`  // 启用状态：0启用，1未启用
-	TotalPv       int64  `json:"totalPv"`       // 总访问量
-	TodayPv       int64  `json:"todayPv"`       // 今日访问量
-	TotalUv       int64  `json:"totalUv"`       // 总独立访客数
-	TodayUv       int64  `json:"todayUv"`       // 今日独立访客数
-	TotalUip      int64  `json:"totalUip"`      // 总IP数
-	TodayUip      int64  `json:"todayUip"`      // 今日IP数
+	Id                  int64    `json:"id"`                  // 短链ID
+	Domain              string   `json:"domain"`              // 域名
+	ShortUri            string   `json:"shortUri"`            // 短链接URI
+	FullShortUrl        string   `json:"fullShortUrl"`        // 完整短链接
+	OriginUrl           string   `json:"originUrl"`           // 原始链接
+	Gid                 string   `json:"gid"`                 // 分组标识
+	ValidDateType       int      `json:"validDateType"`       // 有效期类型：0永久有效，1自定义
+	ValidDate           string   `json:"validDate"`           // 有效期
+	ValidFrom           string   `json:"validFrom"`           // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          string   `json:"expiredUrl"`          // 过期后跳转链接
+	ExpiredMessage      string   `json:"expiredMessage"`      // 过期后提示信息
+	GraceDays           int      `json:"graceDays"`           // 过期宽限天数
+	RedirectType        int      `json:"redirectType"`        // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         string   `json:"iosDeepLink"`         // iOS深度链接
+	AndroidPackage      string   `json:"androidPackage"`      // Android应用包名
+	AndroidDeepLink     string   `json:"androidDeepLink"`     // Android深度链接
+	DeepLinkFallbackUrl string   `json:"deepLinkFallbackUrl"` // 未安装应用时的兜底链接
+	OgTitle             string   `json:"ogTitle"`             // 社交分享预览标题
+	OgDescription       string   `json:"ogDescription"`       // 社交分享预览描述
+	OgImage             string   `json:"ogImage"`             // 社交分享预览图片
+	InGracePeriod       bool     `json:"inGracePeriod"`       // 是否已过期但处于宽限期内
+	CreateTime          string   `json:"createTime"`          // 创建时间
+	Describe            string   `json:"describe"`            // 描述
+	Favicon             string   `json:"favicon"`             // 网站图标
+	EnableStatus        int      `json:"enableStatus"`        // 启用状态：0启用，1未启用
+	SafetyStatus        int      `json:"safetyStatus"`        // 安全状态：0正常，1待审核，2风险提示，3审核未通过
+	TotalPv             int64    `json:"totalPv"`             // 总访问量
+	TodayPv             int64    `json:"todayPv"`             // 今日访问量
+	TotalUv             int64    `json:"totalUv"`             // 总独立访客数
+	TodayUv             int64    `json:"todayUv"`             // 今日独立访客数
+	TotalUip            int64    `json:"totalUip"`            // 总IP数
+	TodayUip            int64    `json:"todayUip"`            // 今日IP数
+	MaxClicks           int      `json:"maxClicks"`           // 最大访问次数，0表示不限制
+	ClickNum            int      `json:"clickNum"`            // 已访问次数
+	QueryParamPolicy    int      `json:"queryParamPolicy"`    // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource           string   `json:"utmSource"`           // utm_source模板
+	UtmMedium           string   `json:"utmMedium"`           // utm_medium模板
+	UtmCampaign         string   `json:"utmCampaign"`         // utm_campaign模板
+	HealthStatus        int      `json:"healthStatus"`        // 目标链接健康状态：0未检测，1正常，2失效
+	HealthCode          int      `json:"healthCode"`          // 最近一次检测的HTTP状态码，请求失败时为0
+	HealthLatency       int      `json:"healthLatency"`       // 最近一次检测的响应耗时（毫秒）
+	HealthRedirects     []string `json:"healthRedirects"`     // 最近一次检测的重定向链路
+	HealthError         string   `json:"healthError"`         // 最近一次检测的失败原因
+	HealthCheckTime     string   `json:"healthCheckTime"`     // 最近一次检测时间，为空表示未检测
+	Tags                []string `json:"tags"`                // 标签
 }
 
 type ShortLinkRedirectReq struct {
@@ -241,6 +709,13 @@ type ShortLinkStatsRespDTO struct {
 	UvTypeStats         []UvTypeStat   `json:"uvTypeStats"`         // 访客类型统计
 	DeviceStats         []DeviceStat   `json:"deviceStats"`         // 设备统计
 	NetworkStats        []NetworkStat  `json:"networkStats"`        // 网络统计
+	VariantStats        []VariantStat  `json:"variantStats"`        // A/B分流版本统计
+	ChannelStats        []ChannelStat  `json:"channelStats"`        // 访问渠道统计
+}
+
+type ShortLinkUnlockReq struct {
+	ShortUri string `path:"short_uri"` // 短链接URI
+	Password string `form:"password"`  // 访问密码
 }
 
 type SortGroup struct {
@@ -260,13 +735,64 @@ type TopIpStat struct {
 }
 
 type UpdateLinkReq struct {
-	FullShortUrl  string `json:"fullShortUrl" validate:"required"` // 完整短链接
-	OriginGid     string `json:"originGid" validate:"required"`    // 原始分组标识
-	Gid           string `json:"gid" validate:"required"`          // 新分组标识
-	OriginUrl     string `json:"originUrl" validate:"required"`    // 原始URL
-	Describe      string `json:"describe,optional"`                // 描述
-	ValidDateType int    `json:"validDateType"`                    // 有效期类型
-	ValidDate     string `json:"validDate,optional"`               // 有效日期
+	FullShortUrl        string        `json:"fullShortUrl" validate:"required"` // 完整短链接
+	OriginGid           string        `json:"originGid" validate:"required"`    // 原始分组标识
+	Gid                 string        `json:"gid" validate:"required"`          // 新分组标识
+	OriginUrl           string        `json:"originUrl" validate:"required"`    // 原始URL
+	Describe            string        `json:"describe,optional"`                // 描述
+	ValidDateType       int           `json:"validDateType"`                    // 有效期类型
+	ValidDate           string        `json:"validDate,optional"`               // 有效日期
+	ValidFrom           *string       `json:"validFrom,optional"`               // 生效时间（ISO-8601格式），为空表示立即生效
+	ExpiredUrl          *string       `json:"expiredUrl,optional"`              // 过期后跳转链接，为空时使用分组配置
+	ExpiredMessage      *string       `json:"expiredMessage,optional"`          // 过期后提示信息，为空时使用分组配置
+	GraceDays           *int          `json:"graceDays,optional"`               // 过期宽限天数，宽限期内继续跳转，0表示使用分组配置
+	RedirectType        *int          `json:"redirectType,optional"`            // 跳转类型 0：302临时重定向 1：301永久重定向 2：307临时重定向 3：中间页倒计时跳转
+	IosDeepLink         *string       `json:"iosDeepLink,optional"`             // iOS深度链接，自定义Scheme或通用链接
+	AndroidPackage      *string       `json:"androidPackage,optional"`          // Android应用包名
+	AndroidDeepLink     *string       `json:"androidDeepLink,optional"`         // Android深度链接，自定义Scheme或App Links链接
+	DeepLinkFallbackUrl *string       `json:"deepLinkFallbackUrl,optional"`     // 未安装应用时的兜底链接，如应用商店或网页，为空时使用原始链接
+	OgTitle             *string       `json:"ogTitle,optional"`                 // 社交分享预览标题，为空时使用目标页面标题
+	OgDescription       *string       `json:"ogDescription,optional"`           // 社交分享预览描述，为空时使用目标页面描述
+	OgImage             *string       `json:"ogImage,optional"`                 // 社交分享预览图片，为空时使用目标页面图片
+	Password            string        `json:"password,optional"`                // 访问密码，为空表示不修改
+	ClearPassword       bool          `json:"clearPassword,optional"`           // 是否清除访问密码
+	MaxClicks           *int          `json:"maxClicks,optional"`               // 最大访问次数，0表示不限制
+	Variants            []LinkVariant `json:"variants,optional"`                // A/B分流目标链接，为空表示不修改
+	ClearVariants       bool          `json:"clearVariants,optional"`           // 是否清除A/B分流
+	QueryParamPolicy    *int          `json:"queryParamPolicy,optional"`        // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource           *string       `json:"utmSource,optional"`               // utm_source模板，为空表示不追加
+	UtmMedium           *string       `json:"utmMedium,optional"`               // utm_medium模板，为空表示不追加
+	UtmCampaign         *string       `json:"utmCampaign,optional"`             // utm_campaign模板，为空表示不追加
+	Tags                []string      `json:"tags,optional"`                    // 标签，为空表示不修改
+	ClearTags           bool          `json:"clearTags,optional"`               // 是否清除标签
+}
+
+type UpdateLinkTemplateReq struct {
+	Id               int64    `json:"id" validate:"required"`    // 模板ID
+	Name             string   `json:"name" validate:"required"`  // 模板名称，用户内唯一
+	Gid              string   `json:"gid,optional"`              // 默认分组标识
+	Domain           string   `json:"domain,optional"`           // 默认域名
+	ValidDays        int      `json:"validDays,optional"`        // 有效天数，从创建时开始计算，0表示永久有效
+	Describe         string   `json:"describe,optional"`         // 描述模板，{date}替换为创建日期，{week}替换为创建时的ISO周
+	QueryParamPolicy int      `json:"queryParamPolicy,optional"` // 查询参数策略 0：忽略 1：透传 2：合并
+	UtmSource        string   `json:"utmSource,optional"`        // utm_source模板
+	UtmMedium        string   `json:"utmMedium,optional"`        // utm_medium模板
+	UtmCampaign      string   `json:"utmCampaign,optional"`      // utm_campaign模板
+	RedirectType     int      `json:"redirectType,optional"`     // 跳转类型
+	ExpiredUrl       string   `json:"expiredUrl,optional"`       // 过期后跳转链接
+	ExpiredMessage   string   `json:"expiredMessage,optional"`   // 过期后提示信息
+	GraceDays        int      `json:"graceDays,optional"`        // 过期宽限天数
+	Tags             []string `json:"tags,optional"`             // 标签，最多10个
+}
+
+type UpdateRedirectRuleReq struct {
+	Id           int64  `json:"id" validate:"required"`           // 规则ID
+	FullShortUrl string `json:"fullShortUrl" validate:"required"` // 完整短链接
+	Gid          string `json:"gid" validate:"required"`          // 分组标识
+	RuleType     string `json:"ruleType" validate:"required"`     // 规则类型 os：操作系统 province：省份 language：语言
+	RuleValue    string `json:"ruleValue" validate:"required"`    // 匹配值
+	TargetUrl    string `json:"targetUrl" validate:"required"`    // 目标链接
+	SortOrder    int    `json:"sortOrder,optional"`               // 排序，越小越优先
 }
 
 type UrlTitleResp struct {
@@ -288,6 +814,14 @@ type UserCheckUsernameResp struct {
 	Code    string `json:"code"`    // 响应码
 }
 
+type UserDomain struct {
+	Domain       string `json:"domain"`                 // 域名
+	VerifyStatus int32  `json:"verifyStatus"`           // 验证状态 0：待验证 1：已验证
+	VerifyRecord string `json:"verifyRecord,omitempty"` // 待配置的TXT记录名称，已验证时为空
+	VerifyValue  string `json:"verifyValue,omitempty"`  // 待配置的TXT记录值，已验证时为空
+	CreateTime   string `json:"createTime"`             // 添加时间
+}
+
 type UserInfoResp struct {
 	Id         int64  `json:"id"`         // 用户ID
 	Username   string `json:"username"`   // 用户名
@@ -350,3 +884,15 @@ type UvTypeStat struct {
 	Cnt    int64   `json:"cnt"`    // 数量
 	Ratio  float64 `json:"ratio"`  // 比例
 }
+
+type VariantStat struct {
+	Variant   string `json:"variant"`   // 版本名称
+	TargetUrl string `json:"targetUrl"` // 目标链接
+	Weight    int    `json:"weight"`    // 流量权重
+	Pv        int64  `json:"pv"`        // 访问量
+	Uv        int64  `json:"uv"`        // 独立访客数
+}
+
+type VerifyUserDomainReq struct {
+	Domain string `json:"domain" validate:"required"` // 自定义域名
+}
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+)
+
+// AndroidStoreUrl 生成Google Play应用详情页链接
+func AndroidStoreUrl(pkg string) string {
+	return "https://play.google.com/store/apps/details?id=" + url.QueryEscape(pkg)
+}
+
+// BuildAndroidIntentUrl 生成Android intent链接，应用未安装时由浏览器跳转到兜底链接
+// 未配置深度链接时使用启动入口打开指定包名的应用
+func BuildAndroidIntentUrl(deepLink, pkg, fallbackUrl string) string {
+	var b strings.Builder
+	b.WriteString("intent://")
+
+	if deepLink != "" {
+		if u, err := url.Parse(deepLink); err == nil && u.Scheme != "" {
+			rest := strings.TrimPrefix(deepLink[len(u.Scheme)+1:], "//")
+			// intent链接使用锚点传递参数，深度链接自身的锚点无法保留
+			if i := strings.Index(rest, "#"); i >= 0 {
+				rest = rest[:i]
+			}
+			b.WriteString(rest)
+			b.WriteString("#Intent;scheme=")
+			b.WriteString(u.Scheme)
+			b.WriteString(";")
+		}
+	}
+	if !strings.Contains(b.String(), "#Intent;") {
+		b.WriteString("#Intent;action=android.intent.action.MAIN;category=android.intent.category.LAUNCHER;")
+	}
+
+	if pkg != "" {
+		b.WriteString("package=")
+		b.WriteString(pkg)
+		b.WriteString(";")
+	}
+	if fallbackUrl != "" {
+		b.WriteString("S.browser_fallback_url=")
+		b.WriteString(url.QueryEscape(fallbackUrl))
+		b.WriteString(";")
+	}
+	b.WriteString("end")
+	return b.String()
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"net"
 	"net/http"
 	"strings"
 )
@@ -39,3 +40,57 @@ func GetClientIP(r *http.Request) string {
 
 	return "unknown"
 }
+
+// GetTrustedClientIP 获取用于限流的客户端IP
+// 只有直连地址属于受信任的反向代理时才读取转发头，避免客户端伪造X-Forwarded-For绕过限制
+func GetTrustedClientIP(r *http.Request, trustedProxies []string) string {
+	remoteIP := getRemoteIP(r)
+	if !isTrustedProxy(remoteIP, trustedProxies) {
+		return remoteIP
+	}
+
+	// 从右向左取第一个非受信任代理的地址，左侧的地址可能由客户端伪造
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ips := strings.Split(forwarded, ",")
+		for i := len(ips) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(ips[i])
+			if ip != "" && !isTrustedProxy(ip, trustedProxies) {
+				return ip
+			}
+		}
+	}
+
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	return remoteIP
+}
+
+// getRemoteIP 获取直连地址，去除端口号
+func getRemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// isTrustedProxy 判断地址是否属于受信任的反向代理，支持IP和CIDR
+func isTrustedProxy(ip string, trustedProxies []string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range trustedProxies {
+		if strings.Contains(proxy, "/") {
+			if _, network, err := net.ParseCIDR(proxy); err == nil && network.Contains(parsed) {
+				return true
+			}
+			continue
+		}
+		if proxyIP := net.ParseIP(proxy); proxyIP != nil && proxyIP.Equal(parsed) {
+			return true
+		}
+	}
+	return false
+}
@@ -90,3 +90,12 @@ func GetIPLocation(ip string) (string, error) {
 
 	return location, nil
 }
+
+// ProvinceFromLocale 从地理位置字符串（格式为 省份-城市）中提取省份，无法识别时返回空字符串
+func ProvinceFromLocale(locale string) string {
+	switch locale {
+	case "", "本地", "未知", "未知地区":
+		return ""
+	}
+	return strings.SplitN(locale, "-", 2)[0]
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTrustedClientIP(t *testing.T) {
+	trustedProxies := []string{"127.0.0.1", "10.0.0.0/8"}
+
+	t.Run("非受信任来源忽略转发头", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/unlock", nil)
+		r.RemoteAddr = "203.0.113.7:52100"
+		r.Header.Set("X-Forwarded-For", "1.2.3.4")
+		r.Header.Set("X-Real-IP", "5.6.7.8")
+		assert.Equal(t, "203.0.113.7", GetTrustedClientIP(r, trustedProxies))
+	})
+
+	t.Run("受信任代理取最右侧的非代理地址", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/unlock", nil)
+		r.RemoteAddr = "127.0.0.1:40000"
+		r.Header.Set("X-Forwarded-For", "1.2.3.4, 198.51.100.9, 10.1.2.3")
+		assert.Equal(t, "198.51.100.9", GetTrustedClientIP(r, trustedProxies))
+	})
+
+	t.Run("受信任代理未携带转发头时使用直连地址", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/unlock", nil)
+		r.RemoteAddr = "[::1]:40000"
+		assert.Equal(t, "::1", GetTrustedClientIP(r, []string{"::1"}))
+	})
+}
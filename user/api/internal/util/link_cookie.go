@@ -0,0 +1,5 @@
+package util
+
+// LinkUnlockCookieName 短链接密码解锁Cookie名称，Cookie的Path限定为对应短链接
+// Cookie的值为链接服务签发的解锁令牌，签名和有效期由链接服务校验
+const LinkUnlockCookieName = "short_link_unlock"
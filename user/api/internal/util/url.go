@@ -0,0 +1,16 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+)
+
+// IsHttpUrl 判断链接是否为带主机名的http(s)链接，用于拒绝javascript:等可执行脚本的链接
+func IsHttpUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	return scheme == "http" || scheme == "https"
+}
@@ -10,6 +10,15 @@ type UserAgentInfo struct {
 	OS          string // 操作系统
 	Device      string // 设备类型
 	DeviceModel string // 设备型号
+	IsCrawler   bool   // 是否为爬虫，如社交平台的链接预览抓取
+}
+
+// crawlerKeywords 爬虫User-Agent关键字（小写）
+var crawlerKeywords = []string{
+	"facebookexternalhit", "facebot", "slackbot", "twitterbot", "linkedinbot",
+	"discordbot", "telegrambot", "whatsapp", "skypeuripreview", "pinterest",
+	"redditbot", "embedly", "googlebot", "bingbot", "baiduspider", "bytespider",
+	"yandexbot", "applebot", "wechatbot", "spider", "crawler",
 }
 
 // ParseUserAgent 解析User-Agent字符串
@@ -27,6 +36,14 @@ func ParseUserAgent(userAgent string) *UserAgentInfo {
 
 	ua := strings.ToLower(userAgent)
 
+	// 识别爬虫
+	for _, keyword := range crawlerKeywords {
+		if strings.Contains(ua, keyword) {
+			info.IsCrawler = true
+			break
+		}
+	}
+
 	// 解析浏览器信息
 	switch {
 	case strings.Contains(ua, "chrome"):
@@ -44,13 +61,8 @@ func ParseUserAgent(userAgent string) *UserAgentInfo {
 	}
 
 	// 解析操作系统信息
+	// 移动端需要先判断：Android的UA包含linux，iOS的UA包含like mac os x
 	switch {
-	case strings.Contains(ua, "windows"):
-		info.OS = "Windows"
-	case strings.Contains(ua, "macintosh") || strings.Contains(ua, "mac os x"):
-		info.OS = "macOS"
-	case strings.Contains(ua, "linux"):
-		info.OS = "Linux"
 	case strings.Contains(ua, "android"):
 		info.OS = "Android"
 		info.Device = "Mobile"
@@ -64,6 +76,12 @@ func ParseUserAgent(userAgent string) *UserAgentInfo {
 		} else {
 			info.Device = "iPod"
 		}
+	case strings.Contains(ua, "windows"):
+		info.OS = "Windows"
+	case strings.Contains(ua, "macintosh") || strings.Contains(ua, "mac os x"):
+		info.OS = "macOS"
+	case strings.Contains(ua, "linux"):
+		info.OS = "Linux"
 	}
 
 	// 如果没有检测到移动设备，则判断为桌面设备